@@ -1,12 +1,37 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/events"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/achievement"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
+	mapuc "github.com/mvcris/maya-guessr/backend/internal/core/use_cases/map"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/notification"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/user"
 	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	pgrepositories "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/memory"
 	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/handlers"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
+)
+
+const accountDeletionInterval = time.Hour
+
+const locationDifficultyInterval = 15 * time.Minute
+
+// Names of the advisory locks held by the background jobs.
+const (
+	accountDeletionJob    = "account_deletions"
+	locationDifficultyJob = "location_difficulty"
 )
 
 func main() {
@@ -20,24 +45,157 @@ func main() {
 	}
 
 	router := gin.Default()
+	// Client IPs key the rate limits: forwarded headers are only read from the proxies listed in
+	// TRUSTED_PROXIES (comma separated IPs or CIDRs), and ignored when it is unset.
+	if err := router.SetTrustedProxies(trustedProxies()); err != nil {
+		log.Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
+
+	// rate limiting must be registered before any route; the default rule always stays in memory
+	defaultRateLimitStore := memory.NewRateLimitMemoryStore()
+	var rateLimitStore repositories.RateLimitStore
+	switch os.Getenv("RATE_LIMIT_STORE") {
+	case "", "memory":
+		rateLimitStore = defaultRateLimitStore
+	case "postgres":
+		rateLimitStore = pgrepositories.NewRateLimitPgStore(db)
+	default:
+		log.Fatalf("unknown RATE_LIMIT_STORE %q", os.Getenv("RATE_LIMIT_STORE"))
+	}
+	personalAccessTokens := auth.NewAuthenticatePersonalAccessTokenUseCase(pgrepositories.NewPersonalAccessTokenPgRepository(db), pgrepositories.NewUserPgRepository(db), services.NewPersonalAccessTokenService())
+	router.Use(middleware.RateLimitMiddleware(rateLimitStore, defaultRateLimitStore, services.NewJwtService(), personalAccessTokens, middleware.RateLimitPolicy{
+		Default: entities.RateLimitRule{Capacity: 120, RefillInterval: 500 * time.Millisecond},
+		Routes: map[string]entities.RateLimitRule{
+			"POST /auth/login":      {Capacity: 10, RefillInterval: 6 * time.Second},
+			"POST /auth/2fa/verify": {Capacity: 10, RefillInterval: 6 * time.Second},
+			"POST /users":           {Capacity: 5, RefillInterval: time.Minute},
+			// each request mails a code
+			"POST /users/me/reauthentication": {Capacity: 3, RefillInterval: time.Minute},
+		},
+	}))
+
+	// notifications are pushed to the clients connected to this instance
+	notificationBroker := memory.NewNotificationMemoryBroker()
+
+	// single player events reach their subscribers inside the transaction of the guess
+	eventBus := events.NewBus()
+	achievement.NewEngine(pgrepositories.NewUserStatsPgRepository(db), pgrepositories.NewUserAchievementPgRepository(db)).Subscribe(eventBus)
+	mapuc.NewPlayCounter(pgrepositories.NewMapPgRepository(db)).Subscribe(eventBus)
 
 	// users routes
 	userHandler := handlers.NewUserHandler(db, router)
 	userHandler.SetupRoutes()
 
 	// auth routes
-	authHandler := handlers.NewAuthHandler(db, router)
+	oidcService, err := services.NewOidcServiceFromEnv()
+	if err != nil {
+		log.Fatalf("invalid OIDC configuration: %v", err)
+	}
+	authHandler := handlers.NewAuthHandler(db, router, oidcService)
 	authHandler.SetupRoutes()
 
+	// two-factor authentication routes
+	twoFactorHandler := handlers.NewTwoFactorHandler(db, router)
+	twoFactorHandler.SetupRoutes()
+
+	// personal access token routes
+	personalAccessTokenHandler := handlers.NewPersonalAccessTokenHandler(db, router)
+	personalAccessTokenHandler.SetupRoutes()
+
 	// maps routes
-	mapHandler := handlers.NewMapHandler(db, router)
+	mapHandler := handlers.NewMapHandler(db, router, notificationBroker)
 	mapHandler.SetupRoutes()
 
+	// admin routes
+	adminHandler := handlers.NewAdminHandler(db, router)
+	adminHandler.SetupRoutes()
+
 	// single player routes
-	singlePlayerHandler := handlers.NewSinglePlayerHandler(db, router)
+	singlePlayerHandler := handlers.NewSinglePlayerHandler(db, router, eventBus)
 	singlePlayerHandler.SetupRoutes()
 
+	// leaderboard routes
+	leaderboardHandler := handlers.NewLeaderboardHandler(db, router)
+	leaderboardHandler.SetupRoutes()
+
+	// notifications routes
+	notificationHandler := handlers.NewNotificationHandler(db, router, notificationBroker)
+	notificationHandler.SetupRoutes()
+
+	// friends routes
+	friendHandler := handlers.NewFriendHandler(db, router, notificationBroker)
+	friendHandler.SetupRoutes()
+
+	// account deletion worker
+	accountDeletions := user.NewProcessAccountDeletionsUseCase(
+		pgrepositories.NewAccountDeletionPgRepository(db),
+		pgrepositories.NewUserPgRepository(db),
+		pgrepositories.NewMapPgRepository(db),
+		pgrepositories.NewMapInvitationPgRepository(db),
+		pgrepositories.NewPersonalDataPgRepository(db),
+		gorm.NewGormTransactionManager(db),
+		notification.NewNotificationService(pgrepositories.NewNotificationPgRepository(db), notificationBroker),
+	)
+	// background jobs run on one instance at a time
+	jobLock := gorm.NewAdvisoryLock(db)
+	go runAccountDeletions(accountDeletions, jobLock)
+
+	// location difficulty worker
+	go runLocationDifficultyRefresh(mapuc.NewRefreshLocationDifficultyUseCase(pgrepositories.NewLocationPgRepository(db), pgrepositories.NewJobStatePgRepository(db)), jobLock)
+
 	if err := router.Run(":8080"); err != nil {
 		log.Fatalf("failed to start server: %v", err)
 	}
-}
\ No newline at end of file
+}
+
+func trustedProxies() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
+// runAccountDeletions erases accounts whose deletion grace period is over, once at startup and then
+// every accountDeletionInterval, unless another instance is already at it.
+func runAccountDeletions(uc *user.ProcessAccountDeletionsUseCase, jobLock *gorm.AdvisoryLock) {
+	ticker := time.NewTicker(accountDeletionInterval)
+	defer ticker.Stop()
+	for {
+		var processed int
+		err := jobLock.RunExclusively(context.Background(), accountDeletionJob, func(ctx context.Context) error {
+			var err error
+			processed, err = uc.Execute(ctx)
+			return err
+		})
+		if err != nil {
+			log.Printf("failed to process account deletions: %v", err)
+		} else if processed > 0 {
+			log.Printf("erased %d deleted accounts", processed)
+		}
+		<-ticker.C
+	}
+}
+
+// runLocationDifficultyRefresh rates every location at startup, then every locationDifficultyInterval
+// the locations played since the previous successful run, unless another instance is already at it.
+func runLocationDifficultyRefresh(uc *mapuc.RefreshLocationDifficultyUseCase, jobLock *gorm.AdvisoryLock) {
+	ticker := time.NewTicker(locationDifficultyInterval)
+	defer ticker.Stop()
+	for {
+		var refreshed int
+		err := jobLock.RunExclusively(context.Background(), locationDifficultyJob, func(ctx context.Context) error {
+			var err error
+			refreshed, err = uc.Execute(ctx)
+			return err
+		})
+		if err != nil {
+			log.Printf("failed to refresh location difficulty: %v", err)
+		} else if refreshed > 0 {
+			log.Printf("rated %d locations", refreshed)
+		}
+		<-ticker.C
+	}
+}
@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"time"
+)
+
+// AccountDeletionGracePeriod is how long a user can change their mind before their account is erased.
+const AccountDeletionGracePeriod = time.Hour * 24 * 30
+
+// AccountDeletionMapsAction says what happens to the maps of a deleted account.
+type AccountDeletionMapsAction string
+
+const (
+	AccountDeletionMapsActionDelete   AccountDeletionMapsAction = "delete"
+	AccountDeletionMapsActionTransfer AccountDeletionMapsAction = "transfer"
+)
+
+// AccountDeletion is a scheduled erasure of a user's account, carried out once ScheduledFor has passed.
+type AccountDeletion struct {
+	UserId     string                    `json:"user_id" gorm:"primaryKey;type:uuid"`
+	MapsAction AccountDeletionMapsAction `json:"maps_action" gorm:"not null"`
+	// TransferToUserId is offered the maps when MapsAction is transfer, and takes over each one it accepts.
+	TransferToUserId *string   `json:"transfer_to_user_id" gorm:"type:uuid;default:null"`
+	ScheduledFor     time.Time `json:"scheduled_for" gorm:"not null;type:timestamptz;index"`
+	CreatedAt        time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	UpdatedAt        time.Time `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+}
+
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+func NewAccountDeletion(userId string, mapsAction AccountDeletionMapsAction, transferToUserId *string, now time.Time) *AccountDeletion {
+	return &AccountDeletion{
+		UserId:           userId,
+		MapsAction:       mapsAction,
+		TransferToUserId: transferToUserId,
+		ScheduledFor:     now.Add(AccountDeletionGracePeriod),
+	}
+}
+
+func (d *AccountDeletion) IsDue(now time.Time) bool {
+	return !now.Before(d.ScheduledFor)
+}
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountDeletionSuite struct {
+	suite.Suite
+}
+
+func TestAccountDeletionSuite(t *testing.T) {
+	suite.Run(t, new(AccountDeletionSuite))
+}
+
+func (s *AccountDeletionSuite) TestTableName() {
+	s.Equal("account_deletions", (AccountDeletion{}).TableName())
+}
+
+func (s *AccountDeletionSuite) TestNewAccountDeletion_SchedulesAfterGracePeriod() {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	deletion := NewAccountDeletion("user-uuid", AccountDeletionMapsActionDelete, nil, now)
+
+	s.Equal(now.Add(AccountDeletionGracePeriod), deletion.ScheduledFor)
+	s.False(deletion.IsDue(now))
+	s.False(deletion.IsDue(now.Add(AccountDeletionGracePeriod - time.Second)))
+	s.True(deletion.IsDue(now.Add(AccountDeletionGracePeriod)))
+}
@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"time"
+)
+
+const (
+	// LoginFailuresBeforeLockout is how many consecutive failed logins lock an account.
+	LoginFailuresBeforeLockout = 5
+	// Each lockout doubles the previous one, starting at baseLockoutDuration and capped at maxLockoutDuration.
+	baseLockoutDuration = time.Minute
+	maxLockoutDuration  = time.Hour
+)
+
+// AccountLockout tracks failed password logins for a user. It only exists while the user has
+// failures since their last successful login.
+type AccountLockout struct {
+	UserId         string     `json:"user_id" gorm:"primaryKey;type:uuid"`
+	User           *User      `json:"user" gorm:"foreignKey:UserId"`
+	FailedAttempts int        `json:"failed_attempts" gorm:"not null;default:0"`
+	Lockouts       int        `json:"lockouts" gorm:"not null;default:0"`
+	LockedUntil    *time.Time `json:"locked_until" gorm:"type:timestamptz;default:null"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+}
+
+func (AccountLockout) TableName() string {
+	return "account_lockouts"
+}
+
+func NewAccountLockout(userId string) *AccountLockout {
+	return &AccountLockout{UserId: userId}
+}
+
+func (l *AccountLockout) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
+
+// RegisterFailure records a failed login. Every LoginFailuresBeforeLockout failures lock the account,
+// each time for twice as long as the previous lockout.
+func (l *AccountLockout) RegisterFailure(now time.Time) {
+	l.FailedAttempts++
+	if l.FailedAttempts < LoginFailuresBeforeLockout {
+		return
+	}
+
+	duration := maxLockoutDuration
+	if l.Lockouts < 16 {
+		duration = min(baseLockoutDuration<<l.Lockouts, maxLockoutDuration)
+	}
+	lockedUntil := now.Add(duration)
+	l.LockedUntil = &lockedUntil
+	l.Lockouts++
+	l.FailedAttempts = 0
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountLockoutSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func TestAccountLockoutSuite(t *testing.T) {
+	suite.Run(t, new(AccountLockoutSuite))
+}
+
+func (s *AccountLockoutSuite) SetupTest() {
+	s.now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *AccountLockoutSuite) TestTableName() {
+	s.Equal("account_lockouts", (AccountLockout{}).TableName())
+}
+
+func (s *AccountLockoutSuite) TestRegisterFailure_LocksAfterThreshold() {
+	lockout := NewAccountLockout("user-uuid")
+
+	for i := 0; i < LoginFailuresBeforeLockout-1; i++ {
+		lockout.RegisterFailure(s.now)
+		s.False(lockout.IsLocked(s.now))
+	}
+	lockout.RegisterFailure(s.now)
+
+	s.True(lockout.IsLocked(s.now))
+	s.Equal(s.now.Add(time.Minute), *lockout.LockedUntil)
+	s.Equal(0, lockout.FailedAttempts)
+	s.False(lockout.IsLocked(s.now.Add(time.Minute)))
+}
+
+func (s *AccountLockoutSuite) TestRegisterFailure_DoublesLockoutUpToMaximum() {
+	lockout := NewAccountLockout("user-uuid")
+	expected := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 16 * time.Minute, 32 * time.Minute, time.Hour, time.Hour}
+
+	for _, duration := range expected {
+		for i := 0; i < LoginFailuresBeforeLockout; i++ {
+			lockout.RegisterFailure(s.now)
+		}
+		s.Equal(s.now.Add(duration), *lockout.LockedUntil)
+	}
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"time"
+)
+
+// EmailChangeRequest is a pending change of a user's email address. The new address only replaces
+// the current one once the user proves access to it with the token mailed there.
+type EmailChangeRequest struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserId    string    `json:"user_id" gorm:"not null;type:uuid;uniqueIndex"`
+	NewEmail  string    `json:"new_email" gorm:"not null"`
+	TokenHash string    `json:"-" gorm:"not null;uniqueIndex"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"not null;type:timestamptz"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+}
+
+func (EmailChangeRequest) TableName() string {
+	return "email_change_requests"
+}
+
+func NewEmailChangeRequest(userId, newEmail, tokenHash string, expiresAt time.Time) *EmailChangeRequest {
+	return &EmailChangeRequest{
+		UserId:    userId,
+		NewEmail:  newEmail,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (r *EmailChangeRequest) IsExpired() bool {
+	return time.Now().After(r.ExpiresAt)
+}
@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"time"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+type FriendshipStatus string
+
+const (
+	FriendshipStatusPending  FriendshipStatus = "pending"
+	FriendshipStatusAccepted FriendshipStatus = "accepted"
+)
+
+// Friendship is the relationship between two users, from the first request on. Each pair is
+// stored once, with UserAId lower than UserBId, so both users always find the same row;
+// RequesterId tells who sent the request.
+type Friendship struct {
+	UserAId     string           `json:"user_a_id" gorm:"primaryKey;type:uuid"`
+	UserA       *User            `json:"user_a" gorm:"foreignKey:UserAId"`
+	UserBId     string           `json:"user_b_id" gorm:"primaryKey;type:uuid;index"`
+	UserB       *User            `json:"user_b" gorm:"foreignKey:UserBId"`
+	RequesterId string           `json:"requester_id" gorm:"not null;type:uuid"`
+	Status      FriendshipStatus `json:"status" gorm:"not null;default:pending"`
+	CreatedAt   time.Time        `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	AcceptedAt  *time.Time       `json:"accepted_at" gorm:"type:timestamptz;default:null"`
+}
+
+func (Friendship) TableName() string {
+	return "friendships"
+}
+
+// FriendshipPair returns the two user ids in storage order.
+func FriendshipPair(userId, otherUserId string) (string, string) {
+	if userId < otherUserId {
+		return userId, otherUserId
+	}
+	return otherUserId, userId
+}
+
+func NewFriendRequest(requesterId, addresseeId string) *Friendship {
+	userAId, userBId := FriendshipPair(requesterId, addresseeId)
+	return &Friendship{
+		UserAId:     userAId,
+		UserBId:     userBId,
+		RequesterId: requesterId,
+		Status:      FriendshipStatusPending,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func (f *Friendship) IsPending() bool {
+	return f.Status == FriendshipStatusPending
+}
+
+func (f *Friendship) IsAccepted() bool {
+	return f.Status == FriendshipStatusAccepted
+}
+
+// OtherUserId returns the user on the other side of the relationship from userId.
+func (f *Friendship) OtherUserId(userId string) string {
+	if f.UserAId == userId {
+		return f.UserBId
+	}
+	return f.UserAId
+}
+
+// OtherUser returns the loaded user on the other side of the relationship from userId, if any.
+func (f *Friendship) OtherUser(userId string) *User {
+	if f.UserAId == userId {
+		return f.UserB
+	}
+	return f.UserA
+}
+
+func (f *Friendship) AddresseeId() string {
+	return f.OtherUserId(f.RequesterId)
+}
+
+// Accept turns a pending request into a friendship. Only the addressee can accept it.
+func (f *Friendship) Accept(userId string, now time.Time) error {
+	if !f.IsPending() {
+		return coreerrors.Conflict("friend request is not pending")
+	}
+	if userId != f.AddresseeId() {
+		return coreerrors.Forbidden("only the addressee can accept a friend request")
+	}
+	f.Status = FriendshipStatusAccepted
+	f.AcceptedAt = &now
+	return nil
+}
+
+// UserBlock stops two users from interacting: the blocked user cannot send friend requests to the
+// blocker, and blocking ends any friendship between them.
+type UserBlock struct {
+	BlockerId string    `json:"blocker_id" gorm:"primaryKey;type:uuid"`
+	BlockedId string    `json:"blocked_id" gorm:"primaryKey;type:uuid;index"`
+	Blocked   *User     `json:"blocked" gorm:"foreignKey:BlockedId"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+}
+
+func (UserBlock) TableName() string {
+	return "user_blocks"
+}
+
+func NewUserBlock(blockerId, blockedId string) *UserBlock {
+	return &UserBlock{
+		BlockerId: blockerId,
+		BlockedId: blockedId,
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FriendshipSuite struct {
+	suite.Suite
+}
+
+func TestFriendshipSuite(t *testing.T) {
+	suite.Run(t, new(FriendshipSuite))
+}
+
+func (s *FriendshipSuite) TestTableName() {
+	s.Equal("friendships", (Friendship{}).TableName())
+	s.Equal("user_blocks", (UserBlock{}).TableName())
+}
+
+func (s *FriendshipSuite) TestNewFriendRequest_StoresPairInOrder() {
+	friendship := NewFriendRequest("user-b", "user-a")
+
+	s.Equal("user-a", friendship.UserAId)
+	s.Equal("user-b", friendship.UserBId)
+	s.Equal("user-b", friendship.RequesterId)
+	s.Equal("user-a", friendship.AddresseeId())
+	s.Equal("user-a", friendship.OtherUserId("user-b"))
+	s.Equal("user-b", friendship.OtherUserId("user-a"))
+	s.True(friendship.IsPending())
+}
+
+func (s *FriendshipSuite) TestAccept() {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	friendship := NewFriendRequest("user-a", "user-b")
+
+	err := friendship.Accept("user-a", now)
+	s.Require().Error(err)
+	s.Equal("only the addressee can accept a friend request", err.Error())
+
+	s.Require().NoError(friendship.Accept("user-b", now))
+	s.True(friendship.IsAccepted())
+	s.Equal(&now, friendship.AcceptedAt)
+
+	err = friendship.Accept("user-b", now)
+	s.Require().Error(err)
+	s.Equal("friend request is not pending", err.Error())
+}
@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"math"
+	"sort"
+)
+
+// GeoBounds is a latitude/longitude box. As in GeoJSON bounding boxes, West is greater than East
+// when the box crosses the antimeridian.
+type GeoBounds struct {
+	West  float64 `json:"west"`
+	South float64 `json:"south"`
+	East  float64 `json:"east"`
+	North float64 `json:"north"`
+}
+
+func (b GeoBounds) CrossesAntimeridian() bool {
+	return b.West > b.East
+}
+
+// lonSpan is a longitude interval with east >= west, both possibly past ±180.
+type lonSpan struct {
+	west, east float64
+}
+
+// GeoCellBounds returns the box holding the 1° cells numbered as Location.GeoCell, false when there
+// are none.
+func GeoCellBounds(cells []int) (GeoBounds, bool) {
+	if len(cells) == 0 {
+		return GeoBounds{}, false
+	}
+	spans := make([]lonSpan, len(cells))
+	south, north := math.Inf(1), math.Inf(-1)
+	for i, cell := range cells {
+		latitude := float64(cell/360 - 90)
+		longitude := float64(cell%360 - 180)
+		spans[i] = lonSpan{west: longitude, east: longitude + 1}
+		south = math.Min(south, latitude)
+		north = math.Max(north, latitude+1)
+	}
+	return boundsOf(spans, south, math.Min(north, 90)), true
+}
+
+// boundsOf returns the narrowest box holding the spans, which goes across the antimeridian when
+// that is narrower.
+func boundsOf(spans []lonSpan, south, north float64) GeoBounds {
+	whole := GeoBounds{West: -180, South: south, East: 180, North: north}
+	type arc struct{ start, end float64 }
+	arcs := make([]arc, 0, len(spans))
+	for _, span := range spans {
+		width := span.east - span.west
+		if width >= 360 {
+			return whole
+		}
+		start := normalizeWest(span.west)
+		arcs = append(arcs, arc{start: start, end: start + width})
+	}
+	sort.Slice(arcs, func(a, b int) bool { return arcs[a].start < arcs[b].start })
+
+	merged := []arc{arcs[0]}
+	for _, a := range arcs[1:] {
+		last := &merged[len(merged)-1]
+		if a.start <= last.end {
+			last.end = math.Max(last.end, a.end)
+		} else {
+			merged = append(merged, a)
+		}
+	}
+	// The last arc may reach around into the first ones.
+	for len(merged) > 1 && merged[len(merged)-1].end-360 >= merged[0].start {
+		last := merged[len(merged)-1]
+		merged = merged[:len(merged)-1]
+		merged[0] = arc{start: last.start - 360, end: math.Max(merged[0].end, last.end-360)}
+		for len(merged) > 1 && merged[1].start <= merged[0].end {
+			merged[0].end = math.Max(merged[0].end, merged[1].end)
+			merged = append(merged[:1], merged[2:]...)
+		}
+	}
+
+	// The box leaves out the widest gap between the arcs.
+	gap, west, east := math.Inf(-1), 0.0, 0.0
+	for i, a := range merged {
+		nextStart := merged[(i+1)%len(merged)].start
+		if i == len(merged)-1 {
+			nextStart += 360
+		}
+		if nextStart-a.end > gap {
+			gap, west, east = nextStart-a.end, nextStart, a.end
+		}
+	}
+	if gap <= 0 {
+		return whole
+	}
+	return GeoBounds{West: normalizeWest(west), South: south, East: normalizeEast(east), North: north}
+}
+
+// normalizeWest brings a longitude into [-180, 180).
+func normalizeWest(longitude float64) float64 {
+	return longitude - 360*math.Floor((longitude+180)/360)
+}
+
+// normalizeEast brings a longitude into (-180, 180].
+func normalizeEast(longitude float64) float64 {
+	return longitude - 360*math.Ceil((longitude-180)/360)
+}
@@ -0,0 +1,24 @@
+package entities
+
+import "time"
+
+// JobState is what a background job remembers between runs. It lives in the database so that every
+// instance of the API, and the next one after a restart, picks up where the last run left off.
+type JobState struct {
+	Name string `json:"name" gorm:"primaryKey"`
+	// Watermark is when the last successful run started.
+	Watermark time.Time `json:"watermark" gorm:"not null;type:timestamptz"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+}
+
+func (JobState) TableName() string {
+	return "job_states"
+}
+
+func NewJobState(name string, watermark time.Time) *JobState {
+	return &JobState{
+		Name:      name,
+		Watermark: watermark,
+		UpdatedAt: time.Now(),
+	}
+}
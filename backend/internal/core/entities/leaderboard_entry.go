@@ -0,0 +1,137 @@
+package entities
+
+import (
+	"time"
+)
+
+type LeaderboardPeriod string
+
+const (
+	LeaderboardPeriodAllTime LeaderboardPeriod = "all_time"
+	LeaderboardPeriodMonthly LeaderboardPeriod = "monthly"
+	LeaderboardPeriodWeekly  LeaderboardPeriod = "weekly"
+)
+
+var leaderboardPeriods = []LeaderboardPeriod{LeaderboardPeriodAllTime, LeaderboardPeriodMonthly, LeaderboardPeriodWeekly}
+
+func (p LeaderboardPeriod) IsValid() bool {
+	switch p {
+	case LeaderboardPeriodAllTime, LeaderboardPeriodMonthly, LeaderboardPeriodWeekly:
+		return true
+	}
+	return false
+}
+
+// Start returns the start of the period containing t, in UTC. Weeks start on Monday; the all-time
+// period starts at the Unix epoch.
+func (p LeaderboardPeriod) Start(t time.Time) time.Time {
+	t = t.UTC()
+	switch p {
+	case LeaderboardPeriodMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case LeaderboardPeriodWeekly:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Unix(0, 0).UTC()
+}
+
+// LeaderboardDurationBucket groups round durations so that games with a similar time pressure
+// compete against each other.
+type LeaderboardDurationBucket string
+
+const (
+	LeaderboardDurationBlitz    LeaderboardDurationBucket = "blitz"
+	LeaderboardDurationRapid    LeaderboardDurationBucket = "rapid"
+	LeaderboardDurationStandard LeaderboardDurationBucket = "standard"
+)
+
+func (b LeaderboardDurationBucket) IsValid() bool {
+	switch b {
+	case LeaderboardDurationBlitz, LeaderboardDurationRapid, LeaderboardDurationStandard:
+		return true
+	}
+	return false
+}
+
+// DurationBucketFor returns the bucket of a round duration: up to 30 seconds is blitz, up to
+// 90 seconds is rapid and anything longer is standard.
+func DurationBucketFor(roundSecondsDuration int) LeaderboardDurationBucket {
+	switch {
+	case roundSecondsDuration <= 30:
+		return LeaderboardDurationBlitz
+	case roundSecondsDuration <= 90:
+		return LeaderboardDurationRapid
+	}
+	return LeaderboardDurationStandard
+}
+
+// LeaderboardGlobalMapId is the MapId of the boards ranking games across every map.
+const LeaderboardGlobalMapId = ""
+
+// LeaderboardKey identifies one board.
+type LeaderboardKey struct {
+	MapId          string
+	Mode           SinglePlayerGameMode
+	DurationBucket LeaderboardDurationBucket
+	Period         LeaderboardPeriod
+	PeriodStart    time.Time
+}
+
+// LeaderboardEntry is a user's best completed game on one board. Entries are materialized when a
+// game completes so that top-N and rank queries are index scans instead of aggregations over
+// every game.
+type LeaderboardEntry struct {
+	MapId          string                    `json:"map_id" gorm:"primaryKey;index:idx_leaderboard_rank,priority:1"`
+	Mode           SinglePlayerGameMode      `json:"mode" gorm:"primaryKey;index:idx_leaderboard_rank,priority:2"`
+	DurationBucket LeaderboardDurationBucket `json:"duration_bucket" gorm:"primaryKey;index:idx_leaderboard_rank,priority:3"`
+	Period         LeaderboardPeriod         `json:"period" gorm:"primaryKey;index:idx_leaderboard_rank,priority:4"`
+	PeriodStart    time.Time                 `json:"period_start" gorm:"primaryKey;type:date;index:idx_leaderboard_rank,priority:5"`
+	UserId         string                    `json:"user_id" gorm:"primaryKey;type:uuid"`
+	User           *User                     `json:"user" gorm:"foreignKey:UserId"`
+	GameId         string                    `json:"game_id" gorm:"not null;type:uuid"`
+	Score          int                       `json:"score" gorm:"not null;index:idx_leaderboard_rank,priority:6,sort:desc"`
+	AchievedAt     time.Time                 `json:"achieved_at" gorm:"not null;type:timestamptz;index:idx_leaderboard_rank,priority:7"`
+}
+
+func (LeaderboardEntry) TableName() string {
+	return "leaderboard_entries"
+}
+
+func (e *LeaderboardEntry) Key() LeaderboardKey {
+	return LeaderboardKey{
+		MapId:          e.MapId,
+		Mode:           e.Mode,
+		DurationBucket: e.DurationBucket,
+		Period:         e.Period,
+		PeriodStart:    e.PeriodStart,
+	}
+}
+
+// NewLeaderboardEntriesFromGame returns the candidate entries of a completed game: one per period,
+// on both the game's map board and the global board.
+func NewLeaderboardEntriesFromGame(game *SinglePlayerGame) []*LeaderboardEntry {
+	achievedAt := time.Now()
+	if game.EndedAt != nil {
+		achievedAt = *game.EndedAt
+	}
+	bucket := DurationBucketFor(game.RoundSecondsDuration)
+
+	entries := make([]*LeaderboardEntry, 0, 2*len(leaderboardPeriods))
+	for _, mapId := range []string{game.MapId, LeaderboardGlobalMapId} {
+		for _, period := range leaderboardPeriods {
+			entries = append(entries, &LeaderboardEntry{
+				MapId:          mapId,
+				Mode:           game.Mode,
+				DurationBucket: bucket,
+				Period:         period,
+				PeriodStart:    period.Start(achievedAt),
+				UserId:         game.UserId,
+				GameId:         game.ID,
+				Score:          game.Score,
+				AchievedAt:     achievedAt,
+			})
+		}
+	}
+	return entries
+}
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LeaderboardEntrySuite struct {
+	suite.Suite
+}
+
+func TestLeaderboardEntrySuite(t *testing.T) {
+	suite.Run(t, new(LeaderboardEntrySuite))
+}
+
+func (s *LeaderboardEntrySuite) TestTableName() {
+	s.Equal("leaderboard_entries", (LeaderboardEntry{}).TableName())
+}
+
+func (s *LeaderboardEntrySuite) TestPeriodStart() {
+	// Sunday 2026-03-15, late evening in UTC-3, already Monday in UTC.
+	t := time.Date(2026, 3, 15, 22, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	s.Equal(time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC), LeaderboardPeriodWeekly.Start(t))
+	s.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), LeaderboardPeriodMonthly.Start(t))
+	s.Equal(time.Unix(0, 0).UTC(), LeaderboardPeriodAllTime.Start(t))
+
+	sunday := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	s.Equal(time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), LeaderboardPeriodWeekly.Start(sunday))
+}
+
+func (s *LeaderboardEntrySuite) TestDurationBucketFor() {
+	s.Equal(LeaderboardDurationBlitz, DurationBucketFor(10))
+	s.Equal(LeaderboardDurationBlitz, DurationBucketFor(30))
+	s.Equal(LeaderboardDurationRapid, DurationBucketFor(31))
+	s.Equal(LeaderboardDurationRapid, DurationBucketFor(90))
+	s.Equal(LeaderboardDurationStandard, DurationBucketFor(300))
+}
+
+func (s *LeaderboardEntrySuite) TestIsValid() {
+	s.True(LeaderboardPeriodWeekly.IsValid())
+	s.False(LeaderboardPeriod("daily").IsValid())
+	s.True(LeaderboardDurationRapid.IsValid())
+	s.False(LeaderboardDurationBucket("slow").IsValid())
+}
+
+func (s *LeaderboardEntrySuite) TestNewLeaderboardEntriesFromGame() {
+	game := NewSinglePlayerGame("user-uuid", "map-uuid", SinglePlayerGameModeNMPZ, 60)
+	game.Score = 21000
+	endedAt := time.Date(2026, 3, 18, 10, 0, 0, 0, time.UTC)
+	game.EndedAt = &endedAt
+
+	entries := NewLeaderboardEntriesFromGame(game)
+
+	s.Require().Len(entries, 6)
+	boards := map[string]int{}
+	for _, entry := range entries {
+		boards[entry.MapId]++
+		s.Equal(game.ID, entry.GameId)
+		s.Equal(21000, entry.Score)
+		s.Equal(LeaderboardDurationRapid, entry.DurationBucket)
+		s.Equal(entry.Period.Start(endedAt), entry.PeriodStart)
+		s.Equal(endedAt, entry.AchievedAt)
+	}
+	s.Equal(3, boards["map-uuid"])
+	s.Equal(3, boards[LeaderboardGlobalMapId])
+}
@@ -11,12 +11,31 @@ import (
 type Location struct {
 	ID string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
 	PanoId string `json:"pano_id" gorm:"not null;uniqueIndex:idx_location_pano_map"`
-	MapId  string `json:"map_id" gorm:"not null;type:uuid;foreignKey:id;uniqueIndex:idx_location_pano_map"`
+	MapId  string `json:"map_id" gorm:"not null;type:uuid;foreignKey:id;uniqueIndex:idx_location_pano_map;index:idx_locations_strata,priority:1,where:deleted_at IS NULL"`
 	Map *Map `json:"map" gorm:"foreignKey:MapId"`
 	Latitude float64 `json:"latitude" gorm:"not null"`
 	Longitude float64 `json:"longitude" gorm:"not null"`
 	Heading float64 `json:"heading" gorm:"not null"`
 	Pitch float64 `json:"pitch" gorm:"not null"`
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country the location is in, if known.
+	CountryCode string `json:"country_code" gorm:"not null;default:'';index:idx_locations_strata,priority:3"`
+	// Provider serves the panorama. CaptureDate, Tags and Note are optional: the note is shown to
+	// the players once the round is over.
+	Provider PanoramaProvider `json:"provider" gorm:"not null;default:google"`
+	CaptureDate string `json:"capture_date" gorm:"not null;default:''"`
+	Tags LocationTags `json:"tags" gorm:"type:text;not null;default:''"`
+	Note string `json:"note" gorm:"not null;default:''"`
+	// GeoCell numbers the 1° by 1° latitude/longitude cell of the location. The database keeps it
+	// up to date; rounds are spread across cells.
+	GeoCell int `json:"-" gorm:"<-:false;type:integer GENERATED ALWAYS AS ((floor(latitude)::integer + 90) * 360 + floor(longitude)::integer + 180) STORED;index:idx_locations_strata,priority:2"`
+	// SampleKey is a random number drawn by the database when the location is created. Reading
+	// locations in its order from a random start samples them without scanning the map.
+	SampleKey float64 `json:"-" gorm:"not null;default:random();index:idx_locations_strata,priority:5"`
+	// PlayCount, DifficultyScore and Difficulty are refreshed from the completed rounds by a
+	// periodic job, see LocationPlayStats.
+	PlayCount int64 `json:"play_count" gorm:"not null;default:0"`
+	DifficultyScore float64 `json:"difficulty_score" gorm:"not null;default:0.5"`
+	Difficulty LocationDifficulty `json:"difficulty" gorm:"not null;default:unrated;index:idx_locations_strata,priority:4"`
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index;type:timestamptz"`
@@ -34,6 +53,10 @@ func NewLocation(panoId, mapId string, latitude, longitude, heading, pitch float
 		Longitude: longitude,
 		Heading: heading,
 		Pitch: pitch,
+		Provider: PanoramaProviderGoogle,
+		Tags: LocationTags{},
+		DifficultyScore: difficultyPrior,
+		Difficulty: LocationDifficultyUnrated,
 	}	
 }
 
@@ -46,6 +69,10 @@ func RestoreLocation(id, panoId, mapId string, latitude, longitude, heading, pit
 		Longitude: longitude,
 		Heading: heading,
 		Pitch: pitch,
+		Provider: PanoramaProviderGoogle,
+		Tags: LocationTags{},
+		DifficultyScore: difficultyPrior,
+		Difficulty: LocationDifficultyUnrated,
 	}
 }
 
@@ -57,12 +84,58 @@ func (l *Location) IsValidLongitude() bool {
 	return l.Longitude >= -180 && l.Longitude <= 180
 }
 
+func (l *Location) IsValidHeading() bool {
+	return l.Heading >= 0 && l.Heading <= 360
+}
+
+func (l *Location) IsValidPitch() bool {
+	return l.Pitch >= -90 && l.Pitch <= 90
+}
+
+// IsNullIsland reports whether the location sits at (0, 0), where points with missing coordinates end up.
+func (l *Location) IsNullIsland() bool {
+	return l.Latitude == 0 && l.Longitude == 0
+}
+
+func (l *Location) IsValidCountryCode() bool {
+	if l.CountryCode == "" {
+		return true
+	}
+	return len(l.CountryCode) == 2 && l.CountryCode[0] >= 'A' && l.CountryCode[0] <= 'Z' && l.CountryCode[1] >= 'A' && l.CountryCode[1] <= 'Z'
+}
+
+// Validate returns the first error among Issues.
 func (l *Location) Validate() error {
+	for _, issue := range l.Issues() {
+		if issue.Severity == LocationIssueSeverityError {
+			return coreerrors.BadRequest(issue.Message)
+		}
+	}
+	return nil
+}
+
+// Issues lists everything wrong with the location on its own, errors first. Issues involving other
+// locations, such as duplicates, are found by services.LocationLinter.
+func (l *Location) Issues() []LocationIssue {
+	var issues []LocationIssue
 	if !l.IsValidLatitude() {
-		return coreerrors.BadRequest(fmt.Sprintf("invalid latitude: %f (must be between -90 and 90)", l.Latitude))
+		issues = append(issues, NewLocationError(LocationIssueInvalidLatitude, fmt.Sprintf("invalid latitude: %f (must be between -90 and 90)", l.Latitude)))
 	}
 	if !l.IsValidLongitude() {
-		return coreerrors.BadRequest(fmt.Sprintf("invalid longitude: %f (must be between -180 and 180)", l.Longitude))
+		issues = append(issues, NewLocationError(LocationIssueInvalidLongitude, fmt.Sprintf("invalid longitude: %f (must be between -180 and 180)", l.Longitude)))
 	}
-	return nil
+	if !l.IsValidHeading() {
+		issues = append(issues, NewLocationError(LocationIssueInvalidHeading, fmt.Sprintf("invalid heading: %f (must be between 0 and 360)", l.Heading)))
+	}
+	if !l.IsValidPitch() {
+		issues = append(issues, NewLocationError(LocationIssueInvalidPitch, fmt.Sprintf("invalid pitch: %f (must be between -90 and 90)", l.Pitch)))
+	}
+	if !l.IsValidCountryCode() {
+		issues = append(issues, NewLocationError(LocationIssueInvalidCountryCode, fmt.Sprintf("invalid country code: %q (must be two uppercase letters)", l.CountryCode)))
+	}
+	issues = append(issues, l.metadataIssues()...)
+	if l.IsNullIsland() {
+		issues = append(issues, NewLocationWarning(LocationIssueNullIsland, "location is at (0, 0), its coordinates are probably missing"))
+	}
+	return issues
 }
\ No newline at end of file
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"math"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+// LocationDifficulty is the level of a location, derived from how players did on it.
+type LocationDifficulty string
+
+const (
+	// LocationDifficultyUnrated is the level of locations played too few times to be rated.
+	LocationDifficultyUnrated LocationDifficulty = "unrated"
+	LocationDifficultyEasy    LocationDifficulty = "easy"
+	LocationDifficultyMedium  LocationDifficulty = "medium"
+	LocationDifficultyHard    LocationDifficulty = "hard"
+	// LocationDifficultyMixed is only a game target: the rounds go from easy to hard.
+	LocationDifficultyMixed LocationDifficulty = "mixed"
+)
+
+// MinDifficultyPlays is how many completed rounds a location needs before it gets a level.
+const MinDifficultyPlays = 5
+
+const (
+	// difficultyPriorPlays is the weight, in rounds, of the prior pulling the score of rarely played
+	// locations toward the middle.
+	difficultyPriorPlays = 5
+	difficultyPrior      = 0.5
+	// maxGuessDistanceKm is half the circumference of the earth, the farthest a guess can be.
+	maxGuessDistanceKm = 20_038
+)
+
+// ParseDifficultyTarget validates the difficulty a game asks for. An empty target means any.
+func ParseDifficultyTarget(target string) (LocationDifficulty, error) {
+	switch difficulty := LocationDifficulty(target); difficulty {
+	case "", LocationDifficultyEasy, LocationDifficultyMedium, LocationDifficultyHard, LocationDifficultyMixed:
+		return difficulty, nil
+	}
+	return "", coreerrors.BadRequest("difficulty must be one of easy, medium, hard or mixed")
+}
+
+// LocationPlayStats sums the completed rounds played on a location.
+type LocationPlayStats struct {
+	LocationId string
+	Plays      int64
+	ScoreSum   int64
+	// LogDistanceSum sums ln(1 + distance in km) over the guesses.
+	LogDistanceSum float64
+}
+
+// DifficultyScore rates the location from 0, always found, to 1, always missed by half the earth.
+// It averages two halves: the missing share of the score, which tells apart the close guesses, and
+// the log of the distance, which tells apart the guesses too far to score at all. Locations with
+// few plays are pulled toward 0.5.
+func (s LocationPlayStats) DifficultyScore() float64 {
+	if s.Plays <= 0 {
+		return difficultyPrior
+	}
+	plays := float64(s.Plays)
+	scorePart := 1 - float64(s.ScoreSum)/plays/PerfectRoundScore
+	distancePart := s.LogDistanceSum / plays / math.Log1p(maxGuessDistanceKm)
+	raw := clamp01((clamp01(scorePart) + clamp01(distancePart)) / 2)
+	return (plays*raw + difficultyPriorPlays*difficultyPrior) / (plays + difficultyPriorPlays)
+}
+
+// Difficulty returns the level of the location, splitting the scores in thirds.
+func (s LocationPlayStats) Difficulty() LocationDifficulty {
+	if s.Plays < MinDifficultyPlays {
+		return LocationDifficultyUnrated
+	}
+	score := s.DifficultyScore()
+	switch {
+	case score < 1.0/3:
+		return LocationDifficultyEasy
+	case score < 2.0/3:
+		return LocationDifficultyMedium
+	default:
+		return LocationDifficultyHard
+	}
+}
+
+func clamp01(value float64) float64 {
+	return math.Max(0, math.Min(1, value))
+}
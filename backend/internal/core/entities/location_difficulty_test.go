@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationDifficultySuite struct {
+	suite.Suite
+}
+
+func TestLocationDifficultySuite(t *testing.T) {
+	suite.Run(t, new(LocationDifficultySuite))
+}
+
+// playStats returns the stats of plays rounds all guessed distanceKm away with the given score.
+func playStats(plays int64, score int64, distanceKm float64) LocationPlayStats {
+	return LocationPlayStats{
+		LocationId:     "loc-uuid",
+		Plays:          plays,
+		ScoreSum:       plays * score,
+		LogDistanceSum: float64(plays) * math.Log1p(distanceKm),
+	}
+}
+
+func (s *LocationDifficultySuite) TestDifficulty_AlwaysFoundIsEasy() {
+	stats := playStats(50, 5000, 0)
+
+	s.Equal(LocationDifficultyEasy, stats.Difficulty())
+	s.Less(stats.DifficultyScore(), 0.1)
+}
+
+func (s *LocationDifficultySuite) TestDifficulty_AlwaysMissedIsHard() {
+	stats := playStats(50, 0, 3000)
+
+	s.Equal(LocationDifficultyHard, stats.Difficulty())
+}
+
+func (s *LocationDifficultySuite) TestDifficultyScore_TellsApartGuessesTooFarToScore() {
+	nextCountry := playStats(50, 0, 300)
+	otherContinent := playStats(50, 0, 8000)
+
+	s.Less(nextCountry.DifficultyScore(), otherContinent.DifficultyScore())
+}
+
+func (s *LocationDifficultySuite) TestDifficulty_FewPlaysStayUnratedAndNearTheMiddle() {
+	stats := playStats(MinDifficultyPlays-1, 5000, 0)
+
+	s.Equal(LocationDifficultyUnrated, stats.Difficulty())
+	s.InDelta(0.5, stats.DifficultyScore(), 0.3)
+	s.Greater(stats.DifficultyScore(), playStats(50, 5000, 0).DifficultyScore())
+}
+
+func (s *LocationDifficultySuite) TestDifficultyScore_WithoutPlaysIsThePrior() {
+	s.Equal(0.5, LocationPlayStats{}.DifficultyScore())
+}
+
+func (s *LocationDifficultySuite) TestParseDifficultyTarget() {
+	for _, target := range []string{"", "easy", "medium", "hard", "mixed"} {
+		difficulty, err := ParseDifficultyTarget(target)
+		s.Require().NoError(err)
+		s.Equal(LocationDifficulty(target), difficulty)
+	}
+
+	_, err := ParseDifficultyTarget("unrated")
+	s.Require().Error(err)
+}
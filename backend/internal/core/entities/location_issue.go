@@ -0,0 +1,51 @@
+package entities
+
+type LocationIssueCode string
+
+const (
+	LocationIssueInvalidLatitude    LocationIssueCode = "invalid_latitude"
+	LocationIssueInvalidLongitude   LocationIssueCode = "invalid_longitude"
+	LocationIssueInvalidHeading     LocationIssueCode = "invalid_heading"
+	LocationIssueInvalidPitch       LocationIssueCode = "invalid_pitch"
+	LocationIssueInvalidCountryCode LocationIssueCode = "invalid_country_code"
+	LocationIssueNullIsland         LocationIssueCode = "null_island"
+	LocationIssueInvalidProvider    LocationIssueCode = "invalid_provider"
+	LocationIssueInvalidCaptureDate LocationIssueCode = "invalid_capture_date"
+	LocationIssueInvalidTags        LocationIssueCode = "invalid_tags"
+	LocationIssueNoteTooLong        LocationIssueCode = "note_too_long"
+	// LocationIssueInvalidPanoId is a panorama id its provider cannot serve.
+	LocationIssueInvalidPanoId LocationIssueCode = "invalid_pano_id"
+	// LocationIssueDuplicatePano is a panorama already on the map, or given twice.
+	LocationIssueDuplicatePano LocationIssueCode = "duplicate_pano"
+	// LocationIssueNearDuplicate is a location too close to another one to make a different round.
+	LocationIssueNearDuplicate LocationIssueCode = "near_duplicate"
+	// LocationIssueOverRepresentedArea is a location in an area holding too large a share of the map.
+	LocationIssueOverRepresentedArea LocationIssueCode = "over_represented_area"
+	// LocationIssueOutsideBoundary is a location outside the boundary of its map.
+	LocationIssueOutsideBoundary LocationIssueCode = "outside_boundary"
+)
+
+// LocationIssueSeverity tells whether an issue blocks saving the location (error) or is only
+// reported (warning).
+type LocationIssueSeverity string
+
+const (
+	LocationIssueSeverityError   LocationIssueSeverity = "error"
+	LocationIssueSeverityWarning LocationIssueSeverity = "warning"
+)
+
+type LocationIssue struct {
+	Code     LocationIssueCode     `json:"code"`
+	Severity LocationIssueSeverity `json:"severity"`
+	Message  string                `json:"message"`
+	// RelatedPanoIds are the other locations involved, by panorama: ids do not exist before saving.
+	RelatedPanoIds []string `json:"related_pano_ids,omitempty"`
+}
+
+func NewLocationError(code LocationIssueCode, message string) LocationIssue {
+	return LocationIssue{Code: code, Severity: LocationIssueSeverityError, Message: message}
+}
+
+func NewLocationWarning(code LocationIssueCode, message string) LocationIssue {
+	return LocationIssue{Code: code, Severity: LocationIssueSeverityWarning, Message: message}
+}
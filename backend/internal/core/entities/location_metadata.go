@@ -0,0 +1,163 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+// PanoramaProvider is the service serving the panorama of a location.
+type PanoramaProvider string
+
+const (
+	// PanoramaProviderGoogle is the provider of locations imported before providers existed.
+	PanoramaProviderGoogle    PanoramaProvider = "google"
+	PanoramaProviderMapillary PanoramaProvider = "mapillary"
+	// PanoramaProviderCustom panoramas are 360° photos hosted by the map makers.
+	PanoramaProviderCustom PanoramaProvider = "custom"
+)
+
+var PanoramaProviders = []PanoramaProvider{
+	PanoramaProviderGoogle,
+	PanoramaProviderMapillary,
+	PanoramaProviderCustom,
+}
+
+func ParsePanoramaProvider(value string) (PanoramaProvider, bool) {
+	for _, provider := range PanoramaProviders {
+		if string(provider) == value {
+			return provider, true
+		}
+	}
+	return "", false
+}
+
+const (
+	MaxLocationTags = 10
+	// MaxLocationNoteLength is in characters.
+	MaxLocationNoteLength = 500
+)
+
+// captureDateLayouts are the accepted capture dates: panorama services often only give the month.
+var captureDateLayouts = []string{"2006-01", "2006-01-02"}
+
+// IsValidCaptureDate reports whether date is empty, a month such as "2019-07" or a day such as
+// "2019-07-14". Capture dates sort as strings.
+func IsValidCaptureDate(date string) bool {
+	if date == "" {
+		return true
+	}
+	for _, layout := range captureDateLayouts {
+		if len(date) == len(layout) {
+			if _, err := time.Parse(layout, date); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LocationTags are the free-form tags of a location, such as "snow" or "coastal", following the
+// rules of MapTags. They are stored space separated.
+type LocationTags []string
+
+// NormalizeLocationTags lowercases, trims and dedupes tags, keeping their order. Invalid tags are
+// kept and reported by Location.Issues.
+func NormalizeLocationTags(tags []string) LocationTags {
+	normalized := make(LocationTags, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+func (t LocationTags) Has(tag string) bool {
+	for _, existing := range t {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAll reports whether the location has every one of tags.
+func (t LocationTags) HasAll(tags []string) bool {
+	for _, tag := range tags {
+		if !t.Has(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate returns the first problem among the tags, such as an invalid tag.
+func (t LocationTags) Validate() error {
+	for _, tag := range t {
+		if !isValidTag(tag) {
+			return coreerrors.BadRequest(fmt.Sprintf("invalid tag: %q (must be 1 to %d lowercase letters, digits or dashes)", tag, MaxMapTagLength))
+		}
+	}
+	if len(t) > MaxLocationTags {
+		return coreerrors.BadRequest(fmt.Sprintf("a location cannot have more than %d tags", MaxLocationTags))
+	}
+	return nil
+}
+
+// Equal reports whether both hold the same tags in the same order.
+func (t LocationTags) Equal(other LocationTags) bool {
+	if len(t) != len(other) {
+		return false
+	}
+	for i := range t {
+		if t[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (t LocationTags) Value() (driver.Value, error) {
+	return strings.Join(t, " "), nil
+}
+
+func (t *LocationTags) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*t = LocationTags{}
+	case string:
+		*t = strings.Fields(v)
+	case []byte:
+		*t = strings.Fields(string(v))
+	default:
+		return fmt.Errorf("cannot scan %T into LocationTags", value)
+	}
+	return nil
+}
+
+// metadataIssues lists what is wrong with the optional metadata of the location.
+func (l *Location) metadataIssues() []LocationIssue {
+	var issues []LocationIssue
+	if _, ok := ParsePanoramaProvider(string(l.Provider)); !ok {
+		issues = append(issues, NewLocationError(LocationIssueInvalidProvider, fmt.Sprintf("invalid provider: %q (must be google, mapillary or custom)", l.Provider)))
+	}
+	if !IsValidCaptureDate(l.CaptureDate) {
+		issues = append(issues, NewLocationError(LocationIssueInvalidCaptureDate, fmt.Sprintf("invalid capture date: %q (must be YYYY-MM or YYYY-MM-DD)", l.CaptureDate)))
+	}
+	if err := l.Tags.Validate(); err != nil {
+		issues = append(issues, NewLocationError(LocationIssueInvalidTags, err.Error()))
+	}
+	if utf8.RuneCountInString(l.Note) > MaxLocationNoteLength {
+		issues = append(issues, NewLocationError(LocationIssueNoteTooLong, fmt.Sprintf("note is longer than %d characters", MaxLocationNoteLength)))
+	}
+	return issues
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -150,3 +151,78 @@ func (s *LocationSuite) TestValidate_WhenBoundaryValues() {
 	err = loc.Validate()
 	s.NoError(err)
 }
+
+func (s *LocationSuite) TestValidate_WhenCountryCode() {
+	loc := NewLocation("pano", "map", 0, 0, 0, 0)
+
+	loc.CountryCode = "BR"
+	s.NoError(loc.Validate())
+
+	loc.CountryCode = "br"
+	err := loc.Validate()
+	s.Error(err)
+	s.Contains(err.Error(), "invalid country code")
+
+	loc.CountryCode = "BRA"
+	s.Error(loc.Validate())
+}
+
+func (s *LocationSuite) TestValidate_WhenHeadingOrPitchOutOfRange() {
+	loc := NewLocation("pano", "map", 20, 20, 360, 90)
+	s.NoError(loc.Validate())
+
+	loc.Heading = -1
+	err := loc.Validate()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid heading")
+
+	loc.Heading = 0
+	loc.Pitch = -91
+	err = loc.Validate()
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid pitch")
+}
+
+func (s *LocationSuite) TestIssues_ListsEveryIssue() {
+	loc := NewLocation("pano", "map", 0, 0, 400, 100)
+
+	issues := loc.Issues()
+
+	s.Require().Len(issues, 3)
+	s.Equal(LocationIssueInvalidHeading, issues[0].Code)
+	s.Equal(LocationIssueInvalidPitch, issues[1].Code)
+	s.Equal(LocationIssueNullIsland, issues[2].Code)
+	s.Equal(LocationIssueSeverityWarning, issues[2].Severity)
+}
+
+func (s *LocationSuite) TestIssues_ChecksTheMetadata() {
+	loc := NewLocation("pano", "map", 20, 20, 0, 0)
+	loc.Provider = PanoramaProviderMapillary
+	loc.CaptureDate = "2019-07"
+	loc.Tags = NormalizeLocationTags([]string{" Snow", "coastal", "snow"})
+	loc.Note = "The church has a blue roof."
+	s.Empty(loc.Issues())
+	s.Equal(LocationTags{"snow", "coastal"}, loc.Tags)
+
+	loc.Provider = "bing"
+	loc.CaptureDate = "2019-13"
+	loc.Tags = LocationTags{"snow day"}
+	loc.Note = strings.Repeat("é", MaxLocationNoteLength+1)
+
+	issues := loc.Issues()
+
+	s.Require().Len(issues, 4)
+	s.Equal(LocationIssueInvalidProvider, issues[0].Code)
+	s.Equal(LocationIssueInvalidCaptureDate, issues[1].Code)
+	s.Equal(LocationIssueInvalidTags, issues[2].Code)
+	s.Equal(LocationIssueNoteTooLong, issues[3].Code)
+}
+
+func (s *LocationSuite) TestIsValidCaptureDate() {
+	for _, date := range []string{"", "2019-07", "2019-07-14"} {
+		s.True(IsValidCaptureDate(date), date)
+	}
+	for _, date := range []string{"2019", "2019-7", "2019-02-30", "07/2019", "2019-07-14T10:00:00Z"} {
+		s.False(IsValidCaptureDate(date), date)
+	}
+}
@@ -3,15 +3,64 @@ package entities
 import (
 	"time"
 
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
 	"gorm.io/gorm"
 )
 
+type MapVisibility string
+
+const (
+	// MapVisibilityPrivate maps can only be seen and played by their owner.
+	MapVisibilityPrivate MapVisibility = "private"
+	// MapVisibilityUnlisted maps can be played by anyone having their link but are not listed.
+	MapVisibilityUnlisted MapVisibility = "unlisted"
+	// MapVisibilityPublic maps are listed for everyone.
+	MapVisibilityPublic MapVisibility = "public"
+)
+
+// MinPublicMapLocations is how many locations a map needs to be public: enough for a full game.
+const MinPublicMapLocations = 5
+
+func ParseMapVisibility(value string) (MapVisibility, bool) {
+	switch v := MapVisibility(value); v {
+	case MapVisibilityPrivate, MapVisibilityUnlisted, MapVisibilityPublic:
+		return v, true
+	}
+	return "", false
+}
+
+// Map names are unique per owner among maps that are not deleted.
 type Map struct {
 	ID string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	Name string `json:"name" gorm:"not null;unique"`
+	Name string `json:"name" gorm:"not null;uniqueIndex:idx_maps_owner_name,priority:2,where:deleted_at IS NULL"`
 	Description string `json:"description" gorm:"not null"`
-	OwnerId string `json:"owner_id" gorm:"not null;type:uuid"`
+	OwnerId string `json:"owner_id" gorm:"not null;type:uuid;uniqueIndex:idx_maps_owner_name,priority:1,where:deleted_at IS NULL"`
 	Owner *User `json:"owner" gorm:"foreignKey:OwnerId"`
+	// Visibility defaults to public in the database because maps created before it existed were all public.
+	Visibility MapVisibility `json:"visibility" gorm:"not null;default:public;index"`
+	PublishedAt *time.Time `json:"published_at" gorm:"type:timestamptz;default:null"`
+	Category MapCategory `json:"category" gorm:"not null;default:'';index"`
+	Tags MapTags `json:"tags" gorm:"type:text;not null;default:''"`
+	// SearchVector is maintained by Postgres from the name, tags and description, weighted in that order.
+	SearchVector string `json:"-" gorm:"->:false;<-:false;type:tsvector GENERATED ALWAYS AS (setweight(to_tsvector('simple', name), 'A') || setweight(to_tsvector('simple', tags), 'B') || setweight(to_tsvector('simple', description), 'C')) STORED;index:idx_maps_search,type:gin"`
+	// ForkedFromId is the map this one was forked from, if any.
+	ForkedFromId *string `json:"forked_from_id" gorm:"type:uuid;index"`
+	// LikeCount, PlayCount and the difficulty ratings are cached aggregates, maintained in the
+	// transactions that change them.
+	LikeCount int64 `json:"like_count" gorm:"not null;default:0"`
+	PlayCount int64 `json:"play_count" gorm:"not null;default:0"`
+	DifficultyRatingCount int64 `json:"difficulty_rating_count" gorm:"not null;default:0"`
+	DifficultyRatingSum int64 `json:"-" gorm:"not null;default:0"`
+	// TrendingScore sums recent plays and likes with an exponential decay, see AddTrending.
+	TrendingScore float64 `json:"-" gorm:"not null;default:0;index"`
+	// Boundary, when set, is the area the locations of the map must be in. OutsideGuesses tells what
+	// happens to the guesses outside it.
+	Boundary *MapBoundary `json:"-" gorm:"type:jsonb"`
+	OutsideGuesses OutsideGuessPolicy `json:"outside_guesses" gorm:"not null;default:reject"`
+	// AllowRepeatLocations lets players draw locations they saw in their earlier games on the map.
+	AllowRepeatLocations bool `json:"allow_repeat_locations" gorm:"not null;default:false"`
+	// Revision is the number of the latest MapRevision, 0 until the locations first change after creation.
+	Revision int `json:"revision" gorm:"not null;default:0"`
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index;type:timestamptz"`
@@ -21,11 +70,15 @@ func (Map) TableName() string {
 	return "maps"
 }
 
+// NewMap returns a private map; it goes public through ChangeVisibility once it has enough locations.
 func NewMap(name, description, ownerId string) *Map {
 	return &Map{
 		Name: name,
 		Description: description,
 		OwnerId: ownerId,
+		Visibility: MapVisibilityPrivate,
+		Tags: MapTags{},
+		OutsideGuesses: OutsideGuessReject,
 	}
 }
 
@@ -36,4 +89,85 @@ func RestoreMap(id, name, description, ownerId string) *Map {
 		Description: description,
 		OwnerId: ownerId,
 	}
-}
\ No newline at end of file
+}
+
+// Fork returns a private copy of the map owned by ownerId. Locations are copied separately.
+func (m *Map) Fork(name, description, ownerId string) *Map {
+	fork := NewMap(name, description, ownerId)
+	fork.ForkedFromId = &m.ID
+	fork.Category = m.Category
+	fork.Tags = append(MapTags{}, m.Tags...)
+	fork.Boundary = m.Boundary
+	fork.OutsideGuesses = m.OutsideGuesses
+	return fork
+}
+
+// InBoundary reports whether the point is inside the boundary of the map, always when it has none.
+func (m *Map) InBoundary(latitude, longitude float64) bool {
+	return m.Boundary == nil || m.Boundary.Contains(latitude, longitude)
+}
+
+// PlaceGuess returns where a guess lands: where it was made, unless it is outside the boundary of a
+// map clamping guesses, which moves it to the closest point of the boundary. A guess outside the
+// boundary of a map rejecting them is an error.
+func (m *Map) PlaceGuess(latitude, longitude float64) (float64, float64, error) {
+	if m.InBoundary(latitude, longitude) {
+		return latitude, longitude, nil
+	}
+	if m.OutsideGuesses == OutsideGuessClamp {
+		latitude, longitude = m.Boundary.Clamp(latitude, longitude)
+		return latitude, longitude, nil
+	}
+	return 0, 0, coreerrors.BadRequest("guess is outside the map boundary")
+}
+
+// Classify sets the category and tags of the map. The empty category uncategorizes it.
+func (m *Map) Classify(category string, tags []string) error {
+	if category != "" {
+		if _, ok := ParseMapCategory(category); !ok {
+			return coreerrors.BadRequest("invalid category")
+		}
+	}
+	normalized, err := NormalizeMapTags(tags)
+	if err != nil {
+		return err
+	}
+	m.Category = MapCategory(category)
+	m.Tags = normalized
+	return nil
+}
+
+// AverageDifficulty is the mean difficulty rating, nil while the map has none.
+func (m *Map) AverageDifficulty() *float64 {
+	if m.DifficultyRatingCount == 0 {
+		return nil
+	}
+	average := float64(m.DifficultyRatingSum) / float64(m.DifficultyRatingCount)
+	return &average
+}
+
+func (m *Map) IsOwnedBy(userId string) bool {
+	return m.OwnerId == userId
+}
+
+func (m *Map) IsPrivate() bool {
+	return m.Visibility == MapVisibilityPrivate
+}
+
+// ChangeVisibility moves the map to visibility. Going public is the publish step: it needs at least
+// MinPublicMapLocations locations and records the first publication time.
+func (m *Map) ChangeVisibility(visibility MapVisibility, locationCount int64, now time.Time) error {
+	if _, ok := ParseMapVisibility(string(visibility)); !ok {
+		return coreerrors.BadRequest("invalid visibility")
+	}
+	if visibility == MapVisibilityPublic {
+		if locationCount < MinPublicMapLocations {
+			return coreerrors.BadRequest("map needs at least 5 locations to be published")
+		}
+		if m.PublishedAt == nil {
+			m.PublishedAt = &now
+		}
+	}
+	m.Visibility = visibility
+	return nil
+}
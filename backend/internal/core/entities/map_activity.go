@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"math"
+	"time"
+)
+
+// Trending weights: a like counts as much as a few plays. Activity loses half its weight every
+// trendingHalfLife.
+const (
+	PlayTrendingWeight = 1.0
+	LikeTrendingWeight = 3.0
+	trendingHalfLife   = 72 * time.Hour
+)
+
+// trendingEpoch is the reference time of trending scores.
+var trendingEpoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// MapActivity is a change to the cached aggregates of a map. Counts are deltas; TrendingWeight,
+// when positive, is activity that happened at At.
+type MapActivity struct {
+	Likes               int64
+	Plays               int64
+	DifficultyRatings   int64
+	DifficultyRatingSum int64
+	TrendingWeight      float64
+	At                  time.Time
+}
+
+// TrendingIncrement is the weight of activity at time at, in the log scale of Map.TrendingScore:
+// newer weights grow instead of older ones decaying, which keeps stored scores comparable.
+func TrendingIncrement(weight float64, at time.Time) float64 {
+	return math.Log(weight) + math.Ln2*float64(at.Sub(trendingEpoch))/float64(trendingHalfLife)
+}
+
+// AddTrending accumulates increment, from TrendingIncrement, into score: log(e^score + e^increment).
+func AddTrending(score, increment float64) float64 {
+	high, low := math.Max(score, increment), math.Min(score, increment)
+	return high + math.Log1p(math.Exp(low-high))
+}
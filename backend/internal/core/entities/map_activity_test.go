@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MapActivitySuite struct {
+	suite.Suite
+}
+
+func TestMapActivitySuite(t *testing.T) {
+	suite.Run(t, new(MapActivitySuite))
+}
+
+func (s *MapActivitySuite) TestTrendingIncrement_DoublesEveryHalfLife() {
+	at := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	later := TrendingIncrement(PlayTrendingWeight, at.Add(trendingHalfLife))
+
+	s.InDelta(TrendingIncrement(PlayTrendingWeight, at)+math.Ln2, later, 1e-9)
+}
+
+func (s *MapActivitySuite) TestTrendingIncrement_RecentPlaysOutweighOldLikes() {
+	at := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	oldLike := TrendingIncrement(LikeTrendingWeight, at)
+	recentPlay := TrendingIncrement(PlayTrendingWeight, at.Add(2*trendingHalfLife))
+
+	s.Greater(recentPlay, oldLike)
+}
+
+func (s *MapActivitySuite) TestAddTrending_SumsInLogScale() {
+	score := AddTrending(math.Log(2), math.Log(3))
+
+	s.InDelta(math.Log(5), score, 1e-9)
+}
+
+func (s *MapActivitySuite) TestAddTrending_DoesNotOverflowOnLargeScores() {
+	score := AddTrending(5000, 5000)
+
+	s.InDelta(5000+math.Ln2, score, 1e-9)
+}
+
+func (s *MapActivitySuite) TestNewMapRating_RejectsOutOfRangeDifficulty() {
+	_, err := NewMapRating("map-uuid", "user-uuid", 0)
+	s.Error(err)
+
+	rating, err := NewMapRating("map-uuid", "user-uuid", MaxMapDifficulty)
+	s.Require().NoError(err)
+	s.Equal(MaxMapDifficulty, rating.Difficulty)
+}
+
+func (s *MapActivitySuite) TestMap_AverageDifficulty() {
+	m := NewMap("Brazil", "desc", "owner-uuid")
+	s.Nil(m.AverageDifficulty())
+
+	m.DifficultyRatingCount = 4
+	m.DifficultyRatingSum = 14
+	s.Require().NotNil(m.AverageDifficulty())
+	s.InDelta(3.5, *m.AverageDifficulty(), 1e-9)
+}
+
+func (s *MapActivitySuite) TestTableName() {
+	s.Equal("map_likes", MapLike{}.TableName())
+	s.Equal("map_ratings", MapRating{}.TableName())
+}
@@ -0,0 +1,287 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+// MaxBoundaryVertices bounds the size of a boundary, which every guess and location edit of the
+// map is checked against.
+const MaxBoundaryVertices = 20_000
+
+// OutsideGuessPolicy tells what happens to a guess outside the boundary of a map.
+type OutsideGuessPolicy string
+
+const (
+	OutsideGuessReject OutsideGuessPolicy = "reject"
+	// OutsideGuessClamp moves the guess to the closest point of the boundary.
+	OutsideGuessClamp OutsideGuessPolicy = "clamp"
+)
+
+func ParseOutsideGuessPolicy(policy string) (OutsideGuessPolicy, bool) {
+	switch p := OutsideGuessPolicy(policy); p {
+	case OutsideGuessReject, OutsideGuessClamp:
+		return p, true
+	}
+	return "", false
+}
+
+type geoPoint struct {
+	lon, lat float64
+}
+
+// boundaryPolygon is an outer ring with its holes. The rings are unwrapped: their longitudes run
+// on past ±180 instead of jumping when they cross the antimeridian.
+type boundaryPolygon struct {
+	outer []geoPoint
+	holes [][]geoPoint
+}
+
+// MapBoundary is the area a map covers, a GeoJSON Polygon or MultiPolygon. Rings may cross the
+// antimeridian, split at it as RFC 7946 asks or with their longitudes jumping across it, but may
+// not go around a pole.
+type MapBoundary struct {
+	geometryType string
+	// coordinates are those of a MultiPolygon, a Polygon being a MultiPolygon of one.
+	coordinates [][][][2]float64
+	polygons    []boundaryPolygon
+}
+
+type geoJSONObject struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates,omitempty"`
+	Geometry    json.RawMessage `json:"geometry,omitempty"`
+}
+
+// ParseMapBoundary reads a GeoJSON Polygon or MultiPolygon, or a Feature holding one.
+func ParseMapBoundary(data []byte) (*MapBoundary, error) {
+	var object geoJSONObject
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, coreerrors.BadRequest("boundary must be GeoJSON")
+	}
+	if object.Type == "Feature" && len(object.Geometry) > 0 {
+		if err := json.Unmarshal(object.Geometry, &object); err != nil {
+			return nil, coreerrors.BadRequest("boundary must be GeoJSON")
+		}
+	}
+
+	var coordinates [][][][]float64
+	switch object.Type {
+	case "Polygon":
+		var polygon [][][]float64
+		if err := json.Unmarshal(object.Coordinates, &polygon); err != nil {
+			return nil, coreerrors.BadRequest("invalid Polygon coordinates")
+		}
+		coordinates = [][][][]float64{polygon}
+	case "MultiPolygon":
+		if err := json.Unmarshal(object.Coordinates, &coordinates); err != nil {
+			return nil, coreerrors.BadRequest("invalid MultiPolygon coordinates")
+		}
+	default:
+		return nil, coreerrors.BadRequest("boundary must be a GeoJSON Polygon or MultiPolygon")
+	}
+	return newMapBoundary(object.Type, coordinates)
+}
+
+func newMapBoundary(geometryType string, coordinates [][][][]float64) (*MapBoundary, error) {
+	if len(coordinates) == 0 {
+		return nil, coreerrors.BadRequest("boundary has no polygon")
+	}
+	boundary := &MapBoundary{geometryType: geometryType}
+	vertices := 0
+	for _, polygon := range coordinates {
+		if len(polygon) == 0 {
+			return nil, coreerrors.BadRequest("boundary has a polygon without rings")
+		}
+		rings := make([][][2]float64, len(polygon))
+		for i, ring := range polygon {
+			if len(ring) < 4 {
+				return nil, coreerrors.BadRequest("boundary rings need at least 4 positions")
+			}
+			rings[i] = make([][2]float64, len(ring))
+			for j, position := range ring {
+				if len(position) < 2 {
+					return nil, coreerrors.BadRequest("boundary positions need a longitude and a latitude")
+				}
+				if position[0] < -180 || position[0] > 180 || position[1] < -90 || position[1] > 90 {
+					return nil, coreerrors.BadRequest(fmt.Sprintf("boundary position out of range: [%f, %f]", position[0], position[1]))
+				}
+				rings[i][j] = [2]float64{position[0], position[1]}
+			}
+			if rings[i][0] != rings[i][len(ring)-1] {
+				return nil, coreerrors.BadRequest("boundary rings must be closed")
+			}
+			vertices += len(ring)
+		}
+		if vertices > MaxBoundaryVertices {
+			return nil, coreerrors.BadRequest(fmt.Sprintf("boundary cannot have more than %d positions", MaxBoundaryVertices))
+		}
+
+		outer, ok := unwrapRing(rings[0], rings[0][0][0])
+		if !ok {
+			return nil, coreerrors.BadRequest("boundary rings cannot go around a pole")
+		}
+		compiled := boundaryPolygon{outer: outer}
+		for _, hole := range rings[1:] {
+			unwrapped, ok := unwrapRing(hole, outer[0].lon)
+			if !ok {
+				return nil, coreerrors.BadRequest("boundary rings cannot go around a pole")
+			}
+			compiled.holes = append(compiled.holes, unwrapped)
+		}
+		boundary.coordinates = append(boundary.coordinates, rings)
+		boundary.polygons = append(boundary.polygons, compiled)
+	}
+	return boundary, nil
+}
+
+// unwrapRing makes the longitudes of a ring continuous, taking the short way between consecutive
+// positions, and starts it at the longitude closest to reference. A ring going around a pole does
+// not come back to its start and is refused.
+func unwrapRing(ring [][2]float64, reference float64) ([]geoPoint, bool) {
+	unwrapped := make([]geoPoint, len(ring))
+	longitude := ring[0][0] + 360*math.Round((reference-ring[0][0])/360)
+	unwrapped[0] = geoPoint{lon: longitude, lat: ring[0][1]}
+	for i := 1; i < len(ring); i++ {
+		longitude += wrapLongitude(ring[i][0] - ring[i-1][0])
+		unwrapped[i] = geoPoint{lon: longitude, lat: ring[i][1]}
+	}
+	return unwrapped, math.Abs(unwrapped[len(ring)-1].lon-unwrapped[0].lon) < 1e-9
+}
+
+// wrapLongitude brings a longitude difference into [-180, 180].
+func wrapLongitude(delta float64) float64 {
+	return delta - 360*math.Round(delta/360)
+}
+
+// Contains reports whether the point is inside the boundary.
+func (b *MapBoundary) Contains(latitude, longitude float64) bool {
+	for _, polygon := range b.polygons {
+		// Unwrapped rings may lie a turn away from the point.
+		for _, shift := range [...]float64{0, -360, 360} {
+			if polygon.contains(latitude, longitude+shift) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p boundaryPolygon) contains(latitude, longitude float64) bool {
+	if !ringContains(p.outer, latitude, longitude) {
+		return false
+	}
+	for _, hole := range p.holes {
+		if ringContains(hole, latitude, longitude) {
+			return false
+		}
+	}
+	return true
+}
+
+// ringContains casts a ray toward the east of the point and counts the edges it crosses.
+func ringContains(ring []geoPoint, latitude, longitude float64) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.lat > latitude) != (b.lat > latitude) &&
+			longitude < (b.lon-a.lon)*(latitude-a.lat)/(b.lat-a.lat)+a.lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Clamp returns the point of the boundary's edges closest to the given one, measured on a plane
+// tangent at the point, which is close enough over the distances a boundary allows.
+func (b *MapBoundary) Clamp(latitude, longitude float64) (float64, float64) {
+	scale := math.Max(math.Cos(latitude*math.Pi/180), 1e-6)
+	best, bestX, bestY := math.Inf(1), 0.0, 0.0
+	for _, polygon := range b.polygons {
+		for _, ring := range append([][]geoPoint{polygon.outer}, polygon.holes...) {
+			for i := 1; i < len(ring); i++ {
+				ax := wrapLongitude(ring[i-1].lon-longitude) * scale
+				ay := ring[i-1].lat - latitude
+				bx := ax + (ring[i].lon-ring[i-1].lon)*scale
+				by := ring[i].lat - latitude
+				x, y := closestToOrigin(ax, ay, bx, by)
+				if distance := x*x + y*y; distance < best {
+					best, bestX, bestY = distance, x, y
+				}
+			}
+		}
+	}
+	return math.Max(-90, math.Min(90, latitude+bestY)), normalizeEast(longitude + bestX/scale)
+}
+
+// closestToOrigin returns the point of the segment from a to b closest to the origin.
+func closestToOrigin(ax, ay, bx, by float64) (float64, float64) {
+	dx, dy := bx-ax, by-ay
+	length := dx*dx + dy*dy
+	if length == 0 {
+		return ax, ay
+	}
+	t := math.Max(0, math.Min(1, -(ax*dx+ay*dy)/length))
+	return ax + t*dx, ay + t*dy
+}
+
+// Bounds returns the box around the boundary, crossing the antimeridian when the boundary does.
+func (b *MapBoundary) Bounds() GeoBounds {
+	spans := make([]lonSpan, len(b.polygons))
+	south, north := math.Inf(1), math.Inf(-1)
+	for i, polygon := range b.polygons {
+		spans[i] = lonSpan{west: math.Inf(1), east: math.Inf(-1)}
+		for _, point := range polygon.outer {
+			spans[i].west = math.Min(spans[i].west, point.lon)
+			spans[i].east = math.Max(spans[i].east, point.lon)
+			south = math.Min(south, point.lat)
+			north = math.Max(north, point.lat)
+		}
+	}
+	return boundsOf(spans, south, north)
+}
+
+func (b *MapBoundary) MarshalJSON() ([]byte, error) {
+	if b.geometryType == "Polygon" {
+		return json.Marshal(struct {
+			Type        string         `json:"type"`
+			Coordinates [][][2]float64 `json:"coordinates"`
+		}{Type: b.geometryType, Coordinates: b.coordinates[0]})
+	}
+	return json.Marshal(struct {
+		Type        string           `json:"type"`
+		Coordinates [][][][2]float64 `json:"coordinates"`
+	}{Type: b.geometryType, Coordinates: b.coordinates})
+}
+
+func (b *MapBoundary) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseMapBoundary(data)
+	if err != nil {
+		return err
+	}
+	*b = *parsed
+	return nil
+}
+
+func (b MapBoundary) Value() (driver.Value, error) {
+	data, err := b.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (b *MapBoundary) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return b.UnmarshalJSON([]byte(v))
+	case []byte:
+		return b.UnmarshalJSON(v)
+	default:
+		return fmt.Errorf("cannot scan %T into MapBoundary", value)
+	}
+}
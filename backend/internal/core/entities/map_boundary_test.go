@@ -0,0 +1,156 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MapBoundarySuite struct {
+	suite.Suite
+}
+
+func TestMapBoundarySuite(t *testing.T) {
+	suite.Run(t, new(MapBoundarySuite))
+}
+
+// squareWithHole is a 10° square around (5, 5) with a 2° hole in its middle.
+const squareWithHole = `{"type":"Polygon","coordinates":[
+	[[0,0],[10,0],[10,10],[0,10],[0,0]],
+	[[4,4],[6,4],[6,6],[4,6],[4,4]]
+]}`
+
+// fijiJumping crosses the antimeridian with its longitudes jumping from 179 to -179.
+const fijiJumping = `{"type":"Polygon","coordinates":[[[177,-20],[-179,-20],[-179,-15],[177,-15],[177,-20]]]}`
+
+// fijiSplit is the same area split at the antimeridian, as RFC 7946 asks.
+const fijiSplit = `{"type":"MultiPolygon","coordinates":[
+	[[[177,-20],[180,-20],[180,-15],[177,-15],[177,-20]]],
+	[[[-180,-20],[-179,-20],[-179,-15],[-180,-15],[-180,-20]]]
+]}`
+
+func (s *MapBoundarySuite) parse(data string) *MapBoundary {
+	boundary, err := ParseMapBoundary([]byte(data))
+	s.Require().NoError(err)
+	return boundary
+}
+
+func (s *MapBoundarySuite) TestParse_RefusesInvalidGeometries() {
+	cases := map[string]string{
+		"not json":      `{`,
+		"point":         `{"type":"Point","coordinates":[0,0]}`,
+		"short ring":    `{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`,
+		"open ring":     `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`,
+		"out of range":  `{"type":"Polygon","coordinates":[[[0,0],[190,0],[1,1],[0,0]]]}`,
+		"no polygon":    `{"type":"MultiPolygon","coordinates":[]}`,
+		"around a pole": `{"type":"Polygon","coordinates":[[[0,80],[120,80],[-120,80],[0,80]]]}`,
+	}
+	for name, data := range cases {
+		_, err := ParseMapBoundary([]byte(data))
+		s.Error(err, name)
+	}
+}
+
+func (s *MapBoundarySuite) TestParse_AcceptsAFeature() {
+	boundary := s.parse(`{"type":"Feature","properties":{},"geometry":` + squareWithHole + `}`)
+
+	s.True(boundary.Contains(1, 1))
+}
+
+func (s *MapBoundarySuite) TestContains_LeavesOutTheHoles() {
+	boundary := s.parse(squareWithHole)
+
+	s.True(boundary.Contains(2, 2))
+	s.False(boundary.Contains(5, 5))
+	s.False(boundary.Contains(11, 5))
+	s.False(boundary.Contains(5, -1))
+}
+
+func (s *MapBoundarySuite) TestContains_AcrossTheAntimeridian() {
+	for _, data := range []string{fijiJumping, fijiSplit} {
+		boundary := s.parse(data)
+
+		s.True(boundary.Contains(-18, 178), data)
+		s.True(boundary.Contains(-18, 180), data)
+		s.True(boundary.Contains(-18, -180), data)
+		s.True(boundary.Contains(-18, -179.5), data)
+		s.False(boundary.Contains(-18, 0), data)
+		s.False(boundary.Contains(-18, -178), data)
+		s.False(boundary.Contains(-18, 176), data)
+	}
+}
+
+func (s *MapBoundarySuite) TestClamp_MovesThePointToTheClosestEdge() {
+	boundary := s.parse(squareWithHole)
+
+	latitude, longitude := boundary.Clamp(5, 12)
+
+	s.InDelta(5, latitude, 1e-9)
+	s.InDelta(10, longitude, 1e-9)
+	s.True(boundary.Contains(latitude, longitude-1e-6))
+}
+
+func (s *MapBoundarySuite) TestClamp_AcrossTheAntimeridian() {
+	boundary := s.parse(fijiJumping)
+
+	latitude, longitude := boundary.Clamp(-17, -178)
+
+	s.InDelta(-17, latitude, 1e-9)
+	s.InDelta(-179, longitude, 1e-9)
+}
+
+func (s *MapBoundarySuite) TestBounds() {
+	s.Equal(GeoBounds{West: 0, South: 0, East: 10, North: 10}, s.parse(squareWithHole).Bounds())
+
+	for _, data := range []string{fijiJumping, fijiSplit} {
+		bounds := s.parse(data).Bounds()
+
+		s.Equal(GeoBounds{West: 177, South: -20, East: -179, North: -15}, bounds, data)
+		s.True(bounds.CrossesAntimeridian(), data)
+	}
+}
+
+func (s *MapBoundarySuite) TestJSON_RoundTrips() {
+	boundary := s.parse(fijiSplit)
+
+	data, err := json.Marshal(boundary)
+	s.Require().NoError(err)
+	var decoded MapBoundary
+	s.Require().NoError(json.Unmarshal(data, &decoded))
+
+	s.JSONEq(string(data), mustMarshal(s, &decoded))
+	s.True(decoded.Contains(-18, 180))
+}
+
+func (s *MapBoundarySuite) TestScan_ReadsTheStoredValue() {
+	value, err := s.parse(squareWithHole).Value()
+	s.Require().NoError(err)
+
+	var scanned MapBoundary
+	s.Require().NoError(scanned.Scan(value))
+
+	s.False(scanned.Contains(5, 5))
+	s.True(scanned.Contains(2, 2))
+}
+
+func (s *MapBoundarySuite) TestGeoCellBounds() {
+	_, ok := GeoCellBounds(nil)
+	s.False(ok)
+
+	// Cells at (10°S, 40°W) and (12°S, 35°W).
+	bounds, ok := GeoCellBounds([]int{80*360 + 140, 78*360 + 145})
+	s.Require().True(ok)
+	s.Equal(GeoBounds{West: -40, South: -12, East: -34, North: -9}, bounds)
+
+	// Cells on both sides of the antimeridian.
+	bounds, ok = GeoCellBounds([]int{70*360 + 359, 70*360 + 0})
+	s.Require().True(ok)
+	s.Equal(GeoBounds{West: 179, South: -20, East: -179, North: -19}, bounds)
+}
+
+func mustMarshal(s *MapBoundarySuite, value any) string {
+	data, err := json.Marshal(value)
+	s.Require().NoError(err)
+	return string(data)
+}
@@ -0,0 +1,111 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+// MapCategory is one of the curated categories a map can be filed under. The empty category means
+// the map is not categorized.
+type MapCategory string
+
+const (
+	MapCategoryWorld     MapCategory = "world"
+	MapCategoryCountry   MapCategory = "country"
+	MapCategoryRegion    MapCategory = "region"
+	MapCategoryUrban     MapCategory = "urban"
+	MapCategoryRural     MapCategory = "rural"
+	MapCategoryLandmarks MapCategory = "landmarks"
+	MapCategoryNature    MapCategory = "nature"
+)
+
+var MapCategories = []MapCategory{
+	MapCategoryWorld,
+	MapCategoryCountry,
+	MapCategoryRegion,
+	MapCategoryUrban,
+	MapCategoryRural,
+	MapCategoryLandmarks,
+	MapCategoryNature,
+}
+
+func ParseMapCategory(value string) (MapCategory, bool) {
+	for _, category := range MapCategories {
+		if string(category) == value {
+			return category, true
+		}
+	}
+	return "", false
+}
+
+const (
+	MaxMapTags      = 10
+	MaxMapTagLength = 32
+)
+
+// MapTags are the free-form tags of a map: lowercase letters, digits and dashes. They are stored
+// space separated so the search vector of the map can be generated from them.
+type MapTags []string
+
+// NormalizeMapTags lowercases and dedupes tags, keeping their order, and rejects invalid ones.
+func NormalizeMapTags(tags []string) (MapTags, error) {
+	normalized := make(MapTags, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !isValidTag(tag) {
+			return nil, coreerrors.BadRequest(fmt.Sprintf("invalid tag: %q (must be 1 to %d lowercase letters, digits or dashes)", tag, MaxMapTagLength))
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > MaxMapTags {
+		return nil, coreerrors.BadRequest(fmt.Sprintf("a map cannot have more than %d tags", MaxMapTags))
+	}
+	return normalized, nil
+}
+
+func isValidTag(tag string) bool {
+	if tag == "" || len(tag) > MaxMapTagLength {
+		return false
+	}
+	for _, r := range tag {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+func (t MapTags) Has(tag string) bool {
+	for _, existing := range t {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (t MapTags) Value() (driver.Value, error) {
+	return strings.Join(t, " "), nil
+}
+
+func (t *MapTags) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*t = MapTags{}
+	case string:
+		*t = strings.Fields(v)
+	case []byte:
+		*t = strings.Fields(string(v))
+	default:
+		return fmt.Errorf("cannot scan %T into MapTags", value)
+	}
+	return nil
+}
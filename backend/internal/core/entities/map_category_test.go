@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MapCategorySuite struct {
+	suite.Suite
+}
+
+func TestMapCategorySuite(t *testing.T) {
+	suite.Run(t, new(MapCategorySuite))
+}
+
+func (s *MapCategorySuite) TestNormalizeMapTags_LowercasesAndDedupes() {
+	tags, err := NormalizeMapTags([]string{" France", "old-town", "france"})
+
+	s.Require().NoError(err)
+	s.Equal(MapTags{"france", "old-town"}, tags)
+}
+
+func (s *MapCategorySuite) TestNormalizeMapTags_RejectsInvalidTags() {
+	_, err := NormalizeMapTags([]string{"two words"})
+	s.Error(err)
+
+	_, err = NormalizeMapTags([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"})
+	s.Error(err)
+}
+
+func (s *MapCategorySuite) TestMapTags_RoundTripsThroughTheDatabaseValue() {
+	value, err := MapTags{"france", "city"}.Value()
+	s.Require().NoError(err)
+	s.Equal("france city", value)
+
+	var tags MapTags
+	s.Require().NoError(tags.Scan(value))
+	s.Equal(MapTags{"france", "city"}, tags)
+}
+
+func (s *MapCategorySuite) TestClassify() {
+	m := NewMap("Paris", "desc", "owner-uuid")
+
+	s.Require().NoError(m.Classify("urban", []string{"France"}))
+	s.Equal(MapCategoryUrban, m.Category)
+	s.Equal(MapTags{"france"}, m.Tags)
+
+	s.Error(m.Classify("space", nil))
+}
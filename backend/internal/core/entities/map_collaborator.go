@@ -0,0 +1,66 @@
+package entities
+
+import "time"
+
+// MapRole is what a user may do with a map. Each role includes the rights of the ones before it:
+// viewers play private maps, editors also curate locations and the description, and the owner
+// also manages visibility, collaborators and ownership.
+type MapRole string
+
+const (
+	MapRoleViewer MapRole = "viewer"
+	MapRoleEditor MapRole = "editor"
+	MapRoleOwner  MapRole = "owner"
+)
+
+// ParseMapCollaboratorRole parses the roles a collaborator can be given; ownership is transferred instead.
+func ParseMapCollaboratorRole(value string) (MapRole, bool) {
+	switch r := MapRole(value); r {
+	case MapRoleViewer, MapRoleEditor:
+		return r, true
+	}
+	return "", false
+}
+
+func (r MapRole) rank() int {
+	switch r {
+	case MapRoleViewer:
+		return 1
+	case MapRoleEditor:
+		return 2
+	case MapRoleOwner:
+		return 3
+	}
+	return 0
+}
+
+// Satisfies reports whether r holds at least the rights of required. The empty role satisfies nothing.
+func (r MapRole) Satisfies(required MapRole) bool {
+	return r.rank() > 0 && r.rank() >= required.rank()
+}
+
+type MapCollaborator struct {
+	MapId       string    `json:"map_id" gorm:"primaryKey;type:uuid"`
+	Map         *Map      `json:"map" gorm:"foreignKey:MapId"`
+	UserId      string    `json:"user_id" gorm:"primaryKey;type:uuid;index"`
+	User        *User     `json:"user" gorm:"foreignKey:UserId"`
+	Role        MapRole   `json:"role" gorm:"not null"`
+	InvitedById string    `json:"invited_by_id" gorm:"not null;type:uuid"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+}
+
+func (MapCollaborator) TableName() string {
+	return "map_collaborators"
+}
+
+func NewMapCollaborator(mapId, userId string, role MapRole, invitedById string) *MapCollaborator {
+	return &MapCollaborator{
+		MapId:       mapId,
+		UserId:      userId,
+		Role:        role,
+		InvitedById: invitedById,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MapCollaboratorSuite struct {
+	suite.Suite
+}
+
+func TestMapCollaboratorSuite(t *testing.T) {
+	suite.Run(t, new(MapCollaboratorSuite))
+}
+
+func (s *MapCollaboratorSuite) TestTableName() {
+	s.Equal("map_collaborators", MapCollaborator{}.TableName())
+}
+
+func (s *MapCollaboratorSuite) TestParseMapCollaboratorRole() {
+	role, ok := ParseMapCollaboratorRole("editor")
+	s.True(ok)
+	s.Equal(MapRoleEditor, role)
+
+	_, ok = ParseMapCollaboratorRole("owner")
+	s.False(ok)
+	_, ok = ParseMapCollaboratorRole("admin")
+	s.False(ok)
+}
+
+func (s *MapCollaboratorSuite) TestSatisfies() {
+	s.True(MapRoleOwner.Satisfies(MapRoleEditor))
+	s.True(MapRoleEditor.Satisfies(MapRoleEditor))
+	s.True(MapRoleEditor.Satisfies(MapRoleViewer))
+	s.False(MapRoleViewer.Satisfies(MapRoleEditor))
+	s.False(MapRoleEditor.Satisfies(MapRoleOwner))
+	s.False(MapRole("").Satisfies(MapRoleViewer))
+}
@@ -0,0 +1,42 @@
+package entities
+
+import "time"
+
+// MapInvitation offers a user a role on a map, which they only get once they accept it. An
+// invitation to the owner role offers them the map itself. A user has at most one pending
+// invitation per map: inviting them again replaces it.
+type MapInvitation struct {
+	MapId       string    `json:"map_id" gorm:"primaryKey;type:uuid"`
+	Map         *Map      `json:"map" gorm:"foreignKey:MapId"`
+	UserId      string    `json:"user_id" gorm:"primaryKey;type:uuid;index"`
+	User        *User     `json:"user" gorm:"foreignKey:UserId"`
+	Role        MapRole   `json:"role" gorm:"not null"`
+	InvitedById string    `json:"invited_by_id" gorm:"not null;type:uuid"`
+	InvitedBy   *User     `json:"invited_by" gorm:"foreignKey:InvitedById"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+}
+
+func (MapInvitation) TableName() string {
+	return "map_invitations"
+}
+
+func NewMapInvitation(mapId, userId string, role MapRole, invitedById string) *MapInvitation {
+	return &MapInvitation{
+		MapId:       mapId,
+		UserId:      userId,
+		Role:        role,
+		InvitedById: invitedById,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// IsOwnershipTransfer reports whether the invitation offers the map itself rather than a role on it.
+func (i *MapInvitation) IsOwnershipTransfer() bool {
+	return i.Role == MapRoleOwner
+}
+
+// IsValidFor reports whether the invitation still stands for m: only the owner invites, so it
+// lapses once the inviter no longer owns the map.
+func (i *MapInvitation) IsValidFor(m *Map) bool {
+	return m.ID == i.MapId && m.IsOwnedBy(i.InvitedById)
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MapInvitationSuite struct {
+	suite.Suite
+}
+
+func TestMapInvitationSuite(t *testing.T) {
+	suite.Run(t, new(MapInvitationSuite))
+}
+
+func (s *MapInvitationSuite) TestTableName() {
+	s.Equal("map_invitations", MapInvitation{}.TableName())
+}
+
+func (s *MapInvitationSuite) TestIsOwnershipTransfer() {
+	s.True(NewMapInvitation("map-uuid", "bob-uuid", MapRoleOwner, "owner-uuid").IsOwnershipTransfer())
+	s.False(NewMapInvitation("map-uuid", "bob-uuid", MapRoleEditor, "owner-uuid").IsOwnershipTransfer())
+}
+
+func (s *MapInvitationSuite) TestIsValidFor_LapsesWhenInviterNoLongerOwnsTheMap() {
+	m := RestoreMap("map-uuid", "Brazil", "", "owner-uuid")
+	invitation := NewMapInvitation("map-uuid", "bob-uuid", MapRoleViewer, "owner-uuid")
+
+	s.True(invitation.IsValidFor(m))
+	m.OwnerId = "carol-uuid"
+	s.False(invitation.IsValidFor(m))
+}
@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+type MapLike struct {
+	MapId     string    `json:"map_id" gorm:"primaryKey;type:uuid"`
+	Map       *Map      `json:"map" gorm:"foreignKey:MapId"`
+	UserId    string    `json:"user_id" gorm:"primaryKey;type:uuid;index"`
+	User      *User     `json:"user" gorm:"foreignKey:UserId"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+}
+
+func (MapLike) TableName() string {
+	return "map_likes"
+}
+
+func NewMapLike(mapId, userId string) *MapLike {
+	return &MapLike{
+		MapId:     mapId,
+		UserId:    userId,
+		CreatedAt: time.Now(),
+	}
+}
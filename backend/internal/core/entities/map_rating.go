@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"time"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+const (
+	MinMapDifficulty = 1
+	MaxMapDifficulty = 5
+)
+
+// MapRating is the difficulty a player gives a map, from MinMapDifficulty to MaxMapDifficulty.
+type MapRating struct {
+	MapId      string    `json:"map_id" gorm:"primaryKey;type:uuid"`
+	Map        *Map      `json:"map" gorm:"foreignKey:MapId"`
+	UserId     string    `json:"user_id" gorm:"primaryKey;type:uuid;index"`
+	User       *User     `json:"user" gorm:"foreignKey:UserId"`
+	Difficulty int       `json:"difficulty" gorm:"not null"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+}
+
+func (MapRating) TableName() string {
+	return "map_ratings"
+}
+
+func NewMapRating(mapId, userId string, difficulty int) (*MapRating, error) {
+	if difficulty < MinMapDifficulty || difficulty > MaxMapDifficulty {
+		return nil, coreerrors.BadRequest("difficulty must be between 1 and 5")
+	}
+	return &MapRating{
+		MapId:      mapId,
+		UserId:     userId,
+		Difficulty: difficulty,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}, nil
+}
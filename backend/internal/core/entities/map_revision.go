@@ -0,0 +1,154 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MapRevisionAction string
+
+const (
+	MapRevisionActionAdded   MapRevisionAction = "added"
+	MapRevisionActionEdited  MapRevisionAction = "edited"
+	MapRevisionActionRemoved MapRevisionAction = "removed"
+)
+
+// LocationSnapshot is the state of a location as recorded in a map revision.
+type LocationSnapshot struct {
+	PanoId      string  `json:"pano_id"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Heading     float64 `json:"heading"`
+	Pitch       float64 `json:"pitch"`
+	CountryCode string  `json:"country_code"`
+	// Provider defaults to google for the snapshots recorded before providers existed.
+	Provider    PanoramaProvider `json:"provider" gorm:"not null;default:google"`
+	CaptureDate string           `json:"capture_date" gorm:"not null;default:''"`
+	Tags        LocationTags     `json:"tags" gorm:"type:text;not null;default:''"`
+	Note        string           `json:"note" gorm:"not null;default:''"`
+}
+
+func SnapshotLocation(l *Location) LocationSnapshot {
+	return LocationSnapshot{
+		PanoId:      l.PanoId,
+		Latitude:    l.Latitude,
+		Longitude:   l.Longitude,
+		Heading:     l.Heading,
+		Pitch:       l.Pitch,
+		CountryCode: l.CountryCode,
+		Provider:    l.Provider,
+		CaptureDate: l.CaptureDate,
+		Tags:        append(LocationTags{}, l.Tags...),
+		Note:        l.Note,
+	}
+}
+
+// Equal reports whether both snapshots hold the same location state.
+func (s LocationSnapshot) Equal(other LocationSnapshot) bool {
+	return s.PanoId == other.PanoId &&
+		s.Latitude == other.Latitude &&
+		s.Longitude == other.Longitude &&
+		s.Heading == other.Heading &&
+		s.Pitch == other.Pitch &&
+		s.CountryCode == other.CountryCode &&
+		s.Provider == other.Provider &&
+		s.CaptureDate == other.CaptureDate &&
+		s.Tags.Equal(other.Tags) &&
+		s.Note == other.Note
+}
+
+// ApplyTo overwrites the location with the snapshot.
+func (s LocationSnapshot) ApplyTo(l *Location) {
+	l.PanoId = s.PanoId
+	l.Latitude = s.Latitude
+	l.Longitude = s.Longitude
+	l.Heading = s.Heading
+	l.Pitch = s.Pitch
+	l.CountryCode = s.CountryCode
+	l.Provider = s.Provider
+	l.CaptureDate = s.CaptureDate
+	l.Tags = append(LocationTags{}, s.Tags...)
+	l.Note = s.Note
+}
+
+// MapRevision is one batch of location changes on a map, changing each location at most once.
+// Revisions are numbered from 1 per map; revision 0 is the map as it was created, or as it was
+// before revisions were recorded.
+type MapRevision struct {
+	ID       string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	MapId    string `json:"map_id" gorm:"not null;type:uuid;uniqueIndex:idx_map_revisions_map_number,priority:1"`
+	Map      *Map   `json:"-" gorm:"foreignKey:MapId"`
+	Number   int    `json:"number" gorm:"not null;uniqueIndex:idx_map_revisions_map_number,priority:2"`
+	AuthorId string `json:"author_id" gorm:"not null;type:uuid;index"`
+	Author   *User  `json:"-" gorm:"foreignKey:AuthorId"`
+	// RevertedTo is the revision the map was reverted to, when the revision is a revert.
+	RevertedTo *int                 `json:"reverted_to"`
+	Changes    []*MapRevisionChange `json:"changes,omitempty" gorm:"foreignKey:RevisionId;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time            `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+}
+
+func (MapRevision) TableName() string {
+	return "map_revisions"
+}
+
+func NewMapRevision(mapId string, number int, authorId string) *MapRevision {
+	return &MapRevision{
+		ID:       uuid.New().String(),
+		MapId:    mapId,
+		Number:   number,
+		AuthorId: authorId,
+	}
+}
+
+// MapRevisionChange is the change of one location in a revision. Before is empty for additions and
+// After is empty for removals.
+type MapRevisionChange struct {
+	ID         string            `json:"-" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	RevisionId string            `json:"-" gorm:"not null;type:uuid;index"`
+	Action     MapRevisionAction `json:"action" gorm:"not null"`
+	LocationId string            `json:"location_id" gorm:"not null;type:uuid"`
+	Before     LocationSnapshot  `json:"before" gorm:"embedded;embeddedPrefix:before_"`
+	After      LocationSnapshot  `json:"after" gorm:"embedded;embeddedPrefix:after_"`
+}
+
+func (MapRevisionChange) TableName() string {
+	return "map_revision_changes"
+}
+
+func NewLocationAddedChange(l *Location) *MapRevisionChange {
+	return &MapRevisionChange{Action: MapRevisionActionAdded, LocationId: l.ID, After: SnapshotLocation(l)}
+}
+
+func NewLocationEditedChange(before, after *Location) *MapRevisionChange {
+	return &MapRevisionChange{Action: MapRevisionActionEdited, LocationId: after.ID, Before: SnapshotLocation(before), After: SnapshotLocation(after)}
+}
+
+func NewLocationRemovedChange(l *Location) *MapRevisionChange {
+	return &MapRevisionChange{Action: MapRevisionActionRemoved, LocationId: l.ID, Before: SnapshotLocation(l)}
+}
+
+// Undo rolls the change back in state, the map's locations keyed by id.
+func (c *MapRevisionChange) Undo(state map[string]LocationSnapshot) {
+	switch c.Action {
+	case MapRevisionActionAdded:
+		delete(state, c.LocationId)
+	case MapRevisionActionEdited, MapRevisionActionRemoved:
+		state[c.LocationId] = c.Before
+	}
+}
+
+// RewindLocations returns the locations state had before revisions, which must be the latest
+// revisions of the map in ascending order. state is left untouched.
+func RewindLocations(state map[string]LocationSnapshot, revisions []*MapRevision) map[string]LocationSnapshot {
+	rewound := make(map[string]LocationSnapshot, len(state))
+	for id, snapshot := range state {
+		rewound[id] = snapshot
+	}
+	for i := len(revisions) - 1; i >= 0; i-- {
+		for _, change := range revisions[i].Changes {
+			change.Undo(rewound)
+		}
+	}
+	return rewound
+}
@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MapRevisionSuite struct {
+	suite.Suite
+}
+
+func TestMapRevisionSuite(t *testing.T) {
+	suite.Run(t, new(MapRevisionSuite))
+}
+
+func (s *MapRevisionSuite) TestTableName() {
+	s.Equal("map_revisions", MapRevision{}.TableName())
+	s.Equal("map_revision_changes", MapRevisionChange{}.TableName())
+}
+
+func (s *MapRevisionSuite) TestRewindLocations_UndoesLaterRevisions() {
+	kept := RestoreLocation("loc-1", "pano-1", "map-uuid", 1, 1, 0, 0)
+	removed := RestoreLocation("loc-2", "pano-2", "map-uuid", 2, 2, 0, 0)
+	added := RestoreLocation("loc-3", "pano-3", "map-uuid", 3, 3, 0, 0)
+	edited := *kept
+	edited.Heading = 90
+
+	first := NewMapRevision("map-uuid", 1, "user-uuid")
+	first.Changes = []*MapRevisionChange{NewLocationRemovedChange(removed), NewLocationAddedChange(added)}
+	second := NewMapRevision("map-uuid", 2, "user-uuid")
+	second.Changes = []*MapRevisionChange{NewLocationEditedChange(kept, &edited)}
+	current := map[string]LocationSnapshot{
+		"loc-1": SnapshotLocation(&edited),
+		"loc-3": SnapshotLocation(added),
+	}
+
+	rewound := RewindLocations(current, []*MapRevision{first, second})
+
+	s.Equal(map[string]LocationSnapshot{
+		"loc-1": SnapshotLocation(kept),
+		"loc-2": SnapshotLocation(removed),
+	}, rewound)
+	s.Len(current, 2, "the current state is left untouched")
+	s.Equal(float64(90), current["loc-1"].Heading)
+}
+
+func (s *MapRevisionSuite) TestSnapshot_KeepsTheMetadata() {
+	location := RestoreLocation("loc-1", "pano-1", "map-uuid", 1, 1, 0, 0)
+	location.Provider = PanoramaProviderCustom
+	location.CaptureDate = "2021-03-02"
+	location.Tags = LocationTags{"snow"}
+	location.Note = "note"
+	snapshot := SnapshotLocation(location)
+
+	location.Tags = append(location.Tags, "coastal")
+	s.False(snapshot.Equal(SnapshotLocation(location)))
+
+	restored := RestoreLocation("loc-1", "", "map-uuid", 0, 0, 0, 0)
+	snapshot.ApplyTo(restored)
+	s.True(snapshot.Equal(SnapshotLocation(restored)))
+	s.Equal(PanoramaProviderCustom, restored.Provider)
+	s.Equal(LocationTags{"snow"}, restored.Tags)
+}
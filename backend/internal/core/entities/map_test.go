@@ -2,6 +2,7 @@ package entities
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -31,6 +32,7 @@ func (s *MapSuite) TestNewMap() {
 	s.Equal(name, m.Name)
 	s.Equal(description, m.Description)
 	s.Equal(ownerId, m.OwnerId)
+	s.Equal(MapVisibilityPrivate, m.Visibility)
 }
 
 func (s *MapSuite) TestRestoreMap() {
@@ -47,3 +49,82 @@ func (s *MapSuite) TestRestoreMap() {
 	s.Equal(description, m.Description)
 	s.Equal(ownerId, m.OwnerId)
 }
+
+func (s *MapSuite) TestIsOwnedBy() {
+	m := NewMap("Brazil", "", "owner-uuid")
+
+	s.True(m.IsOwnedBy("owner-uuid"))
+	s.False(m.IsOwnedBy("other-uuid"))
+}
+
+func (s *MapSuite) TestChangeVisibility_Publish() {
+	m := NewMap("Brazil", "", "owner-uuid")
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	s.Require().NoError(m.ChangeVisibility(MapVisibilityPublic, MinPublicMapLocations, now))
+
+	s.Equal(MapVisibilityPublic, m.Visibility)
+	s.Require().NotNil(m.PublishedAt)
+	s.Equal(now, *m.PublishedAt)
+}
+
+func (s *MapSuite) TestChangeVisibility_RepublishKeepsFirstPublication() {
+	m := NewMap("Brazil", "", "owner-uuid")
+	first := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.Require().NoError(m.ChangeVisibility(MapVisibilityPublic, MinPublicMapLocations, first))
+	s.Require().NoError(m.ChangeVisibility(MapVisibilityPrivate, MinPublicMapLocations, first.Add(time.Hour)))
+
+	s.Require().NoError(m.ChangeVisibility(MapVisibilityPublic, MinPublicMapLocations, first.Add(2*time.Hour)))
+
+	s.Equal(first, *m.PublishedAt)
+}
+
+func (s *MapSuite) TestChangeVisibility_PublishWithTooFewLocations_ReturnsBadRequest() {
+	m := NewMap("Brazil", "", "owner-uuid")
+
+	err := m.ChangeVisibility(MapVisibilityPublic, MinPublicMapLocations-1, time.Now())
+
+	s.Require().Error(err)
+	s.Equal(MapVisibilityPrivate, m.Visibility)
+	s.Nil(m.PublishedAt)
+}
+
+func (s *MapSuite) TestChangeVisibility_Unlisted_NeedsNoLocations() {
+	m := NewMap("Brazil", "", "owner-uuid")
+
+	s.Require().NoError(m.ChangeVisibility(MapVisibilityUnlisted, 0, time.Now()))
+
+	s.Equal(MapVisibilityUnlisted, m.Visibility)
+}
+
+func (s *MapSuite) TestChangeVisibility_Invalid_ReturnsBadRequest() {
+	m := NewMap("Brazil", "", "owner-uuid")
+
+	s.Error(m.ChangeVisibility(MapVisibility("secret"), 10, time.Now()))
+}
+
+func (s *MapSuite) TestPlaceGuess_WithoutBoundary_KeepsTheGuess() {
+	m := NewMap("World", "", "owner-uuid")
+
+	latitude, longitude, err := m.PlaceGuess(-89, 179)
+
+	s.Require().NoError(err)
+	s.Equal(-89.0, latitude)
+	s.Equal(179.0, longitude)
+}
+
+func (s *MapSuite) TestPlaceGuess_OutsideBoundary() {
+	m := NewMap("Square", "", "owner-uuid")
+	boundary, err := ParseMapBoundary([]byte(squareWithHole))
+	s.Require().NoError(err)
+	m.Boundary = boundary
+
+	_, _, err = m.PlaceGuess(5, 12)
+	s.Error(err)
+
+	m.OutsideGuesses = OutsideGuessClamp
+	latitude, longitude, err := m.PlaceGuess(5, 12)
+	s.Require().NoError(err)
+	s.InDelta(5, latitude, 1e-9)
+	s.InDelta(10, longitude, 1e-9)
+}
@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	// NotificationTypeFriendRequest tells the addressee that the actor asked to be friends.
+	NotificationTypeFriendRequest NotificationType = "friend_request"
+	// NotificationTypeFriendAccepted tells the requester that the actor accepted their request.
+	NotificationTypeFriendAccepted NotificationType = "friend_accepted"
+	// NotificationTypeMapInvitation tells a user the actor invited them to collaborate on the map
+	// SubjectId, or offered them its ownership.
+	NotificationTypeMapInvitation NotificationType = "map_invitation"
+	// NotificationTypeMapCollaboratorAdded tells the owner the actor accepted their invitation to the map SubjectId.
+	NotificationTypeMapCollaboratorAdded NotificationType = "map_collaborator_added"
+	// NotificationTypeMapOwnershipTransferred tells the previous owner the actor accepted the map SubjectId.
+	NotificationTypeMapOwnershipTransferred NotificationType = "map_ownership_transferred"
+	// NotificationTypeMapLiked tells the owner the actor liked the map SubjectId.
+	NotificationTypeMapLiked NotificationType = "map_liked"
+)
+
+// Notification is an entry of a user's inbox. ActorId is the user who caused it, when there is one,
+// and SubjectId the id of what it is about, whose kind depends on Type.
+type Notification struct {
+	ID        string           `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserId    string           `json:"user_id" gorm:"not null;type:uuid;index:idx_notifications_inbox,priority:1"`
+	Type      NotificationType `json:"type" gorm:"not null"`
+	ActorId   *string          `json:"actor_id" gorm:"type:uuid;default:null"`
+	Actor     *User            `json:"actor" gorm:"foreignKey:ActorId"`
+	SubjectId string           `json:"subject_id"`
+	ReadAt    *time.Time       `json:"read_at" gorm:"type:timestamptz;default:null"`
+	CreatedAt time.Time        `json:"created_at" gorm:"autoCreateTime;type:timestamptz;index:idx_notifications_inbox,priority:2,sort:desc"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+func NewNotification(userId string, notificationType NotificationType, actorId, subjectId string) *Notification {
+	notification := &Notification{
+		ID:        uuid.New().String(),
+		UserId:    userId,
+		Type:      notificationType,
+		SubjectId: subjectId,
+		CreatedAt: time.Now(),
+	}
+	if actorId != "" {
+		notification.ActorId = &actorId
+	}
+	return notification
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// MarkRead records when the notification was read. Reading it again keeps the first timestamp.
+func (n *Notification) MarkRead(now time.Time) {
+	if n.ReadAt != nil {
+		return
+	}
+	n.ReadAt = &now
+}
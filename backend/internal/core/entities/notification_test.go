@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationSuite struct {
+	suite.Suite
+}
+
+func TestNotificationSuite(t *testing.T) {
+	suite.Run(t, new(NotificationSuite))
+}
+
+func (s *NotificationSuite) TestTableName() {
+	s.Equal("notifications", Notification{}.TableName())
+}
+
+func (s *NotificationSuite) TestNewNotification() {
+	n := NewNotification("bob-uuid", NotificationTypeFriendRequest, "alice-uuid", "alice-uuid")
+
+	s.NotEmpty(n.ID)
+	s.Equal("bob-uuid", n.UserId)
+	s.Equal(NotificationTypeFriendRequest, n.Type)
+	s.Require().NotNil(n.ActorId)
+	s.Equal("alice-uuid", *n.ActorId)
+	s.False(n.IsRead())
+}
+
+func (s *NotificationSuite) TestNewNotification_WithoutActor() {
+	n := NewNotification("bob-uuid", NotificationTypeFriendAccepted, "", "subject")
+
+	s.Nil(n.ActorId)
+}
+
+func (s *NotificationSuite) TestMarkRead_KeepsFirstTimestamp() {
+	n := NewNotification("bob-uuid", NotificationTypeFriendRequest, "alice-uuid", "alice-uuid")
+	first := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	n.MarkRead(first)
+	n.MarkRead(first.Add(time.Hour))
+
+	s.True(n.IsRead())
+	s.Equal(first, *n.ReadAt)
+}
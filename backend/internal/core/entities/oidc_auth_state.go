@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+)
+
+// OidcAuthState holds the server-side half of an in-flight OIDC authorization request:
+// the opaque state sent to the provider, the PKCE code verifier and the expected ID token nonce.
+// When UserId is set the flow links the provider to that user instead of signing in.
+type OidcAuthState struct {
+	ID           string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	State        string    `json:"state" gorm:"not null;uniqueIndex"`
+	Provider     string    `json:"provider" gorm:"not null"`
+	CodeVerifier string    `json:"-" gorm:"not null"`
+	Nonce        string    `json:"-" gorm:"not null"`
+	UserId       *string   `json:"user_id" gorm:"type:uuid;default:null"`
+	ExpiresAt    time.Time `json:"expires_at" gorm:"not null;type:timestamptz"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+}
+
+func (OidcAuthState) TableName() string {
+	return "oidc_auth_states"
+}
+
+func NewOidcAuthState(state, provider, codeVerifier, nonce string, userId *string, expiresAt time.Time) *OidcAuthState {
+	return &OidcAuthState{
+		State:        state,
+		Provider:     provider,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		UserId:       userId,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+func (s *OidcAuthState) IsExpired() bool {
+	return time.Now().After(s.ExpiresAt)
+}
+
+// IsLink reports whether the flow was started by a signed-in user to link a new provider.
+func (s *OidcAuthState) IsLink() bool {
+	return s.UserId != nil
+}
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OidcAuthStateSuite struct {
+	suite.Suite
+}
+
+func TestOidcAuthStateSuite(t *testing.T) {
+	suite.Run(t, new(OidcAuthStateSuite))
+}
+
+func (s *OidcAuthStateSuite) TestTableName() {
+	tableName := (OidcAuthState{}).TableName()
+	s.Equal("oidc_auth_states", tableName)
+}
+
+func (s *OidcAuthStateSuite) TestNewOidcAuthState() {
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	state := NewOidcAuthState("state-123", "google", "verifier", "nonce", nil, expiresAt)
+
+	s.NotNil(state)
+	s.Equal("state-123", state.State)
+	s.Equal("google", state.Provider)
+	s.Equal("verifier", state.CodeVerifier)
+	s.Equal("nonce", state.Nonce)
+	s.Nil(state.UserId)
+	s.Equal(expiresAt, state.ExpiresAt)
+}
+
+func (s *OidcAuthStateSuite) TestIsExpired() {
+	s.False(NewOidcAuthState("state", "google", "v", "n", nil, time.Now().Add(time.Minute)).IsExpired())
+	s.True(NewOidcAuthState("state", "google", "v", "n", nil, time.Now().Add(-time.Minute)).IsExpired())
+}
+
+func (s *OidcAuthStateSuite) TestIsLink() {
+	userId := "user-uuid"
+
+	s.False(NewOidcAuthState("state", "google", "v", "n", nil, time.Now()).IsLink())
+	s.True(NewOidcAuthState("state", "google", "v", "n", &userId, time.Now()).IsLink())
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// TokenScope is a permission granted to a personal access token. Access tokens of a signed-in
+// session are not scoped; scopes only restrict personal access tokens.
+type TokenScope string
+
+const (
+	TokenScopeMapsRead  TokenScope = "maps:read"
+	TokenScopeGamesPlay TokenScope = "games:play"
+	TokenScopeStatsRead TokenScope = "stats:read"
+)
+
+var tokenScopes = map[TokenScope]bool{
+	TokenScopeMapsRead:  true,
+	TokenScopeGamesPlay: true,
+	TokenScopeStatsRead: true,
+}
+
+// ParseTokenScope returns the TokenScope named s, or false if there is none.
+func ParseTokenScope(s string) (TokenScope, bool) {
+	scope := TokenScope(s)
+	return scope, tokenScopes[scope]
+}
+
+// personalAccessTokenUsageResolution bounds how often LastUsedAt is written, so a busy bot does not
+// turn every request into a write.
+const personalAccessTokenUsageResolution = time.Minute
+
+// PersonalAccessToken lets scripts and bots act as a user without the user's password.
+// Only the hash of the token is stored; the plain token is shown to the user once.
+type PersonalAccessToken struct {
+	ID          string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserId      string `json:"user_id" gorm:"not null;type:uuid;index"`
+	Name        string `json:"name" gorm:"not null"`
+	TokenPrefix string `json:"token_prefix" gorm:"not null"`
+	TokenHash   string `json:"-" gorm:"not null;uniqueIndex"`
+	// Scopes is the space separated list of granted scopes.
+	Scopes     string     `json:"scopes" gorm:"not null"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"not null;type:timestamptz"`
+	LastUsedAt *time.Time `json:"last_used_at" gorm:"type:timestamptz;default:null"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	UpdatedAt  time.Time  `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+}
+
+func (PersonalAccessToken) TableName() string {
+	return "personal_access_tokens"
+}
+
+func NewPersonalAccessToken(userId, name, tokenPrefix, tokenHash string, scopes []TokenScope, expiresAt time.Time) *PersonalAccessToken {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	return &PersonalAccessToken{
+		UserId:      userId,
+		Name:        name,
+		TokenPrefix: tokenPrefix,
+		TokenHash:   tokenHash,
+		Scopes:      strings.Join(names, " "),
+		ExpiresAt:   expiresAt,
+	}
+}
+
+func (t *PersonalAccessToken) ScopeList() []TokenScope {
+	fields := strings.Fields(t.Scopes)
+	scopes := make([]TokenScope, len(fields))
+	for i, field := range fields {
+		scopes[i] = TokenScope(field)
+	}
+	return scopes
+}
+
+// HasScopes reports whether the token was granted every one of scopes.
+func (t *PersonalAccessToken) HasScopes(scopes ...TokenScope) bool {
+	granted := t.ScopeList()
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *PersonalAccessToken) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// MarkUsed records a use of the token at now. It returns false, leaving the token untouched, when
+// the last recorded use is too recent to be worth persisting again.
+func (t *PersonalAccessToken) MarkUsed(now time.Time) bool {
+	if t.LastUsedAt != nil && now.Sub(*t.LastUsedAt) < personalAccessTokenUsageResolution {
+		return false
+	}
+	t.LastUsedAt = &now
+	return true
+}
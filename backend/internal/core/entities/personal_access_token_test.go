@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PersonalAccessTokenSuite struct {
+	suite.Suite
+}
+
+func TestPersonalAccessTokenSuite(t *testing.T) {
+	suite.Run(t, new(PersonalAccessTokenSuite))
+}
+
+func (s *PersonalAccessTokenSuite) TestTableName() {
+	s.Equal("personal_access_tokens", (PersonalAccessToken{}).TableName())
+}
+
+func (s *PersonalAccessTokenSuite) TestParseTokenScope() {
+	scope, ok := ParseTokenScope("games:play")
+	s.True(ok)
+	s.Equal(TokenScopeGamesPlay, scope)
+
+	_, ok = ParseTokenScope("admin")
+	s.False(ok)
+}
+
+func (s *PersonalAccessTokenSuite) TestNewPersonalAccessToken_StoresScopes() {
+	token := NewPersonalAccessToken("user-uuid", "bot", "mgp_abc", "hash", []TokenScope{TokenScopeMapsRead, TokenScopeStatsRead}, time.Now().Add(time.Hour))
+
+	s.Equal("maps:read stats:read", token.Scopes)
+	s.Equal([]TokenScope{TokenScopeMapsRead, TokenScopeStatsRead}, token.ScopeList())
+	s.False(token.IsExpired())
+}
+
+func (s *PersonalAccessTokenSuite) TestHasScopes_RequiresEveryScope() {
+	token := NewPersonalAccessToken("user-uuid", "bot", "mgp_abc", "hash", []TokenScope{TokenScopeMapsRead, TokenScopeStatsRead}, time.Now().Add(time.Hour))
+
+	s.True(token.HasScopes(TokenScopeMapsRead))
+	s.True(token.HasScopes(TokenScopeMapsRead, TokenScopeStatsRead))
+	s.False(token.HasScopes(TokenScopeMapsRead, TokenScopeGamesPlay))
+}
+
+func (s *PersonalAccessTokenSuite) TestIsExpired() {
+	token := NewPersonalAccessToken("user-uuid", "bot", "mgp_abc", "hash", nil, time.Now().Add(-time.Second))
+
+	s.True(token.IsExpired())
+}
+
+func (s *PersonalAccessTokenSuite) TestMarkUsed_ThrottlesWrites() {
+	token := NewPersonalAccessToken("user-uuid", "bot", "mgp_abc", "hash", nil, time.Now().Add(time.Hour))
+	now := time.Now()
+
+	s.True(token.MarkUsed(now))
+	s.Equal(now, *token.LastUsedAt)
+	s.False(token.MarkUsed(now.Add(30 * time.Second)))
+	s.Equal(now, *token.LastUsedAt)
+	s.True(token.MarkUsed(now.Add(2 * time.Minute)))
+	s.Equal(now.Add(2*time.Minute), *token.LastUsedAt)
+}
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimitRule describes a token bucket: it holds at most Capacity tokens and gains one every RefillInterval.
+// A zero Capacity disables limiting.
+type RateLimitRule struct {
+	Capacity       int
+	RefillInterval time.Duration
+}
+
+func (r RateLimitRule) IsDisabled() bool {
+	return r.Capacity <= 0 || r.RefillInterval <= 0
+}
+
+// RateLimitDecision is the outcome of taking a token from a bucket.
+type RateLimitDecision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is how long until the next token is available; zero when the request was allowed.
+	RetryAfter time.Duration
+	// ResetAfter is how long until the bucket is full again.
+	ResetAfter time.Duration
+}
+
+// RateLimitBucket is the persisted state of one token bucket. Tokens is only meaningful together with
+// RefilledAt, the moment it was last brought up to date.
+type RateLimitBucket struct {
+	Key        string    `json:"key" gorm:"primaryKey"`
+	Tokens     float64   `json:"tokens" gorm:"not null"`
+	RefilledAt time.Time `json:"refilled_at" gorm:"not null;type:timestamptz;index"`
+	// FullAt is when the bucket is back at capacity. From then on it is indistinguishable from a new
+	// one and can be discarded.
+	FullAt time.Time `json:"full_at" gorm:"not null;default:now();type:timestamptz;index"`
+}
+
+func (RateLimitBucket) TableName() string {
+	return "rate_limit_buckets"
+}
+
+// NewRateLimitBucket returns a full bucket.
+func NewRateLimitBucket(key string, rule RateLimitRule, now time.Time) *RateLimitBucket {
+	return &RateLimitBucket{
+		Key:        key,
+		Tokens:     float64(rule.Capacity),
+		RefilledAt: now,
+		FullAt:     now,
+	}
+}
+
+// Take refills the bucket for the time elapsed since it was last used and consumes one token if available.
+func (b *RateLimitBucket) Take(rule RateLimitRule, now time.Time) RateLimitDecision {
+	b.refill(rule, now)
+
+	decision := RateLimitDecision{Limit: rule.Capacity}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = time.Duration((1 - b.Tokens) * float64(rule.RefillInterval))
+	}
+	decision.Remaining = int(math.Floor(b.Tokens))
+	decision.ResetAfter = time.Duration((float64(rule.Capacity) - b.Tokens) * float64(rule.RefillInterval))
+	b.FullAt = now.Add(decision.ResetAfter)
+	return decision
+}
+
+// IsFull reports whether the bucket would be back at capacity at now, in which case it is
+// indistinguishable from a new one and can be discarded.
+func (b *RateLimitBucket) IsFull(rule RateLimitRule, now time.Time) bool {
+	elapsed := now.Sub(b.RefilledAt)
+	return b.Tokens+float64(elapsed)/float64(rule.RefillInterval) >= float64(rule.Capacity)
+}
+
+func (b *RateLimitBucket) refill(rule RateLimitRule, now time.Time) {
+	if elapsed := now.Sub(b.RefilledAt); elapsed > 0 {
+		b.Tokens = math.Min(float64(rule.Capacity), b.Tokens+float64(elapsed)/float64(rule.RefillInterval))
+	}
+	// Buckets created for a larger rule shrink to the current capacity.
+	b.Tokens = math.Min(b.Tokens, float64(rule.Capacity))
+	b.RefilledAt = now
+}
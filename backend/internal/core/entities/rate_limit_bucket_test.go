@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitBucketSuite struct {
+	suite.Suite
+	rule RateLimitRule
+	now  time.Time
+}
+
+func TestRateLimitBucketSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitBucketSuite))
+}
+
+func (s *RateLimitBucketSuite) SetupTest() {
+	s.rule = RateLimitRule{Capacity: 3, RefillInterval: 10 * time.Second}
+	s.now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *RateLimitBucketSuite) TestTableName() {
+	s.Equal("rate_limit_buckets", (RateLimitBucket{}).TableName())
+}
+
+func (s *RateLimitBucketSuite) TestTake_AllowsBurstUpToCapacity() {
+	bucket := NewRateLimitBucket("key", s.rule, s.now)
+
+	for remaining := 2; remaining >= 0; remaining-- {
+		decision := bucket.Take(s.rule, s.now)
+		s.True(decision.Allowed)
+		s.Equal(3, decision.Limit)
+		s.Equal(remaining, decision.Remaining)
+		s.Zero(decision.RetryAfter)
+	}
+
+	decision := bucket.Take(s.rule, s.now)
+	s.False(decision.Allowed)
+	s.Equal(0, decision.Remaining)
+	s.Equal(10*time.Second, decision.RetryAfter)
+	s.Equal(30*time.Second, decision.ResetAfter)
+	s.Equal(s.now.Add(30*time.Second), bucket.FullAt)
+}
+
+func (s *RateLimitBucketSuite) TestTake_RefillsOverTime() {
+	bucket := NewRateLimitBucket("key", s.rule, s.now)
+	for i := 0; i < 3; i++ {
+		bucket.Take(s.rule, s.now)
+	}
+
+	decision := bucket.Take(s.rule, s.now.Add(4*time.Second))
+	s.False(decision.Allowed)
+	s.Equal(6*time.Second, decision.RetryAfter)
+
+	decision = bucket.Take(s.rule, s.now.Add(10*time.Second))
+	s.True(decision.Allowed)
+	s.Equal(0, decision.Remaining)
+}
+
+func (s *RateLimitBucketSuite) TestTake_NeverRefillsAboveCapacity() {
+	bucket := NewRateLimitBucket("key", s.rule, s.now)
+
+	decision := bucket.Take(s.rule, s.now.Add(time.Hour))
+
+	s.True(decision.Allowed)
+	s.Equal(2, decision.Remaining)
+}
+
+func (s *RateLimitBucketSuite) TestIsFull() {
+	bucket := NewRateLimitBucket("key", s.rule, s.now)
+	s.True(bucket.IsFull(s.rule, s.now))
+
+	bucket.Take(s.rule, s.now)
+	s.False(bucket.IsFull(s.rule, s.now.Add(5*time.Second)))
+	s.True(bucket.IsFull(s.rule, s.now.Add(10*time.Second)))
+}
+
+func (s *RateLimitBucketSuite) TestRateLimitRule_IsDisabled() {
+	s.False(s.rule.IsDisabled())
+	s.True(RateLimitRule{}.IsDisabled())
+	s.True(RateLimitRule{Capacity: 5}.IsDisabled())
+}
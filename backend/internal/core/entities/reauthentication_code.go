@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"time"
+)
+
+// ReauthenticationCode lets a user without a password, signed up through an identity provider, prove
+// they still control their account before changing its credentials. It is mailed to their current
+// address and used once.
+type ReauthenticationCode struct {
+	UserId    string    `json:"user_id" gorm:"primaryKey;type:uuid"`
+	CodeHash  string    `json:"-" gorm:"not null"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"not null;type:timestamptz"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+}
+
+func (ReauthenticationCode) TableName() string {
+	return "reauthentication_codes"
+}
+
+func NewReauthenticationCode(userId, codeHash string, expiresAt time.Time) *ReauthenticationCode {
+	return &ReauthenticationCode{
+		UserId:    userId,
+		CodeHash:  codeHash,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (c *ReauthenticationCode) IsExpired() bool {
+	return time.Now().After(c.ExpiresAt)
+}
@@ -14,6 +14,8 @@ const (
 	SinglePlayerGameStatusPending SinglePlayerGameStatus = "pending"
 	SinglePlayerGameStatusInProgress SinglePlayerGameStatus = "in_progress"
 	SinglePlayerGameStatusCompleted SinglePlayerGameStatus = "completed"
+	// SinglePlayerGameStatusAbandoned marks a game that was ended without being finished, e.g. by an admin.
+	SinglePlayerGameStatusAbandoned SinglePlayerGameStatus = "abandoned"
 )
 
 type SinglePlayerGameMode string
@@ -26,10 +28,12 @@ const (
 
 type SinglePlayerGame struct {
 	ID string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	UserId string `json:"user_id" gorm:"not null;type:uuid"`
+	UserId string `json:"user_id" gorm:"not null;type:uuid;index:idx_single_player_games_user_map,priority:1"`
 	User *User `json:"user" gorm:"foreignKey:UserId"`
-	MapId string `json:"map_id" gorm:"not null;type:uuid"`
+	MapId string `json:"map_id" gorm:"not null;type:uuid;index:idx_single_player_games_user_map,priority:2"`
 	Map *Map `json:"map" gorm:"foreignKey:MapId"`
+	// MapRevision is the map revision the rounds were drawn from.
+	MapRevision int `json:"map_revision" gorm:"not null;default:0"`
 	Score int `json:"score"`
 	Status SinglePlayerGameStatus `json:"status" gorm:"not null;default:pending"`
 	StartedAt *time.Time `json:"started_at" gorm:"type:timestamptz;default:null"`
@@ -38,7 +42,7 @@ type SinglePlayerGame struct {
 	TotalRounds int `json:"total_rounds" gorm:"not null"`
 	CurrentRound int `json:"current_round" gorm:"not null"`
 	RoundSecondsDuration int `json:"round_seconds_duration" gorm:"not null"`
-	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz;index:idx_single_player_games_user_map,priority:3"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index;type:timestamptz"`
 	Rounds []*SinglePlayerRound `json:"rounds" gorm:"foreignKey:GameId"`
@@ -114,6 +118,18 @@ func (g *SinglePlayerGame) Complete() error {
 	return nil
 }
 
+// Abandon ends a pending or in-progress game without completing it. The score so far is kept.
+func (g *SinglePlayerGame) Abandon() error {
+	if g.Status != SinglePlayerGameStatusPending && g.Status != SinglePlayerGameStatusInProgress {
+		return coreerrors.BadRequest("game has already ended")
+	}
+
+	g.Status = SinglePlayerGameStatusAbandoned
+	now := time.Now()
+	g.EndedAt = &now
+	return nil
+}
+
 func (g *SinglePlayerGame) StartNextRound() (*SinglePlayerRound, error) {
 	if !g.IsInProgress() {
 		return nil, coreerrors.BadRequest("game is not in progress")
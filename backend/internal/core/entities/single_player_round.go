@@ -17,16 +17,16 @@ const (
 
 type SinglePlayerRound struct {
 	ID string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	GameId string `json:"game_id" gorm:"not null;type:uuid"`
+	GameId string `json:"game_id" gorm:"not null;type:uuid;index"`
 	Game *SinglePlayerGame `json:"game" gorm:"foreignKey:GameId"`
-	LocationId string `json:"location_id" gorm:"not null;type:uuid"`
+	LocationId string `json:"location_id" gorm:"not null;type:uuid;index"`
 	Location *Location `json:"location" gorm:"foreignKey:LocationId"`
 	Distance float64 `json:"distance"`
 	Score int `json:"score"`
 	GuessLatitude float64 `json:"guess_latitude"`
 	GuessLongitude float64 `json:"guess_longitude"`
 	StartedAt *time.Time `json:"started_at" gorm:"type:timestamptz;default:null"`
-	EndedAt *time.Time `json:"ended_at" gorm:"type:timestamptz;default:null"`
+	EndedAt *time.Time `json:"ended_at" gorm:"type:timestamptz;default:null;index"`
 	RoundNumber int `json:"round_number" gorm:"not null"`
 	TotalRoundSecondsDuration int `json:"total_round_seconds_duration" gorm:"not null"`
 	RoundStatus SinglePlayerRoundStatus `json:"round_status" gorm:"not null;default:pending"`
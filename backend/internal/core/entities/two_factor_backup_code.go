@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"time"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+// TwoFactorBackupCode is a single-use recovery code for when the authenticator is unavailable.
+// Only the hash of the code is stored; the plain code is shown to the user once.
+type TwoFactorBackupCode struct {
+	ID        string     `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserId    string     `json:"user_id" gorm:"not null;type:uuid;index"`
+	CodeHash  string     `json:"-" gorm:"not null"`
+	UsedAt    *time.Time `json:"used_at" gorm:"type:timestamptz;default:null"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+}
+
+func (TwoFactorBackupCode) TableName() string {
+	return "two_factor_backup_codes"
+}
+
+func NewTwoFactorBackupCode(userId, codeHash string) *TwoFactorBackupCode {
+	return &TwoFactorBackupCode{
+		UserId:   userId,
+		CodeHash: codeHash,
+	}
+}
+
+func (c *TwoFactorBackupCode) IsUsed() bool {
+	return c.UsedAt != nil
+}
+
+func (c *TwoFactorBackupCode) Use() error {
+	if c.IsUsed() {
+		return coreerrors.Unauthorized("backup code has already been used")
+	}
+	now := time.Now()
+	c.UsedAt = &now
+	return nil
+}
@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TwoFactorBackupCodeSuite struct {
+	suite.Suite
+}
+
+func TestTwoFactorBackupCodeSuite(t *testing.T) {
+	suite.Run(t, new(TwoFactorBackupCodeSuite))
+}
+
+func (s *TwoFactorBackupCodeSuite) TestTableName() {
+	s.Equal("two_factor_backup_codes", (TwoFactorBackupCode{}).TableName())
+}
+
+func (s *TwoFactorBackupCodeSuite) TestUse_OnlyOnce() {
+	code := NewTwoFactorBackupCode("user-uuid", "hash")
+	s.False(code.IsUsed())
+
+	s.NoError(code.Use())
+	s.True(code.IsUsed())
+
+	err := code.Use()
+	s.Error(err)
+	s.Equal("backup code has already been used", err.Error())
+}
@@ -1,12 +1,59 @@
 package entities
 
 import (
+	"strings"
 	"time"
 
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
+type UserRole string
+
+const (
+	UserRolePlayer UserRole = "player"
+	UserRoleMapCurator UserRole = "map_curator"
+	UserRoleModerator UserRole = "moderator"
+	UserRoleAdmin UserRole = "admin"
+)
+
+// deletedUsernamePrefix starts the placeholder username of erased accounts. It is reserved, or a user
+// could register the placeholder of an account pending deletion and stop it from ever being erased.
+const deletedUsernamePrefix = "deleted_"
+
+// IsReservedUsername reports whether username is kept for erased accounts and cannot be chosen.
+func IsReservedUsername(username string) bool {
+	return strings.HasPrefix(strings.ToLower(username), deletedUsernamePrefix)
+}
+
+// userRoleRanks orders roles by authority. A user may only moderate users of a strictly lower rank.
+var userRoleRanks = map[UserRole]int{
+	UserRolePlayer: 0,
+	UserRoleMapCurator: 1,
+	UserRoleModerator: 2,
+	UserRoleAdmin: 3,
+}
+
+// Satisfies reports whether r is one of roles. Admin satisfies every role.
+func (r UserRole) Satisfies(roles ...UserRole) bool {
+	if r == UserRoleAdmin {
+		return true
+	}
+	for _, role := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseUserRole returns the role named s and whether it exists.
+func ParseUserRole(s string) (UserRole, bool) {
+	role := UserRole(s)
+	_, ok := userRoleRanks[role]
+	return role, ok
+}
 
 type User struct {
 	ID string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
@@ -14,10 +61,14 @@ type User struct {
 	Email string `json:"email" gorm:"not null;unique"`
 	Username string `json:"username" gorm:"not null;unique"`
 	Password string `json:"-" gorm:"not null"`
+	Role UserRole `json:"role" gorm:"not null;default:player;index"`
+	BannedAt *time.Time `json:"banned_at" gorm:"type:timestamptz;default:null"`
+	BanReason string `json:"ban_reason" gorm:"not null;default:''"`
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index;type:timestamptz"`
 	Maps []*Map `json:"maps" gorm:"foreignKey:OwnerId"`
+	Identities []*UserIdentity `json:"identities" gorm:"foreignKey:UserId"`
 }
 
 func (User) TableName() string {
@@ -30,6 +81,7 @@ func NewUser(name, email, username, password string) *User {
 		Email: email,
 		Password: password,
 		Username: username,
+		Role: UserRolePlayer,
 	}
 }
 
@@ -40,6 +92,7 @@ func RestoreUser(id, name, email, username, password string) *User {
 		Email: email,
 		Password: password,
 		Username: username,
+		Role: UserRolePlayer,
 	}
 }
 
@@ -54,4 +107,67 @@ func (u *User) EncryptPassword() error {
 
 func (u *User) ComparePassword(password string) error {
 	 return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
-}
\ No newline at end of file
+}
+
+// HasPassword reports whether the user can sign in with a password.
+// Users created through an OIDC provider have no password until they set one.
+func (u *User) HasPassword() bool {
+	return u.Password != ""
+}
+
+// HasRole reports whether the user has one of roles. Admins implicitly have every role.
+func (u *User) HasRole(roles ...UserRole) bool {
+	return u.Role.Satisfies(roles...)
+}
+
+// CanModerate reports whether u outranks other and may therefore ban them or change their role.
+func (u *User) CanModerate(other *User) bool {
+	return u.ID != other.ID && userRoleRanks[u.Role] > userRoleRanks[other.Role]
+}
+
+func (u *User) IsBanned() bool {
+	return u.BannedAt != nil
+}
+
+func (u *User) Ban(reason string) error {
+	if u.IsBanned() {
+		return coreerrors.Conflict("user is already banned")
+	}
+	now := time.Now()
+	u.BannedAt = &now
+	u.BanReason = reason
+	return nil
+}
+
+func (u *User) Unban() error {
+	if !u.IsBanned() {
+		return coreerrors.BadRequest("user is not banned")
+	}
+	u.BannedAt = nil
+	u.BanReason = ""
+	return nil
+}
+
+// ChangePassword replaces the user's password after checking current against the existing one.
+// Users without a password, who signed up through an OIDC provider, can set one without current:
+// callers must have confirmed their identity another way first.
+func (u *User) ChangePassword(current, newPassword string) error {
+	if u.HasPassword() && u.ComparePassword(current) != nil {
+		return coreerrors.Unauthorized("current password is incorrect")
+	}
+	u.Password = newPassword
+	return u.EncryptPassword()
+}
+
+// Anonymize strips the user's personal data but keeps the row, so games they played stay consistent
+// for other players' history and leaderboards. The placeholder username and email are derived from
+// the ID to stay unique.
+func (u *User) Anonymize() {
+	id := strings.ReplaceAll(u.ID, "-", "")
+	placeholder := deletedUsernamePrefix + id[:min(len(id), 12)]
+	u.Name = "Deleted user"
+	u.Username = placeholder
+	u.Email = placeholder + "@deleted.invalid"
+	u.Password = ""
+	u.BanReason = ""
+}
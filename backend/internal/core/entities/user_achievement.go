@@ -0,0 +1,57 @@
+package entities
+
+import "time"
+
+type AchievementCode string
+
+const (
+	AchievementFirstGame          AchievementCode = "first_game"
+	AchievementFirstPerfectRound  AchievementCode = "first_perfect_round"
+	AchievementPerfectGame        AchievementCode = "perfect_game"
+	AchievementPerfectRounds5     AchievementCode = "perfect_rounds_5"
+	AchievementPerfectRounds50    AchievementCode = "perfect_rounds_50"
+	AchievementGamesPlayed100     AchievementCode = "games_played_100"
+	AchievementNmpzGamesPlayed100 AchievementCode = "nmpz_games_played_100"
+	AchievementCountriesGuessed10 AchievementCode = "countries_guessed_10"
+	AchievementCountriesGuessed50 AchievementCode = "countries_guessed_50"
+)
+
+// Achievement describes a badge. The catalog lives in code; only unlocks are stored.
+type Achievement struct {
+	Code        AchievementCode `json:"code"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+}
+
+// Achievements is the catalog, in display order.
+var Achievements = []Achievement{
+	{Code: AchievementFirstGame, Name: "First steps", Description: "Complete a single player game."},
+	{Code: AchievementFirstPerfectRound, Name: "Bullseye", Description: "Score 5,000 points in a round."},
+	{Code: AchievementPerfectGame, Name: "Flawless", Description: "Score 25,000 points in a game."},
+	{Code: AchievementPerfectRounds5, Name: "Sharpshooter", Description: "Score 5,000 points in 5 rounds."},
+	{Code: AchievementPerfectRounds50, Name: "Marksman", Description: "Score 5,000 points in 50 rounds."},
+	{Code: AchievementGamesPlayed100, Name: "Globetrotter", Description: "Complete 100 single player games."},
+	{Code: AchievementNmpzGamesPlayed100, Name: "Frozen in place", Description: "Complete 100 NMPZ games."},
+	{Code: AchievementCountriesGuessed10, Name: "Explorer", Description: "Guess correctly in 10 countries."},
+	{Code: AchievementCountriesGuessed50, Name: "Cartographer", Description: "Guess correctly in 50 countries."},
+}
+
+// UserAchievement records when a user unlocked an achievement. The primary key makes unlocking
+// idempotent: a second unlock of the same code is a no-op and keeps the first timestamp.
+type UserAchievement struct {
+	UserId     string          `json:"user_id" gorm:"primaryKey;type:uuid"`
+	Code       AchievementCode `json:"code" gorm:"primaryKey"`
+	UnlockedAt time.Time       `json:"unlocked_at" gorm:"not null;type:timestamptz"`
+}
+
+func (UserAchievement) TableName() string {
+	return "user_achievements"
+}
+
+func NewUserAchievement(userId string, code AchievementCode, unlockedAt time.Time) *UserAchievement {
+	return &UserAchievement{
+		UserId:     userId,
+		Code:       code,
+		UnlockedAt: unlockedAt,
+	}
+}
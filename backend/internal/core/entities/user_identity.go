@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// UserIdentity links a User to an account on an external OpenID Connect provider.
+// A user can have at most one identity per provider, and a provider subject can only be linked to one user.
+type UserIdentity struct {
+	ID        string         `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserId    string         `json:"user_id" gorm:"not null;type:uuid;uniqueIndex:idx_user_identity_user_provider"`
+	User      *User          `json:"user" gorm:"foreignKey:UserId"`
+	Provider  string         `json:"provider" gorm:"not null;uniqueIndex:idx_user_identity_provider_subject;uniqueIndex:idx_user_identity_user_provider"`
+	Subject   string         `json:"subject" gorm:"not null;uniqueIndex:idx_user_identity_provider_subject"`
+	Email     string         `json:"email"`
+	CreatedAt time.Time      `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	UpdatedAt time.Time      `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index;type:timestamptz"`
+}
+
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+func NewUserIdentity(userId, provider, subject, email string) *UserIdentity {
+	return &UserIdentity{
+		UserId:   userId,
+		Provider: provider,
+		Subject:  subject,
+		Email:    email,
+	}
+}
+
+func RestoreUserIdentity(id, userId, provider, subject, email string) *UserIdentity {
+	return &UserIdentity{
+		ID:       id,
+		UserId:   userId,
+		Provider: provider,
+		Subject:  subject,
+		Email:    email,
+	}
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UserIdentitySuite struct {
+	suite.Suite
+}
+
+func TestUserIdentitySuite(t *testing.T) {
+	suite.Run(t, new(UserIdentitySuite))
+}
+
+func (s *UserIdentitySuite) TestTableName() {
+	tableName := (UserIdentity{}).TableName()
+	s.Equal("user_identities", tableName)
+}
+
+func (s *UserIdentitySuite) TestNewUserIdentity() {
+	identity := NewUserIdentity("user-uuid-123", "google", "sub-456", "john@example.com")
+
+	s.NotNil(identity)
+	s.Empty(identity.ID)
+	s.Equal("user-uuid-123", identity.UserId)
+	s.Equal("google", identity.Provider)
+	s.Equal("sub-456", identity.Subject)
+	s.Equal("john@example.com", identity.Email)
+}
+
+func (s *UserIdentitySuite) TestRestoreUserIdentity() {
+	identity := RestoreUserIdentity("identity-uuid", "user-uuid-123", "discord", "sub-789", "")
+
+	s.NotNil(identity)
+	s.Equal("identity-uuid", identity.ID)
+	s.Equal("user-uuid-123", identity.UserId)
+	s.Equal("discord", identity.Provider)
+	s.Equal("sub-789", identity.Subject)
+	s.Empty(identity.Email)
+}
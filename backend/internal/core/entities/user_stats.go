@@ -0,0 +1,236 @@
+package entities
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// PerfectRoundScore is the maximum score of a round.
+	PerfectRoundScore = 5000
+
+	// CorrectGuessScore is the round score from which a country counts as guessed correctly: about
+	// half the maximum, or a guess within roughly 25 km.
+	CorrectGuessScore = 2500
+
+	// distanceBucketsPerDecade sets the resolution of the guess distance histogram. Four buckets per
+	// power of ten bound the error of the median estimate to about 33%.
+	distanceBucketsPerDecade = 4
+)
+
+// UserStats aggregates a user's completed single player games. It is never recomputed from
+// rounds: every completed game adds its own UserStats, built by NewUserStatsFromGame, to the
+// stored one.
+type UserStats struct {
+	UserId            string                `json:"user_id" gorm:"primaryKey;type:uuid"`
+	GamesPlayed       int64                 `json:"games_played" gorm:"not null;default:0"`
+	RoundsPlayed      int64                 `json:"rounds_played" gorm:"not null;default:0"`
+	TotalScore        int64                 `json:"total_score" gorm:"not null;default:0"`
+	PerfectRounds     int64                 `json:"perfect_rounds" gorm:"not null;default:0"`
+	TimedRounds       int64                 `json:"timed_rounds" gorm:"not null;default:0"`
+	TotalGuessSeconds float64               `json:"total_guess_seconds" gorm:"not null;default:0"`
+	BestGameId        *string               `json:"best_game_id" gorm:"type:uuid;default:null"`
+	BestGameScore     int                   `json:"best_game_score" gorm:"not null;default:0"`
+	UpdatedAt         time.Time             `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+	ModeMaps          []*UserModeMapStats   `json:"mode_maps" gorm:"foreignKey:UserId"`
+	Countries         []*UserCountryStats   `json:"countries" gorm:"foreignKey:UserId"`
+	DistanceBuckets   []*UserDistanceBucket `json:"distance_buckets" gorm:"foreignKey:UserId"`
+}
+
+func (UserStats) TableName() string {
+	return "user_stats"
+}
+
+// UserModeMapStats aggregates a user's games of one mode on one map.
+type UserModeMapStats struct {
+	UserId      string               `json:"user_id" gorm:"primaryKey;type:uuid"`
+	Mode        SinglePlayerGameMode `json:"mode" gorm:"primaryKey"`
+	MapId       string               `json:"map_id" gorm:"primaryKey;type:uuid"`
+	GamesPlayed int64                `json:"games_played" gorm:"not null;default:0"`
+	TotalScore  int64                `json:"total_score" gorm:"not null;default:0"`
+	BestScore   int                  `json:"best_score" gorm:"not null;default:0"`
+}
+
+func (UserModeMapStats) TableName() string {
+	return "user_mode_map_stats"
+}
+
+// UserCountryStats aggregates a user's rounds on locations of one country.
+type UserCountryStats struct {
+	UserId        string  `json:"user_id" gorm:"primaryKey;type:uuid"`
+	CountryCode   string  `json:"country_code" gorm:"primaryKey"`
+	RoundsPlayed  int64   `json:"rounds_played" gorm:"not null;default:0"`
+	CorrectRounds int64   `json:"correct_rounds" gorm:"not null;default:0"`
+	TotalScore    int64   `json:"total_score" gorm:"not null;default:0"`
+	TotalDistance float64 `json:"total_distance" gorm:"not null;default:0"`
+}
+
+func (UserCountryStats) TableName() string {
+	return "user_country_stats"
+}
+
+// Accuracy is the share of the maximum score the user obtained in the country, between 0 and 1.
+func (s *UserCountryStats) Accuracy() float64 {
+	if s.RoundsPlayed == 0 {
+		return 0
+	}
+	return float64(s.TotalScore) / float64(s.RoundsPlayed*PerfectRoundScore)
+}
+
+// UserDistanceBucket counts a user's rounds whose guess distance fell in one histogram bucket.
+// Keeping a histogram rather than every distance lets the median be estimated incrementally.
+type UserDistanceBucket struct {
+	UserId string `json:"user_id" gorm:"primaryKey;type:uuid"`
+	Bucket int    `json:"bucket" gorm:"primaryKey"`
+	Rounds int64  `json:"rounds" gorm:"not null;default:0"`
+}
+
+func (UserDistanceBucket) TableName() string {
+	return "user_distance_buckets"
+}
+
+// DistanceBucket returns the histogram bucket of a distance in meters. Bucket 0 holds distances
+// under a meter; bucket b > 0 holds [10^((b-1)/4), 10^(b/4)) meters.
+func DistanceBucket(distanceMeters float64) int {
+	if distanceMeters < 1 {
+		return 0
+	}
+	return int(math.Floor(math.Log10(distanceMeters)*distanceBucketsPerDecade)) + 1
+}
+
+// distanceBucketMidpoint returns the geometric middle of a bucket, in meters.
+func distanceBucketMidpoint(bucket int) float64 {
+	if bucket <= 0 {
+		return 0.5
+	}
+	return math.Pow(10, (float64(bucket)-0.5)/distanceBucketsPerDecade)
+}
+
+// NewUserStatsFromGame summarizes one completed game. Rounds should have their Location loaded for
+// the per-country figures; rounds whose location has no country are left out of those.
+func NewUserStatsFromGame(game *SinglePlayerGame, rounds []*SinglePlayerRound) *UserStats {
+	gameId := game.ID
+	stats := &UserStats{
+		UserId:        game.UserId,
+		GamesPlayed:   1,
+		TotalScore:    int64(game.Score),
+		BestGameId:    &gameId,
+		BestGameScore: game.Score,
+		ModeMaps: []*UserModeMapStats{{
+			UserId:      game.UserId,
+			Mode:        game.Mode,
+			MapId:       game.MapId,
+			GamesPlayed: 1,
+			TotalScore:  int64(game.Score),
+			BestScore:   game.Score,
+		}},
+	}
+
+	countries := map[string]*UserCountryStats{}
+	buckets := map[int]*UserDistanceBucket{}
+	for _, round := range rounds {
+		if round.RoundStatus != SinglePlayerRoundStatusCompleted {
+			continue
+		}
+		stats.RoundsPlayed++
+		if round.Score >= PerfectRoundScore {
+			stats.PerfectRounds++
+		}
+		if round.StartedAt != nil && round.EndedAt != nil {
+			stats.TimedRounds++
+			stats.TotalGuessSeconds += round.EndedAt.Sub(*round.StartedAt).Seconds()
+		}
+
+		bucket := DistanceBucket(round.Distance)
+		if buckets[bucket] == nil {
+			buckets[bucket] = &UserDistanceBucket{UserId: game.UserId, Bucket: bucket}
+		}
+		buckets[bucket].Rounds++
+
+		if round.Location == nil || round.Location.CountryCode == "" {
+			continue
+		}
+		country := countries[round.Location.CountryCode]
+		if country == nil {
+			country = &UserCountryStats{UserId: game.UserId, CountryCode: round.Location.CountryCode}
+			countries[round.Location.CountryCode] = country
+		}
+		country.RoundsPlayed++
+		if round.Score >= CorrectGuessScore {
+			country.CorrectRounds++
+		}
+		country.TotalScore += int64(round.Score)
+		country.TotalDistance += round.Distance
+	}
+
+	for _, country := range countries {
+		stats.Countries = append(stats.Countries, country)
+	}
+	sort.Slice(stats.Countries, func(i, j int) bool { return stats.Countries[i].CountryCode < stats.Countries[j].CountryCode })
+	for _, bucket := range buckets {
+		stats.DistanceBuckets = append(stats.DistanceBuckets, bucket)
+	}
+	sort.Slice(stats.DistanceBuckets, func(i, j int) bool { return stats.DistanceBuckets[i].Bucket < stats.DistanceBuckets[j].Bucket })
+	return stats
+}
+
+// CountriesGuessedCorrectly counts the countries with at least one correct guess.
+func (s *UserStats) CountriesGuessedCorrectly() int {
+	count := 0
+	for _, country := range s.Countries {
+		if country.CorrectRounds > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// GamesPlayedInMode sums the games played in mode across every map.
+func (s *UserStats) GamesPlayedInMode(mode SinglePlayerGameMode) int64 {
+	var games int64
+	for _, row := range s.ModeMaps {
+		if row.Mode == mode {
+			games += row.GamesPlayed
+		}
+	}
+	return games
+}
+
+func (s *UserStats) AverageScore() float64 {
+	if s.GamesPlayed == 0 {
+		return 0
+	}
+	return float64(s.TotalScore) / float64(s.GamesPlayed)
+}
+
+func (s *UserStats) AverageGuessSeconds() float64 {
+	if s.TimedRounds == 0 {
+		return 0
+	}
+	return s.TotalGuessSeconds / float64(s.TimedRounds)
+}
+
+// MedianDistance estimates the median guess distance in meters from the distance histogram.
+// It returns false when no round was played.
+func (s *UserStats) MedianDistance() (float64, bool) {
+	var total int64
+	for _, bucket := range s.DistanceBuckets {
+		total += bucket.Rounds
+	}
+	if total == 0 {
+		return 0, false
+	}
+	buckets := make([]*UserDistanceBucket, len(s.DistanceBuckets))
+	copy(buckets, s.DistanceBuckets)
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Bucket < buckets[j].Bucket })
+
+	var seen int64
+	for _, bucket := range buckets {
+		seen += bucket.Rounds
+		if seen*2 >= total {
+			return distanceBucketMidpoint(bucket.Bucket), true
+		}
+	}
+	return distanceBucketMidpoint(buckets[len(buckets)-1].Bucket), true
+}
@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UserStatsSuite struct {
+	suite.Suite
+}
+
+func TestUserStatsSuite(t *testing.T) {
+	suite.Run(t, new(UserStatsSuite))
+}
+
+func (s *UserStatsSuite) TestTableName() {
+	s.Equal("user_stats", (UserStats{}).TableName())
+	s.Equal("user_mode_map_stats", (UserModeMapStats{}).TableName())
+	s.Equal("user_country_stats", (UserCountryStats{}).TableName())
+	s.Equal("user_distance_buckets", (UserDistanceBucket{}).TableName())
+}
+
+func (s *UserStatsSuite) TestDistanceBucket() {
+	s.Equal(0, DistanceBucket(0))
+	s.Equal(0, DistanceBucket(0.9))
+	s.Equal(1, DistanceBucket(1))
+	s.Equal(5, DistanceBucket(10))
+	s.Equal(5, DistanceBucket(17))
+	s.Equal(6, DistanceBucket(18))
+	s.Equal(25, DistanceBucket(1_000_000))
+}
+
+func completedRound(score int, distance float64, countryCode string, guessDuration time.Duration) *SinglePlayerRound {
+	startedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(guessDuration)
+	return &SinglePlayerRound{
+		Score:       score,
+		Distance:    distance,
+		RoundStatus: SinglePlayerRoundStatusCompleted,
+		StartedAt:   &startedAt,
+		EndedAt:     &endedAt,
+		Location:    &Location{CountryCode: countryCode},
+	}
+}
+
+func (s *UserStatsSuite) TestNewUserStatsFromGame() {
+	game := NewSinglePlayerGame("user-uuid", "map-uuid", SinglePlayerGameModeMove, 60)
+	game.ID = "game-uuid"
+	game.Score = 12000
+	rounds := []*SinglePlayerRound{
+		completedRound(5000, 5, "BR", 10*time.Second),
+		completedRound(4000, 20_000, "BR", 20*time.Second),
+		completedRound(3000, 300_000, "", 30*time.Second),
+		{RoundStatus: SinglePlayerRoundStatusPending},
+	}
+
+	stats := NewUserStatsFromGame(game, rounds)
+
+	s.Equal("user-uuid", stats.UserId)
+	s.Equal(int64(1), stats.GamesPlayed)
+	s.Equal(int64(3), stats.RoundsPlayed)
+	s.Equal(int64(12000), stats.TotalScore)
+	s.Equal(int64(1), stats.PerfectRounds)
+	s.InDelta(20, stats.AverageGuessSeconds(), 0.001)
+	s.Require().NotNil(stats.BestGameId)
+	s.Equal("game-uuid", *stats.BestGameId)
+	s.Equal(12000, stats.BestGameScore)
+
+	s.Require().Len(stats.ModeMaps, 1)
+	s.Equal(SinglePlayerGameModeMove, stats.ModeMaps[0].Mode)
+	s.Equal("map-uuid", stats.ModeMaps[0].MapId)
+	s.Equal(12000, stats.ModeMaps[0].BestScore)
+
+	s.Require().Len(stats.Countries, 1)
+	s.Equal("BR", stats.Countries[0].CountryCode)
+	s.Equal(int64(2), stats.Countries[0].RoundsPlayed)
+	s.Equal(int64(2), stats.Countries[0].CorrectRounds)
+	s.InDelta(0.9, stats.Countries[0].Accuracy(), 0.001)
+
+	s.Len(stats.DistanceBuckets, 3)
+}
+
+func (s *UserStatsSuite) TestMedianDistance() {
+	stats := &UserStats{}
+	_, ok := stats.MedianDistance()
+	s.False(ok)
+
+	stats.DistanceBuckets = []*UserDistanceBucket{
+		{Bucket: DistanceBucket(1_000_000), Rounds: 1},
+		{Bucket: DistanceBucket(100), Rounds: 2},
+		{Bucket: DistanceBucket(10_000), Rounds: 2},
+	}
+	median, ok := stats.MedianDistance()
+
+	s.True(ok)
+	s.InDelta(13_335, median, 1)
+}
+
+func (s *UserStatsSuite) TestAverages_WhenEmpty_ReturnZero() {
+	stats := &UserStats{}
+
+	s.Zero(stats.AverageScore())
+	s.Zero(stats.AverageGuessSeconds())
+	s.Zero((&UserCountryStats{}).Accuracy())
+}
+
+func (s *UserStatsSuite) TestCountriesGuessedCorrectlyAndGamesPlayedInMode() {
+	stats := &UserStats{
+		Countries: []*UserCountryStats{
+			{CountryCode: "BR", RoundsPlayed: 3, CorrectRounds: 1},
+			{CountryCode: "AR", RoundsPlayed: 2},
+			{CountryCode: "CL", RoundsPlayed: 1, CorrectRounds: 1},
+		},
+		ModeMaps: []*UserModeMapStats{
+			{Mode: SinglePlayerGameModeNMPZ, MapId: "a", GamesPlayed: 4},
+			{Mode: SinglePlayerGameModeMove, MapId: "a", GamesPlayed: 7},
+			{Mode: SinglePlayerGameModeNMPZ, MapId: "b", GamesPlayed: 2},
+		},
+	}
+
+	s.Equal(2, stats.CountriesGuessedCorrectly())
+	s.Equal(int64(6), stats.GamesPlayedInMode(SinglePlayerGameModeNMPZ))
+	s.Equal(int64(0), stats.GamesPlayedInMode(SinglePlayerGameModeNoMove))
+}
@@ -79,3 +79,99 @@ func (s *UserSuite) TestComparePassword_WhenWrong() {
 
 	s.Error(err)
 }
+
+func (s *UserSuite) TestHasPassword() {
+	s.True(NewUser("John", "john@example.com", "johndoe", "secret").HasPassword())
+	s.False(NewUser("John", "john@example.com", "johndoe", "").HasPassword())
+}
+
+func (s *UserSuite) TestNewUser_IsPlayer() {
+	s.Equal(UserRolePlayer, NewUser("John", "john@example.com", "johndoe", "secret").Role)
+}
+
+func (s *UserSuite) TestParseUserRole() {
+	role, ok := ParseUserRole("map_curator")
+	s.True(ok)
+	s.Equal(UserRoleMapCurator, role)
+
+	_, ok = ParseUserRole("superuser")
+	s.False(ok)
+}
+
+func (s *UserSuite) TestHasRole() {
+	u := NewUser("John", "john@example.com", "johndoe", "secret")
+	s.False(u.HasRole(UserRoleModerator))
+
+	u.Role = UserRoleModerator
+	s.True(u.HasRole(UserRoleMapCurator, UserRoleModerator))
+	s.False(u.HasRole(UserRoleMapCurator))
+
+	u.Role = UserRoleAdmin
+	s.True(u.HasRole(UserRoleMapCurator))
+}
+
+func (s *UserSuite) TestCanModerate() {
+	moderator := RestoreUser("mod-uuid", "Mod", "mod@example.com", "mod", "hash")
+	moderator.Role = UserRoleModerator
+	player := RestoreUser("player-uuid", "Player", "player@example.com", "player", "hash")
+	otherModerator := RestoreUser("mod-uuid-2", "Mod 2", "mod2@example.com", "mod2", "hash")
+	otherModerator.Role = UserRoleModerator
+
+	s.True(moderator.CanModerate(player))
+	s.False(moderator.CanModerate(otherModerator))
+	s.False(moderator.CanModerate(moderator))
+	s.False(player.CanModerate(moderator))
+}
+
+func (s *UserSuite) TestBanAndUnban() {
+	u := NewUser("John", "john@example.com", "johndoe", "secret")
+
+	s.Require().NoError(u.Ban("cheating"))
+	s.True(u.IsBanned())
+	s.Equal("cheating", u.BanReason)
+	s.Error(u.Ban("again"))
+
+	s.Require().NoError(u.Unban())
+	s.False(u.IsBanned())
+	s.Empty(u.BanReason)
+	s.Error(u.Unban())
+}
+
+func (s *UserSuite) TestChangePassword_RequiresCurrentPassword() {
+	u := NewUser("John", "john@example.com", "johndoe", "secret")
+	s.Require().NoError(u.EncryptPassword())
+
+	s.Error(u.ChangePassword("wrong", "new-secret"))
+	s.NoError(u.ComparePassword("secret"))
+
+	s.Require().NoError(u.ChangePassword("secret", "new-secret"))
+	s.NoError(u.ComparePassword("new-secret"))
+}
+
+func (s *UserSuite) TestChangePassword_WhenNoPassword_SetsOne() {
+	u := NewUser("John", "john@example.com", "johndoe", "")
+
+	s.Require().NoError(u.ChangePassword("", "new-secret"))
+	s.True(u.HasPassword())
+	s.NoError(u.ComparePassword("new-secret"))
+}
+
+func (s *UserSuite) TestAnonymize_RemovesPersonalData() {
+	u := RestoreUser("0b8f5f3e-8f2a-4c43-9a0e-5d4c3b2a1f00", "John", "john@example.com", "johndoe", "hash")
+
+	u.Anonymize()
+
+	s.Equal("Deleted user", u.Name)
+	s.Equal("deleted_0b8f5f3e8f2a", u.Username)
+	s.Equal("deleted_0b8f5f3e8f2a@deleted.invalid", u.Email)
+	s.False(u.HasPassword())
+	s.LessOrEqual(len(u.Username), 20)
+	s.True(IsReservedUsername(u.Username))
+}
+
+func (s *UserSuite) TestIsReservedUsername() {
+	s.True(IsReservedUsername("deleted_0b8f5f3e8f2a"))
+	s.True(IsReservedUsername("Deleted_anything"))
+	s.False(IsReservedUsername("undeleted_john"))
+	s.False(IsReservedUsername("deleted"))
+}
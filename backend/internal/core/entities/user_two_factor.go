@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"time"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+// UserTwoFactor holds a user's TOTP enrollment. It is created unconfirmed on enrollment and
+// only protects logins once the user proves possession of the secret with a valid code.
+type UserTwoFactor struct {
+	ID           string     `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserId       string     `json:"user_id" gorm:"not null;type:uuid;uniqueIndex"`
+	User         *User      `json:"user" gorm:"foreignKey:UserId"`
+	Secret       string     `json:"-" gorm:"not null"`
+	ConfirmedAt  *time.Time `json:"confirmed_at" gorm:"type:timestamptz;default:null"`
+	LastUsedStep int64      `json:"-" gorm:"not null;default:0"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime;type:timestamptz"`
+	UpdatedAt    time.Time  `json:"updated_at" gorm:"autoUpdateTime;type:timestamptz"`
+}
+
+func (UserTwoFactor) TableName() string {
+	return "user_two_factors"
+}
+
+func NewUserTwoFactor(userId, secret string) *UserTwoFactor {
+	return &UserTwoFactor{
+		UserId: userId,
+		Secret: secret,
+	}
+}
+
+func (t *UserTwoFactor) IsEnabled() bool {
+	return t.ConfirmedAt != nil
+}
+
+// UseStep records that the code for the given TOTP step was accepted.
+// Each step can only be used once, so a code observed by an attacker cannot be replayed.
+func (t *UserTwoFactor) UseStep(step int64) error {
+	if step <= t.LastUsedStep {
+		return coreerrors.Unauthorized("two-factor code has already been used")
+	}
+	t.LastUsedStep = step
+	return nil
+}
+
+// Confirm enables two-factor authentication after the first valid code at step.
+func (t *UserTwoFactor) Confirm(step int64) error {
+	if t.IsEnabled() {
+		return coreerrors.Conflict("two-factor authentication is already enabled")
+	}
+	if err := t.UseStep(step); err != nil {
+		return err
+	}
+	now := time.Now()
+	t.ConfirmedAt = &now
+	return nil
+}
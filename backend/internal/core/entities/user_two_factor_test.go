@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UserTwoFactorSuite struct {
+	suite.Suite
+}
+
+func TestUserTwoFactorSuite(t *testing.T) {
+	suite.Run(t, new(UserTwoFactorSuite))
+}
+
+func (s *UserTwoFactorSuite) TestTableName() {
+	s.Equal("user_two_factors", (UserTwoFactor{}).TableName())
+}
+
+func (s *UserTwoFactorSuite) TestNewUserTwoFactor_IsNotEnabled() {
+	tf := NewUserTwoFactor("user-uuid", "SECRET")
+
+	s.Equal("user-uuid", tf.UserId)
+	s.Equal("SECRET", tf.Secret)
+	s.False(tf.IsEnabled())
+}
+
+func (s *UserTwoFactorSuite) TestConfirm_EnablesAndRecordsStep() {
+	tf := NewUserTwoFactor("user-uuid", "SECRET")
+
+	err := tf.Confirm(100)
+
+	s.NoError(err)
+	s.True(tf.IsEnabled())
+	s.Equal(int64(100), tf.LastUsedStep)
+}
+
+func (s *UserTwoFactorSuite) TestConfirm_WhenAlreadyEnabled_ReturnsError() {
+	tf := NewUserTwoFactor("user-uuid", "SECRET")
+	s.Require().NoError(tf.Confirm(100))
+
+	err := tf.Confirm(101)
+
+	s.Error(err)
+	s.Equal("two-factor authentication is already enabled", err.Error())
+}
+
+func (s *UserTwoFactorSuite) TestUseStep_RejectsReplayedSteps() {
+	tf := NewUserTwoFactor("user-uuid", "SECRET")
+	s.Require().NoError(tf.UseStep(100))
+
+	s.Error(tf.UseStep(100))
+	s.Error(tf.UseStep(99))
+	s.NoError(tf.UseStep(101))
+}
@@ -3,6 +3,7 @@ package coreerrors
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // HTTPStatusCoder is implemented by domain errors that carry an HTTP status code.
@@ -13,8 +14,9 @@ type HTTPStatusCoder interface {
 
 // domainError holds a message and HTTP status code.
 type domainError struct {
-	message string
-	status  int
+	message    string
+	status     int
+	retryAfter time.Duration
 }
 
 func (e *domainError) Error() string {
@@ -50,6 +52,11 @@ func BadRequest(message string) HTTPStatusCoder {
 	return &domainError{message: message, status: 400}
 }
 
+// TooManyRequests returns an error with HTTP status 429. retryAfter tells the client when it may try again.
+func TooManyRequests(message string, retryAfter time.Duration) HTTPStatusCoder {
+	return &domainError{message: message, status: 429, retryAfter: retryAfter}
+}
+
 func InternalServerError(message string) HTTPStatusCoder {
 	return &domainError{message: message, status: 500}
 }
@@ -76,3 +83,13 @@ func Status(err error) (int, bool) {
 	}
 	return 0, false
 }
+
+// RetryAfter returns how long the client should wait before retrying if err carries that hint, and true.
+// Otherwise it returns (0, false).
+func RetryAfter(err error) (time.Duration, bool) {
+	var e *domainError
+	if errors.As(err, &e) && e.retryAfter > 0 {
+		return e.retryAfter, true
+	}
+	return 0, false
+}
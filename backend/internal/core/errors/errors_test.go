@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -61,6 +62,27 @@ func (s *ErrorsSuite) TestBadRequest() {
 	s.Equal(http.StatusBadRequest, status)
 }
 
+func (s *ErrorsSuite) TestTooManyRequests() {
+	err := TooManyRequests("slow down", 30*time.Second)
+	s.Equal("slow down", err.Error())
+
+	status, ok := Status(err)
+	s.True(ok)
+	s.Equal(http.StatusTooManyRequests, status)
+
+	retryAfter, ok := RetryAfter(err)
+	s.True(ok)
+	s.Equal(30*time.Second, retryAfter)
+}
+
+func (s *ErrorsSuite) TestRetryAfter_WithoutHint() {
+	_, ok := RetryAfter(Conflict("conflict"))
+	s.False(ok)
+
+	_, ok = RetryAfter(errors.New("generic error"))
+	s.False(ok)
+}
+
 func (s *ErrorsSuite) TestValidation_WithoutDetails() {
 	err := Validation("invalid payload", nil)
 	s.Equal("invalid payload", err.Error())
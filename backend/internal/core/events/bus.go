@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+type Handler func(ctx context.Context, event Event) error
+
+// Bus is a synchronous in-process Publisher. Handlers run in the caller's goroutine and context,
+// so a handler runs inside the emitter's transaction and its error aborts it.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[Name][]Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{handlers: map[Name][]Handler{}}
+}
+
+func (b *Bus) Subscribe(name Name, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// Publish runs the handlers of the event in subscription order and stops at the first error.
+func (b *Bus) Publish(ctx context.Context, event Event) error {
+	b.mu.RLock()
+	handlers := b.handlers[event.Name()]
+	b.mu.RUnlock()
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
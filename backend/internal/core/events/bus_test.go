@@ -0,0 +1,61 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BusSuite struct {
+	suite.Suite
+}
+
+func TestBusSuite(t *testing.T) {
+	suite.Run(t, new(BusSuite))
+}
+
+func (s *BusSuite) TestPublish_RunsHandlersOfTheEventInOrder() {
+	bus := NewBus()
+	var calls []string
+	bus.Subscribe(GameCompletedEvent, func(ctx context.Context, event Event) error {
+		calls = append(calls, "first")
+		return nil
+	})
+	bus.Subscribe(GameCompletedEvent, func(ctx context.Context, event Event) error {
+		calls = append(calls, "second")
+		return nil
+	})
+	bus.Subscribe(RoundFinishedEvent, func(ctx context.Context, event Event) error {
+		calls = append(calls, "round")
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), GameCompleted{})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"first", "second"}, calls)
+}
+
+func (s *BusSuite) TestPublish_StopsAtFirstError() {
+	bus := NewBus()
+	errHandler := errors.New("handler failed")
+	called := false
+	bus.Subscribe(RoundFinishedEvent, func(ctx context.Context, event Event) error {
+		return errHandler
+	})
+	bus.Subscribe(RoundFinishedEvent, func(ctx context.Context, event Event) error {
+		called = true
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), RoundFinished{})
+
+	s.ErrorIs(err, errHandler)
+	s.False(called)
+}
+
+func (s *BusSuite) TestPublish_WithoutSubscribers() {
+	s.NoError(NewBus().Publish(context.Background(), GameCompleted{}))
+}
@@ -0,0 +1,46 @@
+// Package events carries domain events from the use cases that emit them to the subscribers that
+// react to them, so emitters do not need to know who listens.
+package events
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type Name string
+
+const (
+	RoundFinishedEvent Name = "single_player.round_finished"
+	GameCompletedEvent Name = "single_player.game_completed"
+)
+
+type Event interface {
+	Name() Name
+}
+
+// RoundFinished is emitted when a single player round receives its guess, before the game
+// advances or completes.
+type RoundFinished struct {
+	Game  *entities.SinglePlayerGame
+	Round *entities.SinglePlayerRound
+}
+
+func (RoundFinished) Name() Name {
+	return RoundFinishedEvent
+}
+
+// GameCompleted is emitted once per single player game, after its last round and after the
+// player's stats include it. Rounds are ordered by round number and have their Location loaded.
+type GameCompleted struct {
+	Game   *entities.SinglePlayerGame
+	Rounds []*entities.SinglePlayerRound
+}
+
+func (GameCompleted) Name() Name {
+	return GameCompletedEvent
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type AccountDeletionRepository interface {
+	// Save inserts the deletion or replaces the pending one of the same user.
+	Save(ctx context.Context, deletion *entities.AccountDeletion) error
+	FindByUserId(ctx context.Context, userId string) (*entities.AccountDeletion, error)
+	// FindDue returns up to limit deletions scheduled at or before now, oldest first.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*entities.AccountDeletion, error)
+	Delete(ctx context.Context, deletion *entities.AccountDeletion) error
+}
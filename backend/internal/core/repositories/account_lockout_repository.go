@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type AccountLockoutRepository interface {
+	FindByUserId(ctx context.Context, userId string) (*entities.AccountLockout, error)
+	FindByUserIdWithLock(ctx context.Context, userId string) (*entities.AccountLockout, error)
+	Save(ctx context.Context, lockout *entities.AccountLockout) error
+	DeleteByUserId(ctx context.Context, userId string) error
+}
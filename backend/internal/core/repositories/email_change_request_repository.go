@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type EmailChangeRequestRepository interface {
+	Create(ctx context.Context, request *entities.EmailChangeRequest) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.EmailChangeRequest, error)
+	Delete(ctx context.Context, request *entities.EmailChangeRequest) error
+	DeleteByUserId(ctx context.Context, userId string) error
+}
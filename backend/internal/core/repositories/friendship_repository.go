@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type FriendshipRepository interface {
+	Create(ctx context.Context, friendship *entities.Friendship) error
+	Update(ctx context.Context, friendship *entities.Friendship) error
+	Delete(ctx context.Context, friendship *entities.Friendship) error
+	// FindByUsers returns the relationship between two users, in either direction.
+	FindByUsers(ctx context.Context, userId, otherUserId string) (*entities.Friendship, error)
+	// FindByUserId returns every relationship of the user, pending ones included, with both users loaded.
+	FindByUserId(ctx context.Context, userId string) ([]*entities.Friendship, error)
+	// FindFriendIds returns the ids of the user's accepted friends.
+	FindFriendIds(ctx context.Context, userId string) ([]string, error)
+	CountFriends(ctx context.Context, userId string) (int64, error)
+}
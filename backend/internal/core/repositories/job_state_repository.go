@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type JobStateRepository interface {
+	// Save creates the state of the job or replaces the existing one.
+	Save(ctx context.Context, state *entities.JobState) error
+	FindByName(ctx context.Context, name string) (*entities.JobState, error)
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type LeaderboardRepository interface {
+	// SubmitBest stores each entry unless the user already holds a higher or equal score on its board.
+	SubmitBest(ctx context.Context, entries []*entities.LeaderboardEntry) error
+	// FindTop returns a page of a board, best first, with User loaded. Ties go to the earliest game.
+	FindTop(ctx context.Context, key entities.LeaderboardKey, offset, limit int) ([]*entities.LeaderboardEntry, error)
+	// FindByKeyAndUserIds returns the entries of the given users on a board, ranked like FindTop.
+	FindByKeyAndUserIds(ctx context.Context, key entities.LeaderboardKey, userIds []string) ([]*entities.LeaderboardEntry, error)
+	CountByKey(ctx context.Context, key entities.LeaderboardKey) (int64, error)
+	FindByKeyAndUserId(ctx context.Context, key entities.LeaderboardKey, userId string) (*entities.LeaderboardEntry, error)
+	// CountAhead returns how many entries of the board rank before entry, so its rank is the count plus one.
+	CountAhead(ctx context.Context, entry *entities.LeaderboardEntry) (int64, error)
+}
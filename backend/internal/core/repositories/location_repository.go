@@ -2,12 +2,59 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 )
 
+// LocationStratum is a group of a map's locations in the same geo cell and country, of the same
+// difficulty.
+type LocationStratum struct {
+	GeoCell     int
+	CountryCode string
+	Difficulty  entities.LocationDifficulty
+	Count       int64
+}
+
+// LocationFilter narrows the locations of a map. The zero filter keeps them all.
+type LocationFilter struct {
+	// Tags keeps the locations having every one of the tags.
+	Tags []string
+}
+
 type LocationRepository interface {
 	Create(ctx context.Context, l *entities.Location) error
+	Update(ctx context.Context, l *entities.Location) error
+	Delete(ctx context.Context, l *entities.Location) error
+	// Restore brings back a deleted location with the values of l.
+	Restore(ctx context.Context, l *entities.Location) error
+	FindByIdAndMapId(ctx context.Context, id, mapId string) (*entities.Location, error)
+	FindByMapId(ctx context.Context, mapId string) ([]*entities.Location, error)
+	// CopyBatch copies up to limit locations of sourceMapId with an id above afterId, in id order,
+	// to targetMapId. It returns how many were copied and the id of the last one, to resume from.
+	CopyBatch(ctx context.Context, sourceMapId, targetMapId, afterId string, limit int) (int, string, error)
 	CountByMapId(ctx context.Context, mapId string) (int64, error)
-	FindRandomLocationByMapId(ctx context.Context, mapId string, quantity int) ([]*entities.Location, error)
+	// CountStrata returns the strata of the map's locations kept by the filter, with their location
+	// counts.
+	CountStrata(ctx context.Context, mapId string, filter LocationFilter) ([]LocationStratum, error)
+	// FindSample returns up to limit locations of the stratum kept by the filter, in the order of
+	// their sample key, starting at from and wrapping around.
+	FindSample(ctx context.Context, mapId string, stratum LocationStratum, filter LocationFilter, from float64, limit int) ([]*entities.Location, error)
+	// FindPlayStats sums the completed rounds of up to limit locations with an id above afterId, in id
+	// order, among the locations with a round completed since the given time. next is the afterId of
+	// the following page, empty once there is none.
+	FindPlayStats(ctx context.Context, since time.Time, afterId string, limit int) (stats []entities.LocationPlayStats, next string, err error)
+	// UpdateDifficulties stores the play count, difficulty score and difficulty of each location.
+	UpdateDifficulties(ctx context.Context, stats []entities.LocationPlayStats) error
+	// CountDifficulties returns the number of the map's locations per difficulty and per tenth of
+	// the difficulty score.
+	CountDifficulties(ctx context.Context, mapId string) ([]LocationDifficultyCount, error)
+}
+
+// LocationDifficultyCount is the number of a map's locations of a difficulty whose difficulty score
+// falls in the tenth Bucket, from 0 to 9.
+type LocationDifficultyCount struct {
+	Difficulty entities.LocationDifficulty
+	Bucket     int
+	Count      int64
 }
@@ -0,0 +1,21 @@
+package repositories
+
+import "context"
+
+// LocationStrataKey names the strata of a map's locations having every one of Tags, as they are at a
+// revision of the map.
+type LocationStrataKey struct {
+	MapId    string
+	Revision int
+	// Tags is the space separated, sorted list of tags.
+	Tags string
+}
+
+// LocationStrataCache keeps counted strata, so that the games of a map only count them again once
+// its locations change. Locations move between strata when they are rated, without a new revision:
+// implementations let entries expire so the counts catch up.
+type LocationStrataCache interface {
+	// Get returns the strata stored under key, false when there are none.
+	Get(ctx context.Context, key LocationStrataKey) ([]LocationStratum, bool, error)
+	Set(ctx context.Context, key LocationStrataKey, strata []LocationStratum) error
+}
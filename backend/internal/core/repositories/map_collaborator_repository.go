@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type MapCollaboratorRepository interface {
+	// Save creates the collaborator or updates the role of an existing one.
+	Save(ctx context.Context, collaborator *entities.MapCollaborator) error
+	Delete(ctx context.Context, mapId, userId string) error
+	FindByMapIdAndUserId(ctx context.Context, mapId, userId string) (*entities.MapCollaborator, error)
+	// FindByMapId returns the collaborators of a map, oldest first, with User loaded.
+	FindByMapId(ctx context.Context, mapId string) ([]*entities.MapCollaborator, error)
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type MapInvitationRepository interface {
+	// Save creates the invitation or replaces the pending one of the user for the same map.
+	Save(ctx context.Context, invitation *entities.MapInvitation) error
+	Delete(ctx context.Context, mapId, userId string) error
+	// DeleteByMapId withdraws every pending invitation to a map.
+	DeleteByMapId(ctx context.Context, mapId string) error
+	FindByMapIdAndUserId(ctx context.Context, mapId, userId string) (*entities.MapInvitation, error)
+	// FindByUserId returns the pending invitations of a user, newest first, with Map and InvitedBy loaded.
+	FindByUserId(ctx context.Context, userId string) ([]*entities.MapInvitation, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type MapLikeRepository interface {
+	// Create stores the like and reports whether it is new; liking twice keeps the first like.
+	Create(ctx context.Context, like *entities.MapLike) (bool, error)
+	// Delete reports whether there was a like to remove.
+	Delete(ctx context.Context, mapId, userId string) (bool, error)
+	Exists(ctx context.Context, mapId, userId string) (bool, error)
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type MapRatingRepository interface {
+	// FindByMapIdAndUserIdForUpdate locks the rating until the transaction ends.
+	FindByMapIdAndUserIdForUpdate(ctx context.Context, mapId, userId string) (*entities.MapRating, error)
+	Save(ctx context.Context, rating *entities.MapRating) error
+	Delete(ctx context.Context, rating *entities.MapRating) error
+}
@@ -2,11 +2,43 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 )
 
+type MapSort string
+
+const (
+	// MapSortRecent lists the most recently published maps first.
+	MapSortRecent MapSort = "recent"
+	// MapSortTrending lists first the maps with the most recent plays and likes.
+	MapSortTrending MapSort = "trending"
+	// MapSortPopular lists the most liked maps first.
+	MapSortPopular MapSort = "popular"
+)
+
 type MapRepository interface {
 	Create(ctx context.Context, m *entities.Map) error
-	FindByName(ctx context.Context, name string) (*entities.Map, error)
+	// Update saves the settings of the map. Its revision and cached aggregates are left alone:
+	// only IncrementRevision and RecordActivity change them.
+	Update(ctx context.Context, m *entities.Map) error
+	FindByOwnerIdAndName(ctx context.Context, ownerId, name string) (*entities.Map, error)
+	// FindByOwnerId returns every map of the owner, private ones included.
+	FindByOwnerId(ctx context.Context, ownerId string) ([]*entities.Map, error)
+	FindById(ctx context.Context, id string) (*entities.Map, error)
+	// FindPublic returns a page of the public maps in sort order, with Owner loaded.
+	FindPublic(ctx context.Context, sort MapSort, offset, limit int) ([]*entities.Map, error)
+	CountPublic(ctx context.Context) (int64, error)
+	CountForks(ctx context.Context, mapId string) (int64, error)
+	// RecordActivity applies activity to the cached aggregates of the map in one statement.
+	RecordActivity(ctx context.Context, mapId string, activity entities.MapActivity) error
+	// IncrementRevision bumps the revision number of the map and returns it. The map row stays
+	// locked until the transaction ends, which serializes concurrent edits.
+	IncrementRevision(ctx context.Context, mapId string) (int, error)
+	Delete(ctx context.Context, m *entities.Map) error
+	DeleteByOwnerId(ctx context.Context, ownerId string) error
+	// FindRecentlyPublishedByOwnerIds returns up to limit public maps of the owners first published
+	// before before, latest first, with Owner loaded.
+	FindRecentlyPublishedByOwnerIds(ctx context.Context, ownerIds []string, before time.Time, limit int) ([]*entities.Map, error)
 }
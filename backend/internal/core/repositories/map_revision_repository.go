@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type MapRevisionRepository interface {
+	// Create stores the revision along with its changes.
+	Create(ctx context.Context, revision *entities.MapRevision) error
+	// FindByMapId returns a page of the revisions of the map, latest first, with Author loaded and
+	// without their changes.
+	FindByMapId(ctx context.Context, mapId string, offset, limit int) ([]*entities.MapRevision, error)
+	CountByMapId(ctx context.Context, mapId string) (int64, error)
+	// FindAfter returns the revisions of the map numbered above number, in ascending order, with
+	// their changes loaded.
+	FindAfter(ctx context.Context, mapId string, number int) ([]*entities.MapRevision, error)
+}
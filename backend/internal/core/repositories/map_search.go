@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+// MapSearchQuery filters the public maps. Zero values leave a filter out.
+type MapSearchQuery struct {
+	// Text is matched against the name, tags and description of the maps, which are then ranked by
+	// relevance. Without it maps come most recently published first.
+	Text     string
+	Category entities.MapCategory
+	// Tags must all be on the map.
+	Tags []string
+	// CountryCode keeps the maps having at least one location in the country.
+	CountryCode  string
+	CreatorId    string
+	MinLocations int64
+	MaxLocations int64
+	Offset       int
+	Limit        int
+}
+
+type MapSearchHit struct {
+	// Map has Owner loaded.
+	Map           *entities.Map
+	LocationCount int64
+	// Rank is the relevance to the query text, 0 without text.
+	Rank float64
+}
+
+// MapSearch finds public maps for discovery.
+type MapSearch interface {
+	// Search returns a page of the maps matching query, best first, and the number of matches.
+	Search(ctx context.Context, query MapSearchQuery) ([]MapSearchHit, int64, error)
+}
@@ -6,18 +6,20 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// NewMockLocationRepository creates a new instance of MockLocationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAccountDeletionRepository creates a new instance of MockAccountDeletionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockLocationRepository(t interface {
+func NewMockAccountDeletionRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockLocationRepository {
-	mock := &MockLocationRepository{}
+}) *MockAccountDeletionRepository {
+	mock := &MockAccountDeletionRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -25,66 +27,57 @@ func NewMockLocationRepository(t interface {
 	return mock
 }
 
-// MockLocationRepository is an autogenerated mock type for the LocationRepository type
-type MockLocationRepository struct {
+// MockAccountDeletionRepository is an autogenerated mock type for the AccountDeletionRepository type
+type MockAccountDeletionRepository struct {
 	mock.Mock
 }
 
-type MockLocationRepository_Expecter struct {
+type MockAccountDeletionRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockLocationRepository) EXPECT() *MockLocationRepository_Expecter {
-	return &MockLocationRepository_Expecter{mock: &_m.Mock}
+func (_m *MockAccountDeletionRepository) EXPECT() *MockAccountDeletionRepository_Expecter {
+	return &MockAccountDeletionRepository_Expecter{mock: &_m.Mock}
 }
 
-// CountByMapId provides a mock function for the type MockLocationRepository
-func (_mock *MockLocationRepository) CountByMapId(ctx context.Context, mapId string) (int64, error) {
-	ret := _mock.Called(ctx, mapId)
+// Delete provides a mock function for the type MockAccountDeletionRepository
+func (_mock *MockAccountDeletionRepository) Delete(ctx context.Context, deletion *entities.AccountDeletion) error {
+	ret := _mock.Called(ctx, deletion)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountByMapId")
+		panic("no return value specified for Delete")
 	}
 
-	var r0 int64
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
-		return returnFunc(ctx, mapId)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
-		r0 = returnFunc(ctx, mapId)
-	} else {
-		r0 = ret.Get(0).(int64)
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, mapId)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.AccountDeletion) error); ok {
+		r0 = returnFunc(ctx, deletion)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockLocationRepository_CountByMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByMapId'
-type MockLocationRepository_CountByMapId_Call struct {
+// MockAccountDeletionRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAccountDeletionRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// CountByMapId is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - mapId string
-func (_e *MockLocationRepository_Expecter) CountByMapId(ctx interface{}, mapId interface{}) *MockLocationRepository_CountByMapId_Call {
-	return &MockLocationRepository_CountByMapId_Call{Call: _e.mock.On("CountByMapId", ctx, mapId)}
+//   - deletion *entities.AccountDeletion
+func (_e *MockAccountDeletionRepository_Expecter) Delete(ctx interface{}, deletion interface{}) *MockAccountDeletionRepository_Delete_Call {
+	return &MockAccountDeletionRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, deletion)}
 }
 
-func (_c *MockLocationRepository_CountByMapId_Call) Run(run func(ctx context.Context, mapId string)) *MockLocationRepository_CountByMapId_Call {
+func (_c *MockAccountDeletionRepository_Delete_Call) Run(run func(ctx context.Context, deletion *entities.AccountDeletion)) *MockAccountDeletionRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *entities.AccountDeletion
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*entities.AccountDeletion)
 		}
 		run(
 			arg0,
@@ -94,54 +87,65 @@ func (_c *MockLocationRepository_CountByMapId_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockLocationRepository_CountByMapId_Call) Return(n int64, err error) *MockLocationRepository_CountByMapId_Call {
-	_c.Call.Return(n, err)
+func (_c *MockAccountDeletionRepository_Delete_Call) Return(err error) *MockAccountDeletionRepository_Delete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockLocationRepository_CountByMapId_Call) RunAndReturn(run func(ctx context.Context, mapId string) (int64, error)) *MockLocationRepository_CountByMapId_Call {
+func (_c *MockAccountDeletionRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, deletion *entities.AccountDeletion) error) *MockAccountDeletionRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockLocationRepository
-func (_mock *MockLocationRepository) Create(ctx context.Context, l *entities.Location) error {
-	ret := _mock.Called(ctx, l)
+// FindByUserId provides a mock function for the type MockAccountDeletionRepository
+func (_mock *MockAccountDeletionRepository) FindByUserId(ctx context.Context, userId string) (*entities.AccountDeletion, error) {
+	ret := _mock.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for FindByUserId")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Location) error); ok {
-		r0 = returnFunc(ctx, l)
+	var r0 *entities.AccountDeletion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.AccountDeletion, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.AccountDeletion); ok {
+		r0 = returnFunc(ctx, userId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AccountDeletion)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockLocationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockLocationRepository_Create_Call struct {
+// MockAccountDeletionRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockAccountDeletionRepository_FindByUserId_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// FindByUserId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - l *entities.Location
-func (_e *MockLocationRepository_Expecter) Create(ctx interface{}, l interface{}) *MockLocationRepository_Create_Call {
-	return &MockLocationRepository_Create_Call{Call: _e.mock.On("Create", ctx, l)}
+//   - userId string
+func (_e *MockAccountDeletionRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockAccountDeletionRepository_FindByUserId_Call {
+	return &MockAccountDeletionRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
 }
 
-func (_c *MockLocationRepository_Create_Call) Run(run func(ctx context.Context, l *entities.Location)) *MockLocationRepository_Create_Call {
+func (_c *MockAccountDeletionRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockAccountDeletionRepository_FindByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.Location
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*entities.Location)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -151,66 +155,66 @@ func (_c *MockLocationRepository_Create_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockLocationRepository_Create_Call) Return(err error) *MockLocationRepository_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockAccountDeletionRepository_FindByUserId_Call) Return(accountDeletion *entities.AccountDeletion, err error) *MockAccountDeletionRepository_FindByUserId_Call {
+	_c.Call.Return(accountDeletion, err)
 	return _c
 }
 
-func (_c *MockLocationRepository_Create_Call) RunAndReturn(run func(ctx context.Context, l *entities.Location) error) *MockLocationRepository_Create_Call {
+func (_c *MockAccountDeletionRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) (*entities.AccountDeletion, error)) *MockAccountDeletionRepository_FindByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindRandomLocationByMapId provides a mock function for the type MockLocationRepository
-func (_mock *MockLocationRepository) FindRandomLocationByMapId(ctx context.Context, mapId string, quantity int) ([]*entities.Location, error) {
-	ret := _mock.Called(ctx, mapId, quantity)
+// FindDue provides a mock function for the type MockAccountDeletionRepository
+func (_mock *MockAccountDeletionRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entities.AccountDeletion, error) {
+	ret := _mock.Called(ctx, now, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindRandomLocationByMapId")
+		panic("no return value specified for FindDue")
 	}
 
-	var r0 []*entities.Location
+	var r0 []*entities.AccountDeletion
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]*entities.Location, error)); ok {
-		return returnFunc(ctx, mapId, quantity)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entities.AccountDeletion, error)); ok {
+		return returnFunc(ctx, now, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []*entities.Location); ok {
-		r0 = returnFunc(ctx, mapId, quantity)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entities.AccountDeletion); ok {
+		r0 = returnFunc(ctx, now, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entities.Location)
+			r0 = ret.Get(0).([]*entities.AccountDeletion)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
-		r1 = returnFunc(ctx, mapId, quantity)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockLocationRepository_FindRandomLocationByMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRandomLocationByMapId'
-type MockLocationRepository_FindRandomLocationByMapId_Call struct {
+// MockAccountDeletionRepository_FindDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDue'
+type MockAccountDeletionRepository_FindDue_Call struct {
 	*mock.Call
 }
 
-// FindRandomLocationByMapId is a helper method to define mock.On call
+// FindDue is a helper method to define mock.On call
 //   - ctx context.Context
-//   - mapId string
-//   - quantity int
-func (_e *MockLocationRepository_Expecter) FindRandomLocationByMapId(ctx interface{}, mapId interface{}, quantity interface{}) *MockLocationRepository_FindRandomLocationByMapId_Call {
-	return &MockLocationRepository_FindRandomLocationByMapId_Call{Call: _e.mock.On("FindRandomLocationByMapId", ctx, mapId, quantity)}
+//   - now time.Time
+//   - limit int
+func (_e *MockAccountDeletionRepository_Expecter) FindDue(ctx interface{}, now interface{}, limit interface{}) *MockAccountDeletionRepository_FindDue_Call {
+	return &MockAccountDeletionRepository_FindDue_Call{Call: _e.mock.On("FindDue", ctx, now, limit)}
 }
 
-func (_c *MockLocationRepository_FindRandomLocationByMapId_Call) Run(run func(ctx context.Context, mapId string, quantity int)) *MockLocationRepository_FindRandomLocationByMapId_Call {
+func (_c *MockAccountDeletionRepository_FindDue_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *MockAccountDeletionRepository_FindDue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 time.Time
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(time.Time)
 		}
 		var arg2 int
 		if args[2] != nil {
@@ -225,81 +229,54 @@ func (_c *MockLocationRepository_FindRandomLocationByMapId_Call) Run(run func(ct
 	return _c
 }
 
-func (_c *MockLocationRepository_FindRandomLocationByMapId_Call) Return(locations []*entities.Location, err error) *MockLocationRepository_FindRandomLocationByMapId_Call {
-	_c.Call.Return(locations, err)
+func (_c *MockAccountDeletionRepository_FindDue_Call) Return(accountDeletions []*entities.AccountDeletion, err error) *MockAccountDeletionRepository_FindDue_Call {
+	_c.Call.Return(accountDeletions, err)
 	return _c
 }
 
-func (_c *MockLocationRepository_FindRandomLocationByMapId_Call) RunAndReturn(run func(ctx context.Context, mapId string, quantity int) ([]*entities.Location, error)) *MockLocationRepository_FindRandomLocationByMapId_Call {
+func (_c *MockAccountDeletionRepository_FindDue_Call) RunAndReturn(run func(ctx context.Context, now time.Time, limit int) ([]*entities.AccountDeletion, error)) *MockAccountDeletionRepository_FindDue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockMapRepository creates a new instance of MockMapRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockMapRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockMapRepository {
-	mock := &MockMapRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockMapRepository is an autogenerated mock type for the MapRepository type
-type MockMapRepository struct {
-	mock.Mock
-}
-
-type MockMapRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockMapRepository) EXPECT() *MockMapRepository_Expecter {
-	return &MockMapRepository_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockMapRepository
-func (_mock *MockMapRepository) Create(ctx context.Context, m *entities.Map) error {
-	ret := _mock.Called(ctx, m)
+// Save provides a mock function for the type MockAccountDeletionRepository
+func (_mock *MockAccountDeletionRepository) Save(ctx context.Context, deletion *entities.AccountDeletion) error {
+	ret := _mock.Called(ctx, deletion)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Save")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Map) error); ok {
-		r0 = returnFunc(ctx, m)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.AccountDeletion) error); ok {
+		r0 = returnFunc(ctx, deletion)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockMapRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockMapRepository_Create_Call struct {
+// MockAccountDeletionRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockAccountDeletionRepository_Save_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Save is a helper method to define mock.On call
 //   - ctx context.Context
-//   - m *entities.Map
-func (_e *MockMapRepository_Expecter) Create(ctx interface{}, m interface{}) *MockMapRepository_Create_Call {
-	return &MockMapRepository_Create_Call{Call: _e.mock.On("Create", ctx, m)}
+//   - deletion *entities.AccountDeletion
+func (_e *MockAccountDeletionRepository_Expecter) Save(ctx interface{}, deletion interface{}) *MockAccountDeletionRepository_Save_Call {
+	return &MockAccountDeletionRepository_Save_Call{Call: _e.mock.On("Save", ctx, deletion)}
 }
 
-func (_c *MockMapRepository_Create_Call) Run(run func(ctx context.Context, m *entities.Map)) *MockMapRepository_Create_Call {
+func (_c *MockAccountDeletionRepository_Save_Call) Run(run func(ctx context.Context, deletion *entities.AccountDeletion)) *MockAccountDeletionRepository_Save_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.Map
+		var arg1 *entities.AccountDeletion
 		if args[1] != nil {
-			arg1 = args[1].(*entities.Map)
+			arg1 = args[1].(*entities.AccountDeletion)
 		}
 		run(
 			arg0,
@@ -309,57 +286,73 @@ func (_c *MockMapRepository_Create_Call) Run(run func(ctx context.Context, m *en
 	return _c
 }
 
-func (_c *MockMapRepository_Create_Call) Return(err error) *MockMapRepository_Create_Call {
+func (_c *MockAccountDeletionRepository_Save_Call) Return(err error) *MockAccountDeletionRepository_Save_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockMapRepository_Create_Call) RunAndReturn(run func(ctx context.Context, m *entities.Map) error) *MockMapRepository_Create_Call {
+func (_c *MockAccountDeletionRepository_Save_Call) RunAndReturn(run func(ctx context.Context, deletion *entities.AccountDeletion) error) *MockAccountDeletionRepository_Save_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByName provides a mock function for the type MockMapRepository
-func (_mock *MockMapRepository) FindByName(ctx context.Context, name string) (*entities.Map, error) {
-	ret := _mock.Called(ctx, name)
+// NewMockAccountLockoutRepository creates a new instance of MockAccountLockoutRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAccountLockoutRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAccountLockoutRepository {
+	mock := &MockAccountLockoutRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAccountLockoutRepository is an autogenerated mock type for the AccountLockoutRepository type
+type MockAccountLockoutRepository struct {
+	mock.Mock
+}
+
+type MockAccountLockoutRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAccountLockoutRepository) EXPECT() *MockAccountLockoutRepository_Expecter {
+	return &MockAccountLockoutRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteByUserId provides a mock function for the type MockAccountLockoutRepository
+func (_mock *MockAccountLockoutRepository) DeleteByUserId(ctx context.Context, userId string) error {
+	ret := _mock.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByName")
+		panic("no return value specified for DeleteByUserId")
 	}
 
-	var r0 *entities.Map
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.Map, error)); ok {
-		return returnFunc(ctx, name)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.Map); ok {
-		r0 = returnFunc(ctx, name)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.Map)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, name)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockMapRepository_FindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByName'
-type MockMapRepository_FindByName_Call struct {
+// MockAccountLockoutRepository_DeleteByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserId'
+type MockAccountLockoutRepository_DeleteByUserId_Call struct {
 	*mock.Call
 }
 
-// FindByName is a helper method to define mock.On call
+// DeleteByUserId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockMapRepository_Expecter) FindByName(ctx interface{}, name interface{}) *MockMapRepository_FindByName_Call {
-	return &MockMapRepository_FindByName_Call{Call: _e.mock.On("FindByName", ctx, name)}
+//   - userId string
+func (_e *MockAccountLockoutRepository_Expecter) DeleteByUserId(ctx interface{}, userId interface{}) *MockAccountLockoutRepository_DeleteByUserId_Call {
+	return &MockAccountLockoutRepository_DeleteByUserId_Call{Call: _e.mock.On("DeleteByUserId", ctx, userId)}
 }
 
-func (_c *MockMapRepository_FindByName_Call) Run(run func(ctx context.Context, name string)) *MockMapRepository_FindByName_Call {
+func (_c *MockAccountLockoutRepository_DeleteByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockAccountLockoutRepository_DeleteByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -377,81 +370,65 @@ func (_c *MockMapRepository_FindByName_Call) Run(run func(ctx context.Context, n
 	return _c
 }
 
-func (_c *MockMapRepository_FindByName_Call) Return(mapParam *entities.Map, err error) *MockMapRepository_FindByName_Call {
-	_c.Call.Return(mapParam, err)
+func (_c *MockAccountLockoutRepository_DeleteByUserId_Call) Return(err error) *MockAccountLockoutRepository_DeleteByUserId_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockMapRepository_FindByName_Call) RunAndReturn(run func(ctx context.Context, name string) (*entities.Map, error)) *MockMapRepository_FindByName_Call {
+func (_c *MockAccountLockoutRepository_DeleteByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) error) *MockAccountLockoutRepository_DeleteByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockRefreshTokenRepository creates a new instance of MockRefreshTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockRefreshTokenRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockRefreshTokenRepository {
-	mock := &MockRefreshTokenRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockRefreshTokenRepository is an autogenerated mock type for the RefreshTokenRepository type
-type MockRefreshTokenRepository struct {
-	mock.Mock
-}
-
-type MockRefreshTokenRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockRefreshTokenRepository) EXPECT() *MockRefreshTokenRepository_Expecter {
-	return &MockRefreshTokenRepository_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockRefreshTokenRepository
-func (_mock *MockRefreshTokenRepository) Create(ctx context.Context, refreshToken *entities.RefreshToken) error {
-	ret := _mock.Called(ctx, refreshToken)
+// FindByUserId provides a mock function for the type MockAccountLockoutRepository
+func (_mock *MockAccountLockoutRepository) FindByUserId(ctx context.Context, userId string) (*entities.AccountLockout, error) {
+	ret := _mock.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for FindByUserId")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.RefreshToken) error); ok {
-		r0 = returnFunc(ctx, refreshToken)
+	var r0 *entities.AccountLockout
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.AccountLockout, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.AccountLockout); ok {
+		r0 = returnFunc(ctx, userId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AccountLockout)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockRefreshTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockRefreshTokenRepository_Create_Call struct {
+// MockAccountLockoutRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockAccountLockoutRepository_FindByUserId_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// FindByUserId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - refreshToken *entities.RefreshToken
-func (_e *MockRefreshTokenRepository_Expecter) Create(ctx interface{}, refreshToken interface{}) *MockRefreshTokenRepository_Create_Call {
-	return &MockRefreshTokenRepository_Create_Call{Call: _e.mock.On("Create", ctx, refreshToken)}
+//   - userId string
+func (_e *MockAccountLockoutRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockAccountLockoutRepository_FindByUserId_Call {
+	return &MockAccountLockoutRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
 }
 
-func (_c *MockRefreshTokenRepository_Create_Call) Run(run func(ctx context.Context, refreshToken *entities.RefreshToken)) *MockRefreshTokenRepository_Create_Call {
+func (_c *MockAccountLockoutRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockAccountLockoutRepository_FindByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.RefreshToken
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*entities.RefreshToken)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -461,57 +438,57 @@ func (_c *MockRefreshTokenRepository_Create_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockRefreshTokenRepository_Create_Call) Return(err error) *MockRefreshTokenRepository_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockAccountLockoutRepository_FindByUserId_Call) Return(accountLockout *entities.AccountLockout, err error) *MockAccountLockoutRepository_FindByUserId_Call {
+	_c.Call.Return(accountLockout, err)
 	return _c
 }
 
-func (_c *MockRefreshTokenRepository_Create_Call) RunAndReturn(run func(ctx context.Context, refreshToken *entities.RefreshToken) error) *MockRefreshTokenRepository_Create_Call {
+func (_c *MockAccountLockoutRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) (*entities.AccountLockout, error)) *MockAccountLockoutRepository_FindByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindById provides a mock function for the type MockRefreshTokenRepository
-func (_mock *MockRefreshTokenRepository) FindById(ctx context.Context, id string) (*entities.RefreshToken, error) {
-	ret := _mock.Called(ctx, id)
+// FindByUserIdWithLock provides a mock function for the type MockAccountLockoutRepository
+func (_mock *MockAccountLockoutRepository) FindByUserIdWithLock(ctx context.Context, userId string) (*entities.AccountLockout, error) {
+	ret := _mock.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindById")
+		panic("no return value specified for FindByUserIdWithLock")
 	}
 
-	var r0 *entities.RefreshToken
+	var r0 *entities.AccountLockout
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.RefreshToken, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.AccountLockout, error)); ok {
+		return returnFunc(ctx, userId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.RefreshToken); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.AccountLockout); ok {
+		r0 = returnFunc(ctx, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.RefreshToken)
+			r0 = ret.Get(0).(*entities.AccountLockout)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, id)
+		r1 = returnFunc(ctx, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRefreshTokenRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
-type MockRefreshTokenRepository_FindById_Call struct {
+// MockAccountLockoutRepository_FindByUserIdWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserIdWithLock'
+type MockAccountLockoutRepository_FindByUserIdWithLock_Call struct {
 	*mock.Call
 }
 
-// FindById is a helper method to define mock.On call
+// FindByUserIdWithLock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *MockRefreshTokenRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockRefreshTokenRepository_FindById_Call {
-	return &MockRefreshTokenRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+//   - userId string
+func (_e *MockAccountLockoutRepository_Expecter) FindByUserIdWithLock(ctx interface{}, userId interface{}) *MockAccountLockoutRepository_FindByUserIdWithLock_Call {
+	return &MockAccountLockoutRepository_FindByUserIdWithLock_Call{Call: _e.mock.On("FindByUserIdWithLock", ctx, userId)}
 }
 
-func (_c *MockRefreshTokenRepository_FindById_Call) Run(run func(ctx context.Context, id string)) *MockRefreshTokenRepository_FindById_Call {
+func (_c *MockAccountLockoutRepository_FindByUserIdWithLock_Call) Run(run func(ctx context.Context, userId string)) *MockAccountLockoutRepository_FindByUserIdWithLock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -529,54 +506,54 @@ func (_c *MockRefreshTokenRepository_FindById_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockRefreshTokenRepository_FindById_Call) Return(refreshToken *entities.RefreshToken, err error) *MockRefreshTokenRepository_FindById_Call {
-	_c.Call.Return(refreshToken, err)
+func (_c *MockAccountLockoutRepository_FindByUserIdWithLock_Call) Return(accountLockout *entities.AccountLockout, err error) *MockAccountLockoutRepository_FindByUserIdWithLock_Call {
+	_c.Call.Return(accountLockout, err)
 	return _c
 }
 
-func (_c *MockRefreshTokenRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id string) (*entities.RefreshToken, error)) *MockRefreshTokenRepository_FindById_Call {
+func (_c *MockAccountLockoutRepository_FindByUserIdWithLock_Call) RunAndReturn(run func(ctx context.Context, userId string) (*entities.AccountLockout, error)) *MockAccountLockoutRepository_FindByUserIdWithLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockRefreshTokenRepository
-func (_mock *MockRefreshTokenRepository) Update(ctx context.Context, refreshToken *entities.RefreshToken) error {
-	ret := _mock.Called(ctx, refreshToken)
+// Save provides a mock function for the type MockAccountLockoutRepository
+func (_mock *MockAccountLockoutRepository) Save(ctx context.Context, lockout *entities.AccountLockout) error {
+	ret := _mock.Called(ctx, lockout)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for Save")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.RefreshToken) error); ok {
-		r0 = returnFunc(ctx, refreshToken)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.AccountLockout) error); ok {
+		r0 = returnFunc(ctx, lockout)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockRefreshTokenRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockRefreshTokenRepository_Update_Call struct {
+// MockAccountLockoutRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockAccountLockoutRepository_Save_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// Save is a helper method to define mock.On call
 //   - ctx context.Context
-//   - refreshToken *entities.RefreshToken
-func (_e *MockRefreshTokenRepository_Expecter) Update(ctx interface{}, refreshToken interface{}) *MockRefreshTokenRepository_Update_Call {
-	return &MockRefreshTokenRepository_Update_Call{Call: _e.mock.On("Update", ctx, refreshToken)}
+//   - lockout *entities.AccountLockout
+func (_e *MockAccountLockoutRepository_Expecter) Save(ctx interface{}, lockout interface{}) *MockAccountLockoutRepository_Save_Call {
+	return &MockAccountLockoutRepository_Save_Call{Call: _e.mock.On("Save", ctx, lockout)}
 }
 
-func (_c *MockRefreshTokenRepository_Update_Call) Run(run func(ctx context.Context, refreshToken *entities.RefreshToken)) *MockRefreshTokenRepository_Update_Call {
+func (_c *MockAccountLockoutRepository_Save_Call) Run(run func(ctx context.Context, lockout *entities.AccountLockout)) *MockAccountLockoutRepository_Save_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.RefreshToken
+		var arg1 *entities.AccountLockout
 		if args[1] != nil {
-			arg1 = args[1].(*entities.RefreshToken)
+			arg1 = args[1].(*entities.AccountLockout)
 		}
 		run(
 			arg0,
@@ -586,23 +563,23 @@ func (_c *MockRefreshTokenRepository_Update_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockRefreshTokenRepository_Update_Call) Return(err error) *MockRefreshTokenRepository_Update_Call {
+func (_c *MockAccountLockoutRepository_Save_Call) Return(err error) *MockAccountLockoutRepository_Save_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockRefreshTokenRepository_Update_Call) RunAndReturn(run func(ctx context.Context, refreshToken *entities.RefreshToken) error) *MockRefreshTokenRepository_Update_Call {
+func (_c *MockAccountLockoutRepository_Save_Call) RunAndReturn(run func(ctx context.Context, lockout *entities.AccountLockout) error) *MockAccountLockoutRepository_Save_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSinglePlayerGameRepository creates a new instance of MockSinglePlayerGameRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockEmailChangeRequestRepository creates a new instance of MockEmailChangeRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSinglePlayerGameRepository(t interface {
+func NewMockEmailChangeRequestRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSinglePlayerGameRepository {
-	mock := &MockSinglePlayerGameRepository{}
+}) *MockEmailChangeRequestRepository {
+	mock := &MockEmailChangeRequestRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -610,57 +587,57 @@ func NewMockSinglePlayerGameRepository(t interface {
 	return mock
 }
 
-// MockSinglePlayerGameRepository is an autogenerated mock type for the SinglePlayerGameRepository type
-type MockSinglePlayerGameRepository struct {
+// MockEmailChangeRequestRepository is an autogenerated mock type for the EmailChangeRequestRepository type
+type MockEmailChangeRequestRepository struct {
 	mock.Mock
 }
 
-type MockSinglePlayerGameRepository_Expecter struct {
+type MockEmailChangeRequestRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSinglePlayerGameRepository) EXPECT() *MockSinglePlayerGameRepository_Expecter {
-	return &MockSinglePlayerGameRepository_Expecter{mock: &_m.Mock}
+func (_m *MockEmailChangeRequestRepository) EXPECT() *MockEmailChangeRequestRepository_Expecter {
+	return &MockEmailChangeRequestRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockSinglePlayerGameRepository
-func (_mock *MockSinglePlayerGameRepository) Create(ctx context.Context, game *entities.SinglePlayerGame) error {
-	ret := _mock.Called(ctx, game)
+// Create provides a mock function for the type MockEmailChangeRequestRepository
+func (_mock *MockEmailChangeRequestRepository) Create(ctx context.Context, request *entities.EmailChangeRequest) error {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.SinglePlayerGame) error); ok {
-		r0 = returnFunc(ctx, game)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.EmailChangeRequest) error); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSinglePlayerGameRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockSinglePlayerGameRepository_Create_Call struct {
+// MockEmailChangeRequestRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockEmailChangeRequestRepository_Create_Call struct {
 	*mock.Call
 }
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - game *entities.SinglePlayerGame
-func (_e *MockSinglePlayerGameRepository_Expecter) Create(ctx interface{}, game interface{}) *MockSinglePlayerGameRepository_Create_Call {
-	return &MockSinglePlayerGameRepository_Create_Call{Call: _e.mock.On("Create", ctx, game)}
+//   - request *entities.EmailChangeRequest
+func (_e *MockEmailChangeRequestRepository_Expecter) Create(ctx interface{}, request interface{}) *MockEmailChangeRequestRepository_Create_Call {
+	return &MockEmailChangeRequestRepository_Create_Call{Call: _e.mock.On("Create", ctx, request)}
 }
 
-func (_c *MockSinglePlayerGameRepository_Create_Call) Run(run func(ctx context.Context, game *entities.SinglePlayerGame)) *MockSinglePlayerGameRepository_Create_Call {
+func (_c *MockEmailChangeRequestRepository_Create_Call) Run(run func(ctx context.Context, request *entities.EmailChangeRequest)) *MockEmailChangeRequestRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.SinglePlayerGame
+		var arg1 *entities.EmailChangeRequest
 		if args[1] != nil {
-			arg1 = args[1].(*entities.SinglePlayerGame)
+			arg1 = args[1].(*entities.EmailChangeRequest)
 		}
 		run(
 			arg0,
@@ -670,132 +647,103 @@ func (_c *MockSinglePlayerGameRepository_Create_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockSinglePlayerGameRepository_Create_Call) Return(err error) *MockSinglePlayerGameRepository_Create_Call {
+func (_c *MockEmailChangeRequestRepository_Create_Call) Return(err error) *MockEmailChangeRequestRepository_Create_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSinglePlayerGameRepository_Create_Call) RunAndReturn(run func(ctx context.Context, game *entities.SinglePlayerGame) error) *MockSinglePlayerGameRepository_Create_Call {
+func (_c *MockEmailChangeRequestRepository_Create_Call) RunAndReturn(run func(ctx context.Context, request *entities.EmailChangeRequest) error) *MockEmailChangeRequestRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByIdAndUserIdWithLock provides a mock function for the type MockSinglePlayerGameRepository
-func (_mock *MockSinglePlayerGameRepository) FindByIdAndUserIdWithLock(ctx context.Context, id string, userId string) (*entities.SinglePlayerGame, error) {
-	ret := _mock.Called(ctx, id, userId)
+// Delete provides a mock function for the type MockEmailChangeRequestRepository
+func (_mock *MockEmailChangeRequestRepository) Delete(ctx context.Context, request *entities.EmailChangeRequest) error {
+	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByIdAndUserIdWithLock")
+		panic("no return value specified for Delete")
 	}
 
-	var r0 *entities.SinglePlayerGame
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.SinglePlayerGame, error)); ok {
-		return returnFunc(ctx, id, userId)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.SinglePlayerGame); ok {
-		r0 = returnFunc(ctx, id, userId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.SinglePlayerGame)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, id, userId)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.EmailChangeRequest) error); ok {
+		r0 = returnFunc(ctx, request)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIdAndUserIdWithLock'
-type MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call struct {
+// MockEmailChangeRequestRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockEmailChangeRequestRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// FindByIdAndUserIdWithLock is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-//   - userId string
-func (_e *MockSinglePlayerGameRepository_Expecter) FindByIdAndUserIdWithLock(ctx interface{}, id interface{}, userId interface{}) *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call {
-	return &MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call{Call: _e.mock.On("FindByIdAndUserIdWithLock", ctx, id, userId)}
+//   - request *entities.EmailChangeRequest
+func (_e *MockEmailChangeRequestRepository_Expecter) Delete(ctx interface{}, request interface{}) *MockEmailChangeRequestRepository_Delete_Call {
+	return &MockEmailChangeRequestRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, request)}
 }
 
-func (_c *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call) Run(run func(ctx context.Context, id string, userId string)) *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call {
+func (_c *MockEmailChangeRequestRepository_Delete_Call) Run(run func(ctx context.Context, request *entities.EmailChangeRequest)) *MockEmailChangeRequestRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *entities.EmailChangeRequest
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg1 = args[1].(*entities.EmailChangeRequest)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call) Return(singlePlayerGame *entities.SinglePlayerGame, err error) *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call {
-	_c.Call.Return(singlePlayerGame, err)
+func (_c *MockEmailChangeRequestRepository_Delete_Call) Return(err error) *MockEmailChangeRequestRepository_Delete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call) RunAndReturn(run func(ctx context.Context, id string, userId string) (*entities.SinglePlayerGame, error)) *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call {
+func (_c *MockEmailChangeRequestRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, request *entities.EmailChangeRequest) error) *MockEmailChangeRequestRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByUserIdAndStatuses provides a mock function for the type MockSinglePlayerGameRepository
-func (_mock *MockSinglePlayerGameRepository) FindByUserIdAndStatuses(ctx context.Context, userId string, statuses []entities.SinglePlayerGameStatus) (*entities.SinglePlayerGame, error) {
-	ret := _mock.Called(ctx, userId, statuses)
+// DeleteByUserId provides a mock function for the type MockEmailChangeRequestRepository
+func (_mock *MockEmailChangeRequestRepository) DeleteByUserId(ctx context.Context, userId string) error {
+	ret := _mock.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByUserIdAndStatuses")
+		panic("no return value specified for DeleteByUserId")
 	}
 
-	var r0 *entities.SinglePlayerGame
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []entities.SinglePlayerGameStatus) (*entities.SinglePlayerGame, error)); ok {
-		return returnFunc(ctx, userId, statuses)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []entities.SinglePlayerGameStatus) *entities.SinglePlayerGame); ok {
-		r0 = returnFunc(ctx, userId, statuses)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.SinglePlayerGame)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []entities.SinglePlayerGameStatus) error); ok {
-		r1 = returnFunc(ctx, userId, statuses)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserIdAndStatuses'
-type MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call struct {
+// MockEmailChangeRequestRepository_DeleteByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserId'
+type MockEmailChangeRequestRepository_DeleteByUserId_Call struct {
 	*mock.Call
 }
 
-// FindByUserIdAndStatuses is a helper method to define mock.On call
+// DeleteByUserId is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userId string
-//   - statuses []entities.SinglePlayerGameStatus
-func (_e *MockSinglePlayerGameRepository_Expecter) FindByUserIdAndStatuses(ctx interface{}, userId interface{}, statuses interface{}) *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call {
-	return &MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call{Call: _e.mock.On("FindByUserIdAndStatuses", ctx, userId, statuses)}
+func (_e *MockEmailChangeRequestRepository_Expecter) DeleteByUserId(ctx interface{}, userId interface{}) *MockEmailChangeRequestRepository_DeleteByUserId_Call {
+	return &MockEmailChangeRequestRepository_DeleteByUserId_Call{Call: _e.mock.On("DeleteByUserId", ctx, userId)}
 }
 
-func (_c *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call) Run(run func(ctx context.Context, userId string, statuses []entities.SinglePlayerGameStatus)) *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call {
+func (_c *MockEmailChangeRequestRepository_DeleteByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockEmailChangeRequestRepository_DeleteByUserId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -805,67 +753,73 @@ func (_c *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call) Run(run f
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 []entities.SinglePlayerGameStatus
-		if args[2] != nil {
-			arg2 = args[2].([]entities.SinglePlayerGameStatus)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call) Return(singlePlayerGame *entities.SinglePlayerGame, err error) *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call {
-	_c.Call.Return(singlePlayerGame, err)
+func (_c *MockEmailChangeRequestRepository_DeleteByUserId_Call) Return(err error) *MockEmailChangeRequestRepository_DeleteByUserId_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call) RunAndReturn(run func(ctx context.Context, userId string, statuses []entities.SinglePlayerGameStatus) (*entities.SinglePlayerGame, error)) *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call {
+func (_c *MockEmailChangeRequestRepository_DeleteByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) error) *MockEmailChangeRequestRepository_DeleteByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockSinglePlayerGameRepository
-func (_mock *MockSinglePlayerGameRepository) Update(ctx context.Context, game *entities.SinglePlayerGame) error {
-	ret := _mock.Called(ctx, game)
+// FindByTokenHash provides a mock function for the type MockEmailChangeRequestRepository
+func (_mock *MockEmailChangeRequestRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.EmailChangeRequest, error) {
+	ret := _mock.Called(ctx, tokenHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for FindByTokenHash")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.SinglePlayerGame) error); ok {
-		r0 = returnFunc(ctx, game)
+	var r0 *entities.EmailChangeRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.EmailChangeRequest, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.EmailChangeRequest); ok {
+		r0 = returnFunc(ctx, tokenHash)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.EmailChangeRequest)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSinglePlayerGameRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockSinglePlayerGameRepository_Update_Call struct {
+// MockEmailChangeRequestRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type MockEmailChangeRequestRepository_FindByTokenHash_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// FindByTokenHash is a helper method to define mock.On call
 //   - ctx context.Context
-//   - game *entities.SinglePlayerGame
-func (_e *MockSinglePlayerGameRepository_Expecter) Update(ctx interface{}, game interface{}) *MockSinglePlayerGameRepository_Update_Call {
-	return &MockSinglePlayerGameRepository_Update_Call{Call: _e.mock.On("Update", ctx, game)}
+//   - tokenHash string
+func (_e *MockEmailChangeRequestRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *MockEmailChangeRequestRepository_FindByTokenHash_Call {
+	return &MockEmailChangeRequestRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
 }
 
-func (_c *MockSinglePlayerGameRepository_Update_Call) Run(run func(ctx context.Context, game *entities.SinglePlayerGame)) *MockSinglePlayerGameRepository_Update_Call {
+func (_c *MockEmailChangeRequestRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockEmailChangeRequestRepository_FindByTokenHash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.SinglePlayerGame
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*entities.SinglePlayerGame)
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
@@ -875,23 +829,23 @@ func (_c *MockSinglePlayerGameRepository_Update_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockSinglePlayerGameRepository_Update_Call) Return(err error) *MockSinglePlayerGameRepository_Update_Call {
-	_c.Call.Return(err)
+func (_c *MockEmailChangeRequestRepository_FindByTokenHash_Call) Return(emailChangeRequest *entities.EmailChangeRequest, err error) *MockEmailChangeRequestRepository_FindByTokenHash_Call {
+	_c.Call.Return(emailChangeRequest, err)
 	return _c
 }
 
-func (_c *MockSinglePlayerGameRepository_Update_Call) RunAndReturn(run func(ctx context.Context, game *entities.SinglePlayerGame) error) *MockSinglePlayerGameRepository_Update_Call {
+func (_c *MockEmailChangeRequestRepository_FindByTokenHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*entities.EmailChangeRequest, error)) *MockEmailChangeRequestRepository_FindByTokenHash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockSinglePlayerRoundRepository creates a new instance of MockSinglePlayerRoundRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockFriendshipRepository creates a new instance of MockFriendshipRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockSinglePlayerRoundRepository(t interface {
+func NewMockFriendshipRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockSinglePlayerRoundRepository {
-	mock := &MockSinglePlayerRoundRepository{}
+}) *MockFriendshipRepository {
+	mock := &MockFriendshipRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -899,61 +853,58 @@ func NewMockSinglePlayerRoundRepository(t interface {
 	return mock
 }
 
-// MockSinglePlayerRoundRepository is an autogenerated mock type for the SinglePlayerRoundRepository type
-type MockSinglePlayerRoundRepository struct {
+// MockFriendshipRepository is an autogenerated mock type for the FriendshipRepository type
+type MockFriendshipRepository struct {
 	mock.Mock
 }
 
-type MockSinglePlayerRoundRepository_Expecter struct {
+type MockFriendshipRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockSinglePlayerRoundRepository) EXPECT() *MockSinglePlayerRoundRepository_Expecter {
-	return &MockSinglePlayerRoundRepository_Expecter{mock: &_m.Mock}
+func (_m *MockFriendshipRepository) EXPECT() *MockFriendshipRepository_Expecter {
+	return &MockFriendshipRepository_Expecter{mock: &_m.Mock}
 }
 
-// FindByGameIdAndRoundNumberWithLock provides a mock function for the type MockSinglePlayerRoundRepository
-func (_mock *MockSinglePlayerRoundRepository) FindByGameIdAndRoundNumberWithLock(ctx context.Context, gameId string, roundNumber int) (*entities.SinglePlayerRound, error) {
-	ret := _mock.Called(ctx, gameId, roundNumber)
+// CountFriends provides a mock function for the type MockFriendshipRepository
+func (_mock *MockFriendshipRepository) CountFriends(ctx context.Context, userId string) (int64, error) {
+	ret := _mock.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByGameIdAndRoundNumberWithLock")
+		panic("no return value specified for CountFriends")
 	}
 
-	var r0 *entities.SinglePlayerRound
+	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*entities.SinglePlayerRound, error)); ok {
-		return returnFunc(ctx, gameId, roundNumber)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, userId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *entities.SinglePlayerRound); ok {
-		r0 = returnFunc(ctx, gameId, roundNumber)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, userId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.SinglePlayerRound)
-		}
+		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
-		r1 = returnFunc(ctx, gameId, roundNumber)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByGameIdAndRoundNumberWithLock'
-type MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call struct {
+// MockFriendshipRepository_CountFriends_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFriends'
+type MockFriendshipRepository_CountFriends_Call struct {
 	*mock.Call
 }
 
-// FindByGameIdAndRoundNumberWithLock is a helper method to define mock.On call
+// CountFriends is a helper method to define mock.On call
 //   - ctx context.Context
-//   - gameId string
-//   - roundNumber int
-func (_e *MockSinglePlayerRoundRepository_Expecter) FindByGameIdAndRoundNumberWithLock(ctx interface{}, gameId interface{}, roundNumber interface{}) *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call {
-	return &MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call{Call: _e.mock.On("FindByGameIdAndRoundNumberWithLock", ctx, gameId, roundNumber)}
+//   - userId string
+func (_e *MockFriendshipRepository_Expecter) CountFriends(ctx interface{}, userId interface{}) *MockFriendshipRepository_CountFriends_Call {
+	return &MockFriendshipRepository_CountFriends_Call{Call: _e.mock.On("CountFriends", ctx, userId)}
 }
 
-func (_c *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call) Run(run func(ctx context.Context, gameId string, roundNumber int)) *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call {
+func (_c *MockFriendshipRepository_CountFriends_Call) Run(run func(ctx context.Context, userId string)) *MockFriendshipRepository_CountFriends_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -963,156 +914,8629 @@ func (_c *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Cal
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call) Return(singlePlayerRound *entities.SinglePlayerRound, err error) *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call {
-	_c.Call.Return(singlePlayerRound, err)
+func (_c *MockFriendshipRepository_CountFriends_Call) Return(n int64, err error) *MockFriendshipRepository_CountFriends_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call) RunAndReturn(run func(ctx context.Context, gameId string, roundNumber int) (*entities.SinglePlayerRound, error)) *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call {
+func (_c *MockFriendshipRepository_CountFriends_Call) RunAndReturn(run func(ctx context.Context, userId string) (int64, error)) *MockFriendshipRepository_CountFriends_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByIdAndGameIdWithLock provides a mock function for the type MockSinglePlayerRoundRepository
-func (_mock *MockSinglePlayerRoundRepository) FindByIdAndGameIdWithLock(ctx context.Context, id string, gameId string) (*entities.SinglePlayerRound, error) {
-	ret := _mock.Called(ctx, id, gameId)
+// Create provides a mock function for the type MockFriendshipRepository
+func (_mock *MockFriendshipRepository) Create(ctx context.Context, friendship *entities.Friendship) error {
+	ret := _mock.Called(ctx, friendship)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByIdAndGameIdWithLock")
+		panic("no return value specified for Create")
 	}
 
-	var r0 *entities.SinglePlayerRound
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.SinglePlayerRound, error)); ok {
-		return returnFunc(ctx, id, gameId)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.SinglePlayerRound); ok {
-		r0 = returnFunc(ctx, id, gameId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.SinglePlayerRound)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, id, gameId)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Friendship) error); ok {
+		r0 = returnFunc(ctx, friendship)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIdAndGameIdWithLock'
-type MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call struct {
+// MockFriendshipRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockFriendshipRepository_Create_Call struct {
 	*mock.Call
 }
 
-// FindByIdAndGameIdWithLock is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-//   - gameId string
-func (_e *MockSinglePlayerRoundRepository_Expecter) FindByIdAndGameIdWithLock(ctx interface{}, id interface{}, gameId interface{}) *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call {
-	return &MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call{Call: _e.mock.On("FindByIdAndGameIdWithLock", ctx, id, gameId)}
+//   - friendship *entities.Friendship
+func (_e *MockFriendshipRepository_Expecter) Create(ctx interface{}, friendship interface{}) *MockFriendshipRepository_Create_Call {
+	return &MockFriendshipRepository_Create_Call{Call: _e.mock.On("Create", ctx, friendship)}
 }
 
-func (_c *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call) Run(run func(ctx context.Context, id string, gameId string)) *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call {
+func (_c *MockFriendshipRepository_Create_Call) Run(run func(ctx context.Context, friendship *entities.Friendship)) *MockFriendshipRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *entities.Friendship
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg1 = args[1].(*entities.Friendship)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call) Return(singlePlayerRound *entities.SinglePlayerRound, err error) *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call {
-	_c.Call.Return(singlePlayerRound, err)
+func (_c *MockFriendshipRepository_Create_Call) Return(err error) *MockFriendshipRepository_Create_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call) RunAndReturn(run func(ctx context.Context, id string, gameId string) (*entities.SinglePlayerRound, error)) *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call {
+func (_c *MockFriendshipRepository_Create_Call) RunAndReturn(run func(ctx context.Context, friendship *entities.Friendship) error) *MockFriendshipRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockSinglePlayerRoundRepository
-func (_mock *MockSinglePlayerRoundRepository) Update(ctx context.Context, round *entities.SinglePlayerRound) error {
-	ret := _mock.Called(ctx, round)
+// Delete provides a mock function for the type MockFriendshipRepository
+func (_mock *MockFriendshipRepository) Delete(ctx context.Context, friendship *entities.Friendship) error {
+	ret := _mock.Called(ctx, friendship)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.SinglePlayerRound) error); ok {
-		r0 = returnFunc(ctx, round)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Friendship) error); ok {
+		r0 = returnFunc(ctx, friendship)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSinglePlayerRoundRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockSinglePlayerRoundRepository_Update_Call struct {
+// MockFriendshipRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockFriendshipRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// Delete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - round *entities.SinglePlayerRound
-func (_e *MockSinglePlayerRoundRepository_Expecter) Update(ctx interface{}, round interface{}) *MockSinglePlayerRoundRepository_Update_Call {
-	return &MockSinglePlayerRoundRepository_Update_Call{Call: _e.mock.On("Update", ctx, round)}
+//   - friendship *entities.Friendship
+func (_e *MockFriendshipRepository_Expecter) Delete(ctx interface{}, friendship interface{}) *MockFriendshipRepository_Delete_Call {
+	return &MockFriendshipRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, friendship)}
+}
+
+func (_c *MockFriendshipRepository_Delete_Call) Run(run func(ctx context.Context, friendship *entities.Friendship)) *MockFriendshipRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Friendship
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Friendship)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_Delete_Call) Return(err error) *MockFriendshipRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, friendship *entities.Friendship) error) *MockFriendshipRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserId provides a mock function for the type MockFriendshipRepository
+func (_mock *MockFriendshipRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.Friendship, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 []*entities.Friendship
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.Friendship, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.Friendship); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Friendship)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockFriendshipRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockFriendshipRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockFriendshipRepository_FindByUserId_Call {
+	return &MockFriendshipRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
+}
+
+func (_c *MockFriendshipRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockFriendshipRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_FindByUserId_Call) Return(friendships []*entities.Friendship, err error) *MockFriendshipRepository_FindByUserId_Call {
+	_c.Call.Return(friendships, err)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) ([]*entities.Friendship, error)) *MockFriendshipRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUsers provides a mock function for the type MockFriendshipRepository
+func (_mock *MockFriendshipRepository) FindByUsers(ctx context.Context, userId string, otherUserId string) (*entities.Friendship, error) {
+	ret := _mock.Called(ctx, userId, otherUserId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUsers")
+	}
+
+	var r0 *entities.Friendship
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Friendship, error)); ok {
+		return returnFunc(ctx, userId, otherUserId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.Friendship); ok {
+		r0 = returnFunc(ctx, userId, otherUserId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Friendship)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userId, otherUserId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipRepository_FindByUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUsers'
+type MockFriendshipRepository_FindByUsers_Call struct {
+	*mock.Call
+}
+
+// FindByUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - otherUserId string
+func (_e *MockFriendshipRepository_Expecter) FindByUsers(ctx interface{}, userId interface{}, otherUserId interface{}) *MockFriendshipRepository_FindByUsers_Call {
+	return &MockFriendshipRepository_FindByUsers_Call{Call: _e.mock.On("FindByUsers", ctx, userId, otherUserId)}
+}
+
+func (_c *MockFriendshipRepository_FindByUsers_Call) Run(run func(ctx context.Context, userId string, otherUserId string)) *MockFriendshipRepository_FindByUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_FindByUsers_Call) Return(friendship *entities.Friendship, err error) *MockFriendshipRepository_FindByUsers_Call {
+	_c.Call.Return(friendship, err)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_FindByUsers_Call) RunAndReturn(run func(ctx context.Context, userId string, otherUserId string) (*entities.Friendship, error)) *MockFriendshipRepository_FindByUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFriendIds provides a mock function for the type MockFriendshipRepository
+func (_mock *MockFriendshipRepository) FindFriendIds(ctx context.Context, userId string) ([]string, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFriendIds")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFriendshipRepository_FindFriendIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFriendIds'
+type MockFriendshipRepository_FindFriendIds_Call struct {
+	*mock.Call
+}
+
+// FindFriendIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockFriendshipRepository_Expecter) FindFriendIds(ctx interface{}, userId interface{}) *MockFriendshipRepository_FindFriendIds_Call {
+	return &MockFriendshipRepository_FindFriendIds_Call{Call: _e.mock.On("FindFriendIds", ctx, userId)}
+}
+
+func (_c *MockFriendshipRepository_FindFriendIds_Call) Run(run func(ctx context.Context, userId string)) *MockFriendshipRepository_FindFriendIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_FindFriendIds_Call) Return(strings []string, err error) *MockFriendshipRepository_FindFriendIds_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_FindFriendIds_Call) RunAndReturn(run func(ctx context.Context, userId string) ([]string, error)) *MockFriendshipRepository_FindFriendIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockFriendshipRepository
+func (_mock *MockFriendshipRepository) Update(ctx context.Context, friendship *entities.Friendship) error {
+	ret := _mock.Called(ctx, friendship)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Friendship) error); ok {
+		r0 = returnFunc(ctx, friendship)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFriendshipRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockFriendshipRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - friendship *entities.Friendship
+func (_e *MockFriendshipRepository_Expecter) Update(ctx interface{}, friendship interface{}) *MockFriendshipRepository_Update_Call {
+	return &MockFriendshipRepository_Update_Call{Call: _e.mock.On("Update", ctx, friendship)}
+}
+
+func (_c *MockFriendshipRepository_Update_Call) Run(run func(ctx context.Context, friendship *entities.Friendship)) *MockFriendshipRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Friendship
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Friendship)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFriendshipRepository_Update_Call) Return(err error) *MockFriendshipRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFriendshipRepository_Update_Call) RunAndReturn(run func(ctx context.Context, friendship *entities.Friendship) error) *MockFriendshipRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJobStateRepository creates a new instance of MockJobStateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJobStateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJobStateRepository {
+	mock := &MockJobStateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJobStateRepository is an autogenerated mock type for the JobStateRepository type
+type MockJobStateRepository struct {
+	mock.Mock
+}
+
+type MockJobStateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJobStateRepository) EXPECT() *MockJobStateRepository_Expecter {
+	return &MockJobStateRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindByName provides a mock function for the type MockJobStateRepository
+func (_mock *MockJobStateRepository) FindByName(ctx context.Context, name string) (*entities.JobState, error) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByName")
+	}
+
+	var r0 *entities.JobState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.JobState, error)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.JobState); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.JobState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobStateRepository_FindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByName'
+type MockJobStateRepository_FindByName_Call struct {
+	*mock.Call
+}
+
+// FindByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockJobStateRepository_Expecter) FindByName(ctx interface{}, name interface{}) *MockJobStateRepository_FindByName_Call {
+	return &MockJobStateRepository_FindByName_Call{Call: _e.mock.On("FindByName", ctx, name)}
+}
+
+func (_c *MockJobStateRepository_FindByName_Call) Run(run func(ctx context.Context, name string)) *MockJobStateRepository_FindByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobStateRepository_FindByName_Call) Return(jobState *entities.JobState, err error) *MockJobStateRepository_FindByName_Call {
+	_c.Call.Return(jobState, err)
+	return _c
+}
+
+func (_c *MockJobStateRepository_FindByName_Call) RunAndReturn(run func(ctx context.Context, name string) (*entities.JobState, error)) *MockJobStateRepository_FindByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockJobStateRepository
+func (_mock *MockJobStateRepository) Save(ctx context.Context, state *entities.JobState) error {
+	ret := _mock.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.JobState) error); ok {
+		r0 = returnFunc(ctx, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockJobStateRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockJobStateRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state *entities.JobState
+func (_e *MockJobStateRepository_Expecter) Save(ctx interface{}, state interface{}) *MockJobStateRepository_Save_Call {
+	return &MockJobStateRepository_Save_Call{Call: _e.mock.On("Save", ctx, state)}
+}
+
+func (_c *MockJobStateRepository_Save_Call) Run(run func(ctx context.Context, state *entities.JobState)) *MockJobStateRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.JobState
+		if args[1] != nil {
+			arg1 = args[1].(*entities.JobState)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobStateRepository_Save_Call) Return(err error) *MockJobStateRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockJobStateRepository_Save_Call) RunAndReturn(run func(ctx context.Context, state *entities.JobState) error) *MockJobStateRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLeaderboardRepository creates a new instance of MockLeaderboardRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLeaderboardRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLeaderboardRepository {
+	mock := &MockLeaderboardRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLeaderboardRepository is an autogenerated mock type for the LeaderboardRepository type
+type MockLeaderboardRepository struct {
+	mock.Mock
+}
+
+type MockLeaderboardRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLeaderboardRepository) EXPECT() *MockLeaderboardRepository_Expecter {
+	return &MockLeaderboardRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountAhead provides a mock function for the type MockLeaderboardRepository
+func (_mock *MockLeaderboardRepository) CountAhead(ctx context.Context, entry *entities.LeaderboardEntry) (int64, error) {
+	ret := _mock.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAhead")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.LeaderboardEntry) (int64, error)); ok {
+		return returnFunc(ctx, entry)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.LeaderboardEntry) int64); ok {
+		r0 = returnFunc(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.LeaderboardEntry) error); ok {
+		r1 = returnFunc(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLeaderboardRepository_CountAhead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAhead'
+type MockLeaderboardRepository_CountAhead_Call struct {
+	*mock.Call
+}
+
+// CountAhead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *entities.LeaderboardEntry
+func (_e *MockLeaderboardRepository_Expecter) CountAhead(ctx interface{}, entry interface{}) *MockLeaderboardRepository_CountAhead_Call {
+	return &MockLeaderboardRepository_CountAhead_Call{Call: _e.mock.On("CountAhead", ctx, entry)}
+}
+
+func (_c *MockLeaderboardRepository_CountAhead_Call) Run(run func(ctx context.Context, entry *entities.LeaderboardEntry)) *MockLeaderboardRepository_CountAhead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.LeaderboardEntry
+		if args[1] != nil {
+			arg1 = args[1].(*entities.LeaderboardEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_CountAhead_Call) Return(n int64, err error) *MockLeaderboardRepository_CountAhead_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_CountAhead_Call) RunAndReturn(run func(ctx context.Context, entry *entities.LeaderboardEntry) (int64, error)) *MockLeaderboardRepository_CountAhead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByKey provides a mock function for the type MockLeaderboardRepository
+func (_mock *MockLeaderboardRepository) CountByKey(ctx context.Context, key entities.LeaderboardKey) (int64, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByKey")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.LeaderboardKey) (int64, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.LeaderboardKey) int64); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.LeaderboardKey) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLeaderboardRepository_CountByKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByKey'
+type MockLeaderboardRepository_CountByKey_Call struct {
+	*mock.Call
+}
+
+// CountByKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key entities.LeaderboardKey
+func (_e *MockLeaderboardRepository_Expecter) CountByKey(ctx interface{}, key interface{}) *MockLeaderboardRepository_CountByKey_Call {
+	return &MockLeaderboardRepository_CountByKey_Call{Call: _e.mock.On("CountByKey", ctx, key)}
+}
+
+func (_c *MockLeaderboardRepository_CountByKey_Call) Run(run func(ctx context.Context, key entities.LeaderboardKey)) *MockLeaderboardRepository_CountByKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.LeaderboardKey
+		if args[1] != nil {
+			arg1 = args[1].(entities.LeaderboardKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_CountByKey_Call) Return(n int64, err error) *MockLeaderboardRepository_CountByKey_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_CountByKey_Call) RunAndReturn(run func(ctx context.Context, key entities.LeaderboardKey) (int64, error)) *MockLeaderboardRepository_CountByKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByKeyAndUserId provides a mock function for the type MockLeaderboardRepository
+func (_mock *MockLeaderboardRepository) FindByKeyAndUserId(ctx context.Context, key entities.LeaderboardKey, userId string) (*entities.LeaderboardEntry, error) {
+	ret := _mock.Called(ctx, key, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByKeyAndUserId")
+	}
+
+	var r0 *entities.LeaderboardEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.LeaderboardKey, string) (*entities.LeaderboardEntry, error)); ok {
+		return returnFunc(ctx, key, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.LeaderboardKey, string) *entities.LeaderboardEntry); ok {
+		r0 = returnFunc(ctx, key, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LeaderboardEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.LeaderboardKey, string) error); ok {
+		r1 = returnFunc(ctx, key, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLeaderboardRepository_FindByKeyAndUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKeyAndUserId'
+type MockLeaderboardRepository_FindByKeyAndUserId_Call struct {
+	*mock.Call
+}
+
+// FindByKeyAndUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key entities.LeaderboardKey
+//   - userId string
+func (_e *MockLeaderboardRepository_Expecter) FindByKeyAndUserId(ctx interface{}, key interface{}, userId interface{}) *MockLeaderboardRepository_FindByKeyAndUserId_Call {
+	return &MockLeaderboardRepository_FindByKeyAndUserId_Call{Call: _e.mock.On("FindByKeyAndUserId", ctx, key, userId)}
+}
+
+func (_c *MockLeaderboardRepository_FindByKeyAndUserId_Call) Run(run func(ctx context.Context, key entities.LeaderboardKey, userId string)) *MockLeaderboardRepository_FindByKeyAndUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.LeaderboardKey
+		if args[1] != nil {
+			arg1 = args[1].(entities.LeaderboardKey)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_FindByKeyAndUserId_Call) Return(leaderboardEntry *entities.LeaderboardEntry, err error) *MockLeaderboardRepository_FindByKeyAndUserId_Call {
+	_c.Call.Return(leaderboardEntry, err)
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_FindByKeyAndUserId_Call) RunAndReturn(run func(ctx context.Context, key entities.LeaderboardKey, userId string) (*entities.LeaderboardEntry, error)) *MockLeaderboardRepository_FindByKeyAndUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByKeyAndUserIds provides a mock function for the type MockLeaderboardRepository
+func (_mock *MockLeaderboardRepository) FindByKeyAndUserIds(ctx context.Context, key entities.LeaderboardKey, userIds []string) ([]*entities.LeaderboardEntry, error) {
+	ret := _mock.Called(ctx, key, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByKeyAndUserIds")
+	}
+
+	var r0 []*entities.LeaderboardEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.LeaderboardKey, []string) ([]*entities.LeaderboardEntry, error)); ok {
+		return returnFunc(ctx, key, userIds)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.LeaderboardKey, []string) []*entities.LeaderboardEntry); ok {
+		r0 = returnFunc(ctx, key, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.LeaderboardEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.LeaderboardKey, []string) error); ok {
+		r1 = returnFunc(ctx, key, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLeaderboardRepository_FindByKeyAndUserIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByKeyAndUserIds'
+type MockLeaderboardRepository_FindByKeyAndUserIds_Call struct {
+	*mock.Call
+}
+
+// FindByKeyAndUserIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key entities.LeaderboardKey
+//   - userIds []string
+func (_e *MockLeaderboardRepository_Expecter) FindByKeyAndUserIds(ctx interface{}, key interface{}, userIds interface{}) *MockLeaderboardRepository_FindByKeyAndUserIds_Call {
+	return &MockLeaderboardRepository_FindByKeyAndUserIds_Call{Call: _e.mock.On("FindByKeyAndUserIds", ctx, key, userIds)}
+}
+
+func (_c *MockLeaderboardRepository_FindByKeyAndUserIds_Call) Run(run func(ctx context.Context, key entities.LeaderboardKey, userIds []string)) *MockLeaderboardRepository_FindByKeyAndUserIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.LeaderboardKey
+		if args[1] != nil {
+			arg1 = args[1].(entities.LeaderboardKey)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_FindByKeyAndUserIds_Call) Return(leaderboardEntrys []*entities.LeaderboardEntry, err error) *MockLeaderboardRepository_FindByKeyAndUserIds_Call {
+	_c.Call.Return(leaderboardEntrys, err)
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_FindByKeyAndUserIds_Call) RunAndReturn(run func(ctx context.Context, key entities.LeaderboardKey, userIds []string) ([]*entities.LeaderboardEntry, error)) *MockLeaderboardRepository_FindByKeyAndUserIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTop provides a mock function for the type MockLeaderboardRepository
+func (_mock *MockLeaderboardRepository) FindTop(ctx context.Context, key entities.LeaderboardKey, offset int, limit int) ([]*entities.LeaderboardEntry, error) {
+	ret := _mock.Called(ctx, key, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTop")
+	}
+
+	var r0 []*entities.LeaderboardEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.LeaderboardKey, int, int) ([]*entities.LeaderboardEntry, error)); ok {
+		return returnFunc(ctx, key, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entities.LeaderboardKey, int, int) []*entities.LeaderboardEntry); ok {
+		r0 = returnFunc(ctx, key, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.LeaderboardEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entities.LeaderboardKey, int, int) error); ok {
+		r1 = returnFunc(ctx, key, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLeaderboardRepository_FindTop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTop'
+type MockLeaderboardRepository_FindTop_Call struct {
+	*mock.Call
+}
+
+// FindTop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key entities.LeaderboardKey
+//   - offset int
+//   - limit int
+func (_e *MockLeaderboardRepository_Expecter) FindTop(ctx interface{}, key interface{}, offset interface{}, limit interface{}) *MockLeaderboardRepository_FindTop_Call {
+	return &MockLeaderboardRepository_FindTop_Call{Call: _e.mock.On("FindTop", ctx, key, offset, limit)}
+}
+
+func (_c *MockLeaderboardRepository_FindTop_Call) Run(run func(ctx context.Context, key entities.LeaderboardKey, offset int, limit int)) *MockLeaderboardRepository_FindTop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entities.LeaderboardKey
+		if args[1] != nil {
+			arg1 = args[1].(entities.LeaderboardKey)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_FindTop_Call) Return(leaderboardEntrys []*entities.LeaderboardEntry, err error) *MockLeaderboardRepository_FindTop_Call {
+	_c.Call.Return(leaderboardEntrys, err)
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_FindTop_Call) RunAndReturn(run func(ctx context.Context, key entities.LeaderboardKey, offset int, limit int) ([]*entities.LeaderboardEntry, error)) *MockLeaderboardRepository_FindTop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitBest provides a mock function for the type MockLeaderboardRepository
+func (_mock *MockLeaderboardRepository) SubmitBest(ctx context.Context, entries []*entities.LeaderboardEntry) error {
+	ret := _mock.Called(ctx, entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitBest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.LeaderboardEntry) error); ok {
+		r0 = returnFunc(ctx, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLeaderboardRepository_SubmitBest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitBest'
+type MockLeaderboardRepository_SubmitBest_Call struct {
+	*mock.Call
+}
+
+// SubmitBest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entries []*entities.LeaderboardEntry
+func (_e *MockLeaderboardRepository_Expecter) SubmitBest(ctx interface{}, entries interface{}) *MockLeaderboardRepository_SubmitBest_Call {
+	return &MockLeaderboardRepository_SubmitBest_Call{Call: _e.mock.On("SubmitBest", ctx, entries)}
+}
+
+func (_c *MockLeaderboardRepository_SubmitBest_Call) Run(run func(ctx context.Context, entries []*entities.LeaderboardEntry)) *MockLeaderboardRepository_SubmitBest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.LeaderboardEntry
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.LeaderboardEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_SubmitBest_Call) Return(err error) *MockLeaderboardRepository_SubmitBest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLeaderboardRepository_SubmitBest_Call) RunAndReturn(run func(ctx context.Context, entries []*entities.LeaderboardEntry) error) *MockLeaderboardRepository_SubmitBest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLocationRepository creates a new instance of MockLocationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLocationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLocationRepository {
+	mock := &MockLocationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLocationRepository is an autogenerated mock type for the LocationRepository type
+type MockLocationRepository struct {
+	mock.Mock
+}
+
+type MockLocationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLocationRepository) EXPECT() *MockLocationRepository_Expecter {
+	return &MockLocationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CopyBatch provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) CopyBatch(ctx context.Context, sourceMapId string, targetMapId string, afterId string, limit int) (int, string, error) {
+	ret := _mock.Called(ctx, sourceMapId, targetMapId, afterId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyBatch")
+	}
+
+	var r0 int
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, int) (int, string, error)); ok {
+		return returnFunc(ctx, sourceMapId, targetMapId, afterId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, int) int); ok {
+		r0 = returnFunc(ctx, sourceMapId, targetMapId, afterId, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, int) string); ok {
+		r1 = returnFunc(ctx, sourceMapId, targetMapId, afterId, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, string, int) error); ok {
+		r2 = returnFunc(ctx, sourceMapId, targetMapId, afterId, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocationRepository_CopyBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyBatch'
+type MockLocationRepository_CopyBatch_Call struct {
+	*mock.Call
+}
+
+// CopyBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceMapId string
+//   - targetMapId string
+//   - afterId string
+//   - limit int
+func (_e *MockLocationRepository_Expecter) CopyBatch(ctx interface{}, sourceMapId interface{}, targetMapId interface{}, afterId interface{}, limit interface{}) *MockLocationRepository_CopyBatch_Call {
+	return &MockLocationRepository_CopyBatch_Call{Call: _e.mock.On("CopyBatch", ctx, sourceMapId, targetMapId, afterId, limit)}
+}
+
+func (_c *MockLocationRepository_CopyBatch_Call) Run(run func(ctx context.Context, sourceMapId string, targetMapId string, afterId string, limit int)) *MockLocationRepository_CopyBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_CopyBatch_Call) Return(n int, s string, err error) *MockLocationRepository_CopyBatch_Call {
+	_c.Call.Return(n, s, err)
+	return _c
+}
+
+func (_c *MockLocationRepository_CopyBatch_Call) RunAndReturn(run func(ctx context.Context, sourceMapId string, targetMapId string, afterId string, limit int) (int, string, error)) *MockLocationRepository_CopyBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByMapId provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) CountByMapId(ctx context.Context, mapId string) (int64, error) {
+	ret := _mock.Called(ctx, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByMapId")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, mapId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, mapId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, mapId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocationRepository_CountByMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByMapId'
+type MockLocationRepository_CountByMapId_Call struct {
+	*mock.Call
+}
+
+// CountByMapId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+func (_e *MockLocationRepository_Expecter) CountByMapId(ctx interface{}, mapId interface{}) *MockLocationRepository_CountByMapId_Call {
+	return &MockLocationRepository_CountByMapId_Call{Call: _e.mock.On("CountByMapId", ctx, mapId)}
+}
+
+func (_c *MockLocationRepository_CountByMapId_Call) Run(run func(ctx context.Context, mapId string)) *MockLocationRepository_CountByMapId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_CountByMapId_Call) Return(n int64, err error) *MockLocationRepository_CountByMapId_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockLocationRepository_CountByMapId_Call) RunAndReturn(run func(ctx context.Context, mapId string) (int64, error)) *MockLocationRepository_CountByMapId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountDifficulties provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) CountDifficulties(ctx context.Context, mapId string) ([]repositories.LocationDifficultyCount, error) {
+	ret := _mock.Called(ctx, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountDifficulties")
+	}
+
+	var r0 []repositories.LocationDifficultyCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]repositories.LocationDifficultyCount, error)); ok {
+		return returnFunc(ctx, mapId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []repositories.LocationDifficultyCount); ok {
+		r0 = returnFunc(ctx, mapId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repositories.LocationDifficultyCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, mapId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocationRepository_CountDifficulties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountDifficulties'
+type MockLocationRepository_CountDifficulties_Call struct {
+	*mock.Call
+}
+
+// CountDifficulties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+func (_e *MockLocationRepository_Expecter) CountDifficulties(ctx interface{}, mapId interface{}) *MockLocationRepository_CountDifficulties_Call {
+	return &MockLocationRepository_CountDifficulties_Call{Call: _e.mock.On("CountDifficulties", ctx, mapId)}
+}
+
+func (_c *MockLocationRepository_CountDifficulties_Call) Run(run func(ctx context.Context, mapId string)) *MockLocationRepository_CountDifficulties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_CountDifficulties_Call) Return(locationDifficultyCounts []repositories.LocationDifficultyCount, err error) *MockLocationRepository_CountDifficulties_Call {
+	_c.Call.Return(locationDifficultyCounts, err)
+	return _c
+}
+
+func (_c *MockLocationRepository_CountDifficulties_Call) RunAndReturn(run func(ctx context.Context, mapId string) ([]repositories.LocationDifficultyCount, error)) *MockLocationRepository_CountDifficulties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountStrata provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) CountStrata(ctx context.Context, mapId string, filter repositories.LocationFilter) ([]repositories.LocationStratum, error) {
+	ret := _mock.Called(ctx, mapId, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountStrata")
+	}
+
+	var r0 []repositories.LocationStratum
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, repositories.LocationFilter) ([]repositories.LocationStratum, error)); ok {
+		return returnFunc(ctx, mapId, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, repositories.LocationFilter) []repositories.LocationStratum); ok {
+		r0 = returnFunc(ctx, mapId, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repositories.LocationStratum)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, repositories.LocationFilter) error); ok {
+		r1 = returnFunc(ctx, mapId, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocationRepository_CountStrata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStrata'
+type MockLocationRepository_CountStrata_Call struct {
+	*mock.Call
+}
+
+// CountStrata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - filter repositories.LocationFilter
+func (_e *MockLocationRepository_Expecter) CountStrata(ctx interface{}, mapId interface{}, filter interface{}) *MockLocationRepository_CountStrata_Call {
+	return &MockLocationRepository_CountStrata_Call{Call: _e.mock.On("CountStrata", ctx, mapId, filter)}
+}
+
+func (_c *MockLocationRepository_CountStrata_Call) Run(run func(ctx context.Context, mapId string, filter repositories.LocationFilter)) *MockLocationRepository_CountStrata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 repositories.LocationFilter
+		if args[2] != nil {
+			arg2 = args[2].(repositories.LocationFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_CountStrata_Call) Return(locationStratums []repositories.LocationStratum, err error) *MockLocationRepository_CountStrata_Call {
+	_c.Call.Return(locationStratums, err)
+	return _c
+}
+
+func (_c *MockLocationRepository_CountStrata_Call) RunAndReturn(run func(ctx context.Context, mapId string, filter repositories.LocationFilter) ([]repositories.LocationStratum, error)) *MockLocationRepository_CountStrata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) Create(ctx context.Context, l *entities.Location) error {
+	ret := _mock.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Location) error); ok {
+		r0 = returnFunc(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockLocationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l *entities.Location
+func (_e *MockLocationRepository_Expecter) Create(ctx interface{}, l interface{}) *MockLocationRepository_Create_Call {
+	return &MockLocationRepository_Create_Call{Call: _e.mock.On("Create", ctx, l)}
+}
+
+func (_c *MockLocationRepository_Create_Call) Run(run func(ctx context.Context, l *entities.Location)) *MockLocationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Location
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Location)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_Create_Call) Return(err error) *MockLocationRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocationRepository_Create_Call) RunAndReturn(run func(ctx context.Context, l *entities.Location) error) *MockLocationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) Delete(ctx context.Context, l *entities.Location) error {
+	ret := _mock.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Location) error); ok {
+		r0 = returnFunc(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocationRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockLocationRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l *entities.Location
+func (_e *MockLocationRepository_Expecter) Delete(ctx interface{}, l interface{}) *MockLocationRepository_Delete_Call {
+	return &MockLocationRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, l)}
+}
+
+func (_c *MockLocationRepository_Delete_Call) Run(run func(ctx context.Context, l *entities.Location)) *MockLocationRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Location
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Location)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_Delete_Call) Return(err error) *MockLocationRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocationRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, l *entities.Location) error) *MockLocationRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIdAndMapId provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) FindByIdAndMapId(ctx context.Context, id string, mapId string) (*entities.Location, error) {
+	ret := _mock.Called(ctx, id, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIdAndMapId")
+	}
+
+	var r0 *entities.Location
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Location, error)); ok {
+		return returnFunc(ctx, id, mapId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.Location); ok {
+		r0 = returnFunc(ctx, id, mapId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Location)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, mapId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocationRepository_FindByIdAndMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIdAndMapId'
+type MockLocationRepository_FindByIdAndMapId_Call struct {
+	*mock.Call
+}
+
+// FindByIdAndMapId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - mapId string
+func (_e *MockLocationRepository_Expecter) FindByIdAndMapId(ctx interface{}, id interface{}, mapId interface{}) *MockLocationRepository_FindByIdAndMapId_Call {
+	return &MockLocationRepository_FindByIdAndMapId_Call{Call: _e.mock.On("FindByIdAndMapId", ctx, id, mapId)}
+}
+
+func (_c *MockLocationRepository_FindByIdAndMapId_Call) Run(run func(ctx context.Context, id string, mapId string)) *MockLocationRepository_FindByIdAndMapId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_FindByIdAndMapId_Call) Return(location *entities.Location, err error) *MockLocationRepository_FindByIdAndMapId_Call {
+	_c.Call.Return(location, err)
+	return _c
+}
+
+func (_c *MockLocationRepository_FindByIdAndMapId_Call) RunAndReturn(run func(ctx context.Context, id string, mapId string) (*entities.Location, error)) *MockLocationRepository_FindByIdAndMapId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByMapId provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) FindByMapId(ctx context.Context, mapId string) ([]*entities.Location, error) {
+	ret := _mock.Called(ctx, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMapId")
+	}
+
+	var r0 []*entities.Location
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.Location, error)); ok {
+		return returnFunc(ctx, mapId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.Location); ok {
+		r0 = returnFunc(ctx, mapId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Location)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, mapId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocationRepository_FindByMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByMapId'
+type MockLocationRepository_FindByMapId_Call struct {
+	*mock.Call
+}
+
+// FindByMapId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+func (_e *MockLocationRepository_Expecter) FindByMapId(ctx interface{}, mapId interface{}) *MockLocationRepository_FindByMapId_Call {
+	return &MockLocationRepository_FindByMapId_Call{Call: _e.mock.On("FindByMapId", ctx, mapId)}
+}
+
+func (_c *MockLocationRepository_FindByMapId_Call) Run(run func(ctx context.Context, mapId string)) *MockLocationRepository_FindByMapId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_FindByMapId_Call) Return(locations []*entities.Location, err error) *MockLocationRepository_FindByMapId_Call {
+	_c.Call.Return(locations, err)
+	return _c
+}
+
+func (_c *MockLocationRepository_FindByMapId_Call) RunAndReturn(run func(ctx context.Context, mapId string) ([]*entities.Location, error)) *MockLocationRepository_FindByMapId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPlayStats provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) FindPlayStats(ctx context.Context, since time.Time, afterId string, limit int) ([]entities.LocationPlayStats, string, error) {
+	ret := _mock.Called(ctx, since, afterId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPlayStats")
+	}
+
+	var r0 []entities.LocationPlayStats
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, string, int) ([]entities.LocationPlayStats, string, error)); ok {
+		return returnFunc(ctx, since, afterId, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, string, int) []entities.LocationPlayStats); ok {
+		r0 = returnFunc(ctx, since, afterId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.LocationPlayStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, string, int) string); ok {
+		r1 = returnFunc(ctx, since, afterId, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, time.Time, string, int) error); ok {
+		r2 = returnFunc(ctx, since, afterId, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocationRepository_FindPlayStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPlayStats'
+type MockLocationRepository_FindPlayStats_Call struct {
+	*mock.Call
+}
+
+// FindPlayStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - afterId string
+//   - limit int
+func (_e *MockLocationRepository_Expecter) FindPlayStats(ctx interface{}, since interface{}, afterId interface{}, limit interface{}) *MockLocationRepository_FindPlayStats_Call {
+	return &MockLocationRepository_FindPlayStats_Call{Call: _e.mock.On("FindPlayStats", ctx, since, afterId, limit)}
+}
+
+func (_c *MockLocationRepository_FindPlayStats_Call) Run(run func(ctx context.Context, since time.Time, afterId string, limit int)) *MockLocationRepository_FindPlayStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_FindPlayStats_Call) Return(stats []entities.LocationPlayStats, next string, err error) *MockLocationRepository_FindPlayStats_Call {
+	_c.Call.Return(stats, next, err)
+	return _c
+}
+
+func (_c *MockLocationRepository_FindPlayStats_Call) RunAndReturn(run func(ctx context.Context, since time.Time, afterId string, limit int) ([]entities.LocationPlayStats, string, error)) *MockLocationRepository_FindPlayStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSample provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) FindSample(ctx context.Context, mapId string, stratum repositories.LocationStratum, filter repositories.LocationFilter, from float64, limit int) ([]*entities.Location, error) {
+	ret := _mock.Called(ctx, mapId, stratum, filter, from, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSample")
+	}
+
+	var r0 []*entities.Location
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, repositories.LocationStratum, repositories.LocationFilter, float64, int) ([]*entities.Location, error)); ok {
+		return returnFunc(ctx, mapId, stratum, filter, from, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, repositories.LocationStratum, repositories.LocationFilter, float64, int) []*entities.Location); ok {
+		r0 = returnFunc(ctx, mapId, stratum, filter, from, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Location)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, repositories.LocationStratum, repositories.LocationFilter, float64, int) error); ok {
+		r1 = returnFunc(ctx, mapId, stratum, filter, from, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocationRepository_FindSample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSample'
+type MockLocationRepository_FindSample_Call struct {
+	*mock.Call
+}
+
+// FindSample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - stratum repositories.LocationStratum
+//   - filter repositories.LocationFilter
+//   - from float64
+//   - limit int
+func (_e *MockLocationRepository_Expecter) FindSample(ctx interface{}, mapId interface{}, stratum interface{}, filter interface{}, from interface{}, limit interface{}) *MockLocationRepository_FindSample_Call {
+	return &MockLocationRepository_FindSample_Call{Call: _e.mock.On("FindSample", ctx, mapId, stratum, filter, from, limit)}
+}
+
+func (_c *MockLocationRepository_FindSample_Call) Run(run func(ctx context.Context, mapId string, stratum repositories.LocationStratum, filter repositories.LocationFilter, from float64, limit int)) *MockLocationRepository_FindSample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 repositories.LocationStratum
+		if args[2] != nil {
+			arg2 = args[2].(repositories.LocationStratum)
+		}
+		var arg3 repositories.LocationFilter
+		if args[3] != nil {
+			arg3 = args[3].(repositories.LocationFilter)
+		}
+		var arg4 float64
+		if args[4] != nil {
+			arg4 = args[4].(float64)
+		}
+		var arg5 int
+		if args[5] != nil {
+			arg5 = args[5].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_FindSample_Call) Return(locations []*entities.Location, err error) *MockLocationRepository_FindSample_Call {
+	_c.Call.Return(locations, err)
+	return _c
+}
+
+func (_c *MockLocationRepository_FindSample_Call) RunAndReturn(run func(ctx context.Context, mapId string, stratum repositories.LocationStratum, filter repositories.LocationFilter, from float64, limit int) ([]*entities.Location, error)) *MockLocationRepository_FindSample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) Restore(ctx context.Context, l *entities.Location) error {
+	ret := _mock.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Location) error); ok {
+		r0 = returnFunc(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocationRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockLocationRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l *entities.Location
+func (_e *MockLocationRepository_Expecter) Restore(ctx interface{}, l interface{}) *MockLocationRepository_Restore_Call {
+	return &MockLocationRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, l)}
+}
+
+func (_c *MockLocationRepository_Restore_Call) Run(run func(ctx context.Context, l *entities.Location)) *MockLocationRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Location
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Location)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_Restore_Call) Return(err error) *MockLocationRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocationRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, l *entities.Location) error) *MockLocationRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) Update(ctx context.Context, l *entities.Location) error {
+	ret := _mock.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Location) error); ok {
+		r0 = returnFunc(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocationRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockLocationRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l *entities.Location
+func (_e *MockLocationRepository_Expecter) Update(ctx interface{}, l interface{}) *MockLocationRepository_Update_Call {
+	return &MockLocationRepository_Update_Call{Call: _e.mock.On("Update", ctx, l)}
+}
+
+func (_c *MockLocationRepository_Update_Call) Run(run func(ctx context.Context, l *entities.Location)) *MockLocationRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Location
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Location)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_Update_Call) Return(err error) *MockLocationRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocationRepository_Update_Call) RunAndReturn(run func(ctx context.Context, l *entities.Location) error) *MockLocationRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDifficulties provides a mock function for the type MockLocationRepository
+func (_mock *MockLocationRepository) UpdateDifficulties(ctx context.Context, stats []entities.LocationPlayStats) error {
+	ret := _mock.Called(ctx, stats)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDifficulties")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []entities.LocationPlayStats) error); ok {
+		r0 = returnFunc(ctx, stats)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocationRepository_UpdateDifficulties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDifficulties'
+type MockLocationRepository_UpdateDifficulties_Call struct {
+	*mock.Call
+}
+
+// UpdateDifficulties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stats []entities.LocationPlayStats
+func (_e *MockLocationRepository_Expecter) UpdateDifficulties(ctx interface{}, stats interface{}) *MockLocationRepository_UpdateDifficulties_Call {
+	return &MockLocationRepository_UpdateDifficulties_Call{Call: _e.mock.On("UpdateDifficulties", ctx, stats)}
+}
+
+func (_c *MockLocationRepository_UpdateDifficulties_Call) Run(run func(ctx context.Context, stats []entities.LocationPlayStats)) *MockLocationRepository_UpdateDifficulties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []entities.LocationPlayStats
+		if args[1] != nil {
+			arg1 = args[1].([]entities.LocationPlayStats)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_UpdateDifficulties_Call) Return(err error) *MockLocationRepository_UpdateDifficulties_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocationRepository_UpdateDifficulties_Call) RunAndReturn(run func(ctx context.Context, stats []entities.LocationPlayStats) error) *MockLocationRepository_UpdateDifficulties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLocationStrataCache creates a new instance of MockLocationStrataCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLocationStrataCache(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLocationStrataCache {
+	mock := &MockLocationStrataCache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLocationStrataCache is an autogenerated mock type for the LocationStrataCache type
+type MockLocationStrataCache struct {
+	mock.Mock
+}
+
+type MockLocationStrataCache_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLocationStrataCache) EXPECT() *MockLocationStrataCache_Expecter {
+	return &MockLocationStrataCache_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockLocationStrataCache
+func (_mock *MockLocationStrataCache) Get(ctx context.Context, key repositories.LocationStrataKey) ([]repositories.LocationStratum, bool, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []repositories.LocationStratum
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.LocationStrataKey) ([]repositories.LocationStratum, bool, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.LocationStrataKey) []repositories.LocationStratum); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repositories.LocationStratum)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repositories.LocationStrataKey) bool); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, repositories.LocationStrataKey) error); ok {
+		r2 = returnFunc(ctx, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockLocationStrataCache_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockLocationStrataCache_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key repositories.LocationStrataKey
+func (_e *MockLocationStrataCache_Expecter) Get(ctx interface{}, key interface{}) *MockLocationStrataCache_Get_Call {
+	return &MockLocationStrataCache_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockLocationStrataCache_Get_Call) Run(run func(ctx context.Context, key repositories.LocationStrataKey)) *MockLocationStrataCache_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repositories.LocationStrataKey
+		if args[1] != nil {
+			arg1 = args[1].(repositories.LocationStrataKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationStrataCache_Get_Call) Return(locationStratums []repositories.LocationStratum, b bool, err error) *MockLocationStrataCache_Get_Call {
+	_c.Call.Return(locationStratums, b, err)
+	return _c
+}
+
+func (_c *MockLocationStrataCache_Get_Call) RunAndReturn(run func(ctx context.Context, key repositories.LocationStrataKey) ([]repositories.LocationStratum, bool, error)) *MockLocationStrataCache_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function for the type MockLocationStrataCache
+func (_mock *MockLocationStrataCache) Set(ctx context.Context, key repositories.LocationStrataKey, strata []repositories.LocationStratum) error {
+	ret := _mock.Called(ctx, key, strata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.LocationStrataKey, []repositories.LocationStratum) error); ok {
+		r0 = returnFunc(ctx, key, strata)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLocationStrataCache_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockLocationStrataCache_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key repositories.LocationStrataKey
+//   - strata []repositories.LocationStratum
+func (_e *MockLocationStrataCache_Expecter) Set(ctx interface{}, key interface{}, strata interface{}) *MockLocationStrataCache_Set_Call {
+	return &MockLocationStrataCache_Set_Call{Call: _e.mock.On("Set", ctx, key, strata)}
+}
+
+func (_c *MockLocationStrataCache_Set_Call) Run(run func(ctx context.Context, key repositories.LocationStrataKey, strata []repositories.LocationStratum)) *MockLocationStrataCache_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repositories.LocationStrataKey
+		if args[1] != nil {
+			arg1 = args[1].(repositories.LocationStrataKey)
+		}
+		var arg2 []repositories.LocationStratum
+		if args[2] != nil {
+			arg2 = args[2].([]repositories.LocationStratum)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocationStrataCache_Set_Call) Return(err error) *MockLocationStrataCache_Set_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLocationStrataCache_Set_Call) RunAndReturn(run func(ctx context.Context, key repositories.LocationStrataKey, strata []repositories.LocationStratum) error) *MockLocationStrataCache_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMapCollaboratorRepository creates a new instance of MockMapCollaboratorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMapCollaboratorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMapCollaboratorRepository {
+	mock := &MockMapCollaboratorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMapCollaboratorRepository is an autogenerated mock type for the MapCollaboratorRepository type
+type MockMapCollaboratorRepository struct {
+	mock.Mock
+}
+
+type MockMapCollaboratorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMapCollaboratorRepository) EXPECT() *MockMapCollaboratorRepository_Expecter {
+	return &MockMapCollaboratorRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockMapCollaboratorRepository
+func (_mock *MockMapCollaboratorRepository) Delete(ctx context.Context, mapId string, userId string) error {
+	ret := _mock.Called(ctx, mapId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, mapId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapCollaboratorRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMapCollaboratorRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - userId string
+func (_e *MockMapCollaboratorRepository_Expecter) Delete(ctx interface{}, mapId interface{}, userId interface{}) *MockMapCollaboratorRepository_Delete_Call {
+	return &MockMapCollaboratorRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, mapId, userId)}
+}
+
+func (_c *MockMapCollaboratorRepository_Delete_Call) Run(run func(ctx context.Context, mapId string, userId string)) *MockMapCollaboratorRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapCollaboratorRepository_Delete_Call) Return(err error) *MockMapCollaboratorRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapCollaboratorRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, mapId string, userId string) error) *MockMapCollaboratorRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByMapId provides a mock function for the type MockMapCollaboratorRepository
+func (_mock *MockMapCollaboratorRepository) FindByMapId(ctx context.Context, mapId string) ([]*entities.MapCollaborator, error) {
+	ret := _mock.Called(ctx, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMapId")
+	}
+
+	var r0 []*entities.MapCollaborator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.MapCollaborator, error)); ok {
+		return returnFunc(ctx, mapId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.MapCollaborator); ok {
+		r0 = returnFunc(ctx, mapId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MapCollaborator)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, mapId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapCollaboratorRepository_FindByMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByMapId'
+type MockMapCollaboratorRepository_FindByMapId_Call struct {
+	*mock.Call
+}
+
+// FindByMapId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+func (_e *MockMapCollaboratorRepository_Expecter) FindByMapId(ctx interface{}, mapId interface{}) *MockMapCollaboratorRepository_FindByMapId_Call {
+	return &MockMapCollaboratorRepository_FindByMapId_Call{Call: _e.mock.On("FindByMapId", ctx, mapId)}
+}
+
+func (_c *MockMapCollaboratorRepository_FindByMapId_Call) Run(run func(ctx context.Context, mapId string)) *MockMapCollaboratorRepository_FindByMapId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapCollaboratorRepository_FindByMapId_Call) Return(mapCollaborators []*entities.MapCollaborator, err error) *MockMapCollaboratorRepository_FindByMapId_Call {
+	_c.Call.Return(mapCollaborators, err)
+	return _c
+}
+
+func (_c *MockMapCollaboratorRepository_FindByMapId_Call) RunAndReturn(run func(ctx context.Context, mapId string) ([]*entities.MapCollaborator, error)) *MockMapCollaboratorRepository_FindByMapId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByMapIdAndUserId provides a mock function for the type MockMapCollaboratorRepository
+func (_mock *MockMapCollaboratorRepository) FindByMapIdAndUserId(ctx context.Context, mapId string, userId string) (*entities.MapCollaborator, error) {
+	ret := _mock.Called(ctx, mapId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMapIdAndUserId")
+	}
+
+	var r0 *entities.MapCollaborator
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.MapCollaborator, error)); ok {
+		return returnFunc(ctx, mapId, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.MapCollaborator); ok {
+		r0 = returnFunc(ctx, mapId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.MapCollaborator)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, mapId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapCollaboratorRepository_FindByMapIdAndUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByMapIdAndUserId'
+type MockMapCollaboratorRepository_FindByMapIdAndUserId_Call struct {
+	*mock.Call
+}
+
+// FindByMapIdAndUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - userId string
+func (_e *MockMapCollaboratorRepository_Expecter) FindByMapIdAndUserId(ctx interface{}, mapId interface{}, userId interface{}) *MockMapCollaboratorRepository_FindByMapIdAndUserId_Call {
+	return &MockMapCollaboratorRepository_FindByMapIdAndUserId_Call{Call: _e.mock.On("FindByMapIdAndUserId", ctx, mapId, userId)}
+}
+
+func (_c *MockMapCollaboratorRepository_FindByMapIdAndUserId_Call) Run(run func(ctx context.Context, mapId string, userId string)) *MockMapCollaboratorRepository_FindByMapIdAndUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapCollaboratorRepository_FindByMapIdAndUserId_Call) Return(mapCollaborator *entities.MapCollaborator, err error) *MockMapCollaboratorRepository_FindByMapIdAndUserId_Call {
+	_c.Call.Return(mapCollaborator, err)
+	return _c
+}
+
+func (_c *MockMapCollaboratorRepository_FindByMapIdAndUserId_Call) RunAndReturn(run func(ctx context.Context, mapId string, userId string) (*entities.MapCollaborator, error)) *MockMapCollaboratorRepository_FindByMapIdAndUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockMapCollaboratorRepository
+func (_mock *MockMapCollaboratorRepository) Save(ctx context.Context, collaborator *entities.MapCollaborator) error {
+	ret := _mock.Called(ctx, collaborator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.MapCollaborator) error); ok {
+		r0 = returnFunc(ctx, collaborator)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapCollaboratorRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockMapCollaboratorRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collaborator *entities.MapCollaborator
+func (_e *MockMapCollaboratorRepository_Expecter) Save(ctx interface{}, collaborator interface{}) *MockMapCollaboratorRepository_Save_Call {
+	return &MockMapCollaboratorRepository_Save_Call{Call: _e.mock.On("Save", ctx, collaborator)}
+}
+
+func (_c *MockMapCollaboratorRepository_Save_Call) Run(run func(ctx context.Context, collaborator *entities.MapCollaborator)) *MockMapCollaboratorRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.MapCollaborator
+		if args[1] != nil {
+			arg1 = args[1].(*entities.MapCollaborator)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapCollaboratorRepository_Save_Call) Return(err error) *MockMapCollaboratorRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapCollaboratorRepository_Save_Call) RunAndReturn(run func(ctx context.Context, collaborator *entities.MapCollaborator) error) *MockMapCollaboratorRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMapInvitationRepository creates a new instance of MockMapInvitationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMapInvitationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMapInvitationRepository {
+	mock := &MockMapInvitationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMapInvitationRepository is an autogenerated mock type for the MapInvitationRepository type
+type MockMapInvitationRepository struct {
+	mock.Mock
+}
+
+type MockMapInvitationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMapInvitationRepository) EXPECT() *MockMapInvitationRepository_Expecter {
+	return &MockMapInvitationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockMapInvitationRepository
+func (_mock *MockMapInvitationRepository) Delete(ctx context.Context, mapId string, userId string) error {
+	ret := _mock.Called(ctx, mapId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, mapId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapInvitationRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMapInvitationRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - userId string
+func (_e *MockMapInvitationRepository_Expecter) Delete(ctx interface{}, mapId interface{}, userId interface{}) *MockMapInvitationRepository_Delete_Call {
+	return &MockMapInvitationRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, mapId, userId)}
+}
+
+func (_c *MockMapInvitationRepository_Delete_Call) Run(run func(ctx context.Context, mapId string, userId string)) *MockMapInvitationRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_Delete_Call) Return(err error) *MockMapInvitationRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, mapId string, userId string) error) *MockMapInvitationRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByMapId provides a mock function for the type MockMapInvitationRepository
+func (_mock *MockMapInvitationRepository) DeleteByMapId(ctx context.Context, mapId string) error {
+	ret := _mock.Called(ctx, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByMapId")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, mapId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapInvitationRepository_DeleteByMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByMapId'
+type MockMapInvitationRepository_DeleteByMapId_Call struct {
+	*mock.Call
+}
+
+// DeleteByMapId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+func (_e *MockMapInvitationRepository_Expecter) DeleteByMapId(ctx interface{}, mapId interface{}) *MockMapInvitationRepository_DeleteByMapId_Call {
+	return &MockMapInvitationRepository_DeleteByMapId_Call{Call: _e.mock.On("DeleteByMapId", ctx, mapId)}
+}
+
+func (_c *MockMapInvitationRepository_DeleteByMapId_Call) Run(run func(ctx context.Context, mapId string)) *MockMapInvitationRepository_DeleteByMapId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_DeleteByMapId_Call) Return(err error) *MockMapInvitationRepository_DeleteByMapId_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_DeleteByMapId_Call) RunAndReturn(run func(ctx context.Context, mapId string) error) *MockMapInvitationRepository_DeleteByMapId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByMapIdAndUserId provides a mock function for the type MockMapInvitationRepository
+func (_mock *MockMapInvitationRepository) FindByMapIdAndUserId(ctx context.Context, mapId string, userId string) (*entities.MapInvitation, error) {
+	ret := _mock.Called(ctx, mapId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMapIdAndUserId")
+	}
+
+	var r0 *entities.MapInvitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.MapInvitation, error)); ok {
+		return returnFunc(ctx, mapId, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.MapInvitation); ok {
+		r0 = returnFunc(ctx, mapId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.MapInvitation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, mapId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapInvitationRepository_FindByMapIdAndUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByMapIdAndUserId'
+type MockMapInvitationRepository_FindByMapIdAndUserId_Call struct {
+	*mock.Call
+}
+
+// FindByMapIdAndUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - userId string
+func (_e *MockMapInvitationRepository_Expecter) FindByMapIdAndUserId(ctx interface{}, mapId interface{}, userId interface{}) *MockMapInvitationRepository_FindByMapIdAndUserId_Call {
+	return &MockMapInvitationRepository_FindByMapIdAndUserId_Call{Call: _e.mock.On("FindByMapIdAndUserId", ctx, mapId, userId)}
+}
+
+func (_c *MockMapInvitationRepository_FindByMapIdAndUserId_Call) Run(run func(ctx context.Context, mapId string, userId string)) *MockMapInvitationRepository_FindByMapIdAndUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_FindByMapIdAndUserId_Call) Return(mapInvitation *entities.MapInvitation, err error) *MockMapInvitationRepository_FindByMapIdAndUserId_Call {
+	_c.Call.Return(mapInvitation, err)
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_FindByMapIdAndUserId_Call) RunAndReturn(run func(ctx context.Context, mapId string, userId string) (*entities.MapInvitation, error)) *MockMapInvitationRepository_FindByMapIdAndUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserId provides a mock function for the type MockMapInvitationRepository
+func (_mock *MockMapInvitationRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.MapInvitation, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 []*entities.MapInvitation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.MapInvitation, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.MapInvitation); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MapInvitation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapInvitationRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockMapInvitationRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockMapInvitationRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockMapInvitationRepository_FindByUserId_Call {
+	return &MockMapInvitationRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
+}
+
+func (_c *MockMapInvitationRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockMapInvitationRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_FindByUserId_Call) Return(mapInvitations []*entities.MapInvitation, err error) *MockMapInvitationRepository_FindByUserId_Call {
+	_c.Call.Return(mapInvitations, err)
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) ([]*entities.MapInvitation, error)) *MockMapInvitationRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockMapInvitationRepository
+func (_mock *MockMapInvitationRepository) Save(ctx context.Context, invitation *entities.MapInvitation) error {
+	ret := _mock.Called(ctx, invitation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.MapInvitation) error); ok {
+		r0 = returnFunc(ctx, invitation)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapInvitationRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockMapInvitationRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitation *entities.MapInvitation
+func (_e *MockMapInvitationRepository_Expecter) Save(ctx interface{}, invitation interface{}) *MockMapInvitationRepository_Save_Call {
+	return &MockMapInvitationRepository_Save_Call{Call: _e.mock.On("Save", ctx, invitation)}
+}
+
+func (_c *MockMapInvitationRepository_Save_Call) Run(run func(ctx context.Context, invitation *entities.MapInvitation)) *MockMapInvitationRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.MapInvitation
+		if args[1] != nil {
+			arg1 = args[1].(*entities.MapInvitation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_Save_Call) Return(err error) *MockMapInvitationRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapInvitationRepository_Save_Call) RunAndReturn(run func(ctx context.Context, invitation *entities.MapInvitation) error) *MockMapInvitationRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMapLikeRepository creates a new instance of MockMapLikeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMapLikeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMapLikeRepository {
+	mock := &MockMapLikeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMapLikeRepository is an autogenerated mock type for the MapLikeRepository type
+type MockMapLikeRepository struct {
+	mock.Mock
+}
+
+type MockMapLikeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMapLikeRepository) EXPECT() *MockMapLikeRepository_Expecter {
+	return &MockMapLikeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockMapLikeRepository
+func (_mock *MockMapLikeRepository) Create(ctx context.Context, like *entities.MapLike) (bool, error) {
+	ret := _mock.Called(ctx, like)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.MapLike) (bool, error)); ok {
+		return returnFunc(ctx, like)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.MapLike) bool); ok {
+		r0 = returnFunc(ctx, like)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entities.MapLike) error); ok {
+		r1 = returnFunc(ctx, like)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapLikeRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockMapLikeRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - like *entities.MapLike
+func (_e *MockMapLikeRepository_Expecter) Create(ctx interface{}, like interface{}) *MockMapLikeRepository_Create_Call {
+	return &MockMapLikeRepository_Create_Call{Call: _e.mock.On("Create", ctx, like)}
+}
+
+func (_c *MockMapLikeRepository_Create_Call) Run(run func(ctx context.Context, like *entities.MapLike)) *MockMapLikeRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.MapLike
+		if args[1] != nil {
+			arg1 = args[1].(*entities.MapLike)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapLikeRepository_Create_Call) Return(b bool, err error) *MockMapLikeRepository_Create_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockMapLikeRepository_Create_Call) RunAndReturn(run func(ctx context.Context, like *entities.MapLike) (bool, error)) *MockMapLikeRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockMapLikeRepository
+func (_mock *MockMapLikeRepository) Delete(ctx context.Context, mapId string, userId string) (bool, error) {
+	ret := _mock.Called(ctx, mapId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, mapId, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, mapId, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, mapId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapLikeRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMapLikeRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - userId string
+func (_e *MockMapLikeRepository_Expecter) Delete(ctx interface{}, mapId interface{}, userId interface{}) *MockMapLikeRepository_Delete_Call {
+	return &MockMapLikeRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, mapId, userId)}
+}
+
+func (_c *MockMapLikeRepository_Delete_Call) Run(run func(ctx context.Context, mapId string, userId string)) *MockMapLikeRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapLikeRepository_Delete_Call) Return(b bool, err error) *MockMapLikeRepository_Delete_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockMapLikeRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, mapId string, userId string) (bool, error)) *MockMapLikeRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockMapLikeRepository
+func (_mock *MockMapLikeRepository) Exists(ctx context.Context, mapId string, userId string) (bool, error) {
+	ret := _mock.Called(ctx, mapId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, mapId, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, mapId, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, mapId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapLikeRepository_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockMapLikeRepository_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - userId string
+func (_e *MockMapLikeRepository_Expecter) Exists(ctx interface{}, mapId interface{}, userId interface{}) *MockMapLikeRepository_Exists_Call {
+	return &MockMapLikeRepository_Exists_Call{Call: _e.mock.On("Exists", ctx, mapId, userId)}
+}
+
+func (_c *MockMapLikeRepository_Exists_Call) Run(run func(ctx context.Context, mapId string, userId string)) *MockMapLikeRepository_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapLikeRepository_Exists_Call) Return(b bool, err error) *MockMapLikeRepository_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockMapLikeRepository_Exists_Call) RunAndReturn(run func(ctx context.Context, mapId string, userId string) (bool, error)) *MockMapLikeRepository_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMapRatingRepository creates a new instance of MockMapRatingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMapRatingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMapRatingRepository {
+	mock := &MockMapRatingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMapRatingRepository is an autogenerated mock type for the MapRatingRepository type
+type MockMapRatingRepository struct {
+	mock.Mock
+}
+
+type MockMapRatingRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMapRatingRepository) EXPECT() *MockMapRatingRepository_Expecter {
+	return &MockMapRatingRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockMapRatingRepository
+func (_mock *MockMapRatingRepository) Delete(ctx context.Context, rating *entities.MapRating) error {
+	ret := _mock.Called(ctx, rating)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.MapRating) error); ok {
+		r0 = returnFunc(ctx, rating)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapRatingRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMapRatingRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rating *entities.MapRating
+func (_e *MockMapRatingRepository_Expecter) Delete(ctx interface{}, rating interface{}) *MockMapRatingRepository_Delete_Call {
+	return &MockMapRatingRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, rating)}
+}
+
+func (_c *MockMapRatingRepository_Delete_Call) Run(run func(ctx context.Context, rating *entities.MapRating)) *MockMapRatingRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.MapRating
+		if args[1] != nil {
+			arg1 = args[1].(*entities.MapRating)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRatingRepository_Delete_Call) Return(err error) *MockMapRatingRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapRatingRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, rating *entities.MapRating) error) *MockMapRatingRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByMapIdAndUserIdForUpdate provides a mock function for the type MockMapRatingRepository
+func (_mock *MockMapRatingRepository) FindByMapIdAndUserIdForUpdate(ctx context.Context, mapId string, userId string) (*entities.MapRating, error) {
+	ret := _mock.Called(ctx, mapId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMapIdAndUserIdForUpdate")
+	}
+
+	var r0 *entities.MapRating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.MapRating, error)); ok {
+		return returnFunc(ctx, mapId, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.MapRating); ok {
+		r0 = returnFunc(ctx, mapId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.MapRating)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, mapId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByMapIdAndUserIdForUpdate'
+type MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call struct {
+	*mock.Call
+}
+
+// FindByMapIdAndUserIdForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - userId string
+func (_e *MockMapRatingRepository_Expecter) FindByMapIdAndUserIdForUpdate(ctx interface{}, mapId interface{}, userId interface{}) *MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call {
+	return &MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call{Call: _e.mock.On("FindByMapIdAndUserIdForUpdate", ctx, mapId, userId)}
+}
+
+func (_c *MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call) Run(run func(ctx context.Context, mapId string, userId string)) *MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call) Return(mapRating *entities.MapRating, err error) *MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call {
+	_c.Call.Return(mapRating, err)
+	return _c
+}
+
+func (_c *MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call) RunAndReturn(run func(ctx context.Context, mapId string, userId string) (*entities.MapRating, error)) *MockMapRatingRepository_FindByMapIdAndUserIdForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockMapRatingRepository
+func (_mock *MockMapRatingRepository) Save(ctx context.Context, rating *entities.MapRating) error {
+	ret := _mock.Called(ctx, rating)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.MapRating) error); ok {
+		r0 = returnFunc(ctx, rating)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapRatingRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockMapRatingRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rating *entities.MapRating
+func (_e *MockMapRatingRepository_Expecter) Save(ctx interface{}, rating interface{}) *MockMapRatingRepository_Save_Call {
+	return &MockMapRatingRepository_Save_Call{Call: _e.mock.On("Save", ctx, rating)}
+}
+
+func (_c *MockMapRatingRepository_Save_Call) Run(run func(ctx context.Context, rating *entities.MapRating)) *MockMapRatingRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.MapRating
+		if args[1] != nil {
+			arg1 = args[1].(*entities.MapRating)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRatingRepository_Save_Call) Return(err error) *MockMapRatingRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapRatingRepository_Save_Call) RunAndReturn(run func(ctx context.Context, rating *entities.MapRating) error) *MockMapRatingRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMapRepository creates a new instance of MockMapRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMapRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMapRepository {
+	mock := &MockMapRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMapRepository is an autogenerated mock type for the MapRepository type
+type MockMapRepository struct {
+	mock.Mock
+}
+
+type MockMapRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMapRepository) EXPECT() *MockMapRepository_Expecter {
+	return &MockMapRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountForks provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) CountForks(ctx context.Context, mapId string) (int64, error) {
+	ret := _mock.Called(ctx, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountForks")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, mapId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, mapId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, mapId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRepository_CountForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountForks'
+type MockMapRepository_CountForks_Call struct {
+	*mock.Call
+}
+
+// CountForks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+func (_e *MockMapRepository_Expecter) CountForks(ctx interface{}, mapId interface{}) *MockMapRepository_CountForks_Call {
+	return &MockMapRepository_CountForks_Call{Call: _e.mock.On("CountForks", ctx, mapId)}
+}
+
+func (_c *MockMapRepository_CountForks_Call) Run(run func(ctx context.Context, mapId string)) *MockMapRepository_CountForks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_CountForks_Call) Return(n int64, err error) *MockMapRepository_CountForks_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockMapRepository_CountForks_Call) RunAndReturn(run func(ctx context.Context, mapId string) (int64, error)) *MockMapRepository_CountForks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountPublic provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) CountPublic(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPublic")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRepository_CountPublic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPublic'
+type MockMapRepository_CountPublic_Call struct {
+	*mock.Call
+}
+
+// CountPublic is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockMapRepository_Expecter) CountPublic(ctx interface{}) *MockMapRepository_CountPublic_Call {
+	return &MockMapRepository_CountPublic_Call{Call: _e.mock.On("CountPublic", ctx)}
+}
+
+func (_c *MockMapRepository_CountPublic_Call) Run(run func(ctx context.Context)) *MockMapRepository_CountPublic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_CountPublic_Call) Return(n int64, err error) *MockMapRepository_CountPublic_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockMapRepository_CountPublic_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockMapRepository_CountPublic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) Create(ctx context.Context, m *entities.Map) error {
+	ret := _mock.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Map) error); ok {
+		r0 = returnFunc(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockMapRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - m *entities.Map
+func (_e *MockMapRepository_Expecter) Create(ctx interface{}, m interface{}) *MockMapRepository_Create_Call {
+	return &MockMapRepository_Create_Call{Call: _e.mock.On("Create", ctx, m)}
+}
+
+func (_c *MockMapRepository_Create_Call) Run(run func(ctx context.Context, m *entities.Map)) *MockMapRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Map
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Map)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_Create_Call) Return(err error) *MockMapRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapRepository_Create_Call) RunAndReturn(run func(ctx context.Context, m *entities.Map) error) *MockMapRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) Delete(ctx context.Context, m *entities.Map) error {
+	ret := _mock.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Map) error); ok {
+		r0 = returnFunc(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockMapRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - m *entities.Map
+func (_e *MockMapRepository_Expecter) Delete(ctx interface{}, m interface{}) *MockMapRepository_Delete_Call {
+	return &MockMapRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, m)}
+}
+
+func (_c *MockMapRepository_Delete_Call) Run(run func(ctx context.Context, m *entities.Map)) *MockMapRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Map
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Map)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_Delete_Call) Return(err error) *MockMapRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, m *entities.Map) error) *MockMapRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByOwnerId provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) DeleteByOwnerId(ctx context.Context, ownerId string) error {
+	ret := _mock.Called(ctx, ownerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByOwnerId")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, ownerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapRepository_DeleteByOwnerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByOwnerId'
+type MockMapRepository_DeleteByOwnerId_Call struct {
+	*mock.Call
+}
+
+// DeleteByOwnerId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerId string
+func (_e *MockMapRepository_Expecter) DeleteByOwnerId(ctx interface{}, ownerId interface{}) *MockMapRepository_DeleteByOwnerId_Call {
+	return &MockMapRepository_DeleteByOwnerId_Call{Call: _e.mock.On("DeleteByOwnerId", ctx, ownerId)}
+}
+
+func (_c *MockMapRepository_DeleteByOwnerId_Call) Run(run func(ctx context.Context, ownerId string)) *MockMapRepository_DeleteByOwnerId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_DeleteByOwnerId_Call) Return(err error) *MockMapRepository_DeleteByOwnerId_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapRepository_DeleteByOwnerId_Call) RunAndReturn(run func(ctx context.Context, ownerId string) error) *MockMapRepository_DeleteByOwnerId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) FindById(ctx context.Context, id string) (*entities.Map, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *entities.Map
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.Map, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.Map); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Map)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockMapRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockMapRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockMapRepository_FindById_Call {
+	return &MockMapRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockMapRepository_FindById_Call) Run(run func(ctx context.Context, id string)) *MockMapRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_FindById_Call) Return(mapParam *entities.Map, err error) *MockMapRepository_FindById_Call {
+	_c.Call.Return(mapParam, err)
+	return _c
+}
+
+func (_c *MockMapRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id string) (*entities.Map, error)) *MockMapRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByOwnerId provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) FindByOwnerId(ctx context.Context, ownerId string) ([]*entities.Map, error) {
+	ret := _mock.Called(ctx, ownerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByOwnerId")
+	}
+
+	var r0 []*entities.Map
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.Map, error)); ok {
+		return returnFunc(ctx, ownerId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.Map); ok {
+		r0 = returnFunc(ctx, ownerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Map)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, ownerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRepository_FindByOwnerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByOwnerId'
+type MockMapRepository_FindByOwnerId_Call struct {
+	*mock.Call
+}
+
+// FindByOwnerId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerId string
+func (_e *MockMapRepository_Expecter) FindByOwnerId(ctx interface{}, ownerId interface{}) *MockMapRepository_FindByOwnerId_Call {
+	return &MockMapRepository_FindByOwnerId_Call{Call: _e.mock.On("FindByOwnerId", ctx, ownerId)}
+}
+
+func (_c *MockMapRepository_FindByOwnerId_Call) Run(run func(ctx context.Context, ownerId string)) *MockMapRepository_FindByOwnerId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_FindByOwnerId_Call) Return(maps []*entities.Map, err error) *MockMapRepository_FindByOwnerId_Call {
+	_c.Call.Return(maps, err)
+	return _c
+}
+
+func (_c *MockMapRepository_FindByOwnerId_Call) RunAndReturn(run func(ctx context.Context, ownerId string) ([]*entities.Map, error)) *MockMapRepository_FindByOwnerId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByOwnerIdAndName provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) FindByOwnerIdAndName(ctx context.Context, ownerId string, name string) (*entities.Map, error) {
+	ret := _mock.Called(ctx, ownerId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByOwnerIdAndName")
+	}
+
+	var r0 *entities.Map
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.Map, error)); ok {
+		return returnFunc(ctx, ownerId, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.Map); ok {
+		r0 = returnFunc(ctx, ownerId, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Map)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, ownerId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRepository_FindByOwnerIdAndName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByOwnerIdAndName'
+type MockMapRepository_FindByOwnerIdAndName_Call struct {
+	*mock.Call
+}
+
+// FindByOwnerIdAndName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerId string
+//   - name string
+func (_e *MockMapRepository_Expecter) FindByOwnerIdAndName(ctx interface{}, ownerId interface{}, name interface{}) *MockMapRepository_FindByOwnerIdAndName_Call {
+	return &MockMapRepository_FindByOwnerIdAndName_Call{Call: _e.mock.On("FindByOwnerIdAndName", ctx, ownerId, name)}
+}
+
+func (_c *MockMapRepository_FindByOwnerIdAndName_Call) Run(run func(ctx context.Context, ownerId string, name string)) *MockMapRepository_FindByOwnerIdAndName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_FindByOwnerIdAndName_Call) Return(mapParam *entities.Map, err error) *MockMapRepository_FindByOwnerIdAndName_Call {
+	_c.Call.Return(mapParam, err)
+	return _c
+}
+
+func (_c *MockMapRepository_FindByOwnerIdAndName_Call) RunAndReturn(run func(ctx context.Context, ownerId string, name string) (*entities.Map, error)) *MockMapRepository_FindByOwnerIdAndName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPublic provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) FindPublic(ctx context.Context, sort repositories.MapSort, offset int, limit int) ([]*entities.Map, error) {
+	ret := _mock.Called(ctx, sort, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPublic")
+	}
+
+	var r0 []*entities.Map
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.MapSort, int, int) ([]*entities.Map, error)); ok {
+		return returnFunc(ctx, sort, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.MapSort, int, int) []*entities.Map); ok {
+		r0 = returnFunc(ctx, sort, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Map)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repositories.MapSort, int, int) error); ok {
+		r1 = returnFunc(ctx, sort, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRepository_FindPublic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPublic'
+type MockMapRepository_FindPublic_Call struct {
+	*mock.Call
+}
+
+// FindPublic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sort repositories.MapSort
+//   - offset int
+//   - limit int
+func (_e *MockMapRepository_Expecter) FindPublic(ctx interface{}, sort interface{}, offset interface{}, limit interface{}) *MockMapRepository_FindPublic_Call {
+	return &MockMapRepository_FindPublic_Call{Call: _e.mock.On("FindPublic", ctx, sort, offset, limit)}
+}
+
+func (_c *MockMapRepository_FindPublic_Call) Run(run func(ctx context.Context, sort repositories.MapSort, offset int, limit int)) *MockMapRepository_FindPublic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repositories.MapSort
+		if args[1] != nil {
+			arg1 = args[1].(repositories.MapSort)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_FindPublic_Call) Return(maps []*entities.Map, err error) *MockMapRepository_FindPublic_Call {
+	_c.Call.Return(maps, err)
+	return _c
+}
+
+func (_c *MockMapRepository_FindPublic_Call) RunAndReturn(run func(ctx context.Context, sort repositories.MapSort, offset int, limit int) ([]*entities.Map, error)) *MockMapRepository_FindPublic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRecentlyPublishedByOwnerIds provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) FindRecentlyPublishedByOwnerIds(ctx context.Context, ownerIds []string, before time.Time, limit int) ([]*entities.Map, error) {
+	ret := _mock.Called(ctx, ownerIds, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRecentlyPublishedByOwnerIds")
+	}
+
+	var r0 []*entities.Map
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, time.Time, int) ([]*entities.Map, error)); ok {
+		return returnFunc(ctx, ownerIds, before, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, time.Time, int) []*entities.Map); ok {
+		r0 = returnFunc(ctx, ownerIds, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Map)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, ownerIds, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRepository_FindRecentlyPublishedByOwnerIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRecentlyPublishedByOwnerIds'
+type MockMapRepository_FindRecentlyPublishedByOwnerIds_Call struct {
+	*mock.Call
+}
+
+// FindRecentlyPublishedByOwnerIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerIds []string
+//   - before time.Time
+//   - limit int
+func (_e *MockMapRepository_Expecter) FindRecentlyPublishedByOwnerIds(ctx interface{}, ownerIds interface{}, before interface{}, limit interface{}) *MockMapRepository_FindRecentlyPublishedByOwnerIds_Call {
+	return &MockMapRepository_FindRecentlyPublishedByOwnerIds_Call{Call: _e.mock.On("FindRecentlyPublishedByOwnerIds", ctx, ownerIds, before, limit)}
+}
+
+func (_c *MockMapRepository_FindRecentlyPublishedByOwnerIds_Call) Run(run func(ctx context.Context, ownerIds []string, before time.Time, limit int)) *MockMapRepository_FindRecentlyPublishedByOwnerIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_FindRecentlyPublishedByOwnerIds_Call) Return(maps []*entities.Map, err error) *MockMapRepository_FindRecentlyPublishedByOwnerIds_Call {
+	_c.Call.Return(maps, err)
+	return _c
+}
+
+func (_c *MockMapRepository_FindRecentlyPublishedByOwnerIds_Call) RunAndReturn(run func(ctx context.Context, ownerIds []string, before time.Time, limit int) ([]*entities.Map, error)) *MockMapRepository_FindRecentlyPublishedByOwnerIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementRevision provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) IncrementRevision(ctx context.Context, mapId string) (int, error) {
+	ret := _mock.Called(ctx, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementRevision")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return returnFunc(ctx, mapId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, mapId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, mapId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRepository_IncrementRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementRevision'
+type MockMapRepository_IncrementRevision_Call struct {
+	*mock.Call
+}
+
+// IncrementRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+func (_e *MockMapRepository_Expecter) IncrementRevision(ctx interface{}, mapId interface{}) *MockMapRepository_IncrementRevision_Call {
+	return &MockMapRepository_IncrementRevision_Call{Call: _e.mock.On("IncrementRevision", ctx, mapId)}
+}
+
+func (_c *MockMapRepository_IncrementRevision_Call) Run(run func(ctx context.Context, mapId string)) *MockMapRepository_IncrementRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_IncrementRevision_Call) Return(n int, err error) *MockMapRepository_IncrementRevision_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockMapRepository_IncrementRevision_Call) RunAndReturn(run func(ctx context.Context, mapId string) (int, error)) *MockMapRepository_IncrementRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordActivity provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) RecordActivity(ctx context.Context, mapId string, activity entities.MapActivity) error {
+	ret := _mock.Called(ctx, mapId, activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordActivity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, entities.MapActivity) error); ok {
+		r0 = returnFunc(ctx, mapId, activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapRepository_RecordActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordActivity'
+type MockMapRepository_RecordActivity_Call struct {
+	*mock.Call
+}
+
+// RecordActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - activity entities.MapActivity
+func (_e *MockMapRepository_Expecter) RecordActivity(ctx interface{}, mapId interface{}, activity interface{}) *MockMapRepository_RecordActivity_Call {
+	return &MockMapRepository_RecordActivity_Call{Call: _e.mock.On("RecordActivity", ctx, mapId, activity)}
+}
+
+func (_c *MockMapRepository_RecordActivity_Call) Run(run func(ctx context.Context, mapId string, activity entities.MapActivity)) *MockMapRepository_RecordActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 entities.MapActivity
+		if args[2] != nil {
+			arg2 = args[2].(entities.MapActivity)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_RecordActivity_Call) Return(err error) *MockMapRepository_RecordActivity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapRepository_RecordActivity_Call) RunAndReturn(run func(ctx context.Context, mapId string, activity entities.MapActivity) error) *MockMapRepository_RecordActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockMapRepository
+func (_mock *MockMapRepository) Update(ctx context.Context, m *entities.Map) error {
+	ret := _mock.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Map) error); ok {
+		r0 = returnFunc(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockMapRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - m *entities.Map
+func (_e *MockMapRepository_Expecter) Update(ctx interface{}, m interface{}) *MockMapRepository_Update_Call {
+	return &MockMapRepository_Update_Call{Call: _e.mock.On("Update", ctx, m)}
+}
+
+func (_c *MockMapRepository_Update_Call) Run(run func(ctx context.Context, m *entities.Map)) *MockMapRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Map
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Map)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRepository_Update_Call) Return(err error) *MockMapRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapRepository_Update_Call) RunAndReturn(run func(ctx context.Context, m *entities.Map) error) *MockMapRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMapRevisionRepository creates a new instance of MockMapRevisionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMapRevisionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMapRevisionRepository {
+	mock := &MockMapRevisionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMapRevisionRepository is an autogenerated mock type for the MapRevisionRepository type
+type MockMapRevisionRepository struct {
+	mock.Mock
+}
+
+type MockMapRevisionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMapRevisionRepository) EXPECT() *MockMapRevisionRepository_Expecter {
+	return &MockMapRevisionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByMapId provides a mock function for the type MockMapRevisionRepository
+func (_mock *MockMapRevisionRepository) CountByMapId(ctx context.Context, mapId string) (int64, error) {
+	ret := _mock.Called(ctx, mapId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByMapId")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, mapId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, mapId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, mapId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRevisionRepository_CountByMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByMapId'
+type MockMapRevisionRepository_CountByMapId_Call struct {
+	*mock.Call
+}
+
+// CountByMapId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+func (_e *MockMapRevisionRepository_Expecter) CountByMapId(ctx interface{}, mapId interface{}) *MockMapRevisionRepository_CountByMapId_Call {
+	return &MockMapRevisionRepository_CountByMapId_Call{Call: _e.mock.On("CountByMapId", ctx, mapId)}
+}
+
+func (_c *MockMapRevisionRepository_CountByMapId_Call) Run(run func(ctx context.Context, mapId string)) *MockMapRevisionRepository_CountByMapId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRevisionRepository_CountByMapId_Call) Return(n int64, err error) *MockMapRevisionRepository_CountByMapId_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockMapRevisionRepository_CountByMapId_Call) RunAndReturn(run func(ctx context.Context, mapId string) (int64, error)) *MockMapRevisionRepository_CountByMapId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockMapRevisionRepository
+func (_mock *MockMapRevisionRepository) Create(ctx context.Context, revision *entities.MapRevision) error {
+	ret := _mock.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.MapRevision) error); ok {
+		r0 = returnFunc(ctx, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMapRevisionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockMapRevisionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision *entities.MapRevision
+func (_e *MockMapRevisionRepository_Expecter) Create(ctx interface{}, revision interface{}) *MockMapRevisionRepository_Create_Call {
+	return &MockMapRevisionRepository_Create_Call{Call: _e.mock.On("Create", ctx, revision)}
+}
+
+func (_c *MockMapRevisionRepository_Create_Call) Run(run func(ctx context.Context, revision *entities.MapRevision)) *MockMapRevisionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.MapRevision
+		if args[1] != nil {
+			arg1 = args[1].(*entities.MapRevision)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRevisionRepository_Create_Call) Return(err error) *MockMapRevisionRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMapRevisionRepository_Create_Call) RunAndReturn(run func(ctx context.Context, revision *entities.MapRevision) error) *MockMapRevisionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAfter provides a mock function for the type MockMapRevisionRepository
+func (_mock *MockMapRevisionRepository) FindAfter(ctx context.Context, mapId string, number int) ([]*entities.MapRevision, error) {
+	ret := _mock.Called(ctx, mapId, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAfter")
+	}
+
+	var r0 []*entities.MapRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]*entities.MapRevision, error)); ok {
+		return returnFunc(ctx, mapId, number)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []*entities.MapRevision); ok {
+		r0 = returnFunc(ctx, mapId, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MapRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, mapId, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRevisionRepository_FindAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAfter'
+type MockMapRevisionRepository_FindAfter_Call struct {
+	*mock.Call
+}
+
+// FindAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - number int
+func (_e *MockMapRevisionRepository_Expecter) FindAfter(ctx interface{}, mapId interface{}, number interface{}) *MockMapRevisionRepository_FindAfter_Call {
+	return &MockMapRevisionRepository_FindAfter_Call{Call: _e.mock.On("FindAfter", ctx, mapId, number)}
+}
+
+func (_c *MockMapRevisionRepository_FindAfter_Call) Run(run func(ctx context.Context, mapId string, number int)) *MockMapRevisionRepository_FindAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRevisionRepository_FindAfter_Call) Return(mapRevisions []*entities.MapRevision, err error) *MockMapRevisionRepository_FindAfter_Call {
+	_c.Call.Return(mapRevisions, err)
+	return _c
+}
+
+func (_c *MockMapRevisionRepository_FindAfter_Call) RunAndReturn(run func(ctx context.Context, mapId string, number int) ([]*entities.MapRevision, error)) *MockMapRevisionRepository_FindAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByMapId provides a mock function for the type MockMapRevisionRepository
+func (_mock *MockMapRevisionRepository) FindByMapId(ctx context.Context, mapId string, offset int, limit int) ([]*entities.MapRevision, error) {
+	ret := _mock.Called(ctx, mapId, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMapId")
+	}
+
+	var r0 []*entities.MapRevision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) ([]*entities.MapRevision, error)); ok {
+		return returnFunc(ctx, mapId, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) []*entities.MapRevision); ok {
+		r0 = returnFunc(ctx, mapId, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MapRevision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = returnFunc(ctx, mapId, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockMapRevisionRepository_FindByMapId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByMapId'
+type MockMapRevisionRepository_FindByMapId_Call struct {
+	*mock.Call
+}
+
+// FindByMapId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapId string
+//   - offset int
+//   - limit int
+func (_e *MockMapRevisionRepository_Expecter) FindByMapId(ctx interface{}, mapId interface{}, offset interface{}, limit interface{}) *MockMapRevisionRepository_FindByMapId_Call {
+	return &MockMapRevisionRepository_FindByMapId_Call{Call: _e.mock.On("FindByMapId", ctx, mapId, offset, limit)}
+}
+
+func (_c *MockMapRevisionRepository_FindByMapId_Call) Run(run func(ctx context.Context, mapId string, offset int, limit int)) *MockMapRevisionRepository_FindByMapId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapRevisionRepository_FindByMapId_Call) Return(mapRevisions []*entities.MapRevision, err error) *MockMapRevisionRepository_FindByMapId_Call {
+	_c.Call.Return(mapRevisions, err)
+	return _c
+}
+
+func (_c *MockMapRevisionRepository_FindByMapId_Call) RunAndReturn(run func(ctx context.Context, mapId string, offset int, limit int) ([]*entities.MapRevision, error)) *MockMapRevisionRepository_FindByMapId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMapSearch creates a new instance of MockMapSearch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMapSearch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMapSearch {
+	mock := &MockMapSearch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMapSearch is an autogenerated mock type for the MapSearch type
+type MockMapSearch struct {
+	mock.Mock
+}
+
+type MockMapSearch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMapSearch) EXPECT() *MockMapSearch_Expecter {
+	return &MockMapSearch_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function for the type MockMapSearch
+func (_mock *MockMapSearch) Search(ctx context.Context, query repositories.MapSearchQuery) ([]repositories.MapSearchHit, int64, error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []repositories.MapSearchHit
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.MapSearchQuery) ([]repositories.MapSearchHit, int64, error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.MapSearchQuery) []repositories.MapSearchHit); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repositories.MapSearchHit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repositories.MapSearchQuery) int64); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, repositories.MapSearchQuery) error); ok {
+		r2 = returnFunc(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockMapSearch_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockMapSearch_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query repositories.MapSearchQuery
+func (_e *MockMapSearch_Expecter) Search(ctx interface{}, query interface{}) *MockMapSearch_Search_Call {
+	return &MockMapSearch_Search_Call{Call: _e.mock.On("Search", ctx, query)}
+}
+
+func (_c *MockMapSearch_Search_Call) Run(run func(ctx context.Context, query repositories.MapSearchQuery)) *MockMapSearch_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repositories.MapSearchQuery
+		if args[1] != nil {
+			arg1 = args[1].(repositories.MapSearchQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMapSearch_Search_Call) Return(mapSearchHits []repositories.MapSearchHit, n int64, err error) *MockMapSearch_Search_Call {
+	_c.Call.Return(mapSearchHits, n, err)
+	return _c
+}
+
+func (_c *MockMapSearch_Search_Call) RunAndReturn(run func(ctx context.Context, query repositories.MapSearchQuery) ([]repositories.MapSearchHit, int64, error)) *MockMapSearch_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationRepository creates a new instance of MockNotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationRepository {
+	mock := &MockNotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationRepository is an autogenerated mock type for the NotificationRepository type
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+type MockNotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationRepository) EXPECT() *MockNotificationRepository_Expecter {
+	return &MockNotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByUserId provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) CountByUserId(ctx context.Context, userId string, unreadOnly bool) (int64, error) {
+	ret := _mock.Called(ctx, userId, unreadOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUserId")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) (int64, error)); ok {
+		return returnFunc(ctx, userId, unreadOnly)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) int64); ok {
+		r0 = returnFunc(ctx, userId, unreadOnly)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = returnFunc(ctx, userId, unreadOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_CountByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUserId'
+type MockNotificationRepository_CountByUserId_Call struct {
+	*mock.Call
+}
+
+// CountByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - unreadOnly bool
+func (_e *MockNotificationRepository_Expecter) CountByUserId(ctx interface{}, userId interface{}, unreadOnly interface{}) *MockNotificationRepository_CountByUserId_Call {
+	return &MockNotificationRepository_CountByUserId_Call{Call: _e.mock.On("CountByUserId", ctx, userId, unreadOnly)}
+}
+
+func (_c *MockNotificationRepository_CountByUserId_Call) Run(run func(ctx context.Context, userId string, unreadOnly bool)) *MockNotificationRepository_CountByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_CountByUserId_Call) Return(n int64, err error) *MockNotificationRepository_CountByUserId_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_CountByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string, unreadOnly bool) (int64, error)) *MockNotificationRepository_CountByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) Create(ctx context.Context, notification *entities.Notification) error {
+	ret := _mock.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.Notification) error); ok {
+		r0 = returnFunc(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockNotificationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockNotificationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification *entities.Notification
+func (_e *MockNotificationRepository_Expecter) Create(ctx interface{}, notification interface{}) *MockNotificationRepository_Create_Call {
+	return &MockNotificationRepository_Create_Call{Call: _e.mock.On("Create", ctx, notification)}
+}
+
+func (_c *MockNotificationRepository_Create_Call) Run(run func(ctx context.Context, notification *entities.Notification)) *MockNotificationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.Notification
+		if args[1] != nil {
+			arg1 = args[1].(*entities.Notification)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_Create_Call) Return(err error) *MockNotificationRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_Create_Call) RunAndReturn(run func(ctx context.Context, notification *entities.Notification) error) *MockNotificationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserId provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) FindByUserId(ctx context.Context, userId string, unreadOnly bool, offset int, limit int) ([]*entities.Notification, error) {
+	ret := _mock.Called(ctx, userId, unreadOnly, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 []*entities.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool, int, int) ([]*entities.Notification, error)); ok {
+		return returnFunc(ctx, userId, unreadOnly, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool, int, int) []*entities.Notification); ok {
+		r0 = returnFunc(ctx, userId, unreadOnly, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool, int, int) error); ok {
+		r1 = returnFunc(ctx, userId, unreadOnly, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockNotificationRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - unreadOnly bool
+//   - offset int
+//   - limit int
+func (_e *MockNotificationRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}, unreadOnly interface{}, offset interface{}, limit interface{}) *MockNotificationRepository_FindByUserId_Call {
+	return &MockNotificationRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId, unreadOnly, offset, limit)}
+}
+
+func (_c *MockNotificationRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string, unreadOnly bool, offset int, limit int)) *MockNotificationRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_FindByUserId_Call) Return(notifications []*entities.Notification, err error) *MockNotificationRepository_FindByUserId_Call {
+	_c.Call.Return(notifications, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string, unreadOnly bool, offset int, limit int) ([]*entities.Notification, error)) *MockNotificationRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllRead provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) MarkAllRead(ctx context.Context, userId string, at time.Time) (int64, error) {
+	ret := _mock.Called(ctx, userId, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllRead")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, userId, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) int64); ok {
+		r0 = returnFunc(ctx, userId, at)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userId, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type MockNotificationRepository_MarkAllRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - at time.Time
+func (_e *MockNotificationRepository_Expecter) MarkAllRead(ctx interface{}, userId interface{}, at interface{}) *MockNotificationRepository_MarkAllRead_Call {
+	return &MockNotificationRepository_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", ctx, userId, at)}
+}
+
+func (_c *MockNotificationRepository_MarkAllRead_Call) Run(run func(ctx context.Context, userId string, at time.Time)) *MockNotificationRepository_MarkAllRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_MarkAllRead_Call) Return(n int64, err error) *MockNotificationRepository_MarkAllRead_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_MarkAllRead_Call) RunAndReturn(run func(ctx context.Context, userId string, at time.Time) (int64, error)) *MockNotificationRepository_MarkAllRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function for the type MockNotificationRepository
+func (_mock *MockNotificationRepository) MarkRead(ctx context.Context, userId string, id string, at time.Time) (bool, error) {
+	ret := _mock.Called(ctx, userId, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, userId, id, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) bool); ok {
+		r0 = returnFunc(ctx, userId, id, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userId, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationRepository_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockNotificationRepository_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - id string
+//   - at time.Time
+func (_e *MockNotificationRepository_Expecter) MarkRead(ctx interface{}, userId interface{}, id interface{}, at interface{}) *MockNotificationRepository_MarkRead_Call {
+	return &MockNotificationRepository_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, userId, id, at)}
+}
+
+func (_c *MockNotificationRepository_MarkRead_Call) Run(run func(ctx context.Context, userId string, id string, at time.Time)) *MockNotificationRepository_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationRepository_MarkRead_Call) Return(b bool, err error) *MockNotificationRepository_MarkRead_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockNotificationRepository_MarkRead_Call) RunAndReturn(run func(ctx context.Context, userId string, id string, at time.Time) (bool, error)) *MockNotificationRepository_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOidcAuthStateRepository creates a new instance of MockOidcAuthStateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOidcAuthStateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOidcAuthStateRepository {
+	mock := &MockOidcAuthStateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOidcAuthStateRepository is an autogenerated mock type for the OidcAuthStateRepository type
+type MockOidcAuthStateRepository struct {
+	mock.Mock
+}
+
+type MockOidcAuthStateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOidcAuthStateRepository) EXPECT() *MockOidcAuthStateRepository_Expecter {
+	return &MockOidcAuthStateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockOidcAuthStateRepository
+func (_mock *MockOidcAuthStateRepository) Create(ctx context.Context, state *entities.OidcAuthState) error {
+	ret := _mock.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.OidcAuthState) error); ok {
+		r0 = returnFunc(ctx, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOidcAuthStateRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockOidcAuthStateRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state *entities.OidcAuthState
+func (_e *MockOidcAuthStateRepository_Expecter) Create(ctx interface{}, state interface{}) *MockOidcAuthStateRepository_Create_Call {
+	return &MockOidcAuthStateRepository_Create_Call{Call: _e.mock.On("Create", ctx, state)}
+}
+
+func (_c *MockOidcAuthStateRepository_Create_Call) Run(run func(ctx context.Context, state *entities.OidcAuthState)) *MockOidcAuthStateRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.OidcAuthState
+		if args[1] != nil {
+			arg1 = args[1].(*entities.OidcAuthState)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOidcAuthStateRepository_Create_Call) Return(err error) *MockOidcAuthStateRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOidcAuthStateRepository_Create_Call) RunAndReturn(run func(ctx context.Context, state *entities.OidcAuthState) error) *MockOidcAuthStateRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockOidcAuthStateRepository
+func (_mock *MockOidcAuthStateRepository) Delete(ctx context.Context, state *entities.OidcAuthState) error {
+	ret := _mock.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.OidcAuthState) error); ok {
+		r0 = returnFunc(ctx, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOidcAuthStateRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockOidcAuthStateRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state *entities.OidcAuthState
+func (_e *MockOidcAuthStateRepository_Expecter) Delete(ctx interface{}, state interface{}) *MockOidcAuthStateRepository_Delete_Call {
+	return &MockOidcAuthStateRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, state)}
+}
+
+func (_c *MockOidcAuthStateRepository_Delete_Call) Run(run func(ctx context.Context, state *entities.OidcAuthState)) *MockOidcAuthStateRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.OidcAuthState
+		if args[1] != nil {
+			arg1 = args[1].(*entities.OidcAuthState)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOidcAuthStateRepository_Delete_Call) Return(err error) *MockOidcAuthStateRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOidcAuthStateRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, state *entities.OidcAuthState) error) *MockOidcAuthStateRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByState provides a mock function for the type MockOidcAuthStateRepository
+func (_mock *MockOidcAuthStateRepository) FindByState(ctx context.Context, state string) (*entities.OidcAuthState, error) {
+	ret := _mock.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByState")
+	}
+
+	var r0 *entities.OidcAuthState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.OidcAuthState, error)); ok {
+		return returnFunc(ctx, state)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.OidcAuthState); ok {
+		r0 = returnFunc(ctx, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OidcAuthState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOidcAuthStateRepository_FindByState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByState'
+type MockOidcAuthStateRepository_FindByState_Call struct {
+	*mock.Call
+}
+
+// FindByState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state string
+func (_e *MockOidcAuthStateRepository_Expecter) FindByState(ctx interface{}, state interface{}) *MockOidcAuthStateRepository_FindByState_Call {
+	return &MockOidcAuthStateRepository_FindByState_Call{Call: _e.mock.On("FindByState", ctx, state)}
+}
+
+func (_c *MockOidcAuthStateRepository_FindByState_Call) Run(run func(ctx context.Context, state string)) *MockOidcAuthStateRepository_FindByState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOidcAuthStateRepository_FindByState_Call) Return(oidcAuthState *entities.OidcAuthState, err error) *MockOidcAuthStateRepository_FindByState_Call {
+	_c.Call.Return(oidcAuthState, err)
+	return _c
+}
+
+func (_c *MockOidcAuthStateRepository_FindByState_Call) RunAndReturn(run func(ctx context.Context, state string) (*entities.OidcAuthState, error)) *MockOidcAuthStateRepository_FindByState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPersonalAccessTokenRepository creates a new instance of MockPersonalAccessTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPersonalAccessTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPersonalAccessTokenRepository {
+	mock := &MockPersonalAccessTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPersonalAccessTokenRepository is an autogenerated mock type for the PersonalAccessTokenRepository type
+type MockPersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+type MockPersonalAccessTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPersonalAccessTokenRepository) EXPECT() *MockPersonalAccessTokenRepository_Expecter {
+	return &MockPersonalAccessTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByUserId provides a mock function for the type MockPersonalAccessTokenRepository
+func (_mock *MockPersonalAccessTokenRepository) CountByUserId(ctx context.Context, userId string) (int64, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUserId")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPersonalAccessTokenRepository_CountByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUserId'
+type MockPersonalAccessTokenRepository_CountByUserId_Call struct {
+	*mock.Call
+}
+
+// CountByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockPersonalAccessTokenRepository_Expecter) CountByUserId(ctx interface{}, userId interface{}) *MockPersonalAccessTokenRepository_CountByUserId_Call {
+	return &MockPersonalAccessTokenRepository_CountByUserId_Call{Call: _e.mock.On("CountByUserId", ctx, userId)}
+}
+
+func (_c *MockPersonalAccessTokenRepository_CountByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockPersonalAccessTokenRepository_CountByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_CountByUserId_Call) Return(n int64, err error) *MockPersonalAccessTokenRepository_CountByUserId_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_CountByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) (int64, error)) *MockPersonalAccessTokenRepository_CountByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockPersonalAccessTokenRepository
+func (_mock *MockPersonalAccessTokenRepository) Create(ctx context.Context, token *entities.PersonalAccessToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.PersonalAccessToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPersonalAccessTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPersonalAccessTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *entities.PersonalAccessToken
+func (_e *MockPersonalAccessTokenRepository_Expecter) Create(ctx interface{}, token interface{}) *MockPersonalAccessTokenRepository_Create_Call {
+	return &MockPersonalAccessTokenRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *MockPersonalAccessTokenRepository_Create_Call) Run(run func(ctx context.Context, token *entities.PersonalAccessToken)) *MockPersonalAccessTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.PersonalAccessToken
+		if args[1] != nil {
+			arg1 = args[1].(*entities.PersonalAccessToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_Create_Call) Return(err error) *MockPersonalAccessTokenRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_Create_Call) RunAndReturn(run func(ctx context.Context, token *entities.PersonalAccessToken) error) *MockPersonalAccessTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockPersonalAccessTokenRepository
+func (_mock *MockPersonalAccessTokenRepository) Delete(ctx context.Context, token *entities.PersonalAccessToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.PersonalAccessToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPersonalAccessTokenRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockPersonalAccessTokenRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *entities.PersonalAccessToken
+func (_e *MockPersonalAccessTokenRepository_Expecter) Delete(ctx interface{}, token interface{}) *MockPersonalAccessTokenRepository_Delete_Call {
+	return &MockPersonalAccessTokenRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, token)}
+}
+
+func (_c *MockPersonalAccessTokenRepository_Delete_Call) Run(run func(ctx context.Context, token *entities.PersonalAccessToken)) *MockPersonalAccessTokenRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.PersonalAccessToken
+		if args[1] != nil {
+			arg1 = args[1].(*entities.PersonalAccessToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_Delete_Call) Return(err error) *MockPersonalAccessTokenRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, token *entities.PersonalAccessToken) error) *MockPersonalAccessTokenRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIdAndUserId provides a mock function for the type MockPersonalAccessTokenRepository
+func (_mock *MockPersonalAccessTokenRepository) FindByIdAndUserId(ctx context.Context, id string, userId string) (*entities.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIdAndUserId")
+	}
+
+	var r0 *entities.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, id, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, id, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPersonalAccessTokenRepository_FindByIdAndUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIdAndUserId'
+type MockPersonalAccessTokenRepository_FindByIdAndUserId_Call struct {
+	*mock.Call
+}
+
+// FindByIdAndUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userId string
+func (_e *MockPersonalAccessTokenRepository_Expecter) FindByIdAndUserId(ctx interface{}, id interface{}, userId interface{}) *MockPersonalAccessTokenRepository_FindByIdAndUserId_Call {
+	return &MockPersonalAccessTokenRepository_FindByIdAndUserId_Call{Call: _e.mock.On("FindByIdAndUserId", ctx, id, userId)}
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByIdAndUserId_Call) Run(run func(ctx context.Context, id string, userId string)) *MockPersonalAccessTokenRepository_FindByIdAndUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByIdAndUserId_Call) Return(personalAccessToken *entities.PersonalAccessToken, err error) *MockPersonalAccessTokenRepository_FindByIdAndUserId_Call {
+	_c.Call.Return(personalAccessToken, err)
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByIdAndUserId_Call) RunAndReturn(run func(ctx context.Context, id string, userId string) (*entities.PersonalAccessToken, error)) *MockPersonalAccessTokenRepository_FindByIdAndUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTokenHash provides a mock function for the type MockPersonalAccessTokenRepository
+func (_mock *MockPersonalAccessTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTokenHash")
+	}
+
+	var r0 *entities.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPersonalAccessTokenRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type MockPersonalAccessTokenRepository_FindByTokenHash_Call struct {
+	*mock.Call
+}
+
+// FindByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockPersonalAccessTokenRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *MockPersonalAccessTokenRepository_FindByTokenHash_Call {
+	return &MockPersonalAccessTokenRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockPersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByTokenHash_Call) Return(personalAccessToken *entities.PersonalAccessToken, err error) *MockPersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(personalAccessToken, err)
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByTokenHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*entities.PersonalAccessToken, error)) *MockPersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserId provides a mock function for the type MockPersonalAccessTokenRepository
+func (_mock *MockPersonalAccessTokenRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 []*entities.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPersonalAccessTokenRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockPersonalAccessTokenRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockPersonalAccessTokenRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockPersonalAccessTokenRepository_FindByUserId_Call {
+	return &MockPersonalAccessTokenRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockPersonalAccessTokenRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByUserId_Call) Return(personalAccessTokens []*entities.PersonalAccessToken, err error) *MockPersonalAccessTokenRepository_FindByUserId_Call {
+	_c.Call.Return(personalAccessTokens, err)
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) ([]*entities.PersonalAccessToken, error)) *MockPersonalAccessTokenRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserIdAndName provides a mock function for the type MockPersonalAccessTokenRepository
+func (_mock *MockPersonalAccessTokenRepository) FindByUserIdAndName(ctx context.Context, userId string, name string) (*entities.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, userId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIdAndName")
+	}
+
+	var r0 *entities.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, userId, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, userId, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPersonalAccessTokenRepository_FindByUserIdAndName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserIdAndName'
+type MockPersonalAccessTokenRepository_FindByUserIdAndName_Call struct {
+	*mock.Call
+}
+
+// FindByUserIdAndName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - name string
+func (_e *MockPersonalAccessTokenRepository_Expecter) FindByUserIdAndName(ctx interface{}, userId interface{}, name interface{}) *MockPersonalAccessTokenRepository_FindByUserIdAndName_Call {
+	return &MockPersonalAccessTokenRepository_FindByUserIdAndName_Call{Call: _e.mock.On("FindByUserIdAndName", ctx, userId, name)}
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByUserIdAndName_Call) Run(run func(ctx context.Context, userId string, name string)) *MockPersonalAccessTokenRepository_FindByUserIdAndName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByUserIdAndName_Call) Return(personalAccessToken *entities.PersonalAccessToken, err error) *MockPersonalAccessTokenRepository_FindByUserIdAndName_Call {
+	_c.Call.Return(personalAccessToken, err)
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_FindByUserIdAndName_Call) RunAndReturn(run func(ctx context.Context, userId string, name string) (*entities.PersonalAccessToken, error)) *MockPersonalAccessTokenRepository_FindByUserIdAndName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastUsedAt provides a mock function for the type MockPersonalAccessTokenRepository
+func (_mock *MockPersonalAccessTokenRepository) UpdateLastUsedAt(ctx context.Context, token *entities.PersonalAccessToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastUsedAt")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.PersonalAccessToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastUsedAt'
+type MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call struct {
+	*mock.Call
+}
+
+// UpdateLastUsedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *entities.PersonalAccessToken
+func (_e *MockPersonalAccessTokenRepository_Expecter) UpdateLastUsedAt(ctx interface{}, token interface{}) *MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call {
+	return &MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call{Call: _e.mock.On("UpdateLastUsedAt", ctx, token)}
+}
+
+func (_c *MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call) Run(run func(ctx context.Context, token *entities.PersonalAccessToken)) *MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.PersonalAccessToken
+		if args[1] != nil {
+			arg1 = args[1].(*entities.PersonalAccessToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call) Return(err error) *MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call) RunAndReturn(run func(ctx context.Context, token *entities.PersonalAccessToken) error) *MockPersonalAccessTokenRepository_UpdateLastUsedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPersonalDataRepository creates a new instance of MockPersonalDataRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPersonalDataRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPersonalDataRepository {
+	mock := &MockPersonalDataRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPersonalDataRepository is an autogenerated mock type for the PersonalDataRepository type
+type MockPersonalDataRepository struct {
+	mock.Mock
+}
+
+type MockPersonalDataRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPersonalDataRepository) EXPECT() *MockPersonalDataRepository_Expecter {
+	return &MockPersonalDataRepository_Expecter{mock: &_m.Mock}
+}
+
+// EraseCredentials provides a mock function for the type MockPersonalDataRepository
+func (_mock *MockPersonalDataRepository) EraseCredentials(ctx context.Context, userId string) error {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseCredentials")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPersonalDataRepository_EraseCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EraseCredentials'
+type MockPersonalDataRepository_EraseCredentials_Call struct {
+	*mock.Call
+}
+
+// EraseCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockPersonalDataRepository_Expecter) EraseCredentials(ctx interface{}, userId interface{}) *MockPersonalDataRepository_EraseCredentials_Call {
+	return &MockPersonalDataRepository_EraseCredentials_Call{Call: _e.mock.On("EraseCredentials", ctx, userId)}
+}
+
+func (_c *MockPersonalDataRepository_EraseCredentials_Call) Run(run func(ctx context.Context, userId string)) *MockPersonalDataRepository_EraseCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalDataRepository_EraseCredentials_Call) Return(err error) *MockPersonalDataRepository_EraseCredentials_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPersonalDataRepository_EraseCredentials_Call) RunAndReturn(run func(ctx context.Context, userId string) error) *MockPersonalDataRepository_EraseCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function for the type MockPersonalDataRepository
+func (_mock *MockPersonalDataRepository) Export(ctx context.Context, userId string) (*repositories.PersonalDataExport, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 *repositories.PersonalDataExport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*repositories.PersonalDataExport, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *repositories.PersonalDataExport); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repositories.PersonalDataExport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPersonalDataRepository_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockPersonalDataRepository_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockPersonalDataRepository_Expecter) Export(ctx interface{}, userId interface{}) *MockPersonalDataRepository_Export_Call {
+	return &MockPersonalDataRepository_Export_Call{Call: _e.mock.On("Export", ctx, userId)}
+}
+
+func (_c *MockPersonalDataRepository_Export_Call) Run(run func(ctx context.Context, userId string)) *MockPersonalDataRepository_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPersonalDataRepository_Export_Call) Return(personalDataExport *repositories.PersonalDataExport, err error) *MockPersonalDataRepository_Export_Call {
+	_c.Call.Return(personalDataExport, err)
+	return _c
+}
+
+func (_c *MockPersonalDataRepository_Export_Call) RunAndReturn(run func(ctx context.Context, userId string) (*repositories.PersonalDataExport, error)) *MockPersonalDataRepository_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRateLimitStore creates a new instance of MockRateLimitStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRateLimitStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRateLimitStore {
+	mock := &MockRateLimitStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRateLimitStore is an autogenerated mock type for the RateLimitStore type
+type MockRateLimitStore struct {
+	mock.Mock
+}
+
+type MockRateLimitStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRateLimitStore) EXPECT() *MockRateLimitStore_Expecter {
+	return &MockRateLimitStore_Expecter{mock: &_m.Mock}
+}
+
+// Take provides a mock function for the type MockRateLimitStore
+func (_mock *MockRateLimitStore) Take(ctx context.Context, key string, rule entities.RateLimitRule) (entities.RateLimitDecision, error) {
+	ret := _mock.Called(ctx, key, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Take")
+	}
+
+	var r0 entities.RateLimitDecision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, entities.RateLimitRule) (entities.RateLimitDecision, error)); ok {
+		return returnFunc(ctx, key, rule)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, entities.RateLimitRule) entities.RateLimitDecision); ok {
+		r0 = returnFunc(ctx, key, rule)
+	} else {
+		r0 = ret.Get(0).(entities.RateLimitDecision)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, entities.RateLimitRule) error); ok {
+		r1 = returnFunc(ctx, key, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRateLimitStore_Take_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Take'
+type MockRateLimitStore_Take_Call struct {
+	*mock.Call
+}
+
+// Take is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - rule entities.RateLimitRule
+func (_e *MockRateLimitStore_Expecter) Take(ctx interface{}, key interface{}, rule interface{}) *MockRateLimitStore_Take_Call {
+	return &MockRateLimitStore_Take_Call{Call: _e.mock.On("Take", ctx, key, rule)}
+}
+
+func (_c *MockRateLimitStore_Take_Call) Run(run func(ctx context.Context, key string, rule entities.RateLimitRule)) *MockRateLimitStore_Take_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 entities.RateLimitRule
+		if args[2] != nil {
+			arg2 = args[2].(entities.RateLimitRule)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRateLimitStore_Take_Call) Return(rateLimitDecision entities.RateLimitDecision, err error) *MockRateLimitStore_Take_Call {
+	_c.Call.Return(rateLimitDecision, err)
+	return _c
+}
+
+func (_c *MockRateLimitStore_Take_Call) RunAndReturn(run func(ctx context.Context, key string, rule entities.RateLimitRule) (entities.RateLimitDecision, error)) *MockRateLimitStore_Take_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReauthenticationCodeRepository creates a new instance of MockReauthenticationCodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReauthenticationCodeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReauthenticationCodeRepository {
+	mock := &MockReauthenticationCodeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReauthenticationCodeRepository is an autogenerated mock type for the ReauthenticationCodeRepository type
+type MockReauthenticationCodeRepository struct {
+	mock.Mock
+}
+
+type MockReauthenticationCodeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReauthenticationCodeRepository) EXPECT() *MockReauthenticationCodeRepository_Expecter {
+	return &MockReauthenticationCodeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockReauthenticationCodeRepository
+func (_mock *MockReauthenticationCodeRepository) Delete(ctx context.Context, code *entities.ReauthenticationCode) error {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.ReauthenticationCode) error); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReauthenticationCodeRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockReauthenticationCodeRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code *entities.ReauthenticationCode
+func (_e *MockReauthenticationCodeRepository_Expecter) Delete(ctx interface{}, code interface{}) *MockReauthenticationCodeRepository_Delete_Call {
+	return &MockReauthenticationCodeRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, code)}
+}
+
+func (_c *MockReauthenticationCodeRepository_Delete_Call) Run(run func(ctx context.Context, code *entities.ReauthenticationCode)) *MockReauthenticationCodeRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.ReauthenticationCode
+		if args[1] != nil {
+			arg1 = args[1].(*entities.ReauthenticationCode)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReauthenticationCodeRepository_Delete_Call) Return(err error) *MockReauthenticationCodeRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReauthenticationCodeRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, code *entities.ReauthenticationCode) error) *MockReauthenticationCodeRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserId provides a mock function for the type MockReauthenticationCodeRepository
+func (_mock *MockReauthenticationCodeRepository) FindByUserId(ctx context.Context, userId string) (*entities.ReauthenticationCode, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 *entities.ReauthenticationCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.ReauthenticationCode, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.ReauthenticationCode); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReauthenticationCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReauthenticationCodeRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockReauthenticationCodeRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockReauthenticationCodeRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockReauthenticationCodeRepository_FindByUserId_Call {
+	return &MockReauthenticationCodeRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
+}
+
+func (_c *MockReauthenticationCodeRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockReauthenticationCodeRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReauthenticationCodeRepository_FindByUserId_Call) Return(reauthenticationCode *entities.ReauthenticationCode, err error) *MockReauthenticationCodeRepository_FindByUserId_Call {
+	_c.Call.Return(reauthenticationCode, err)
+	return _c
+}
+
+func (_c *MockReauthenticationCodeRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) (*entities.ReauthenticationCode, error)) *MockReauthenticationCodeRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockReauthenticationCodeRepository
+func (_mock *MockReauthenticationCodeRepository) Save(ctx context.Context, code *entities.ReauthenticationCode) error {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.ReauthenticationCode) error); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReauthenticationCodeRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockReauthenticationCodeRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code *entities.ReauthenticationCode
+func (_e *MockReauthenticationCodeRepository_Expecter) Save(ctx interface{}, code interface{}) *MockReauthenticationCodeRepository_Save_Call {
+	return &MockReauthenticationCodeRepository_Save_Call{Call: _e.mock.On("Save", ctx, code)}
+}
+
+func (_c *MockReauthenticationCodeRepository_Save_Call) Run(run func(ctx context.Context, code *entities.ReauthenticationCode)) *MockReauthenticationCodeRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.ReauthenticationCode
+		if args[1] != nil {
+			arg1 = args[1].(*entities.ReauthenticationCode)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReauthenticationCodeRepository_Save_Call) Return(err error) *MockReauthenticationCodeRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReauthenticationCodeRepository_Save_Call) RunAndReturn(run func(ctx context.Context, code *entities.ReauthenticationCode) error) *MockReauthenticationCodeRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRefreshTokenRepository creates a new instance of MockRefreshTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRefreshTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRefreshTokenRepository {
+	mock := &MockRefreshTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRefreshTokenRepository is an autogenerated mock type for the RefreshTokenRepository type
+type MockRefreshTokenRepository struct {
+	mock.Mock
+}
+
+type MockRefreshTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRefreshTokenRepository) EXPECT() *MockRefreshTokenRepository_Expecter {
+	return &MockRefreshTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockRefreshTokenRepository
+func (_mock *MockRefreshTokenRepository) Create(ctx context.Context, refreshToken *entities.RefreshToken) error {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRefreshTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken *entities.RefreshToken
+func (_e *MockRefreshTokenRepository_Expecter) Create(ctx interface{}, refreshToken interface{}) *MockRefreshTokenRepository_Create_Call {
+	return &MockRefreshTokenRepository_Create_Call{Call: _e.mock.On("Create", ctx, refreshToken)}
+}
+
+func (_c *MockRefreshTokenRepository_Create_Call) Run(run func(ctx context.Context, refreshToken *entities.RefreshToken)) *MockRefreshTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.RefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(*entities.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_Create_Call) Return(err error) *MockRefreshTokenRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_Create_Call) RunAndReturn(run func(ctx context.Context, refreshToken *entities.RefreshToken) error) *MockRefreshTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireAllByUserId provides a mock function for the type MockRefreshTokenRepository
+func (_mock *MockRefreshTokenRepository) ExpireAllByUserId(ctx context.Context, userId string) error {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireAllByUserId")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepository_ExpireAllByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireAllByUserId'
+type MockRefreshTokenRepository_ExpireAllByUserId_Call struct {
+	*mock.Call
+}
+
+// ExpireAllByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockRefreshTokenRepository_Expecter) ExpireAllByUserId(ctx interface{}, userId interface{}) *MockRefreshTokenRepository_ExpireAllByUserId_Call {
+	return &MockRefreshTokenRepository_ExpireAllByUserId_Call{Call: _e.mock.On("ExpireAllByUserId", ctx, userId)}
+}
+
+func (_c *MockRefreshTokenRepository_ExpireAllByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockRefreshTokenRepository_ExpireAllByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_ExpireAllByUserId_Call) Return(err error) *MockRefreshTokenRepository_ExpireAllByUserId_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_ExpireAllByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) error) *MockRefreshTokenRepository_ExpireAllByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockRefreshTokenRepository
+func (_mock *MockRefreshTokenRepository) FindById(ctx context.Context, id string) (*entities.RefreshToken, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *entities.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.RefreshToken, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.RefreshToken); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockRefreshTokenRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockRefreshTokenRepository_Expecter) FindById(ctx interface{}, id interface{}) *MockRefreshTokenRepository_FindById_Call {
+	return &MockRefreshTokenRepository_FindById_Call{Call: _e.mock.On("FindById", ctx, id)}
+}
+
+func (_c *MockRefreshTokenRepository_FindById_Call) Run(run func(ctx context.Context, id string)) *MockRefreshTokenRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_FindById_Call) Return(refreshToken *entities.RefreshToken, err error) *MockRefreshTokenRepository_FindById_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_FindById_Call) RunAndReturn(run func(ctx context.Context, id string) (*entities.RefreshToken, error)) *MockRefreshTokenRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockRefreshTokenRepository
+func (_mock *MockRefreshTokenRepository) Update(ctx context.Context, refreshToken *entities.RefreshToken) error {
+	ret := _mock.Called(ctx, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRefreshTokenRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken *entities.RefreshToken
+func (_e *MockRefreshTokenRepository_Expecter) Update(ctx interface{}, refreshToken interface{}) *MockRefreshTokenRepository_Update_Call {
+	return &MockRefreshTokenRepository_Update_Call{Call: _e.mock.On("Update", ctx, refreshToken)}
+}
+
+func (_c *MockRefreshTokenRepository_Update_Call) Run(run func(ctx context.Context, refreshToken *entities.RefreshToken)) *MockRefreshTokenRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.RefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(*entities.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_Update_Call) Return(err error) *MockRefreshTokenRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepository_Update_Call) RunAndReturn(run func(ctx context.Context, refreshToken *entities.RefreshToken) error) *MockRefreshTokenRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSinglePlayerGameRepository creates a new instance of MockSinglePlayerGameRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSinglePlayerGameRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSinglePlayerGameRepository {
+	mock := &MockSinglePlayerGameRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSinglePlayerGameRepository is an autogenerated mock type for the SinglePlayerGameRepository type
+type MockSinglePlayerGameRepository struct {
+	mock.Mock
+}
+
+type MockSinglePlayerGameRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSinglePlayerGameRepository) EXPECT() *MockSinglePlayerGameRepository_Expecter {
+	return &MockSinglePlayerGameRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockSinglePlayerGameRepository
+func (_mock *MockSinglePlayerGameRepository) Create(ctx context.Context, game *entities.SinglePlayerGame) error {
+	ret := _mock.Called(ctx, game)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.SinglePlayerGame) error); ok {
+		r0 = returnFunc(ctx, game)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSinglePlayerGameRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockSinglePlayerGameRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - game *entities.SinglePlayerGame
+func (_e *MockSinglePlayerGameRepository_Expecter) Create(ctx interface{}, game interface{}) *MockSinglePlayerGameRepository_Create_Call {
+	return &MockSinglePlayerGameRepository_Create_Call{Call: _e.mock.On("Create", ctx, game)}
+}
+
+func (_c *MockSinglePlayerGameRepository_Create_Call) Run(run func(ctx context.Context, game *entities.SinglePlayerGame)) *MockSinglePlayerGameRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.SinglePlayerGame
+		if args[1] != nil {
+			arg1 = args[1].(*entities.SinglePlayerGame)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_Create_Call) Return(err error) *MockSinglePlayerGameRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_Create_Call) RunAndReturn(run func(ctx context.Context, game *entities.SinglePlayerGame) error) *MockSinglePlayerGameRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIdAndUserIdWithLock provides a mock function for the type MockSinglePlayerGameRepository
+func (_mock *MockSinglePlayerGameRepository) FindByIdAndUserIdWithLock(ctx context.Context, id string, userId string) (*entities.SinglePlayerGame, error) {
+	ret := _mock.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIdAndUserIdWithLock")
+	}
+
+	var r0 *entities.SinglePlayerGame
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.SinglePlayerGame, error)); ok {
+		return returnFunc(ctx, id, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.SinglePlayerGame); ok {
+		r0 = returnFunc(ctx, id, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SinglePlayerGame)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIdAndUserIdWithLock'
+type MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call struct {
+	*mock.Call
+}
+
+// FindByIdAndUserIdWithLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userId string
+func (_e *MockSinglePlayerGameRepository_Expecter) FindByIdAndUserIdWithLock(ctx interface{}, id interface{}, userId interface{}) *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call {
+	return &MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call{Call: _e.mock.On("FindByIdAndUserIdWithLock", ctx, id, userId)}
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call) Run(run func(ctx context.Context, id string, userId string)) *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call) Return(singlePlayerGame *entities.SinglePlayerGame, err error) *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call {
+	_c.Call.Return(singlePlayerGame, err)
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call) RunAndReturn(run func(ctx context.Context, id string, userId string) (*entities.SinglePlayerGame, error)) *MockSinglePlayerGameRepository_FindByIdAndUserIdWithLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIdWithLock provides a mock function for the type MockSinglePlayerGameRepository
+func (_mock *MockSinglePlayerGameRepository) FindByIdWithLock(ctx context.Context, id string) (*entities.SinglePlayerGame, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIdWithLock")
+	}
+
+	var r0 *entities.SinglePlayerGame
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.SinglePlayerGame, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.SinglePlayerGame); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SinglePlayerGame)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSinglePlayerGameRepository_FindByIdWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIdWithLock'
+type MockSinglePlayerGameRepository_FindByIdWithLock_Call struct {
+	*mock.Call
+}
+
+// FindByIdWithLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSinglePlayerGameRepository_Expecter) FindByIdWithLock(ctx interface{}, id interface{}) *MockSinglePlayerGameRepository_FindByIdWithLock_Call {
+	return &MockSinglePlayerGameRepository_FindByIdWithLock_Call{Call: _e.mock.On("FindByIdWithLock", ctx, id)}
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByIdWithLock_Call) Run(run func(ctx context.Context, id string)) *MockSinglePlayerGameRepository_FindByIdWithLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByIdWithLock_Call) Return(singlePlayerGame *entities.SinglePlayerGame, err error) *MockSinglePlayerGameRepository_FindByIdWithLock_Call {
+	_c.Call.Return(singlePlayerGame, err)
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByIdWithLock_Call) RunAndReturn(run func(ctx context.Context, id string) (*entities.SinglePlayerGame, error)) *MockSinglePlayerGameRepository_FindByIdWithLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserIdAndStatuses provides a mock function for the type MockSinglePlayerGameRepository
+func (_mock *MockSinglePlayerGameRepository) FindByUserIdAndStatuses(ctx context.Context, userId string, statuses []entities.SinglePlayerGameStatus) (*entities.SinglePlayerGame, error) {
+	ret := _mock.Called(ctx, userId, statuses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIdAndStatuses")
+	}
+
+	var r0 *entities.SinglePlayerGame
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []entities.SinglePlayerGameStatus) (*entities.SinglePlayerGame, error)); ok {
+		return returnFunc(ctx, userId, statuses)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []entities.SinglePlayerGameStatus) *entities.SinglePlayerGame); ok {
+		r0 = returnFunc(ctx, userId, statuses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SinglePlayerGame)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []entities.SinglePlayerGameStatus) error); ok {
+		r1 = returnFunc(ctx, userId, statuses)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserIdAndStatuses'
+type MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call struct {
+	*mock.Call
+}
+
+// FindByUserIdAndStatuses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - statuses []entities.SinglePlayerGameStatus
+func (_e *MockSinglePlayerGameRepository_Expecter) FindByUserIdAndStatuses(ctx interface{}, userId interface{}, statuses interface{}) *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call {
+	return &MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call{Call: _e.mock.On("FindByUserIdAndStatuses", ctx, userId, statuses)}
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call) Run(run func(ctx context.Context, userId string, statuses []entities.SinglePlayerGameStatus)) *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []entities.SinglePlayerGameStatus
+		if args[2] != nil {
+			arg2 = args[2].([]entities.SinglePlayerGameStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call) Return(singlePlayerGame *entities.SinglePlayerGame, err error) *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call {
+	_c.Call.Return(singlePlayerGame, err)
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call) RunAndReturn(run func(ctx context.Context, userId string, statuses []entities.SinglePlayerGameStatus) (*entities.SinglePlayerGame, error)) *MockSinglePlayerGameRepository_FindByUserIdAndStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRecentCompletedByUserIds provides a mock function for the type MockSinglePlayerGameRepository
+func (_mock *MockSinglePlayerGameRepository) FindRecentCompletedByUserIds(ctx context.Context, userIds []string, before time.Time, limit int) ([]*entities.SinglePlayerGame, error) {
+	ret := _mock.Called(ctx, userIds, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRecentCompletedByUserIds")
+	}
+
+	var r0 []*entities.SinglePlayerGame
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, time.Time, int) ([]*entities.SinglePlayerGame, error)); ok {
+		return returnFunc(ctx, userIds, before, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, time.Time, int) []*entities.SinglePlayerGame); ok {
+		r0 = returnFunc(ctx, userIds, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.SinglePlayerGame)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, userIds, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRecentCompletedByUserIds'
+type MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call struct {
+	*mock.Call
+}
+
+// FindRecentCompletedByUserIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIds []string
+//   - before time.Time
+//   - limit int
+func (_e *MockSinglePlayerGameRepository_Expecter) FindRecentCompletedByUserIds(ctx interface{}, userIds interface{}, before interface{}, limit interface{}) *MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call {
+	return &MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call{Call: _e.mock.On("FindRecentCompletedByUserIds", ctx, userIds, before, limit)}
+}
+
+func (_c *MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call) Run(run func(ctx context.Context, userIds []string, before time.Time, limit int)) *MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call) Return(singlePlayerGames []*entities.SinglePlayerGame, err error) *MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call {
+	_c.Call.Return(singlePlayerGames, err)
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call) RunAndReturn(run func(ctx context.Context, userIds []string, before time.Time, limit int) ([]*entities.SinglePlayerGame, error)) *MockSinglePlayerGameRepository_FindRecentCompletedByUserIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockSinglePlayerGameRepository
+func (_mock *MockSinglePlayerGameRepository) Update(ctx context.Context, game *entities.SinglePlayerGame) error {
+	ret := _mock.Called(ctx, game)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.SinglePlayerGame) error); ok {
+		r0 = returnFunc(ctx, game)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSinglePlayerGameRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSinglePlayerGameRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - game *entities.SinglePlayerGame
+func (_e *MockSinglePlayerGameRepository_Expecter) Update(ctx interface{}, game interface{}) *MockSinglePlayerGameRepository_Update_Call {
+	return &MockSinglePlayerGameRepository_Update_Call{Call: _e.mock.On("Update", ctx, game)}
+}
+
+func (_c *MockSinglePlayerGameRepository_Update_Call) Run(run func(ctx context.Context, game *entities.SinglePlayerGame)) *MockSinglePlayerGameRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.SinglePlayerGame
+		if args[1] != nil {
+			arg1 = args[1].(*entities.SinglePlayerGame)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_Update_Call) Return(err error) *MockSinglePlayerGameRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSinglePlayerGameRepository_Update_Call) RunAndReturn(run func(ctx context.Context, game *entities.SinglePlayerGame) error) *MockSinglePlayerGameRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSinglePlayerRoundRepository creates a new instance of MockSinglePlayerRoundRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSinglePlayerRoundRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSinglePlayerRoundRepository {
+	mock := &MockSinglePlayerRoundRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSinglePlayerRoundRepository is an autogenerated mock type for the SinglePlayerRoundRepository type
+type MockSinglePlayerRoundRepository struct {
+	mock.Mock
+}
+
+type MockSinglePlayerRoundRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSinglePlayerRoundRepository) EXPECT() *MockSinglePlayerRoundRepository_Expecter {
+	return &MockSinglePlayerRoundRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindByGameId provides a mock function for the type MockSinglePlayerRoundRepository
+func (_mock *MockSinglePlayerRoundRepository) FindByGameId(ctx context.Context, gameId string) ([]*entities.SinglePlayerRound, error) {
+	ret := _mock.Called(ctx, gameId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGameId")
+	}
+
+	var r0 []*entities.SinglePlayerRound
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.SinglePlayerRound, error)); ok {
+		return returnFunc(ctx, gameId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.SinglePlayerRound); ok {
+		r0 = returnFunc(ctx, gameId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.SinglePlayerRound)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, gameId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSinglePlayerRoundRepository_FindByGameId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByGameId'
+type MockSinglePlayerRoundRepository_FindByGameId_Call struct {
+	*mock.Call
+}
+
+// FindByGameId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameId string
+func (_e *MockSinglePlayerRoundRepository_Expecter) FindByGameId(ctx interface{}, gameId interface{}) *MockSinglePlayerRoundRepository_FindByGameId_Call {
+	return &MockSinglePlayerRoundRepository_FindByGameId_Call{Call: _e.mock.On("FindByGameId", ctx, gameId)}
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByGameId_Call) Run(run func(ctx context.Context, gameId string)) *MockSinglePlayerRoundRepository_FindByGameId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByGameId_Call) Return(singlePlayerRounds []*entities.SinglePlayerRound, err error) *MockSinglePlayerRoundRepository_FindByGameId_Call {
+	_c.Call.Return(singlePlayerRounds, err)
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByGameId_Call) RunAndReturn(run func(ctx context.Context, gameId string) ([]*entities.SinglePlayerRound, error)) *MockSinglePlayerRoundRepository_FindByGameId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByGameIdAndRoundNumberWithLock provides a mock function for the type MockSinglePlayerRoundRepository
+func (_mock *MockSinglePlayerRoundRepository) FindByGameIdAndRoundNumberWithLock(ctx context.Context, gameId string, roundNumber int) (*entities.SinglePlayerRound, error) {
+	ret := _mock.Called(ctx, gameId, roundNumber)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGameIdAndRoundNumberWithLock")
+	}
+
+	var r0 *entities.SinglePlayerRound
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (*entities.SinglePlayerRound, error)); ok {
+		return returnFunc(ctx, gameId, roundNumber)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) *entities.SinglePlayerRound); ok {
+		r0 = returnFunc(ctx, gameId, roundNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SinglePlayerRound)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, gameId, roundNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByGameIdAndRoundNumberWithLock'
+type MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call struct {
+	*mock.Call
+}
+
+// FindByGameIdAndRoundNumberWithLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gameId string
+//   - roundNumber int
+func (_e *MockSinglePlayerRoundRepository_Expecter) FindByGameIdAndRoundNumberWithLock(ctx interface{}, gameId interface{}, roundNumber interface{}) *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call {
+	return &MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call{Call: _e.mock.On("FindByGameIdAndRoundNumberWithLock", ctx, gameId, roundNumber)}
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call) Run(run func(ctx context.Context, gameId string, roundNumber int)) *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call) Return(singlePlayerRound *entities.SinglePlayerRound, err error) *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call {
+	_c.Call.Return(singlePlayerRound, err)
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call) RunAndReturn(run func(ctx context.Context, gameId string, roundNumber int) (*entities.SinglePlayerRound, error)) *MockSinglePlayerRoundRepository_FindByGameIdAndRoundNumberWithLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIdAndGameIdWithLock provides a mock function for the type MockSinglePlayerRoundRepository
+func (_mock *MockSinglePlayerRoundRepository) FindByIdAndGameIdWithLock(ctx context.Context, id string, gameId string) (*entities.SinglePlayerRound, error) {
+	ret := _mock.Called(ctx, id, gameId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIdAndGameIdWithLock")
+	}
+
+	var r0 *entities.SinglePlayerRound
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.SinglePlayerRound, error)); ok {
+		return returnFunc(ctx, id, gameId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.SinglePlayerRound); ok {
+		r0 = returnFunc(ctx, id, gameId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SinglePlayerRound)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, gameId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIdAndGameIdWithLock'
+type MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call struct {
+	*mock.Call
+}
+
+// FindByIdAndGameIdWithLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - gameId string
+func (_e *MockSinglePlayerRoundRepository_Expecter) FindByIdAndGameIdWithLock(ctx interface{}, id interface{}, gameId interface{}) *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call {
+	return &MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call{Call: _e.mock.On("FindByIdAndGameIdWithLock", ctx, id, gameId)}
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call) Run(run func(ctx context.Context, id string, gameId string)) *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call) Return(singlePlayerRound *entities.SinglePlayerRound, err error) *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call {
+	_c.Call.Return(singlePlayerRound, err)
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call) RunAndReturn(run func(ctx context.Context, id string, gameId string) (*entities.SinglePlayerRound, error)) *MockSinglePlayerRoundRepository_FindByIdAndGameIdWithLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSeenLocationIds provides a mock function for the type MockSinglePlayerRoundRepository
+func (_mock *MockSinglePlayerRoundRepository) FindSeenLocationIds(ctx context.Context, userId string, mapId string, since time.Time, limit int) ([]string, error) {
+	ret := _mock.Called(ctx, userId, mapId, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSeenLocationIds")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, int) ([]string, error)); ok {
+		return returnFunc(ctx, userId, mapId, since, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time, int) []string); ok {
+		r0 = returnFunc(ctx, userId, mapId, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, userId, mapId, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSinglePlayerRoundRepository_FindSeenLocationIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSeenLocationIds'
+type MockSinglePlayerRoundRepository_FindSeenLocationIds_Call struct {
+	*mock.Call
+}
+
+// FindSeenLocationIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - mapId string
+//   - since time.Time
+//   - limit int
+func (_e *MockSinglePlayerRoundRepository_Expecter) FindSeenLocationIds(ctx interface{}, userId interface{}, mapId interface{}, since interface{}, limit interface{}) *MockSinglePlayerRoundRepository_FindSeenLocationIds_Call {
+	return &MockSinglePlayerRoundRepository_FindSeenLocationIds_Call{Call: _e.mock.On("FindSeenLocationIds", ctx, userId, mapId, since, limit)}
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindSeenLocationIds_Call) Run(run func(ctx context.Context, userId string, mapId string, since time.Time, limit int)) *MockSinglePlayerRoundRepository_FindSeenLocationIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindSeenLocationIds_Call) Return(strings []string, err error) *MockSinglePlayerRoundRepository_FindSeenLocationIds_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_FindSeenLocationIds_Call) RunAndReturn(run func(ctx context.Context, userId string, mapId string, since time.Time, limit int) ([]string, error)) *MockSinglePlayerRoundRepository_FindSeenLocationIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockSinglePlayerRoundRepository
+func (_mock *MockSinglePlayerRoundRepository) Update(ctx context.Context, round *entities.SinglePlayerRound) error {
+	ret := _mock.Called(ctx, round)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.SinglePlayerRound) error); ok {
+		r0 = returnFunc(ctx, round)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSinglePlayerRoundRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSinglePlayerRoundRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - round *entities.SinglePlayerRound
+func (_e *MockSinglePlayerRoundRepository_Expecter) Update(ctx interface{}, round interface{}) *MockSinglePlayerRoundRepository_Update_Call {
+	return &MockSinglePlayerRoundRepository_Update_Call{Call: _e.mock.On("Update", ctx, round)}
+}
+
+func (_c *MockSinglePlayerRoundRepository_Update_Call) Run(run func(ctx context.Context, round *entities.SinglePlayerRound)) *MockSinglePlayerRoundRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.SinglePlayerRound
+		if args[1] != nil {
+			arg1 = args[1].(*entities.SinglePlayerRound)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_Update_Call) Return(err error) *MockSinglePlayerRoundRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSinglePlayerRoundRepository_Update_Call) RunAndReturn(run func(ctx context.Context, round *entities.SinglePlayerRound) error) *MockSinglePlayerRoundRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTwoFactorBackupCodeRepository creates a new instance of MockTwoFactorBackupCodeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTwoFactorBackupCodeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTwoFactorBackupCodeRepository {
+	mock := &MockTwoFactorBackupCodeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTwoFactorBackupCodeRepository is an autogenerated mock type for the TwoFactorBackupCodeRepository type
+type MockTwoFactorBackupCodeRepository struct {
+	mock.Mock
+}
+
+type MockTwoFactorBackupCodeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTwoFactorBackupCodeRepository) EXPECT() *MockTwoFactorBackupCodeRepository_Expecter {
+	return &MockTwoFactorBackupCodeRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountUnusedByUserId provides a mock function for the type MockTwoFactorBackupCodeRepository
+func (_mock *MockTwoFactorBackupCodeRepository) CountUnusedByUserId(ctx context.Context, userId string) (int64, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnusedByUserId")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnusedByUserId'
+type MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call struct {
+	*mock.Call
+}
+
+// CountUnusedByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockTwoFactorBackupCodeRepository_Expecter) CountUnusedByUserId(ctx interface{}, userId interface{}) *MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call {
+	return &MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call{Call: _e.mock.On("CountUnusedByUserId", ctx, userId)}
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call) Return(n int64, err error) *MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) (int64, error)) *MockTwoFactorBackupCodeRepository_CountUnusedByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMany provides a mock function for the type MockTwoFactorBackupCodeRepository
+func (_mock *MockTwoFactorBackupCodeRepository) CreateMany(ctx context.Context, codes []*entities.TwoFactorBackupCode) error {
+	ret := _mock.Called(ctx, codes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMany")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.TwoFactorBackupCode) error); ok {
+		r0 = returnFunc(ctx, codes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTwoFactorBackupCodeRepository_CreateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMany'
+type MockTwoFactorBackupCodeRepository_CreateMany_Call struct {
+	*mock.Call
+}
+
+// CreateMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - codes []*entities.TwoFactorBackupCode
+func (_e *MockTwoFactorBackupCodeRepository_Expecter) CreateMany(ctx interface{}, codes interface{}) *MockTwoFactorBackupCodeRepository_CreateMany_Call {
+	return &MockTwoFactorBackupCodeRepository_CreateMany_Call{Call: _e.mock.On("CreateMany", ctx, codes)}
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_CreateMany_Call) Run(run func(ctx context.Context, codes []*entities.TwoFactorBackupCode)) *MockTwoFactorBackupCodeRepository_CreateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.TwoFactorBackupCode
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.TwoFactorBackupCode)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_CreateMany_Call) Return(err error) *MockTwoFactorBackupCodeRepository_CreateMany_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_CreateMany_Call) RunAndReturn(run func(ctx context.Context, codes []*entities.TwoFactorBackupCode) error) *MockTwoFactorBackupCodeRepository_CreateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserId provides a mock function for the type MockTwoFactorBackupCodeRepository
+func (_mock *MockTwoFactorBackupCodeRepository) DeleteByUserId(ctx context.Context, userId string) error {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserId")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTwoFactorBackupCodeRepository_DeleteByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserId'
+type MockTwoFactorBackupCodeRepository_DeleteByUserId_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockTwoFactorBackupCodeRepository_Expecter) DeleteByUserId(ctx interface{}, userId interface{}) *MockTwoFactorBackupCodeRepository_DeleteByUserId_Call {
+	return &MockTwoFactorBackupCodeRepository_DeleteByUserId_Call{Call: _e.mock.On("DeleteByUserId", ctx, userId)}
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_DeleteByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockTwoFactorBackupCodeRepository_DeleteByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_DeleteByUserId_Call) Return(err error) *MockTwoFactorBackupCodeRepository_DeleteByUserId_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_DeleteByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) error) *MockTwoFactorBackupCodeRepository_DeleteByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUnusedByUserIdAndCodeHash provides a mock function for the type MockTwoFactorBackupCodeRepository
+func (_mock *MockTwoFactorBackupCodeRepository) FindUnusedByUserIdAndCodeHash(ctx context.Context, userId string, codeHash string) (*entities.TwoFactorBackupCode, error) {
+	ret := _mock.Called(ctx, userId, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnusedByUserIdAndCodeHash")
+	}
+
+	var r0 *entities.TwoFactorBackupCode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.TwoFactorBackupCode, error)); ok {
+		return returnFunc(ctx, userId, codeHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.TwoFactorBackupCode); ok {
+		r0 = returnFunc(ctx, userId, codeHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.TwoFactorBackupCode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userId, codeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnusedByUserIdAndCodeHash'
+type MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call struct {
+	*mock.Call
+}
+
+// FindUnusedByUserIdAndCodeHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - codeHash string
+func (_e *MockTwoFactorBackupCodeRepository_Expecter) FindUnusedByUserIdAndCodeHash(ctx interface{}, userId interface{}, codeHash interface{}) *MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call {
+	return &MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call{Call: _e.mock.On("FindUnusedByUserIdAndCodeHash", ctx, userId, codeHash)}
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call) Run(run func(ctx context.Context, userId string, codeHash string)) *MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call) Return(twoFactorBackupCode *entities.TwoFactorBackupCode, err error) *MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call {
+	_c.Call.Return(twoFactorBackupCode, err)
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call) RunAndReturn(run func(ctx context.Context, userId string, codeHash string) (*entities.TwoFactorBackupCode, error)) *MockTwoFactorBackupCodeRepository_FindUnusedByUserIdAndCodeHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockTwoFactorBackupCodeRepository
+func (_mock *MockTwoFactorBackupCodeRepository) Update(ctx context.Context, code *entities.TwoFactorBackupCode) error {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.TwoFactorBackupCode) error); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTwoFactorBackupCodeRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockTwoFactorBackupCodeRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code *entities.TwoFactorBackupCode
+func (_e *MockTwoFactorBackupCodeRepository_Expecter) Update(ctx interface{}, code interface{}) *MockTwoFactorBackupCodeRepository_Update_Call {
+	return &MockTwoFactorBackupCodeRepository_Update_Call{Call: _e.mock.On("Update", ctx, code)}
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_Update_Call) Run(run func(ctx context.Context, code *entities.TwoFactorBackupCode)) *MockTwoFactorBackupCodeRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.TwoFactorBackupCode
+		if args[1] != nil {
+			arg1 = args[1].(*entities.TwoFactorBackupCode)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_Update_Call) Return(err error) *MockTwoFactorBackupCodeRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTwoFactorBackupCodeRepository_Update_Call) RunAndReturn(run func(ctx context.Context, code *entities.TwoFactorBackupCode) error) *MockTwoFactorBackupCodeRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTwoFactorRepository creates a new instance of MockTwoFactorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTwoFactorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTwoFactorRepository {
+	mock := &MockTwoFactorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockTwoFactorRepository is an autogenerated mock type for the TwoFactorRepository type
+type MockTwoFactorRepository struct {
+	mock.Mock
+}
+
+type MockTwoFactorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTwoFactorRepository) EXPECT() *MockTwoFactorRepository_Expecter {
+	return &MockTwoFactorRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockTwoFactorRepository
+func (_mock *MockTwoFactorRepository) Create(ctx context.Context, twoFactor *entities.UserTwoFactor) error {
+	ret := _mock.Called(ctx, twoFactor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.UserTwoFactor) error); ok {
+		r0 = returnFunc(ctx, twoFactor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTwoFactorRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTwoFactorRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - twoFactor *entities.UserTwoFactor
+func (_e *MockTwoFactorRepository_Expecter) Create(ctx interface{}, twoFactor interface{}) *MockTwoFactorRepository_Create_Call {
+	return &MockTwoFactorRepository_Create_Call{Call: _e.mock.On("Create", ctx, twoFactor)}
+}
+
+func (_c *MockTwoFactorRepository_Create_Call) Run(run func(ctx context.Context, twoFactor *entities.UserTwoFactor)) *MockTwoFactorRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.UserTwoFactor
+		if args[1] != nil {
+			arg1 = args[1].(*entities.UserTwoFactor)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_Create_Call) Return(err error) *MockTwoFactorRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_Create_Call) RunAndReturn(run func(ctx context.Context, twoFactor *entities.UserTwoFactor) error) *MockTwoFactorRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockTwoFactorRepository
+func (_mock *MockTwoFactorRepository) Delete(ctx context.Context, twoFactor *entities.UserTwoFactor) error {
+	ret := _mock.Called(ctx, twoFactor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.UserTwoFactor) error); ok {
+		r0 = returnFunc(ctx, twoFactor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTwoFactorRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTwoFactorRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - twoFactor *entities.UserTwoFactor
+func (_e *MockTwoFactorRepository_Expecter) Delete(ctx interface{}, twoFactor interface{}) *MockTwoFactorRepository_Delete_Call {
+	return &MockTwoFactorRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, twoFactor)}
+}
+
+func (_c *MockTwoFactorRepository_Delete_Call) Run(run func(ctx context.Context, twoFactor *entities.UserTwoFactor)) *MockTwoFactorRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.UserTwoFactor
+		if args[1] != nil {
+			arg1 = args[1].(*entities.UserTwoFactor)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_Delete_Call) Return(err error) *MockTwoFactorRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, twoFactor *entities.UserTwoFactor) error) *MockTwoFactorRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserId provides a mock function for the type MockTwoFactorRepository
+func (_mock *MockTwoFactorRepository) FindByUserId(ctx context.Context, userId string) (*entities.UserTwoFactor, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 *entities.UserTwoFactor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.UserTwoFactor, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.UserTwoFactor); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserTwoFactor)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTwoFactorRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockTwoFactorRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockTwoFactorRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockTwoFactorRepository_FindByUserId_Call {
+	return &MockTwoFactorRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
+}
+
+func (_c *MockTwoFactorRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockTwoFactorRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_FindByUserId_Call) Return(userTwoFactor *entities.UserTwoFactor, err error) *MockTwoFactorRepository_FindByUserId_Call {
+	_c.Call.Return(userTwoFactor, err)
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) (*entities.UserTwoFactor, error)) *MockTwoFactorRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserIdWithLock provides a mock function for the type MockTwoFactorRepository
+func (_mock *MockTwoFactorRepository) FindByUserIdWithLock(ctx context.Context, userId string) (*entities.UserTwoFactor, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIdWithLock")
+	}
+
+	var r0 *entities.UserTwoFactor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.UserTwoFactor, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.UserTwoFactor); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserTwoFactor)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTwoFactorRepository_FindByUserIdWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserIdWithLock'
+type MockTwoFactorRepository_FindByUserIdWithLock_Call struct {
+	*mock.Call
+}
+
+// FindByUserIdWithLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockTwoFactorRepository_Expecter) FindByUserIdWithLock(ctx interface{}, userId interface{}) *MockTwoFactorRepository_FindByUserIdWithLock_Call {
+	return &MockTwoFactorRepository_FindByUserIdWithLock_Call{Call: _e.mock.On("FindByUserIdWithLock", ctx, userId)}
+}
+
+func (_c *MockTwoFactorRepository_FindByUserIdWithLock_Call) Run(run func(ctx context.Context, userId string)) *MockTwoFactorRepository_FindByUserIdWithLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_FindByUserIdWithLock_Call) Return(userTwoFactor *entities.UserTwoFactor, err error) *MockTwoFactorRepository_FindByUserIdWithLock_Call {
+	_c.Call.Return(userTwoFactor, err)
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_FindByUserIdWithLock_Call) RunAndReturn(run func(ctx context.Context, userId string) (*entities.UserTwoFactor, error)) *MockTwoFactorRepository_FindByUserIdWithLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockTwoFactorRepository
+func (_mock *MockTwoFactorRepository) Update(ctx context.Context, twoFactor *entities.UserTwoFactor) error {
+	ret := _mock.Called(ctx, twoFactor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.UserTwoFactor) error); ok {
+		r0 = returnFunc(ctx, twoFactor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTwoFactorRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockTwoFactorRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - twoFactor *entities.UserTwoFactor
+func (_e *MockTwoFactorRepository_Expecter) Update(ctx interface{}, twoFactor interface{}) *MockTwoFactorRepository_Update_Call {
+	return &MockTwoFactorRepository_Update_Call{Call: _e.mock.On("Update", ctx, twoFactor)}
+}
+
+func (_c *MockTwoFactorRepository_Update_Call) Run(run func(ctx context.Context, twoFactor *entities.UserTwoFactor)) *MockTwoFactorRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.UserTwoFactor
+		if args[1] != nil {
+			arg1 = args[1].(*entities.UserTwoFactor)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_Update_Call) Return(err error) *MockTwoFactorRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTwoFactorRepository_Update_Call) RunAndReturn(run func(ctx context.Context, twoFactor *entities.UserTwoFactor) error) *MockTwoFactorRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserAchievementRepository creates a new instance of MockUserAchievementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserAchievementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserAchievementRepository {
+	mock := &MockUserAchievementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserAchievementRepository is an autogenerated mock type for the UserAchievementRepository type
+type MockUserAchievementRepository struct {
+	mock.Mock
+}
+
+type MockUserAchievementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserAchievementRepository) EXPECT() *MockUserAchievementRepository_Expecter {
+	return &MockUserAchievementRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindByUserId provides a mock function for the type MockUserAchievementRepository
+func (_mock *MockUserAchievementRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.UserAchievement, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 []*entities.UserAchievement
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.UserAchievement, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.UserAchievement); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserAchievement)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserAchievementRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockUserAchievementRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockUserAchievementRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockUserAchievementRepository_FindByUserId_Call {
+	return &MockUserAchievementRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
+}
+
+func (_c *MockUserAchievementRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockUserAchievementRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserAchievementRepository_FindByUserId_Call) Return(userAchievements []*entities.UserAchievement, err error) *MockUserAchievementRepository_FindByUserId_Call {
+	_c.Call.Return(userAchievements, err)
+	return _c
+}
+
+func (_c *MockUserAchievementRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) ([]*entities.UserAchievement, error)) *MockUserAchievementRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unlock provides a mock function for the type MockUserAchievementRepository
+func (_mock *MockUserAchievementRepository) Unlock(ctx context.Context, achievements []*entities.UserAchievement) error {
+	ret := _mock.Called(ctx, achievements)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entities.UserAchievement) error); ok {
+		r0 = returnFunc(ctx, achievements)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserAchievementRepository_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type MockUserAchievementRepository_Unlock_Call struct {
+	*mock.Call
+}
+
+// Unlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - achievements []*entities.UserAchievement
+func (_e *MockUserAchievementRepository_Expecter) Unlock(ctx interface{}, achievements interface{}) *MockUserAchievementRepository_Unlock_Call {
+	return &MockUserAchievementRepository_Unlock_Call{Call: _e.mock.On("Unlock", ctx, achievements)}
+}
+
+func (_c *MockUserAchievementRepository_Unlock_Call) Run(run func(ctx context.Context, achievements []*entities.UserAchievement)) *MockUserAchievementRepository_Unlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entities.UserAchievement
+		if args[1] != nil {
+			arg1 = args[1].([]*entities.UserAchievement)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserAchievementRepository_Unlock_Call) Return(err error) *MockUserAchievementRepository_Unlock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserAchievementRepository_Unlock_Call) RunAndReturn(run func(ctx context.Context, achievements []*entities.UserAchievement) error) *MockUserAchievementRepository_Unlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserBlockRepository creates a new instance of MockUserBlockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserBlockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserBlockRepository {
+	mock := &MockUserBlockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserBlockRepository is an autogenerated mock type for the UserBlockRepository type
+type MockUserBlockRepository struct {
+	mock.Mock
+}
+
+type MockUserBlockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserBlockRepository) EXPECT() *MockUserBlockRepository_Expecter {
+	return &MockUserBlockRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserBlockRepository
+func (_mock *MockUserBlockRepository) Create(ctx context.Context, block *entities.UserBlock) error {
+	ret := _mock.Called(ctx, block)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.UserBlock) error); ok {
+		r0 = returnFunc(ctx, block)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserBlockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserBlockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - block *entities.UserBlock
+func (_e *MockUserBlockRepository_Expecter) Create(ctx interface{}, block interface{}) *MockUserBlockRepository_Create_Call {
+	return &MockUserBlockRepository_Create_Call{Call: _e.mock.On("Create", ctx, block)}
+}
+
+func (_c *MockUserBlockRepository_Create_Call) Run(run func(ctx context.Context, block *entities.UserBlock)) *MockUserBlockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.UserBlock
+		if args[1] != nil {
+			arg1 = args[1].(*entities.UserBlock)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserBlockRepository_Create_Call) Return(err error) *MockUserBlockRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserBlockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, block *entities.UserBlock) error) *MockUserBlockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockUserBlockRepository
+func (_mock *MockUserBlockRepository) Delete(ctx context.Context, blockerId string, blockedId string) error {
+	ret := _mock.Called(ctx, blockerId, blockedId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, blockerId, blockedId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserBlockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserBlockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockerId string
+//   - blockedId string
+func (_e *MockUserBlockRepository_Expecter) Delete(ctx interface{}, blockerId interface{}, blockedId interface{}) *MockUserBlockRepository_Delete_Call {
+	return &MockUserBlockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, blockerId, blockedId)}
+}
+
+func (_c *MockUserBlockRepository_Delete_Call) Run(run func(ctx context.Context, blockerId string, blockedId string)) *MockUserBlockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserBlockRepository_Delete_Call) Return(err error) *MockUserBlockRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserBlockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, blockerId string, blockedId string) error) *MockUserBlockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistsBetween provides a mock function for the type MockUserBlockRepository
+func (_mock *MockUserBlockRepository) ExistsBetween(ctx context.Context, userId string, otherUserId string) (bool, error) {
+	ret := _mock.Called(ctx, userId, otherUserId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsBetween")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, userId, otherUserId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, userId, otherUserId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userId, otherUserId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserBlockRepository_ExistsBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsBetween'
+type MockUserBlockRepository_ExistsBetween_Call struct {
+	*mock.Call
+}
+
+// ExistsBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - otherUserId string
+func (_e *MockUserBlockRepository_Expecter) ExistsBetween(ctx interface{}, userId interface{}, otherUserId interface{}) *MockUserBlockRepository_ExistsBetween_Call {
+	return &MockUserBlockRepository_ExistsBetween_Call{Call: _e.mock.On("ExistsBetween", ctx, userId, otherUserId)}
+}
+
+func (_c *MockUserBlockRepository_ExistsBetween_Call) Run(run func(ctx context.Context, userId string, otherUserId string)) *MockUserBlockRepository_ExistsBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserBlockRepository_ExistsBetween_Call) Return(b bool, err error) *MockUserBlockRepository_ExistsBetween_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserBlockRepository_ExistsBetween_Call) RunAndReturn(run func(ctx context.Context, userId string, otherUserId string) (bool, error)) *MockUserBlockRepository_ExistsBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByBlockerId provides a mock function for the type MockUserBlockRepository
+func (_mock *MockUserBlockRepository) FindByBlockerId(ctx context.Context, blockerId string) ([]*entities.UserBlock, error) {
+	ret := _mock.Called(ctx, blockerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByBlockerId")
+	}
+
+	var r0 []*entities.UserBlock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.UserBlock, error)); ok {
+		return returnFunc(ctx, blockerId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.UserBlock); ok {
+		r0 = returnFunc(ctx, blockerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserBlock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, blockerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserBlockRepository_FindByBlockerId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByBlockerId'
+type MockUserBlockRepository_FindByBlockerId_Call struct {
+	*mock.Call
+}
+
+// FindByBlockerId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockerId string
+func (_e *MockUserBlockRepository_Expecter) FindByBlockerId(ctx interface{}, blockerId interface{}) *MockUserBlockRepository_FindByBlockerId_Call {
+	return &MockUserBlockRepository_FindByBlockerId_Call{Call: _e.mock.On("FindByBlockerId", ctx, blockerId)}
+}
+
+func (_c *MockUserBlockRepository_FindByBlockerId_Call) Run(run func(ctx context.Context, blockerId string)) *MockUserBlockRepository_FindByBlockerId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserBlockRepository_FindByBlockerId_Call) Return(userBlocks []*entities.UserBlock, err error) *MockUserBlockRepository_FindByBlockerId_Call {
+	_c.Call.Return(userBlocks, err)
+	return _c
+}
+
+func (_c *MockUserBlockRepository_FindByBlockerId_Call) RunAndReturn(run func(ctx context.Context, blockerId string) ([]*entities.UserBlock, error)) *MockUserBlockRepository_FindByBlockerId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserIdentityRepository creates a new instance of MockUserIdentityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserIdentityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserIdentityRepository {
+	mock := &MockUserIdentityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserIdentityRepository is an autogenerated mock type for the UserIdentityRepository type
+type MockUserIdentityRepository struct {
+	mock.Mock
+}
+
+type MockUserIdentityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserIdentityRepository) EXPECT() *MockUserIdentityRepository_Expecter {
+	return &MockUserIdentityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUserIdentityRepository
+func (_mock *MockUserIdentityRepository) Create(ctx context.Context, identity *entities.UserIdentity) error {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.UserIdentity) error); ok {
+		r0 = returnFunc(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserIdentityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUserIdentityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *entities.UserIdentity
+func (_e *MockUserIdentityRepository_Expecter) Create(ctx interface{}, identity interface{}) *MockUserIdentityRepository_Create_Call {
+	return &MockUserIdentityRepository_Create_Call{Call: _e.mock.On("Create", ctx, identity)}
+}
+
+func (_c *MockUserIdentityRepository_Create_Call) Run(run func(ctx context.Context, identity *entities.UserIdentity)) *MockUserIdentityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.UserIdentity
+		if args[1] != nil {
+			arg1 = args[1].(*entities.UserIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserIdentityRepository_Create_Call) Return(err error) *MockUserIdentityRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserIdentityRepository_Create_Call) RunAndReturn(run func(ctx context.Context, identity *entities.UserIdentity) error) *MockUserIdentityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockUserIdentityRepository
+func (_mock *MockUserIdentityRepository) Delete(ctx context.Context, identity *entities.UserIdentity) error {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.UserIdentity) error); ok {
+		r0 = returnFunc(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserIdentityRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserIdentityRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *entities.UserIdentity
+func (_e *MockUserIdentityRepository_Expecter) Delete(ctx interface{}, identity interface{}) *MockUserIdentityRepository_Delete_Call {
+	return &MockUserIdentityRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, identity)}
+}
+
+func (_c *MockUserIdentityRepository_Delete_Call) Run(run func(ctx context.Context, identity *entities.UserIdentity)) *MockUserIdentityRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.UserIdentity
+		if args[1] != nil {
+			arg1 = args[1].(*entities.UserIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserIdentityRepository_Delete_Call) Return(err error) *MockUserIdentityRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserIdentityRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, identity *entities.UserIdentity) error) *MockUserIdentityRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByProviderAndSubject provides a mock function for the type MockUserIdentityRepository
+func (_mock *MockUserIdentityRepository) FindByProviderAndSubject(ctx context.Context, provider string, subject string) (*entities.UserIdentity, error) {
+	ret := _mock.Called(ctx, provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProviderAndSubject")
+	}
+
+	var r0 *entities.UserIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.UserIdentity, error)); ok {
+		return returnFunc(ctx, provider, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.UserIdentity); ok {
+		r0 = returnFunc(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserIdentityRepository_FindByProviderAndSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProviderAndSubject'
+type MockUserIdentityRepository_FindByProviderAndSubject_Call struct {
+	*mock.Call
+}
+
+// FindByProviderAndSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - subject string
+func (_e *MockUserIdentityRepository_Expecter) FindByProviderAndSubject(ctx interface{}, provider interface{}, subject interface{}) *MockUserIdentityRepository_FindByProviderAndSubject_Call {
+	return &MockUserIdentityRepository_FindByProviderAndSubject_Call{Call: _e.mock.On("FindByProviderAndSubject", ctx, provider, subject)}
+}
+
+func (_c *MockUserIdentityRepository_FindByProviderAndSubject_Call) Run(run func(ctx context.Context, provider string, subject string)) *MockUserIdentityRepository_FindByProviderAndSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserIdentityRepository_FindByProviderAndSubject_Call) Return(userIdentity *entities.UserIdentity, err error) *MockUserIdentityRepository_FindByProviderAndSubject_Call {
+	_c.Call.Return(userIdentity, err)
+	return _c
+}
+
+func (_c *MockUserIdentityRepository_FindByProviderAndSubject_Call) RunAndReturn(run func(ctx context.Context, provider string, subject string) (*entities.UserIdentity, error)) *MockUserIdentityRepository_FindByProviderAndSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserId provides a mock function for the type MockUserIdentityRepository
+func (_mock *MockUserIdentityRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.UserIdentity, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 []*entities.UserIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*entities.UserIdentity, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*entities.UserIdentity); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserIdentityRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockUserIdentityRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockUserIdentityRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockUserIdentityRepository_FindByUserId_Call {
+	return &MockUserIdentityRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
+}
+
+func (_c *MockUserIdentityRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockUserIdentityRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserIdentityRepository_FindByUserId_Call) Return(userIdentitys []*entities.UserIdentity, err error) *MockUserIdentityRepository_FindByUserId_Call {
+	_c.Call.Return(userIdentitys, err)
+	return _c
+}
+
+func (_c *MockUserIdentityRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) ([]*entities.UserIdentity, error)) *MockUserIdentityRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserIdAndProvider provides a mock function for the type MockUserIdentityRepository
+func (_mock *MockUserIdentityRepository) FindByUserIdAndProvider(ctx context.Context, userId string, provider string) (*entities.UserIdentity, error) {
+	ret := _mock.Called(ctx, userId, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIdAndProvider")
+	}
+
+	var r0 *entities.UserIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*entities.UserIdentity, error)); ok {
+		return returnFunc(ctx, userId, provider)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *entities.UserIdentity); ok {
+		r0 = returnFunc(ctx, userId, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userId, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserIdentityRepository_FindByUserIdAndProvider_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserIdAndProvider'
+type MockUserIdentityRepository_FindByUserIdAndProvider_Call struct {
+	*mock.Call
+}
+
+// FindByUserIdAndProvider is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - provider string
+func (_e *MockUserIdentityRepository_Expecter) FindByUserIdAndProvider(ctx interface{}, userId interface{}, provider interface{}) *MockUserIdentityRepository_FindByUserIdAndProvider_Call {
+	return &MockUserIdentityRepository_FindByUserIdAndProvider_Call{Call: _e.mock.On("FindByUserIdAndProvider", ctx, userId, provider)}
 }
 
-func (_c *MockSinglePlayerRoundRepository_Update_Call) Run(run func(ctx context.Context, round *entities.SinglePlayerRound)) *MockSinglePlayerRoundRepository_Update_Call {
+func (_c *MockUserIdentityRepository_FindByUserIdAndProvider_Call) Run(run func(ctx context.Context, userId string, provider string)) *MockUserIdentityRepository_FindByUserIdAndProvider_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *entities.SinglePlayerRound
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*entities.SinglePlayerRound)
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSinglePlayerRoundRepository_Update_Call) Return(err error) *MockSinglePlayerRoundRepository_Update_Call {
-	_c.Call.Return(err)
+func (_c *MockUserIdentityRepository_FindByUserIdAndProvider_Call) Return(userIdentity *entities.UserIdentity, err error) *MockUserIdentityRepository_FindByUserIdAndProvider_Call {
+	_c.Call.Return(userIdentity, err)
 	return _c
 }
 
-func (_c *MockSinglePlayerRoundRepository_Update_Call) RunAndReturn(run func(ctx context.Context, round *entities.SinglePlayerRound) error) *MockSinglePlayerRoundRepository_Update_Call {
+func (_c *MockUserIdentityRepository_FindByUserIdAndProvider_Call) RunAndReturn(run func(ctx context.Context, userId string, provider string) (*entities.UserIdentity, error)) *MockUserIdentityRepository_FindByUserIdAndProvider_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1201,6 +9625,63 @@ func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// Delete provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Delete(ctx context.Context, user *entities.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockUserRepository_Expecter) Delete(ctx interface{}, user interface{}) *MockUserRepository_Delete_Call {
+	return &MockUserRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, user)}
+}
+
+func (_c *MockUserRepository_Delete_Call) Run(run func(ctx context.Context, user *entities.User)) *MockUserRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) Return(err error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) error) *MockUserRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByEmail provides a mock function for the type MockUserRepository
 func (_mock *MockUserRepository) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
 	ret := _mock.Called(ctx, email)
@@ -1404,3 +9885,286 @@ func (_c *MockUserRepository_FindByUsername_Call) RunAndReturn(run func(ctx cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// Search provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Search(ctx context.Context, filter repositories.UserSearchFilter) ([]*entities.User, int64, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*entities.User
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.UserSearchFilter) ([]*entities.User, int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repositories.UserSearchFilter) []*entities.User); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repositories.UserSearchFilter) int64); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, repositories.UserSearchFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockUserRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockUserRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter repositories.UserSearchFilter
+func (_e *MockUserRepository_Expecter) Search(ctx interface{}, filter interface{}) *MockUserRepository_Search_Call {
+	return &MockUserRepository_Search_Call{Call: _e.mock.On("Search", ctx, filter)}
+}
+
+func (_c *MockUserRepository_Search_Call) Run(run func(ctx context.Context, filter repositories.UserSearchFilter)) *MockUserRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 repositories.UserSearchFilter
+		if args[1] != nil {
+			arg1 = args[1].(repositories.UserSearchFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Search_Call) Return(users []*entities.User, n int64, err error) *MockUserRepository_Search_Call {
+	_c.Call.Return(users, n, err)
+	return _c
+}
+
+func (_c *MockUserRepository_Search_Call) RunAndReturn(run func(ctx context.Context, filter repositories.UserSearchFilter) ([]*entities.User, int64, error)) *MockUserRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockUserRepository
+func (_mock *MockUserRepository) Update(ctx context.Context, user *entities.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockUserRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entities.User
+func (_e *MockUserRepository_Expecter) Update(ctx interface{}, user interface{}) *MockUserRepository_Update_Call {
+	return &MockUserRepository_Update_Call{Call: _e.mock.On("Update", ctx, user)}
+}
+
+func (_c *MockUserRepository_Update_Call) Run(run func(ctx context.Context, user *entities.User)) *MockUserRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.User
+		if args[1] != nil {
+			arg1 = args[1].(*entities.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Update_Call) Return(err error) *MockUserRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepository_Update_Call) RunAndReturn(run func(ctx context.Context, user *entities.User) error) *MockUserRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserStatsRepository creates a new instance of MockUserStatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserStatsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserStatsRepository {
+	mock := &MockUserStatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserStatsRepository is an autogenerated mock type for the UserStatsRepository type
+type MockUserStatsRepository struct {
+	mock.Mock
+}
+
+type MockUserStatsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserStatsRepository) EXPECT() *MockUserStatsRepository_Expecter {
+	return &MockUserStatsRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindByUserId provides a mock function for the type MockUserStatsRepository
+func (_mock *MockUserStatsRepository) FindByUserId(ctx context.Context, userId string) (*entities.UserStats, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserId")
+	}
+
+	var r0 *entities.UserStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entities.UserStats, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entities.UserStats); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserStatsRepository_FindByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserId'
+type MockUserStatsRepository_FindByUserId_Call struct {
+	*mock.Call
+}
+
+// FindByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockUserStatsRepository_Expecter) FindByUserId(ctx interface{}, userId interface{}) *MockUserStatsRepository_FindByUserId_Call {
+	return &MockUserStatsRepository_FindByUserId_Call{Call: _e.mock.On("FindByUserId", ctx, userId)}
+}
+
+func (_c *MockUserStatsRepository_FindByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockUserStatsRepository_FindByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserStatsRepository_FindByUserId_Call) Return(userStats *entities.UserStats, err error) *MockUserStatsRepository_FindByUserId_Call {
+	_c.Call.Return(userStats, err)
+	return _c
+}
+
+func (_c *MockUserStatsRepository_FindByUserId_Call) RunAndReturn(run func(ctx context.Context, userId string) (*entities.UserStats, error)) *MockUserStatsRepository_FindByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Increment provides a mock function for the type MockUserStatsRepository
+func (_mock *MockUserStatsRepository) Increment(ctx context.Context, delta *entities.UserStats) error {
+	ret := _mock.Called(ctx, delta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Increment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entities.UserStats) error); ok {
+		r0 = returnFunc(ctx, delta)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserStatsRepository_Increment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Increment'
+type MockUserStatsRepository_Increment_Call struct {
+	*mock.Call
+}
+
+// Increment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delta *entities.UserStats
+func (_e *MockUserStatsRepository_Expecter) Increment(ctx interface{}, delta interface{}) *MockUserStatsRepository_Increment_Call {
+	return &MockUserStatsRepository_Increment_Call{Call: _e.mock.On("Increment", ctx, delta)}
+}
+
+func (_c *MockUserStatsRepository_Increment_Call) Run(run func(ctx context.Context, delta *entities.UserStats)) *MockUserStatsRepository_Increment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entities.UserStats
+		if args[1] != nil {
+			arg1 = args[1].(*entities.UserStats)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUserStatsRepository_Increment_Call) Return(err error) *MockUserStatsRepository_Increment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserStatsRepository_Increment_Call) RunAndReturn(run func(ctx context.Context, delta *entities.UserStats) error) *MockUserStatsRepository_Increment_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *entities.Notification) error
+	// FindByUserId returns a page of the user's inbox, newest first, with Actor loaded.
+	FindByUserId(ctx context.Context, userId string, unreadOnly bool, offset, limit int) ([]*entities.Notification, error)
+	CountByUserId(ctx context.Context, userId string, unreadOnly bool) (int64, error)
+	// MarkRead marks one notification of the user as read and reports whether it exists.
+	MarkRead(ctx context.Context, userId, id string, at time.Time) (bool, error)
+	// MarkAllRead marks every unread notification of the user as read and returns how many there were.
+	MarkAllRead(ctx context.Context, userId string, at time.Time) (int64, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type OidcAuthStateRepository interface {
+	Create(ctx context.Context, state *entities.OidcAuthState) error
+	FindByState(ctx context.Context, state string) (*entities.OidcAuthState, error)
+	Delete(ctx context.Context, state *entities.OidcAuthState) error
+}
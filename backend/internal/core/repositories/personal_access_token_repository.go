@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *entities.PersonalAccessToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.PersonalAccessToken, error)
+	FindByIdAndUserId(ctx context.Context, id, userId string) (*entities.PersonalAccessToken, error)
+	FindByUserIdAndName(ctx context.Context, userId, name string) (*entities.PersonalAccessToken, error)
+	FindByUserId(ctx context.Context, userId string) ([]*entities.PersonalAccessToken, error)
+	CountByUserId(ctx context.Context, userId string) (int64, error)
+	UpdateLastUsedAt(ctx context.Context, token *entities.PersonalAccessToken) error
+	Delete(ctx context.Context, token *entities.PersonalAccessToken) error
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+// PersonalDataExport is everything stored about a user, as handed out for data access requests.
+type PersonalDataExport struct {
+	User                 *entities.User
+	Maps                 []*entities.Map
+	Locations            []*entities.Location
+	Games                []*entities.SinglePlayerGame
+	Sessions             []*entities.RefreshToken
+	Identities           []*entities.UserIdentity
+	PersonalAccessTokens []*entities.PersonalAccessToken
+	Achievements         []*entities.UserAchievement
+	Friendships          []*entities.Friendship
+	// Blocks only holds the blocks the user made; being blocked by someone is their data.
+	Blocks           []*entities.UserBlock
+	Notifications    []*entities.Notification
+	Collaborations   []*entities.MapCollaborator
+	MapLikes         []*entities.MapLike
+	MapRatings       []*entities.MapRating
+	TwoFactorEnabled bool
+}
+
+// PersonalDataRepository is the one place knowing every table holding a user's personal data.
+// Any new table referencing users must be added to both methods.
+type PersonalDataRepository interface {
+	// Export returns nil when the user does not exist.
+	Export(ctx context.Context, userId string) (*PersonalDataExport, error)
+	// EraseCredentials permanently deletes the user's sessions, linked identities, two-factor
+	// secrets, personal access tokens and other sign-in state, along with friendships, blocks,
+	// notifications, map collaborations, likes and ratings. The cached aggregates of the liked and
+	// rated maps are updated to match.
+	EraseCredentials(ctx context.Context, userId string) error
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+// RateLimitStore holds the token buckets used to throttle requests. Implementations must make Take
+// atomic per key so concurrent requests cannot spend the same token.
+type RateLimitStore interface {
+	// Take refills the bucket stored under key, creating a full one if needed, and consumes one token from it.
+	Take(ctx context.Context, key string, rule entities.RateLimitRule) (entities.RateLimitDecision, error)
+}
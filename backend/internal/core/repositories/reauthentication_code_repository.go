@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type ReauthenticationCodeRepository interface {
+	// Save stores code, replacing the user's previous one.
+	Save(ctx context.Context, code *entities.ReauthenticationCode) error
+	FindByUserId(ctx context.Context, userId string) (*entities.ReauthenticationCode, error)
+	Delete(ctx context.Context, code *entities.ReauthenticationCode) error
+}
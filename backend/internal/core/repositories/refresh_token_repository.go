@@ -10,4 +10,6 @@ type RefreshTokenRepository interface {
 	Create(ctx context.Context, refreshToken *entities.RefreshToken) error
 	FindById(ctx context.Context, id string) (*entities.RefreshToken, error)
 	Update(ctx context.Context, refreshToken *entities.RefreshToken) error
+	// ExpireAllByUserId expires every refresh token of the user that is still valid.
+	ExpireAllByUserId(ctx context.Context, userId string) error
 }
\ No newline at end of file
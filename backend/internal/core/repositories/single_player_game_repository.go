@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 )
@@ -11,4 +12,8 @@ type SinglePlayerGameRepository interface {
 	FindByUserIdAndStatuses(ctx context.Context, userId string, statuses []entities.SinglePlayerGameStatus) (*entities.SinglePlayerGame, error)
 	Update(ctx context.Context, game *entities.SinglePlayerGame) error
 	FindByIdAndUserIdWithLock(ctx context.Context, id, userId string) (*entities.SinglePlayerGame, error)
+	FindByIdWithLock(ctx context.Context, id string) (*entities.SinglePlayerGame, error)
+	// FindRecentCompletedByUserIds returns up to limit games of the users completed before before,
+	// latest first, with User and Map loaded. Games on private maps are left out.
+	FindRecentCompletedByUserIds(ctx context.Context, userIds []string, before time.Time, limit int) ([]*entities.SinglePlayerGame, error)
 }
\ No newline at end of file
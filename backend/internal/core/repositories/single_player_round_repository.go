@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 )
@@ -10,4 +11,9 @@ type SinglePlayerRoundRepository interface {
 	Update(ctx context.Context, round *entities.SinglePlayerRound) error
 	FindByIdAndGameIdWithLock(ctx context.Context, id, gameId string) (*entities.SinglePlayerRound, error)
 	FindByGameIdAndRoundNumberWithLock(ctx context.Context, gameId string, roundNumber int) (*entities.SinglePlayerRound, error)
+	// FindByGameId returns the rounds of a game ordered by round number, with their Location loaded.
+	FindByGameId(ctx context.Context, gameId string) ([]*entities.SinglePlayerRound, error)
+	// FindSeenLocationIds returns the distinct locations of the started rounds of the user's games on
+	// the map created since the given time, most recently seen first, at most limit of them.
+	FindSeenLocationIds(ctx context.Context, userId, mapId string, since time.Time, limit int) ([]string, error)
 }
\ No newline at end of file
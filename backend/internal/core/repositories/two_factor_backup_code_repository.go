@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type TwoFactorBackupCodeRepository interface {
+	CreateMany(ctx context.Context, codes []*entities.TwoFactorBackupCode) error
+	FindUnusedByUserIdAndCodeHash(ctx context.Context, userId, codeHash string) (*entities.TwoFactorBackupCode, error)
+	CountUnusedByUserId(ctx context.Context, userId string) (int64, error)
+	Update(ctx context.Context, code *entities.TwoFactorBackupCode) error
+	DeleteByUserId(ctx context.Context, userId string) error
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type TwoFactorRepository interface {
+	Create(ctx context.Context, twoFactor *entities.UserTwoFactor) error
+	Update(ctx context.Context, twoFactor *entities.UserTwoFactor) error
+	FindByUserId(ctx context.Context, userId string) (*entities.UserTwoFactor, error)
+	FindByUserIdWithLock(ctx context.Context, userId string) (*entities.UserTwoFactor, error)
+	Delete(ctx context.Context, twoFactor *entities.UserTwoFactor) error
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type UserAchievementRepository interface {
+	// Unlock stores the achievements the user does not hold yet and leaves the others untouched.
+	Unlock(ctx context.Context, achievements []*entities.UserAchievement) error
+	FindByUserId(ctx context.Context, userId string) ([]*entities.UserAchievement, error)
+}
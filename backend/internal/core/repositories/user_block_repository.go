@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type UserBlockRepository interface {
+	// Create stores the block, doing nothing when it already exists.
+	Create(ctx context.Context, block *entities.UserBlock) error
+	Delete(ctx context.Context, blockerId, blockedId string) error
+	// ExistsBetween reports whether either user blocked the other.
+	ExistsBetween(ctx context.Context, userId, otherUserId string) (bool, error)
+	// FindByBlockerId returns the blocks made by the user, newest first, with the blocked user loaded.
+	FindByBlockerId(ctx context.Context, blockerId string) ([]*entities.UserBlock, error)
+}
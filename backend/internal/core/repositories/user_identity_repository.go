@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type UserIdentityRepository interface {
+	Create(ctx context.Context, identity *entities.UserIdentity) error
+	FindByProviderAndSubject(ctx context.Context, provider, subject string) (*entities.UserIdentity, error)
+	FindByUserIdAndProvider(ctx context.Context, userId, provider string) (*entities.UserIdentity, error)
+	FindByUserId(ctx context.Context, userId string) ([]*entities.UserIdentity, error)
+	Delete(ctx context.Context, identity *entities.UserIdentity) error
+}
@@ -6,9 +6,23 @@ import (
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 )
 
+// UserSearchFilter narrows UserRepository.Search. Zero values do not filter.
+type UserSearchFilter struct {
+	// Query matches case-insensitively anywhere in the name, username or email.
+	Query  string
+	Role   entities.UserRole
+	Banned *bool
+	Limit  int
+	Offset int
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *entities.User) error
+	Update(ctx context.Context, user *entities.User) error
+	Delete(ctx context.Context, user *entities.User) error
 	FindByEmail(ctx context.Context, email string) (*entities.User, error)
 	FindByUsername(ctx context.Context, username string) (*entities.User, error)
 	FindById(ctx context.Context, id string) (*entities.User, error)
+	// Search returns one page of matching users, newest first, and the total number of matches.
+	Search(ctx context.Context, filter UserSearchFilter) ([]*entities.User, int64, error)
 }
\ No newline at end of file
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+type UserStatsRepository interface {
+	// Increment adds the counters of delta, usually built by entities.NewUserStatsFromGame, to the
+	// user's stored stats, creating them on the first game.
+	Increment(ctx context.Context, delta *entities.UserStats) error
+	FindByUserId(ctx context.Context, userId string) (*entities.UserStats, error)
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+const emailVerificationTokenBytes = 32
+
+// EmailVerificationService issues the tokens proving ownership of an email address and mails them.
+type EmailVerificationService struct {
+	mailer     Mailer
+	confirmUrl string
+}
+
+// NewEmailVerificationService returns a service whose emails link to confirmUrl with the token in the
+// "token" query parameter. Without a confirmUrl the emails contain the bare token.
+func NewEmailVerificationService(mailer Mailer, confirmUrl string) *EmailVerificationService {
+	return &EmailVerificationService{mailer: mailer, confirmUrl: confirmUrl}
+}
+
+// NewEmailVerificationServiceFromEnv builds an EmailVerificationService linking to EMAIL_CONFIRM_URL.
+func NewEmailVerificationServiceFromEnv(mailer Mailer) *EmailVerificationService {
+	return NewEmailVerificationService(mailer, os.Getenv("EMAIL_CONFIRM_URL"))
+}
+
+// GenerateToken returns a new random verification token.
+func (s *EmailVerificationService) GenerateToken() (string, error) {
+	b := make([]byte, emailVerificationTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HashToken returns the SHA-256 hex digest of token, which is what gets stored.
+func (s *EmailVerificationService) HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// SendEmailChangeConfirmation mails token to the address the user wants to switch to.
+func (s *EmailVerificationService) SendEmailChangeConfirmation(ctx context.Context, to, token string) error {
+	body := fmt.Sprintf("Use this code to confirm your new Maya Guessr email address: %s", token)
+	if s.confirmUrl != "" {
+		body = fmt.Sprintf("Open this link to confirm your new Maya Guessr email address: %s?token=%s", s.confirmUrl, url.QueryEscape(token))
+	}
+	return s.mailer.Send(ctx, MailMessage{
+		To:      to,
+		Subject: "Confirm your new email address",
+		Body:    body + "\n\nIf you did not ask to change your email address, ignore this message.",
+	})
+}
+
+// SendReauthenticationCode mails code to the user's current address, for them to confirm a change of
+// their credentials.
+func (s *EmailVerificationService) SendReauthenticationCode(ctx context.Context, to, code string) error {
+	return s.mailer.Send(ctx, MailMessage{
+		To:      to,
+		Subject: "Confirm it is you",
+		Body:    fmt.Sprintf("Use this code to confirm the change to your Maya Guessr account: %s\n\nIf you did not ask for it, ignore this message.", code),
+	})
+}
@@ -16,8 +16,26 @@ type JwtService struct {
 type AccessTokenClaims struct {
 	jwt.RegisteredClaims
 	UserId string `json:"user_id"`
+	// Role is the user's role when the token was issued. Tokens issued before roles existed have none.
+	Role string `json:"role,omitempty"`
+	// Purpose tells access tokens apart from refresh tokens, which also carry a user_id claim.
+	Purpose string `json:"purpose"`
 }
 
+// TwoFactorChallengeClaims identify a user who passed the password step but still owes a second factor.
+// They deliberately carry no user_id claim so they can never be accepted as an access token.
+type TwoFactorChallengeClaims struct {
+	jwt.RegisteredClaims
+	Purpose string `json:"purpose"`
+}
+
+const accessTokenPurpose = "access"
+
+const (
+	twoFactorChallengePurpose = "2fa_challenge"
+	twoFactorChallengeTTL     = 5 * time.Minute
+)
+
 type RefreshTokenClaims struct {
 	jwt.RegisteredClaims
 	ID     string `json:"id"`
@@ -36,13 +54,15 @@ func (s *JwtService) GetSecretKey() []byte {
 	return s.secretKey
 }
 
-func (s *JwtService) GenerateAccessToken(userId string) (string, error) {
+func (s *JwtService) GenerateAccessToken(userId string, role string) (string, error) {
 	claims := &AccessTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   userId,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 24)),
 		},
 		UserId: userId,
+		Role: role,
+		Purpose: accessTokenPurpose,
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString(s.GetSecretKey())
@@ -71,7 +91,11 @@ func (s *JwtService) ValidateAccessToken(tokenString string) (*AccessTokenClaims
 	if err != nil {
 		return nil, err
 	}
-	return tok.Claims.(*AccessTokenClaims), nil
+	claims := tok.Claims.(*AccessTokenClaims)
+	if claims.Purpose != accessTokenPurpose || claims.UserId == "" {
+		return nil, errors.New("token is not an access token")
+	}
+	return claims, nil
 }
 
 func (s *JwtService) ValidateRefreshToken(tokenString string) (*RefreshTokenClaims, error) {
@@ -85,4 +109,33 @@ func (s *JwtService) ValidateRefreshToken(tokenString string) (*RefreshTokenClai
 		return nil, err
 	}
 	return tok.Claims.(*RefreshTokenClaims), nil
+}
+
+func (s *JwtService) GenerateTwoFactorChallengeToken(userId string) (string, error) {
+	claims := &TwoFactorChallengeClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userId,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(twoFactorChallengeTTL)),
+		},
+		Purpose: twoFactorChallengePurpose,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(s.GetSecretKey())
+}
+
+func (s *JwtService) ValidateTwoFactorChallengeToken(tokenString string) (*TwoFactorChallengeClaims, error) {
+	tok, err := jwt.ParseWithClaims(tokenString, &TwoFactorChallengeClaims{}, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return s.GetSecretKey(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	claims := tok.Claims.(*TwoFactorChallengeClaims)
+	if claims.Purpose != twoFactorChallengePurpose || claims.Subject == "" {
+		return nil, errors.New("token is not a two-factor challenge token")
+	}
+	return claims, nil
 }
\ No newline at end of file
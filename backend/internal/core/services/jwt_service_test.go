@@ -49,7 +49,7 @@ func (s *JwtServiceSuite) TestGenerateAccessToken_ReturnsValidToken() {
 	svc := NewJwtService()
 	userID := "user-123"
 
-	token, err := svc.GenerateAccessToken(userID)
+	token, err := svc.GenerateAccessToken(userID, "player")
 
 	s.Require().NoError(err)
 	s.NotEmpty(token)
@@ -60,13 +60,14 @@ func (s *JwtServiceSuite) TestGenerateAccessToken_ValidTokenCanBeValidated() {
 	svc := NewJwtService()
 	userID := "user-456"
 
-	token, err := svc.GenerateAccessToken(userID)
+	token, err := svc.GenerateAccessToken(userID, "player")
 	s.Require().NoError(err)
 
 	claims, err := svc.ValidateAccessToken(token)
 	s.Require().NoError(err)
 	s.Equal(userID, claims.UserId)
 	s.Equal(userID, claims.Subject)
+	s.Equal("player", claims.Role)
 	s.NotNil(claims.ExpiresAt)
 }
 
@@ -138,7 +139,7 @@ func (s *JwtServiceSuite) TestValidateRefreshToken_WhenTokenEmpty_ReturnsError()
 func (s *JwtServiceSuite) TestValidateAccessToken_WhenTokenSignedWithDifferentSecret_ReturnsError() {
 	defer s.setupJWTEnv()()
 	svc := NewJwtService()
-	token, err := svc.GenerateAccessToken("user-1")
+	token, err := svc.GenerateAccessToken("user-1", "player")
 	s.Require().NoError(err)
 
 	prev := os.Getenv("JWT_SECRET_KEY")
@@ -165,3 +166,49 @@ func (s *JwtServiceSuite) TestValidateRefreshToken_WhenTokenSignedWithDifferentS
 	s.Require().Error(err)
 }
 
+
+func (s *JwtServiceSuite) TestGenerateTwoFactorChallengeToken_ValidTokenCanBeValidated() {
+	defer s.setupJWTEnv()()
+	svc := NewJwtService()
+
+	token, err := svc.GenerateTwoFactorChallengeToken("user-2fa")
+	s.Require().NoError(err)
+
+	claims, err := svc.ValidateTwoFactorChallengeToken(token)
+	s.Require().NoError(err)
+	s.Equal("user-2fa", claims.Subject)
+	s.NotNil(claims.ExpiresAt)
+}
+
+func (s *JwtServiceSuite) TestValidateAccessToken_WhenTwoFactorChallengeToken_ReturnsError() {
+	defer s.setupJWTEnv()()
+	svc := NewJwtService()
+	token, err := svc.GenerateTwoFactorChallengeToken("user-2fa")
+	s.Require().NoError(err)
+
+	_, err = svc.ValidateAccessToken(token)
+
+	s.Require().Error(err)
+}
+
+func (s *JwtServiceSuite) TestValidateAccessToken_WhenRefreshToken_ReturnsError() {
+	defer s.setupJWTEnv()()
+	svc := NewJwtService()
+	token, err := svc.GenerateRefreshToken("user-1", "refresh-id-1")
+	s.Require().NoError(err)
+
+	_, err = svc.ValidateAccessToken(token)
+
+	s.Require().Error(err)
+}
+
+func (s *JwtServiceSuite) TestValidateTwoFactorChallengeToken_WhenAccessToken_ReturnsError() {
+	defer s.setupJWTEnv()()
+	svc := NewJwtService()
+	token, err := svc.GenerateAccessToken("user-1", "player")
+	s.Require().NoError(err)
+
+	_, err = svc.ValidateTwoFactorChallengeToken(token)
+
+	s.Require().Error(err)
+}
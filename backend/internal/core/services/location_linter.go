@@ -0,0 +1,294 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+type LocationLintConfig struct {
+	// DuplicateRadiusMeters is how close two locations may get before they are near duplicates.
+	DuplicateRadiusMeters float64
+	// ClusterRadiusMeters is the radius of the areas checked for over-representation.
+	ClusterRadiusMeters float64
+	// MaxClusterShare is the largest share of the map's locations one area may hold.
+	MaxClusterShare float64
+	// MinClusterSize is the number of locations an area needs before it counts as over-represented,
+	// so that small maps are not flagged for every few close locations.
+	MinClusterSize int
+}
+
+func DefaultLocationLintConfig() LocationLintConfig {
+	return LocationLintConfig{
+		DuplicateRadiusMeters: 50,
+		ClusterRadiusMeters:   25_000,
+		MaxClusterShare:       0.25,
+		MinClusterSize:        5,
+	}
+}
+
+type LocationLintResult struct {
+	// Index is the position of the location in the linted list.
+	Index  int                      `json:"index"`
+	PanoId string                   `json:"pano_id"`
+	Issues []entities.LocationIssue `json:"issues"`
+}
+
+type LocationLintReport struct {
+	// Locations holds the linted locations having issues, in order.
+	Locations []LocationLintResult `json:"locations"`
+	Errors    int                  `json:"errors"`
+	Warnings  int                  `json:"warnings"`
+}
+
+// Err returns a bad request error listing every error of the report, nil when there is none.
+func (r LocationLintReport) Err() error {
+	if r.Errors == 0 {
+		return nil
+	}
+	var messages []string
+	for _, result := range r.Locations {
+		for _, issue := range result.Issues {
+			if issue.Severity == entities.LocationIssueSeverityError {
+				messages = append(messages, fmt.Sprintf("location %d (%s): %s", result.Index, result.PanoId, issue.Message))
+			}
+		}
+	}
+	return coreerrors.BadRequest(strings.Join(messages, "; "))
+}
+
+// LocationLinter checks locations before they are added to a map. Besides the checks each location
+// makes on itself, it finds panoramas given twice, near duplicate points and areas holding too much
+// of the map, and reports every issue of every location rather than stopping at the first.
+type LocationLinter struct {
+	geoService *GeoService
+	config     LocationLintConfig
+	// panoramas, when set, checks the panorama ids against the format of their provider.
+	panoramas *PanoramaRegistry
+	// boundary, when set, is the area the locations must be in.
+	boundary *entities.MapBoundary
+}
+
+func NewLocationLinter(geoService *GeoService, panoramas *PanoramaRegistry, config LocationLintConfig) *LocationLinter {
+	return &LocationLinter{geoService: geoService, panoramas: panoramas, config: config}
+}
+
+// WithDuplicateRadius returns a copy of the linter using another near duplicate radius.
+func (l *LocationLinter) WithDuplicateRadius(meters float64) *LocationLinter {
+	config := l.config
+	config.DuplicateRadiusMeters = meters
+	linter := NewLocationLinter(l.geoService, l.panoramas, config)
+	linter.boundary = l.boundary
+	return linter
+}
+
+// WithBoundary returns a copy of the linter refusing the locations outside boundary, or accepting
+// them anywhere when boundary is nil.
+func (l *LocationLinter) WithBoundary(boundary *entities.MapBoundary) *LocationLinter {
+	linter := NewLocationLinter(l.geoService, l.panoramas, l.config)
+	linter.boundary = boundary
+	return linter
+}
+
+// Lint reports the issues of locations, checked against each other and against existing, the
+// locations already on the map. Only locations get a result: the existing ones are context.
+func (l *LocationLinter) Lint(locations, existing []*entities.Location) LocationLintReport {
+	all := make([]*entities.Location, 0, len(existing)+len(locations))
+	all = append(all, existing...)
+	all = append(all, locations...)
+	offset := len(existing)
+
+	issues := make([][]entities.LocationIssue, len(locations))
+	for i, location := range locations {
+		issues[i] = location.Issues()
+	}
+	l.findInvalidPanos(locations, issues)
+	l.findDuplicatePanos(locations, existing, issues)
+
+	// Points with broken coordinates would only add noise to the spatial checks.
+	var placed []int
+	for i, location := range all {
+		if location.IsValidLatitude() && location.IsValidLongitude() && !location.IsNullIsland() {
+			placed = append(placed, i)
+		}
+	}
+	l.findOutsideBoundary(all, placed, offset, issues)
+	l.findNearDuplicates(all, placed, offset, issues)
+	l.findClusters(all, placed, offset, issues)
+
+	report := LocationLintReport{Locations: []LocationLintResult{}}
+	for i, locationIssues := range issues {
+		if len(locationIssues) == 0 {
+			continue
+		}
+		sort.SliceStable(locationIssues, func(a, b int) bool {
+			return locationIssues[a].Severity == entities.LocationIssueSeverityError && locationIssues[b].Severity != entities.LocationIssueSeverityError
+		})
+		for _, issue := range locationIssues {
+			if issue.Severity == entities.LocationIssueSeverityError {
+				report.Errors++
+			} else {
+				report.Warnings++
+			}
+		}
+		report.Locations = append(report.Locations, LocationLintResult{Index: i, PanoId: locations[i].PanoId, Issues: locationIssues})
+	}
+	return report
+}
+
+// findInvalidPanos flags the panoramas of disabled providers and the ids their provider cannot
+// serve. Unknown providers are already an invalid_provider error of the location.
+func (l *LocationLinter) findInvalidPanos(locations []*entities.Location, issues [][]entities.LocationIssue) {
+	if l.panoramas == nil {
+		return
+	}
+	for i, location := range locations {
+		if _, known := entities.ParsePanoramaProvider(string(location.Provider)); !known {
+			continue
+		}
+		provider, ok := l.panoramas.Provider(location.Provider)
+		if !ok {
+			issues[i] = append(issues[i], entities.NewLocationError(entities.LocationIssueInvalidProvider, fmt.Sprintf("panorama provider %q is not enabled", location.Provider)))
+			continue
+		}
+		if err := provider.ValidatePanoId(location.PanoId); err != nil {
+			issues[i] = append(issues[i], entities.NewLocationError(entities.LocationIssueInvalidPanoId, err.Error()))
+		}
+	}
+}
+
+func (l *LocationLinter) findDuplicatePanos(locations, existing []*entities.Location, issues [][]entities.LocationIssue) {
+	onMap := make(map[string]bool, len(existing))
+	for _, location := range existing {
+		onMap[location.PanoId] = true
+	}
+	given := make(map[string]bool, len(locations))
+	for i, location := range locations {
+		switch {
+		case onMap[location.PanoId]:
+			issues[i] = append(issues[i], entities.NewLocationError(entities.LocationIssueDuplicatePano, "panorama is already on the map"))
+		case given[location.PanoId]:
+			issues[i] = append(issues[i], entities.NewLocationError(entities.LocationIssueDuplicatePano, "panorama is given more than once"))
+		}
+		given[location.PanoId] = true
+	}
+}
+
+func (l *LocationLinter) findOutsideBoundary(all []*entities.Location, placed []int, offset int, issues [][]entities.LocationIssue) {
+	if l.boundary == nil {
+		return
+	}
+	for _, i := range placed {
+		if i >= offset && !l.boundary.Contains(all[i].Latitude, all[i].Longitude) {
+			issues[i-offset] = append(issues[i-offset], entities.NewLocationError(entities.LocationIssueOutsideBoundary, "location is outside the map boundary"))
+		}
+	}
+}
+
+func (l *LocationLinter) findNearDuplicates(all []*entities.Location, placed []int, offset int, issues [][]entities.LocationIssue) {
+	radius := l.config.DuplicateRadiusMeters
+	if radius <= 0 {
+		return
+	}
+	grid := newSpatialGrid(radius)
+	for _, i := range placed {
+		grid.add(i, all[i].Latitude, all[i].Longitude)
+	}
+	for _, i := range placed {
+		if i < offset {
+			continue
+		}
+		var related []string
+		for _, j := range l.within(grid, all, i, radius) {
+			// The same panorama twice is already a duplicate_pano error.
+			if j != i && all[j].PanoId != all[i].PanoId {
+				related = append(related, all[j].PanoId)
+			}
+		}
+		if len(related) == 0 {
+			continue
+		}
+		issue := entities.NewLocationWarning(entities.LocationIssueNearDuplicate,
+			fmt.Sprintf("location is within %s of %d other location(s)", formatDistance(radius), len(related)))
+		issue.RelatedPanoIds = related
+		issues[i-offset] = append(issues[i-offset], issue)
+	}
+}
+
+// findClusters flags the areas holding more than MaxClusterShare of the placed locations. The
+// locations with the most neighbors are taken as area centers first, and each location belongs to
+// one area at most, so an area is reported once.
+func (l *LocationLinter) findClusters(all []*entities.Location, placed []int, offset int, issues [][]entities.LocationIssue) {
+	radius := l.config.ClusterRadiusMeters
+	total := len(placed)
+	if radius <= 0 || total < l.config.MinClusterSize {
+		return
+	}
+	overRepresented := func(size int) bool {
+		return size >= l.config.MinClusterSize && float64(size) > l.config.MaxClusterShare*float64(total)
+	}
+
+	grid := newSpatialGrid(radius)
+	for _, i := range placed {
+		grid.add(i, all[i].Latitude, all[i].Longitude)
+	}
+	neighbors := make(map[int][]int, total)
+	centers := make([]int, 0, total)
+	for _, i := range placed {
+		neighbors[i] = l.within(grid, all, i, radius)
+		if overRepresented(len(neighbors[i])) {
+			centers = append(centers, i)
+		}
+	}
+	sort.SliceStable(centers, func(a, b int) bool { return len(neighbors[centers[a]]) > len(neighbors[centers[b]]) })
+
+	clustered := make(map[int]bool)
+	for _, center := range centers {
+		if clustered[center] {
+			continue
+		}
+		var members []int
+		for _, j := range neighbors[center] {
+			if !clustered[j] {
+				members = append(members, j)
+			}
+		}
+		if !overRepresented(len(members)) {
+			continue
+		}
+		message := fmt.Sprintf("%d of the map's %d locations are within %s of %s", len(members), total, formatDistance(radius), all[center].PanoId)
+		for _, j := range members {
+			clustered[j] = true
+			if j < offset {
+				continue
+			}
+			issue := entities.NewLocationWarning(entities.LocationIssueOverRepresentedArea, message)
+			if j != center {
+				issue.RelatedPanoIds = []string{all[center].PanoId}
+			}
+			issues[j-offset] = append(issues[j-offset], issue)
+		}
+	}
+}
+
+// within returns the locations of the grid at most radius meters away from all[i], i included.
+func (l *LocationLinter) within(grid *spatialGrid, all []*entities.Location, i int, radius float64) []int {
+	var indexes []int
+	for _, j := range grid.near(all[i].Latitude, all[i].Longitude) {
+		if l.geoService.CalculateDistance(all[i].Latitude, all[i].Longitude, all[j].Latitude, all[j].Longitude) <= radius {
+			indexes = append(indexes, j)
+		}
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+func formatDistance(meters float64) string {
+	if meters < metersPerKm {
+		return fmt.Sprintf("%.0f m", meters)
+	}
+	return fmt.Sprintf("%g km", meters/metersPerKm)
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationLinterSuite struct {
+	suite.Suite
+	linter *LocationLinter
+}
+
+func TestLocationLinterSuite(t *testing.T) {
+	suite.Run(t, new(LocationLinterSuite))
+}
+
+func (s *LocationLinterSuite) SetupTest() {
+	s.linter = NewLocationLinter(NewGeoService(), nil, DefaultLocationLintConfig())
+}
+
+func codesOf(result LocationLintResult) []entities.LocationIssueCode {
+	codes := make([]entities.LocationIssueCode, len(result.Issues))
+	for i, issue := range result.Issues {
+		codes[i] = issue.Code
+	}
+	return codes
+}
+
+func (s *LocationLinterSuite) TestLint_ReportsEveryIssueOfEveryLocation() {
+	locations := []*entities.Location{
+		entities.NewLocation("ok", "map", 48.85, 2.35, 90, 0),
+		entities.NewLocation("broken", "map", 95, 2.35, 400, -100),
+		entities.NewLocation("null", "map", 0, 0, 0, 0),
+	}
+
+	report := s.linter.Lint(locations, nil)
+
+	s.Equal(3, report.Errors)
+	s.Equal(1, report.Warnings)
+	s.Require().Len(report.Locations, 2)
+	s.Equal(1, report.Locations[0].Index)
+	s.Equal([]entities.LocationIssueCode{entities.LocationIssueInvalidLatitude, entities.LocationIssueInvalidHeading, entities.LocationIssueInvalidPitch}, codesOf(report.Locations[0]))
+	s.Equal(2, report.Locations[1].Index)
+	s.Equal([]entities.LocationIssueCode{entities.LocationIssueNullIsland}, codesOf(report.Locations[1]))
+	s.Require().Error(report.Err())
+	s.Contains(report.Err().Error(), "location 1 (broken): invalid pitch")
+}
+
+func (s *LocationLinterSuite) TestLint_FlagsNearDuplicatesAcrossTheAntimeridian() {
+	existing := []*entities.Location{entities.RestoreLocation("loc-1", "east", "map", -16.5, 179.9998, 0, 0)}
+	locations := []*entities.Location{
+		entities.NewLocation("west", "map", -16.5, -179.9998, 0, 0),
+		entities.NewLocation("far", "map", -17.5, 178.5, 0, 0),
+	}
+
+	report := s.linter.Lint(locations, existing)
+
+	s.Equal(0, report.Errors)
+	s.Require().Len(report.Locations, 1)
+	s.Equal("west", report.Locations[0].PanoId)
+	s.Equal(entities.LocationIssueNearDuplicate, report.Locations[0].Issues[0].Code)
+	s.Equal([]string{"east"}, report.Locations[0].Issues[0].RelatedPanoIds)
+	s.NoError(report.Err())
+}
+
+func (s *LocationLinterSuite) TestLint_FlagsPanoramasAlreadyOnTheMap() {
+	existing := []*entities.Location{entities.RestoreLocation("loc-1", "pano", "map", 10, 10, 0, 0)}
+
+	report := s.linter.Lint([]*entities.Location{entities.NewLocation("pano", "map", 20, 20, 0, 0)}, existing)
+
+	s.Equal(1, report.Errors)
+	s.Equal([]entities.LocationIssueCode{entities.LocationIssueDuplicatePano}, codesOf(report.Locations[0]))
+}
+
+func (s *LocationLinterSuite) TestLint_FlagsOverRepresentedAreaOnce() {
+	var locations []*entities.Location
+	// Six locations in Paris, a few hundred meters apart, and four spread across the world.
+	for i, pano := range []string{"p1", "p2", "p3", "p4", "p5", "p6"} {
+		locations = append(locations, entities.NewLocation(pano, "map", 48.85+float64(i)*0.005, 2.35, 0, 0))
+	}
+	locations = append(locations,
+		entities.NewLocation("tokyo", "map", 35.68, 139.69, 0, 0),
+		entities.NewLocation("lima", "map", -12.05, -77.04, 0, 0),
+		entities.NewLocation("cairo", "map", 30.04, 31.24, 0, 0),
+		entities.NewLocation("sydney", "map", -33.87, 151.21, 0, 0),
+	)
+
+	report := s.linter.Lint(locations, nil)
+
+	s.Equal(0, report.Errors)
+	s.Equal(6, report.Warnings)
+	s.Require().Len(report.Locations, 6)
+	for _, result := range report.Locations {
+		s.Equal([]entities.LocationIssueCode{entities.LocationIssueOverRepresentedArea}, codesOf(result))
+		s.Contains(result.Issues[0].Message, "6 of the map's 10 locations are within 25 km")
+	}
+}
+
+func (s *LocationLinterSuite) TestLint_SmallMapsAreNotClusters() {
+	locations := []*entities.Location{
+		entities.NewLocation("p1", "map", 48.85, 2.35, 0, 0),
+		entities.NewLocation("p2", "map", 48.86, 2.35, 0, 0),
+		entities.NewLocation("p3", "map", 48.87, 2.35, 0, 0),
+	}
+
+	report := s.linter.Lint(locations, nil)
+
+	s.Empty(report.Locations)
+}
+
+func (s *LocationLinterSuite) TestWithDuplicateRadius_WidensTheCheck() {
+	locations := []*entities.Location{
+		entities.NewLocation("p1", "map", 48.85, 2.35, 0, 0),
+		entities.NewLocation("p2", "map", 48.851, 2.35, 0, 0),
+	}
+
+	s.Empty(s.linter.Lint(locations, nil).Locations)
+
+	report := s.linter.WithDuplicateRadius(200).Lint(locations, nil)
+	s.Equal(2, report.Warnings)
+}
+
+func (s *LocationLinterSuite) TestWithBoundary_FlagsLocationsOutsideIt() {
+	boundary, err := entities.ParseMapBoundary([]byte(`{"type":"Polygon","coordinates":[[[177,-20],[-179,-20],[-179,-15],[177,-15],[177,-20]]]}`))
+	s.Require().NoError(err)
+	locations := []*entities.Location{
+		entities.NewLocation("suva", "map", -18.14, 178.44, 0, 0),
+		entities.NewLocation("lakeba", "map", -18.2, -179.8, 0, 0),
+		entities.NewLocation("apia", "map", -13.83, -171.76, 0, 0),
+	}
+
+	report := s.linter.WithBoundary(boundary).Lint(locations, nil)
+
+	s.Equal(1, report.Errors)
+	s.Require().Len(report.Locations, 1)
+	s.Equal("apia", report.Locations[0].PanoId)
+	s.Equal([]entities.LocationIssueCode{entities.LocationIssueOutsideBoundary}, codesOf(report.Locations[0]))
+	s.Empty(s.linter.Lint(locations, nil).Locations)
+}
+
+func (s *LocationLinterSuite) TestLint_ChecksPanoIdsAgainstTheirProvider() {
+	linter := NewLocationLinter(NewGeoService(), NewPanoramaRegistry(NewGooglePanoramaProvider(), NewMapillaryPanoramaProvider()), DefaultLocationLintConfig())
+	google := entities.NewLocation("Ujk8pJ6z2dGmR4ZlLzPSkQ", "map", 48.85, 2.35, 0, 0)
+	mapillary := entities.NewLocation("not-numeric", "map", -33.86, 151.21, 0, 0)
+	mapillary.Provider = entities.PanoramaProviderMapillary
+	custom := entities.NewLocation("louvre", "map", 35.68, 139.69, 0, 0)
+	custom.Provider = entities.PanoramaProviderCustom
+
+	report := linter.Lint([]*entities.Location{google, mapillary, custom}, nil)
+
+	s.Equal(2, report.Errors)
+	s.Require().Len(report.Locations, 2)
+	s.Equal([]entities.LocationIssueCode{entities.LocationIssueInvalidPanoId}, codesOf(report.Locations[0]))
+	s.Equal([]entities.LocationIssueCode{entities.LocationIssueInvalidProvider}, codesOf(report.Locations[1]))
+	s.Equal(`panorama provider "custom" is not enabled`, report.Locations[1].Issues[0].Message)
+}
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type LocationSampleRequest struct {
+	MapId    string
+	Quantity int
+	// Exclude holds location ids to avoid, such as the ones the player saw recently, most recent
+	// first. They are only picked when the map has nothing else left, the last ones first.
+	Exclude []string
+	// Difficulty restricts the locations to one difficulty, any when empty.
+	Difficulty entities.LocationDifficulty
+	// Tags restricts the locations to the ones having every one of the tags.
+	Tags []string
+	// Strata are the strata of the map's locations having Tags, from CountStrata. They are counted
+	// once per revision of the map, as counting them goes through every location of the map.
+	Strata []repositories.LocationStratum
+	// Picked holds the locations already drawn for the game by earlier samples. They are never
+	// returned again and the new locations keep away from them.
+	Picked []*entities.Location
+}
+
+// LocationSampler picks the locations of the rounds of a game.
+type LocationSampler interface {
+	// CountStrata returns the strata of the map's locations having every one of tags, as they are at
+	// the revision of the map.
+	CountStrata(ctx context.Context, mapId string, revision int, tags []string) ([]repositories.LocationStratum, error)
+	// Sample returns up to Quantity distinct locations of the map, fewer only when the map has fewer
+	// of the requested difficulty.
+	Sample(ctx context.Context, request LocationSampleRequest) ([]*entities.Location, error)
+}
+
+type LocationSamplerConfig struct {
+	// MinSeparationMeters is the distance wanted between any two rounds of a game.
+	MinSeparationMeters float64
+	// CandidatesPerDraw is how many locations one draw reads from a stratum.
+	CandidatesPerDraw int
+	// DrawsPerRound bounds the draws, and so the queries, of each sampling step per missing round.
+	DrawsPerRound int
+}
+
+func DefaultLocationSamplerConfig() LocationSamplerConfig {
+	return LocationSamplerConfig{
+		MinSeparationMeters: 50_000,
+		CandidatesPerDraw:   8,
+		DrawsPerRound:       4,
+	}
+}
+
+// SpatialLocationSampler spreads the rounds of a game across the map. The map's locations are
+// grouped by country, when the map spans enough countries, or else by geo cell; each round comes
+// from another group, drawn with a weight growing with the square root of its size, so that large
+// groups come up more often without crowding out the small ones. A draw reads a few locations of
+// one stratum from a random sample key, an index range scan whatever the size of the map.
+//
+// When the map cannot satisfy every constraint, they are relaxed one step at a time: first a group
+// may give several rounds, then the separation shrinks and goes, and last the excluded locations
+// come back.
+type SpatialLocationSampler struct {
+	locationRepository repositories.LocationRepository
+	strataCache        repositories.LocationStrataCache
+	geoService         *GeoService
+	config             LocationSamplerConfig
+	random             func() float64
+}
+
+func NewSpatialLocationSampler(locationRepository repositories.LocationRepository, strataCache repositories.LocationStrataCache, geoService *GeoService, config LocationSamplerConfig) *SpatialLocationSampler {
+	return &SpatialLocationSampler{
+		locationRepository: locationRepository,
+		strataCache:        strataCache,
+		geoService:         geoService,
+		config:             config,
+		random:             rand.Float64,
+	}
+}
+
+type sampleStep struct {
+	minSeparation float64
+	// revisit lets a group give more than one round.
+	revisit bool
+	// allowExcluded lets excluded locations be picked.
+	allowExcluded bool
+}
+
+type stratumGroup struct {
+	strata []repositories.LocationStratum
+	count  int64
+}
+
+type sampleState struct {
+	picked    []*entities.Location
+	pickedIds map[string]bool
+	// excluded maps the excluded locations to their position in the request.
+	excluded map[string]int
+}
+
+// CountStrata only counts the strata of a revision once, every revision of the map changing its
+// locations.
+func (s *SpatialLocationSampler) CountStrata(ctx context.Context, mapId string, revision int, tags []string) ([]repositories.LocationStratum, error) {
+	sortedTags := append([]string(nil), tags...)
+	sort.Strings(sortedTags)
+	key := repositories.LocationStrataKey{MapId: mapId, Revision: revision, Tags: strings.Join(sortedTags, " ")}
+	strata, ok, err := s.strataCache.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return strata, nil
+	}
+
+	strata, err = s.locationRepository.CountStrata(ctx, mapId, repositories.LocationFilter{Tags: tags})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.strataCache.Set(ctx, key, strata); err != nil {
+		return nil, err
+	}
+	return strata, nil
+}
+
+func (s *SpatialLocationSampler) Sample(ctx context.Context, request LocationSampleRequest) ([]*entities.Location, error) {
+	filter := repositories.LocationFilter{Tags: request.Tags}
+	strata := request.Strata
+	if request.Difficulty != "" {
+		strata = filterStrata(strata, request.Difficulty)
+	}
+	groups := s.shuffle(groupStrata(strata, request.Quantity))
+	wanted := len(request.Picked) + request.Quantity
+	state := &sampleState{
+		picked:    make([]*entities.Location, 0, wanted),
+		pickedIds: make(map[string]bool, wanted),
+		excluded:  make(map[string]int, len(request.Exclude)),
+	}
+	for _, location := range request.Picked {
+		state.picked = append(state.picked, location)
+		state.pickedIds[location.ID] = true
+	}
+	for i, id := range request.Exclude {
+		if _, ok := state.excluded[id]; !ok {
+			state.excluded[id] = i
+		}
+	}
+	if len(groups) == 0 {
+		return state.picked[len(request.Picked):], nil
+	}
+
+	separation := s.config.MinSeparationMeters
+	steps := []sampleStep{
+		{minSeparation: separation},
+		{minSeparation: separation, revisit: true},
+		{minSeparation: separation / 10, revisit: true},
+		{revisit: true},
+		{revisit: true, allowExcluded: true},
+	}
+	used := make([]bool, len(groups))
+	for _, step := range steps {
+		budget := (wanted - len(state.picked)) * s.config.DrawsPerRound
+		for i := 0; budget > 0 && len(state.picked) < wanted; i++ {
+			if i == len(groups) && !step.revisit {
+				break
+			}
+			g := i % len(groups)
+			if used[g] && !step.revisit {
+				continue
+			}
+			budget--
+			candidates, err := s.locationRepository.FindSample(ctx, request.MapId, s.pickStratum(groups[g]), filter, s.random(), s.config.CandidatesPerDraw)
+			if err != nil {
+				return nil, err
+			}
+			if location := s.firstAcceptable(candidates, state, step); location != nil {
+				state.picked = append(state.picked, location)
+				state.pickedIds[location.ID] = true
+				used[g] = true
+			}
+		}
+	}
+	return state.picked[len(request.Picked):], nil
+}
+
+func filterStrata(strata []repositories.LocationStratum, difficulty entities.LocationDifficulty) []repositories.LocationStratum {
+	filtered := strata[:0:0]
+	for _, stratum := range strata {
+		if stratum.Difficulty == difficulty {
+			filtered = append(filtered, stratum)
+		}
+	}
+	return filtered
+}
+
+// groupStrata groups the strata by country when the map spans at least quantity countries, and by
+// geo cell otherwise. Locations without a country keep to their cell either way.
+func groupStrata(strata []repositories.LocationStratum, quantity int) []*stratumGroup {
+	countries := make(map[string]bool)
+	for _, stratum := range strata {
+		if stratum.CountryCode != "" {
+			countries[stratum.CountryCode] = true
+		}
+	}
+	byCountry := len(countries) >= quantity
+
+	type groupKey struct {
+		geoCell     int
+		countryCode string
+	}
+	index := make(map[groupKey]*stratumGroup)
+	var groups []*stratumGroup
+	for _, stratum := range strata {
+		key := groupKey{geoCell: stratum.GeoCell}
+		if byCountry && stratum.CountryCode != "" {
+			key = groupKey{countryCode: stratum.CountryCode}
+		}
+		group, ok := index[key]
+		if !ok {
+			group = &stratumGroup{}
+			index[key] = group
+			groups = append(groups, group)
+		}
+		group.strata = append(group.strata, stratum)
+		group.count += stratum.Count
+	}
+	return groups
+}
+
+// shuffle orders the groups by weighted random sampling without replacement, with weights growing
+// with the square root of the group sizes.
+func (s *SpatialLocationSampler) shuffle(groups []*stratumGroup) []*stratumGroup {
+	keys := make(map[*stratumGroup]float64, len(groups))
+	for _, group := range groups {
+		keys[group] = math.Log(s.random()) / math.Sqrt(float64(group.count))
+	}
+	sort.SliceStable(groups, func(a, b int) bool { return keys[groups[a]] > keys[groups[b]] })
+	return groups
+}
+
+// pickStratum draws a stratum of the group, weighted by size.
+func (s *SpatialLocationSampler) pickStratum(group *stratumGroup) repositories.LocationStratum {
+	target := s.random() * float64(group.count)
+	for _, stratum := range group.strata {
+		target -= float64(stratum.Count)
+		if target < 0 {
+			return stratum
+		}
+	}
+	return group.strata[len(group.strata)-1]
+}
+
+// firstAcceptable returns the first candidate meeting the step. When the step allows excluded
+// locations, one that is not excluded still wins, and otherwise the one excluded last.
+func (s *SpatialLocationSampler) firstAcceptable(candidates []*entities.Location, state *sampleState, step sampleStep) *entities.Location {
+	var fallback *entities.Location
+	fallbackPosition := -1
+	for _, candidate := range candidates {
+		if state.pickedIds[candidate.ID] || !s.isSeparated(candidate, state.picked, step.minSeparation) {
+			continue
+		}
+		position, excluded := state.excluded[candidate.ID]
+		if !excluded {
+			return candidate
+		}
+		if step.allowExcluded && position > fallbackPosition {
+			fallback, fallbackPosition = candidate, position
+		}
+	}
+	return fallback
+}
+
+func (s *SpatialLocationSampler) isSeparated(candidate *entities.Location, picked []*entities.Location, minSeparation float64) bool {
+	if minSeparation <= 0 {
+		return true
+	}
+	for _, location := range picked {
+		if s.geoService.CalculateDistance(candidate.Latitude, candidate.Longitude, location.Latitude, location.Longitude) < minSeparation {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationSamplerSuite struct {
+	suite.Suite
+	locationRepo *repomocks.MockLocationRepository
+	strataCache  *repomocks.MockLocationStrataCache
+	sampler      *SpatialLocationSampler
+}
+
+func TestLocationSamplerSuite(t *testing.T) {
+	suite.Run(t, new(LocationSamplerSuite))
+}
+
+func (s *LocationSamplerSuite) SetupTest() {
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.strataCache = repomocks.NewMockLocationStrataCache(s.T())
+	s.sampler = NewSpatialLocationSampler(s.locationRepo, s.strataCache, NewGeoService(), DefaultLocationSamplerConfig())
+	s.sampler.random = func() float64 { return 0.5 }
+}
+
+// serveCells makes FindSample return the locations of each geo cell.
+func (s *LocationSamplerSuite) serveCells(cells map[int][]*entities.Location) {
+	s.locationRepo.EXPECT().FindSample(mock.Anything, "map-uuid", mock.Anything, repositories.LocationFilter{}, mock.Anything, 8).
+		RunAndReturn(func(_ context.Context, _ string, stratum repositories.LocationStratum, _ repositories.LocationFilter, _ float64, _ int) ([]*entities.Location, error) {
+			return cells[stratum.GeoCell], nil
+		})
+}
+
+// sample counts the strata of the request's map, once as a game creation does, then samples.
+func (s *LocationSamplerSuite) sample(request LocationSampleRequest) ([]*entities.Location, error) {
+	s.strataCache.EXPECT().Get(mock.Anything, mock.Anything).Return(nil, false, nil).Once()
+	s.strataCache.EXPECT().Set(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	strata, err := s.sampler.CountStrata(context.Background(), request.MapId, 1, request.Tags)
+	s.Require().NoError(err)
+	request.Strata = strata
+	return s.sampler.Sample(context.Background(), request)
+}
+
+func idsOf(locations []*entities.Location) []string {
+	ids := make([]string, len(locations))
+	for i, location := range locations {
+		ids[i] = location.ID
+	}
+	return ids
+}
+
+func (s *LocationSamplerSuite) TestSample_TakesEachRoundFromAnotherCell() {
+	cells := make(map[int][]*entities.Location)
+	var strata []repositories.LocationStratum
+	for cell := 0; cell < 6; cell++ {
+		strata = append(strata, repositories.LocationStratum{GeoCell: cell, Count: 1000})
+		cells[cell] = []*entities.Location{
+			entities.RestoreLocation(fmt.Sprintf("loc-%d-a", cell), "pano", "map-uuid", float64(cell*10), 10, 0, 0),
+			entities.RestoreLocation(fmt.Sprintf("loc-%d-b", cell), "pano", "map-uuid", float64(cell*10), 10.1, 0, 0),
+		}
+	}
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).Return(strata, nil)
+	s.serveCells(cells)
+
+	locations, err := s.sample(LocationSampleRequest{MapId: "map-uuid", Quantity: 5})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"loc-0-a", "loc-1-a", "loc-2-a", "loc-3-a", "loc-4-a"}, idsOf(locations))
+	s.locationRepo.AssertNumberOfCalls(s.T(), "FindSample", 5)
+}
+
+func (s *LocationSamplerSuite) TestSample_RelaxesSeparationOnSmallMaps() {
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).
+		Return([]repositories.LocationStratum{{GeoCell: 1, Count: 2}}, nil)
+	s.serveCells(map[int][]*entities.Location{1: {
+		entities.RestoreLocation("loc-a", "pano-a", "map-uuid", 48.85, 2.35, 0, 0),
+		entities.RestoreLocation("loc-b", "pano-b", "map-uuid", 48.86, 2.35, 0, 0),
+	}})
+
+	locations, err := s.sample(LocationSampleRequest{MapId: "map-uuid", Quantity: 2})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"loc-a", "loc-b"}, idsOf(locations))
+}
+
+func (s *LocationSamplerSuite) TestSample_PicksExcludedLocationsLast() {
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).
+		Return([]repositories.LocationStratum{{GeoCell: 1, Count: 2}}, nil)
+	s.serveCells(map[int][]*entities.Location{1: {
+		entities.RestoreLocation("loc-seen", "pano-a", "map-uuid", 0, 1, 0, 0),
+		entities.RestoreLocation("loc-fresh", "pano-b", "map-uuid", 10, 1, 0, 0),
+	}})
+
+	locations, err := s.sample(LocationSampleRequest{MapId: "map-uuid", Quantity: 2, Exclude: []string{"loc-seen"}})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"loc-fresh", "loc-seen"}, idsOf(locations))
+}
+
+func (s *LocationSamplerSuite) TestSample_WhenEverythingIsExcluded_PicksTheLocationsSeenLongestAgo() {
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).
+		Return([]repositories.LocationStratum{{GeoCell: 1, Count: 3}}, nil)
+	s.serveCells(map[int][]*entities.Location{1: {
+		entities.RestoreLocation("loc-a", "pano-a", "map-uuid", 0, 1, 0, 0),
+		entities.RestoreLocation("loc-b", "pano-b", "map-uuid", 10, 1, 0, 0),
+		entities.RestoreLocation("loc-c", "pano-c", "map-uuid", 20, 1, 0, 0),
+	}})
+
+	locations, err := s.sample(LocationSampleRequest{MapId: "map-uuid", Quantity: 2, Exclude: []string{"loc-b", "loc-a", "loc-c"}})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"loc-c", "loc-a"}, idsOf(locations))
+}
+
+func (s *LocationSamplerSuite) TestSample_KeepsToTheRequestedDifficulty() {
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).
+		Return([]repositories.LocationStratum{
+			{GeoCell: 1, Difficulty: entities.LocationDifficultyEasy, Count: 1},
+			{GeoCell: 2, Difficulty: entities.LocationDifficultyHard, Count: 1},
+		}, nil)
+	s.serveCells(map[int][]*entities.Location{
+		1: {entities.RestoreLocation("loc-easy", "pano-a", "map-uuid", 0, 1, 0, 0)},
+		2: {entities.RestoreLocation("loc-hard", "pano-b", "map-uuid", 10, 1, 0, 0)},
+	})
+
+	locations, err := s.sample(LocationSampleRequest{MapId: "map-uuid", Quantity: 2, Difficulty: entities.LocationDifficultyHard})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"loc-hard"}, idsOf(locations))
+}
+
+func (s *LocationSamplerSuite) TestSample_SkipsAndKeepsAwayFromPickedLocations() {
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).
+		Return([]repositories.LocationStratum{{GeoCell: 1, Count: 3}}, nil)
+	picked := entities.RestoreLocation("loc-picked", "pano-a", "map-uuid", 0, 1, 0, 0)
+	s.serveCells(map[int][]*entities.Location{1: {
+		picked,
+		entities.RestoreLocation("loc-near", "pano-b", "map-uuid", 0.01, 1, 0, 0),
+		entities.RestoreLocation("loc-far", "pano-c", "map-uuid", 10, 1, 0, 0),
+	}})
+
+	locations, err := s.sample(LocationSampleRequest{MapId: "map-uuid", Quantity: 1, Picked: []*entities.Location{picked}})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"loc-far"}, idsOf(locations))
+}
+
+func (s *LocationSamplerSuite) TestSample_WhenMapIsEmpty_ReturnsNothing() {
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).Return(nil, nil)
+
+	locations, err := s.sample(LocationSampleRequest{MapId: "map-uuid", Quantity: 5})
+
+	s.Require().NoError(err)
+	s.Empty(locations)
+}
+
+func (s *LocationSamplerSuite) TestGroupStrata_ByCountryWhenTheMapSpansEnoughCountries() {
+	strata := []repositories.LocationStratum{
+		{GeoCell: 1, CountryCode: "FR", Count: 10},
+		{GeoCell: 2, CountryCode: "FR", Count: 5},
+		{GeoCell: 3, CountryCode: "BE", Count: 3},
+		{GeoCell: 4, Count: 2},
+	}
+
+	byCountry := groupStrata(strata, 2)
+	s.Require().Len(byCountry, 3)
+	s.Equal(int64(15), byCountry[0].count)
+
+	byCell := groupStrata(strata, 3)
+	s.Len(byCell, 4)
+}
+
+func (s *LocationSamplerSuite) TestSample_FiltersByTags() {
+	filter := repositories.LocationFilter{Tags: []string{"snow"}}
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", filter).
+		Return([]repositories.LocationStratum{{GeoCell: 1, Count: 1}}, nil)
+	s.locationRepo.EXPECT().FindSample(mock.Anything, "map-uuid", repositories.LocationStratum{GeoCell: 1, Count: 1}, filter, mock.Anything, 8).
+		Return([]*entities.Location{entities.RestoreLocation("loc-snow", "pano", "map-uuid", 60, 10, 0, 0)}, nil)
+
+	locations, err := s.sample(LocationSampleRequest{MapId: "map-uuid", Quantity: 1, Tags: []string{"snow"}})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"loc-snow"}, idsOf(locations))
+}
+
+func (s *LocationSamplerSuite) TestCountStrata_CountsEachRevisionOnce() {
+	strata := []repositories.LocationStratum{{GeoCell: 1, Count: 10}}
+	key := repositories.LocationStrataKey{MapId: "map-uuid", Revision: 3, Tags: "coastal snow"}
+	s.strataCache.EXPECT().Get(mock.Anything, key).Return(nil, false, nil).Once()
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{Tags: []string{"snow", "coastal"}}).Return(strata, nil).Once()
+	s.strataCache.EXPECT().Set(mock.Anything, key, strata).Return(nil).Once()
+	s.strataCache.EXPECT().Get(mock.Anything, key).Return(strata, true, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		counted, err := s.sampler.CountStrata(context.Background(), "map-uuid", 3, []string{"snow", "coastal"})
+		s.Require().NoError(err)
+		s.Equal(strata, counted)
+	}
+}
@@ -0,0 +1,14 @@
+package services
+
+import "context"
+
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers transactional emails.
+type Mailer interface {
+	Send(ctx context.Context, message MailMessage) error
+}
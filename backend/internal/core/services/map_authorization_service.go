@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+// MapAuthorizationService decides what users may do with a map: the owner holds every right,
+// collaborators the rights of their role, and anyone may play a map that is not private. Use
+// cases ask it rather than comparing owners themselves.
+type MapAuthorizationService struct {
+	collaboratorRepository repositories.MapCollaboratorRepository
+}
+
+func NewMapAuthorizationService(collaboratorRepository repositories.MapCollaboratorRepository) *MapAuthorizationService {
+	return &MapAuthorizationService{collaboratorRepository: collaboratorRepository}
+}
+
+// RoleOf returns the role userId holds on m, or the empty role. Anonymous users hold none.
+func (s *MapAuthorizationService) RoleOf(ctx context.Context, m *entities.Map, userId string) (entities.MapRole, error) {
+	if userId == "" {
+		return "", nil
+	}
+	if m.IsOwnedBy(userId) {
+		return entities.MapRoleOwner, nil
+	}
+	collaborator, err := s.collaboratorRepository.FindByMapIdAndUserId(ctx, m.ID, userId)
+	if err != nil {
+		return "", err
+	}
+	if collaborator == nil {
+		return "", nil
+	}
+	return collaborator.Role, nil
+}
+
+// CanPlay reports whether userId may see and play m. Unlisted maps are playable by anyone
+// knowing their id.
+func (s *MapAuthorizationService) CanPlay(ctx context.Context, m *entities.Map, userId string) (bool, error) {
+	if !m.IsPrivate() {
+		return true, nil
+	}
+	role, err := s.RoleOf(ctx, m, userId)
+	if err != nil {
+		return false, err
+	}
+	return role.Satisfies(entities.MapRoleViewer), nil
+}
+
+// Authorize returns nil when userId holds at least required on m. Users who cannot see the map at
+// all get a not found error, so private maps do not leak; the others get a forbidden error.
+func (s *MapAuthorizationService) Authorize(ctx context.Context, m *entities.Map, userId string, required entities.MapRole) error {
+	role, err := s.RoleOf(ctx, m, userId)
+	if err != nil {
+		return err
+	}
+	if role.Satisfies(required) {
+		return nil
+	}
+	if m.IsPrivate() && role == "" {
+		return coreerrors.NotFound("map not found")
+	}
+	return coreerrors.Forbidden("insufficient permissions on this map")
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MapAuthorizationServiceSuite struct {
+	suite.Suite
+	collaboratorRepo *repomocks.MockMapCollaboratorRepository
+	service          *MapAuthorizationService
+	m                *entities.Map
+}
+
+func TestMapAuthorizationServiceSuite(t *testing.T) {
+	suite.Run(t, new(MapAuthorizationServiceSuite))
+}
+
+func (s *MapAuthorizationServiceSuite) SetupTest() {
+	s.collaboratorRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.service = NewMapAuthorizationService(s.collaboratorRepo)
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+}
+
+func (s *MapAuthorizationServiceSuite) TestRoleOf_Owner() {
+	role, err := s.service.RoleOf(context.Background(), s.m, "owner-uuid")
+
+	s.Require().NoError(err)
+	s.Equal(entities.MapRoleOwner, role)
+}
+
+func (s *MapAuthorizationServiceSuite) TestRoleOf_Collaborator() {
+	s.collaboratorRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "bob-uuid", entities.MapRoleEditor, "owner-uuid"), nil)
+
+	role, err := s.service.RoleOf(context.Background(), s.m, "bob-uuid")
+
+	s.Require().NoError(err)
+	s.Equal(entities.MapRoleEditor, role)
+}
+
+func (s *MapAuthorizationServiceSuite) TestRoleOf_Anonymous() {
+	role, err := s.service.RoleOf(context.Background(), s.m, "")
+
+	s.Require().NoError(err)
+	s.Empty(role)
+}
+
+func (s *MapAuthorizationServiceSuite) TestCanPlay_PrivateMapNeedsARole() {
+	s.collaboratorRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "bob-uuid", entities.MapRoleViewer, "owner-uuid"), nil)
+	s.collaboratorRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "carol-uuid").Return(nil, nil)
+
+	bob, err := s.service.CanPlay(context.Background(), s.m, "bob-uuid")
+	s.Require().NoError(err)
+	carol, err := s.service.CanPlay(context.Background(), s.m, "carol-uuid")
+	s.Require().NoError(err)
+
+	s.True(bob)
+	s.False(carol)
+}
+
+func (s *MapAuthorizationServiceSuite) TestCanPlay_UnlistedMapIsOpen() {
+	s.m.Visibility = entities.MapVisibilityUnlisted
+
+	ok, err := s.service.CanPlay(context.Background(), s.m, "")
+
+	s.Require().NoError(err)
+	s.True(ok)
+}
+
+func (s *MapAuthorizationServiceSuite) TestAuthorize_ViewerCannotEdit() {
+	s.collaboratorRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "bob-uuid", entities.MapRoleViewer, "owner-uuid"), nil)
+
+	err := s.service.Authorize(context.Background(), s.m, "bob-uuid", entities.MapRoleEditor)
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions on this map", err.Error())
+}
+
+func (s *MapAuthorizationServiceSuite) TestAuthorize_StrangerOnPrivateMap_ReturnsNotFound() {
+	s.collaboratorRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "carol-uuid").Return(nil, nil)
+
+	err := s.service.Authorize(context.Background(), s.m, "carol-uuid", entities.MapRoleEditor)
+
+	s.Require().Error(err)
+	s.Equal("map not found", err.Error())
+}
+
+func (s *MapAuthorizationServiceSuite) TestAuthorize_WhenRepositoryFails_ReturnsError() {
+	errMock := errors.New("mock error")
+	s.collaboratorRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").Return(nil, errMock)
+
+	err := s.service.Authorize(context.Background(), s.m, "bob-uuid", entities.MapRoleEditor)
+
+	s.ErrorIs(err, errMock)
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+)
+
+// Notifier delivers notifications to users' inboxes. Use cases call it for anything a user should
+// hear about; it stores the notification and pushes it to the user's connected clients.
+type Notifier interface {
+	Notify(ctx context.Context, notification *entities.Notification) error
+}
+
+// NotificationBroker fans stored notifications out to the streams of connected clients.
+type NotificationBroker interface {
+	// Publish hands notification to every current subscriber of its user without blocking.
+	Publish(notification *entities.Notification)
+	// Subscribe returns the channel receiving the user's new notifications and a function to call
+	// once the client is gone.
+	Subscribe(userId string) (<-chan *entities.Notification, func())
+}
@@ -0,0 +1,409 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OidcProviderConfig describes an OpenID Connect provider registered with the application.
+type OidcProviderConfig struct {
+	Name         string
+	Issuer       string
+	ClientId     string
+	ClientSecret string
+	RedirectUrl  string
+	Scopes       []string
+}
+
+// OidcIdentity is the verified set of claims extracted from a provider's ID token.
+type OidcIdentity struct {
+	Provider          string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+type oidcProvider struct {
+	config    OidcProviderConfig
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]any
+}
+
+type oidcIdTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// ErrOidcProviderNotFound is returned when a flow references a provider that is not configured.
+var ErrOidcProviderNotFound = errors.New("oidc provider not found")
+
+// OidcService implements the client side of the OpenID Connect authorization code flow with PKCE.
+// Provider metadata and signing keys are discovered lazily and cached per provider.
+type OidcService struct {
+	providers  map[string]*oidcProvider
+	httpClient *http.Client
+}
+
+// NewOidcService returns an OidcService for the given providers.
+// A nil httpClient falls back to a client with a 10 second timeout.
+func NewOidcService(providers []OidcProviderConfig, httpClient *http.Client) *OidcService {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	registered := make(map[string]*oidcProvider, len(providers))
+	for _, p := range providers {
+		if len(p.Scopes) == 0 {
+			p.Scopes = []string{"openid", "email", "profile"}
+		}
+		registered[p.Name] = &oidcProvider{config: p}
+	}
+	return &OidcService{providers: registered, httpClient: httpClient}
+}
+
+// NewOidcServiceFromEnv builds an OidcService from OIDC_PROVIDERS (a comma separated list of names)
+// and the OIDC_<NAME>_ISSUER, _CLIENT_ID, _CLIENT_SECRET, _REDIRECT_URL and optional _SCOPES variables.
+// Social login is optional, so an empty OIDC_PROVIDERS yields a service without providers.
+func NewOidcServiceFromEnv() (*OidcService, error) {
+	var providers []OidcProviderConfig
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		config := OidcProviderConfig{
+			Name:         name,
+			Issuer:       os.Getenv(prefix + "ISSUER"),
+			ClientId:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectUrl:  os.Getenv(prefix + "REDIRECT_URL"),
+		}
+		if config.Issuer == "" || config.ClientId == "" || config.RedirectUrl == "" {
+			return nil, fmt.Errorf("%sISSUER, %sCLIENT_ID and %sREDIRECT_URL must be set", prefix, prefix, prefix)
+		}
+		if scopes := os.Getenv(prefix + "SCOPES"); scopes != "" {
+			config.Scopes = strings.Fields(strings.ReplaceAll(scopes, ",", " "))
+		}
+		providers = append(providers, config)
+	}
+	return NewOidcService(providers, nil), nil
+}
+
+// HasProvider reports whether a provider with the given name is configured.
+func (s *OidcService) HasProvider(name string) bool {
+	_, ok := s.providers[name]
+	return ok
+}
+
+// AuthorizationUrl returns the provider URL the user agent must be redirected to in order to start the flow.
+func (s *OidcService) AuthorizationUrl(ctx context.Context, provider, state, nonce, codeChallenge string) (string, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return "", ErrOidcProviderNotFound
+	}
+	discovery, err := s.discover(ctx, p)
+	if err != nil {
+		return "", err
+	}
+
+	authUrl, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid authorization endpoint: %w", err)
+	}
+	query := authUrl.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientId)
+	query.Set("redirect_uri", p.config.RedirectUrl)
+	query.Set("scope", strings.Join(p.config.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authUrl.RawQuery = query.Encode()
+	return authUrl.String(), nil
+}
+
+// Exchange redeems an authorization code and returns the identity asserted by the verified ID token.
+func (s *OidcService) Exchange(ctx context.Context, provider, code, codeVerifier, nonce string) (*OidcIdentity, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, ErrOidcProviderNotFound
+	}
+	discovery, err := s.discover(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectUrl)
+	form.Set("client_id", p.config.ClientId)
+	form.Set("code_verifier", codeVerifier)
+	if p.config.ClientSecret != "" {
+		form.Set("client_secret", p.config.ClientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var tokenResponse struct {
+		IdToken string `json:"id_token"`
+	}
+	if err := s.doJSON(req, &tokenResponse); err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+	if tokenResponse.IdToken == "" {
+		return nil, errors.New("token response did not include an id_token")
+	}
+
+	claims, err := s.verifyIdToken(ctx, p, discovery, tokenResponse.IdToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("id token nonce mismatch")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("id token is missing the sub claim")
+	}
+
+	return &OidcIdentity{
+		Provider:          provider,
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     claims.EmailVerified,
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+	}, nil
+}
+
+func (s *OidcService) verifyIdToken(ctx context.Context, p *oidcProvider, discovery *oidcDiscovery, idToken string) (*oidcIdTokenClaims, error) {
+	tok, err := jwt.ParseWithClaims(idToken, &oidcIdTokenClaims{}, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return s.signingKey(ctx, p, discovery, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.config.ClientId),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+	return tok.Claims.(*oidcIdTokenClaims), nil
+}
+
+// signingKey returns the provider key for kid, refreshing the cached JWKS once when the key is unknown
+// so that provider key rotation is picked up without a restart.
+func (s *OidcService) signingKey(ctx context.Context, p *oidcProvider, discovery *oidcDiscovery, kid string) (any, error) {
+	p.mu.Lock()
+	keys := p.keys
+	p.mu.Unlock()
+
+	if key, ok := pickKey(keys, kid); ok {
+		return key, nil
+	}
+
+	keys, err := s.fetchKeys(ctx, discovery.JwksUri)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+
+	if key, ok := pickKey(keys, kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("signing key %q not found", kid)
+}
+
+func pickKey(keys map[string]any, kid string) (any, bool) {
+	if kid != "" {
+		key, ok := keys[kid]
+		return key, ok
+	}
+	// Tokens without a kid are only accepted when the provider publishes a single key.
+	if len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func (s *OidcService) discover(ctx context.Context, p *oidcProvider) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	cached := p.discovery
+	p.mu.Unlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, err
+	}
+	var discovery oidcDiscovery
+	if err := s.doJSON(req, &discovery); err != nil {
+		return nil, fmt.Errorf("oidc discovery failed: %w", err)
+	}
+	if discovery.Issuer != p.config.Issuer {
+		return nil, fmt.Errorf("oidc discovery issuer mismatch: expected %q, got %q", p.config.Issuer, discovery.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JwksUri == "" {
+		return nil, errors.New("oidc discovery document is incomplete")
+	}
+
+	p.mu.Lock()
+	p.discovery = &discovery
+	p.mu.Unlock()
+	return &discovery, nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (s *OidcService) fetchKeys(ctx context.Context, jwksUri string) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksUri, nil)
+	if err != nil {
+		return nil, err
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := s.doJSON(req, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]any, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			// Unsupported key types are skipped rather than failing the whole set.
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func (s *OidcService) doJSON(req *http.Request, out any) error {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, req.URL.Host)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// GeneratePkce returns a random PKCE code verifier and its S256 code challenge.
+func GeneratePkce() (verifier string, challenge string, err error) {
+	verifier, err = RandomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	return verifier, PkceChallenge(verifier), nil
+}
+
+// PkceChallenge derives the S256 code challenge for a code verifier.
+func PkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// RandomToken returns n cryptographically random bytes encoded as unpadded base64url.
+func RandomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
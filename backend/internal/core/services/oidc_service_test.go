@@ -0,0 +1,136 @@
+package services_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services/oidctest"
+	"github.com/stretchr/testify/suite"
+)
+
+type OidcServiceSuite struct {
+	suite.Suite
+	provider *oidctest.Provider
+	svc      *services.OidcService
+}
+
+func TestOidcServiceSuite(t *testing.T) {
+	suite.Run(t, new(OidcServiceSuite))
+}
+
+func (s *OidcServiceSuite) SetupTest() {
+	s.provider = oidctest.NewProvider(s.T())
+	s.svc = services.NewOidcService([]services.OidcProviderConfig{s.provider.Config("fake")}, nil)
+}
+
+func (s *OidcServiceSuite) authorize(nonce string, identity oidctest.Identity) (code, verifier string) {
+	verifier, challenge, err := services.GeneratePkce()
+	s.Require().NoError(err)
+	authUrl, err := s.svc.AuthorizationUrl(context.Background(), "fake", "state-123", nonce, challenge)
+	s.Require().NoError(err)
+	code, _ = s.provider.Authorize(authUrl, identity)
+	return code, verifier
+}
+
+func (s *OidcServiceSuite) TestHasProvider() {
+	s.True(s.svc.HasProvider("fake"))
+	s.False(s.svc.HasProvider("unknown"))
+}
+
+func (s *OidcServiceSuite) TestAuthorizationUrl_ContainsPkceAndState() {
+	authUrl, err := s.svc.AuthorizationUrl(context.Background(), "fake", "state-123", "nonce-456", "challenge")
+
+	s.Require().NoError(err)
+	u, err := url.Parse(authUrl)
+	s.Require().NoError(err)
+	s.Equal(s.provider.Issuer()+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	q := u.Query()
+	s.Equal("code", q.Get("response_type"))
+	s.Equal(s.provider.ClientId, q.Get("client_id"))
+	s.Equal(s.provider.RedirectUrl, q.Get("redirect_uri"))
+	s.Equal("openid email profile", q.Get("scope"))
+	s.Equal("state-123", q.Get("state"))
+	s.Equal("nonce-456", q.Get("nonce"))
+	s.Equal("challenge", q.Get("code_challenge"))
+	s.Equal("S256", q.Get("code_challenge_method"))
+}
+
+func (s *OidcServiceSuite) TestAuthorizationUrl_WhenProviderUnknown_ReturnsError() {
+	_, err := s.svc.AuthorizationUrl(context.Background(), "unknown", "state", "nonce", "challenge")
+
+	s.ErrorIs(err, services.ErrOidcProviderNotFound)
+}
+
+func (s *OidcServiceSuite) TestNewOidcServiceFromEnv_WhenProviderIncomplete_ReturnsError() {
+	s.T().Setenv("OIDC_PROVIDERS", "google")
+	s.T().Setenv("OIDC_GOOGLE_ISSUER", "https://accounts.google.com")
+
+	_, err := services.NewOidcServiceFromEnv()
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "OIDC_GOOGLE_CLIENT_ID")
+}
+
+func (s *OidcServiceSuite) TestExchange_WhenCodeValid_ReturnsIdentity() {
+	code, verifier := s.authorize("nonce-1", oidctest.Identity{
+		Subject:           "sub-123",
+		Email:             "john@example.com",
+		EmailVerified:     true,
+		Name:              "John Doe",
+		PreferredUsername: "johnny",
+	})
+
+	identity, err := s.svc.Exchange(context.Background(), "fake", code, verifier, "nonce-1")
+
+	s.Require().NoError(err)
+	s.Equal("fake", identity.Provider)
+	s.Equal("sub-123", identity.Subject)
+	s.Equal("john@example.com", identity.Email)
+	s.True(identity.EmailVerified)
+	s.Equal("John Doe", identity.Name)
+	s.Equal("johnny", identity.PreferredUsername)
+}
+
+func (s *OidcServiceSuite) TestExchange_WhenVerifierWrong_ReturnsError() {
+	code, _ := s.authorize("nonce-1", oidctest.Identity{Subject: "sub-123"})
+
+	_, err := s.svc.Exchange(context.Background(), "fake", code, "wrong-verifier", "nonce-1")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "token exchange failed")
+}
+
+func (s *OidcServiceSuite) TestExchange_WhenNonceMismatch_ReturnsError() {
+	code, verifier := s.authorize("nonce-1", oidctest.Identity{Subject: "sub-123"})
+
+	_, err := s.svc.Exchange(context.Background(), "fake", code, verifier, "other-nonce")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "nonce mismatch")
+}
+
+func (s *OidcServiceSuite) TestExchange_WhenCodeReused_ReturnsError() {
+	code, verifier := s.authorize("nonce-1", oidctest.Identity{Subject: "sub-123"})
+	_, err := s.svc.Exchange(context.Background(), "fake", code, verifier, "nonce-1")
+	s.Require().NoError(err)
+
+	_, err = s.svc.Exchange(context.Background(), "fake", code, verifier, "nonce-1")
+
+	s.Require().Error(err)
+}
+
+func (s *OidcServiceSuite) TestExchange_WhenProviderUnknown_ReturnsError() {
+	_, err := s.svc.Exchange(context.Background(), "unknown", "code", "verifier", "nonce")
+
+	s.ErrorIs(err, services.ErrOidcProviderNotFound)
+}
+
+func (s *OidcServiceSuite) TestGeneratePkce_ChallengeMatchesVerifier() {
+	verifier, challenge, err := services.GeneratePkce()
+
+	s.Require().NoError(err)
+	s.GreaterOrEqual(len(verifier), 43)
+	s.Equal(services.PkceChallenge(verifier), challenge)
+}
@@ -0,0 +1,200 @@
+// Package oidctest provides an in-process OpenID Connect provider for tests.
+// It implements discovery, JWKS, and the authorization code grant with PKCE,
+// signing ID tokens with a freshly generated RSA key.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+const keyId = "test-key"
+
+// Identity is the user a test "signs in" as at the fake provider.
+type Identity struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+type pendingCode struct {
+	identity      Identity
+	clientId      string
+	redirectUri   string
+	nonce         string
+	codeChallenge string
+}
+
+// Provider is a fake OIDC provider backed by an httptest.Server.
+type Provider struct {
+	t            testing.TB
+	server       *httptest.Server
+	key          *rsa.PrivateKey
+	ClientId     string
+	ClientSecret string
+	RedirectUrl  string
+
+	mu    sync.Mutex
+	codes map[string]pendingCode
+}
+
+// NewProvider starts a fake provider that is shut down when the test finishes.
+func NewProvider(t testing.TB) *Provider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	p := &Provider{
+		t:            t,
+		key:          key,
+		ClientId:     "maya-guessr-test",
+		ClientSecret: "test-secret",
+		RedirectUrl:  "http://localhost/auth/oidc/fake/callback",
+		codes:        make(map[string]pendingCode),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/jwks", p.handleJwks)
+	mux.HandleFunc("/token", p.handleToken)
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+// Issuer returns the provider issuer URL.
+func (p *Provider) Issuer() string {
+	return p.server.URL
+}
+
+// Config returns an OidcProviderConfig registering this provider under name.
+func (p *Provider) Config(name string) services.OidcProviderConfig {
+	return services.OidcProviderConfig{
+		Name:         name,
+		Issuer:       p.Issuer(),
+		ClientId:     p.ClientId,
+		ClientSecret: p.ClientSecret,
+		RedirectUrl:  p.RedirectUrl,
+	}
+}
+
+// Authorize simulates the user approving the authorization request at authorizationUrl
+// and returns the code and state the provider would redirect back with.
+func (p *Provider) Authorize(authorizationUrl string, identity Identity) (code string, state string) {
+	p.t.Helper()
+	u, err := url.Parse(authorizationUrl)
+	if err != nil {
+		p.t.Fatalf("invalid authorization url: %v", err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		p.t.Fatalf("authorization request is missing a S256 code challenge")
+	}
+	code, err = services.RandomToken(16)
+	if err != nil {
+		p.t.Fatalf("failed to generate code: %v", err)
+	}
+	p.mu.Lock()
+	p.codes[code] = pendingCode{
+		identity:      identity,
+		clientId:      q.Get("client_id"),
+		redirectUri:   q.Get("redirect_uri"),
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+	}
+	p.mu.Unlock()
+	return code, q.Get("state")
+}
+
+func (p *Provider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{
+		"issuer":                 p.Issuer(),
+		"authorization_endpoint": p.Issuer() + "/authorize",
+		"token_endpoint":         p.Issuer() + "/token",
+		"jwks_uri":               p.Issuer() + "/jwks",
+	})
+}
+
+func (p *Provider) handleJwks(w http.ResponseWriter, _ *http.Request) {
+	pub := p.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyId,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *Provider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	p.mu.Lock()
+	pending, ok := p.codes[code]
+	delete(p.codes, code)
+	p.mu.Unlock()
+
+	if !ok ||
+		pending.clientId != r.PostForm.Get("client_id") ||
+		pending.redirectUri != r.PostForm.Get("redirect_uri") ||
+		r.PostForm.Get("client_secret") != p.ClientSecret ||
+		services.PkceChallenge(r.PostForm.Get("code_verifier")) != pending.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":                p.Issuer(),
+		"sub":                pending.identity.Subject,
+		"aud":                pending.clientId,
+		"iat":                now.Unix(),
+		"exp":                now.Add(5 * time.Minute).Unix(),
+		"nonce":              pending.nonce,
+		"email":              pending.identity.Email,
+		"email_verified":     pending.identity.EmailVerified,
+		"name":               pending.identity.Name,
+		"preferred_username": pending.identity.PreferredUsername,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyId
+	idToken, err := token.SignedString(p.key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "fake-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+// PanoramaPayload is what a client needs to render the panorama of a round, whatever its provider.
+// It never holds the coordinates of the location.
+type PanoramaPayload struct {
+	Provider entities.PanoramaProvider `json:"provider"`
+	PanoId   string                    `json:"pano_id"`
+	Heading  float64                   `json:"heading"`
+	Pitch    float64                   `json:"pitch"`
+	// ImageUrl is the full equirectangular image, for providers serving one.
+	ImageUrl string `json:"image_url,omitempty"`
+	// Tiles is set for providers serving the image in tiles.
+	Tiles *PanoramaTiles `json:"tiles,omitempty"`
+}
+
+// PanoramaTiles describes a tiled equirectangular image. Zoom level z is 2^z tiles wide and half as
+// many tall.
+type PanoramaTiles struct {
+	// UrlTemplate has {z}, {x} and {y} placeholders.
+	UrlTemplate string `json:"url_template"`
+	TileSize    int    `json:"tile_size"`
+	MaxZoom     int    `json:"max_zoom"`
+}
+
+// PanoramaProvider is a service serving panoramas: it knows the format of its panorama ids and how
+// clients load them.
+type PanoramaProvider interface {
+	Name() entities.PanoramaProvider
+	// ValidatePanoId returns a bad request error when panoId cannot be a panorama of the provider.
+	ValidatePanoId(panoId string) error
+	// Payload returns the render payload of location, whose panorama id is valid.
+	Payload(location *entities.Location) PanoramaPayload
+}
+
+// PanoramaRegistry holds the enabled panorama providers.
+type PanoramaRegistry struct {
+	providers map[entities.PanoramaProvider]PanoramaProvider
+}
+
+func NewPanoramaRegistry(providers ...PanoramaProvider) *PanoramaRegistry {
+	registered := make(map[entities.PanoramaProvider]PanoramaProvider, len(providers))
+	for _, provider := range providers {
+		registered[provider.Name()] = provider
+	}
+	return &PanoramaRegistry{providers: registered}
+}
+
+// NewPanoramaRegistryFromEnv enables Google and Mapillary, and the custom provider when
+// CUSTOM_PANORAMA_BASE_URL is set, with the optional CUSTOM_PANORAMA_TILE_SIZE and
+// CUSTOM_PANORAMA_MAX_ZOOM.
+func NewPanoramaRegistryFromEnv() *PanoramaRegistry {
+	providers := []PanoramaProvider{NewGooglePanoramaProvider(), NewMapillaryPanoramaProvider()}
+	if baseUrl := os.Getenv("CUSTOM_PANORAMA_BASE_URL"); baseUrl != "" {
+		config := DefaultCustomPanoramaConfig(baseUrl)
+		if value := os.Getenv("CUSTOM_PANORAMA_TILE_SIZE"); value != "" {
+			tileSize, err := strconv.Atoi(value)
+			if err != nil || tileSize <= 0 {
+				panic(fmt.Sprintf("invalid CUSTOM_PANORAMA_TILE_SIZE %q", value))
+			}
+			config.TileSize = tileSize
+		}
+		if value := os.Getenv("CUSTOM_PANORAMA_MAX_ZOOM"); value != "" {
+			maxZoom, err := strconv.Atoi(value)
+			if err != nil || maxZoom < 0 {
+				panic(fmt.Sprintf("invalid CUSTOM_PANORAMA_MAX_ZOOM %q", value))
+			}
+			config.MaxZoom = maxZoom
+		}
+		provider, err := NewCustomPanoramaProvider(config)
+		if err != nil {
+			panic(err)
+		}
+		providers = append(providers, provider)
+	}
+	return NewPanoramaRegistry(providers...)
+}
+
+// Provider returns the provider named name, false when it is not enabled.
+func (r *PanoramaRegistry) Provider(name entities.PanoramaProvider) (PanoramaProvider, bool) {
+	provider, ok := r.providers[name]
+	return provider, ok
+}
+
+// Validate checks that the provider of location is enabled and that its panorama id has the
+// provider's format.
+func (r *PanoramaRegistry) Validate(location *entities.Location) error {
+	provider, ok := r.Provider(location.Provider)
+	if !ok {
+		return coreerrors.BadRequest(fmt.Sprintf("panorama provider %q is not enabled", location.Provider))
+	}
+	return provider.ValidatePanoId(location.PanoId)
+}
+
+// Payload returns the render payload of location.
+func (r *PanoramaRegistry) Payload(location *entities.Location) (PanoramaPayload, error) {
+	provider, ok := r.Provider(location.Provider)
+	if !ok {
+		return PanoramaPayload{}, coreerrors.InternalServerError(fmt.Sprintf("panorama provider %q is not enabled", location.Provider))
+	}
+	return provider.Payload(location), nil
+}
+
+func basePanoramaPayload(location *entities.Location) PanoramaPayload {
+	return PanoramaPayload{
+		Provider: location.Provider,
+		PanoId:   location.PanoId,
+		Heading:  location.Heading,
+		Pitch:    location.Pitch,
+	}
+}
+
+// googlePanoIdPattern matches Street View panoramas, 22 characters long, and photo spheres
+// uploaded by users, which start with CAoS or AF1Qip.
+var googlePanoIdPattern = regexp.MustCompile(`^([A-Za-z0-9_-]{22}|(CAoS|AF1Qip)[A-Za-z0-9_-]{10,500})$`)
+
+// GooglePanoramaProvider serves Street View panoramas. Clients load them with the Maps JavaScript
+// API from the panorama id alone.
+type GooglePanoramaProvider struct{}
+
+func NewGooglePanoramaProvider() *GooglePanoramaProvider {
+	return &GooglePanoramaProvider{}
+}
+
+func (p *GooglePanoramaProvider) Name() entities.PanoramaProvider {
+	return entities.PanoramaProviderGoogle
+}
+
+func (p *GooglePanoramaProvider) ValidatePanoId(panoId string) error {
+	if !googlePanoIdPattern.MatchString(panoId) {
+		return coreerrors.BadRequest(fmt.Sprintf("invalid google panorama id: %q", panoId))
+	}
+	return nil
+}
+
+func (p *GooglePanoramaProvider) Payload(location *entities.Location) PanoramaPayload {
+	return basePanoramaPayload(location)
+}
+
+var mapillaryPanoIdPattern = regexp.MustCompile(`^[0-9]{1,20}$`)
+
+// MapillaryPanoramaProvider serves Mapillary images, whose ids are numeric. Clients load them with
+// MapillaryJS and their own access token.
+type MapillaryPanoramaProvider struct{}
+
+func NewMapillaryPanoramaProvider() *MapillaryPanoramaProvider {
+	return &MapillaryPanoramaProvider{}
+}
+
+func (p *MapillaryPanoramaProvider) Name() entities.PanoramaProvider {
+	return entities.PanoramaProviderMapillary
+}
+
+func (p *MapillaryPanoramaProvider) ValidatePanoId(panoId string) error {
+	if !mapillaryPanoIdPattern.MatchString(panoId) {
+		return coreerrors.BadRequest(fmt.Sprintf("invalid mapillary image id: %q (must be numeric)", panoId))
+	}
+	return nil
+}
+
+func (p *MapillaryPanoramaProvider) Payload(location *entities.Location) PanoramaPayload {
+	return basePanoramaPayload(location)
+}
+
+// CustomPanoramaConfig locates the 360° photos hosted by the map makers. The photo of panorama id
+// is at <BaseUrl>/<id>/pano.jpg and its tiles at <BaseUrl>/<id>/tiles/<z>/<x>/<y>.jpg.
+type CustomPanoramaConfig struct {
+	BaseUrl  string
+	TileSize int
+	MaxZoom  int
+}
+
+func DefaultCustomPanoramaConfig(baseUrl string) CustomPanoramaConfig {
+	return CustomPanoramaConfig{
+		BaseUrl:  baseUrl,
+		TileSize: 512,
+		MaxZoom:  3,
+	}
+}
+
+// customPanoIdPattern keeps ids usable as a single path segment.
+var customPanoIdPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,127}$`)
+
+// CustomPanoramaProvider serves the 360° photos hosted by the map makers.
+type CustomPanoramaProvider struct {
+	config CustomPanoramaConfig
+}
+
+// NewCustomPanoramaProvider returns an error when the base URL is not an absolute http(s) URL.
+func NewCustomPanoramaProvider(config CustomPanoramaConfig) (*CustomPanoramaProvider, error) {
+	parsed, err := url.Parse(config.BaseUrl)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid custom panorama base url %q", config.BaseUrl)
+	}
+	config.BaseUrl = strings.TrimSuffix(config.BaseUrl, "/")
+	return &CustomPanoramaProvider{config: config}, nil
+}
+
+func (p *CustomPanoramaProvider) Name() entities.PanoramaProvider {
+	return entities.PanoramaProviderCustom
+}
+
+func (p *CustomPanoramaProvider) ValidatePanoId(panoId string) error {
+	if !customPanoIdPattern.MatchString(panoId) {
+		return coreerrors.BadRequest(fmt.Sprintf("invalid custom panorama id: %q (must be up to 128 letters, digits, dashes or underscores)", panoId))
+	}
+	return nil
+}
+
+func (p *CustomPanoramaProvider) Payload(location *entities.Location) PanoramaPayload {
+	payload := basePanoramaPayload(location)
+	base := p.config.BaseUrl + "/" + location.PanoId
+	payload.ImageUrl = base + "/pano.jpg"
+	payload.Tiles = &PanoramaTiles{
+		UrlTemplate: base + "/tiles/{z}/{x}/{y}.jpg",
+		TileSize:    p.config.TileSize,
+		MaxZoom:     p.config.MaxZoom,
+	}
+	return payload
+}
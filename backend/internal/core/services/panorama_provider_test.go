@@ -0,0 +1,96 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/stretchr/testify/suite"
+)
+
+type PanoramaRegistrySuite struct {
+	suite.Suite
+	registry *PanoramaRegistry
+}
+
+func TestPanoramaRegistrySuite(t *testing.T) {
+	suite.Run(t, new(PanoramaRegistrySuite))
+}
+
+func (s *PanoramaRegistrySuite) SetupTest() {
+	custom, err := NewCustomPanoramaProvider(DefaultCustomPanoramaConfig("https://panos.example.com/360/"))
+	s.Require().NoError(err)
+	s.registry = NewPanoramaRegistry(NewGooglePanoramaProvider(), NewMapillaryPanoramaProvider(), custom)
+}
+
+func panoramaLocation(provider entities.PanoramaProvider, panoId string) *entities.Location {
+	location := entities.NewLocation(panoId, "map", 48.85, 2.35, 90, 5)
+	location.Provider = provider
+	return location
+}
+
+func (s *PanoramaRegistrySuite) TestValidate_ChecksTheIdFormatOfEachProvider() {
+	valid := []*entities.Location{
+		panoramaLocation(entities.PanoramaProviderGoogle, "Ujk8pJ6z2dGmR4ZlLzPSkQ"),
+		panoramaLocation(entities.PanoramaProviderGoogle, "AF1QipNg8vXZw2Bd3mfQ_kH0l7Yr4bW9uTq"),
+		panoramaLocation(entities.PanoramaProviderMapillary, "498763468214164"),
+		panoramaLocation(entities.PanoramaProviderCustom, "louvre-courtyard_2"),
+	}
+	for _, location := range valid {
+		s.NoError(s.registry.Validate(location), location.PanoId)
+	}
+
+	invalid := []*entities.Location{
+		panoramaLocation(entities.PanoramaProviderGoogle, "498763468214164"),
+		panoramaLocation(entities.PanoramaProviderMapillary, "Ujk8pJ6z2dGmR4ZlLzPSkQ"),
+		panoramaLocation(entities.PanoramaProviderCustom, "../secrets"),
+		panoramaLocation(entities.PanoramaProviderCustom, ""),
+	}
+	for _, location := range invalid {
+		s.Error(s.registry.Validate(location), location.PanoId)
+	}
+}
+
+func (s *PanoramaRegistrySuite) TestValidate_DisabledProvider_ReturnsBadRequest() {
+	registry := NewPanoramaRegistry(NewGooglePanoramaProvider())
+
+	err := registry.Validate(panoramaLocation(entities.PanoramaProviderMapillary, "498763468214164"))
+
+	s.Require().Error(err)
+	s.Equal(`panorama provider "mapillary" is not enabled`, err.Error())
+}
+
+func (s *PanoramaRegistrySuite) TestPayload_GoogleGivesThePanoIdOnly() {
+	payload, err := s.registry.Payload(panoramaLocation(entities.PanoramaProviderGoogle, "Ujk8pJ6z2dGmR4ZlLzPSkQ"))
+
+	s.Require().NoError(err)
+	s.Equal(PanoramaPayload{Provider: entities.PanoramaProviderGoogle, PanoId: "Ujk8pJ6z2dGmR4ZlLzPSkQ", Heading: 90, Pitch: 5}, payload)
+}
+
+func (s *PanoramaRegistrySuite) TestPayload_CustomGivesTheImageAndTiles() {
+	payload, err := s.registry.Payload(panoramaLocation(entities.PanoramaProviderCustom, "louvre"))
+
+	s.Require().NoError(err)
+	s.Equal("https://panos.example.com/360/louvre/pano.jpg", payload.ImageUrl)
+	s.Equal(&PanoramaTiles{UrlTemplate: "https://panos.example.com/360/louvre/tiles/{z}/{x}/{y}.jpg", TileSize: 512, MaxZoom: 3}, payload.Tiles)
+}
+
+func (s *PanoramaRegistrySuite) TestNewCustomPanoramaProvider_RejectsRelativeUrls() {
+	_, err := NewCustomPanoramaProvider(DefaultCustomPanoramaConfig("/panos"))
+
+	s.Error(err)
+}
+
+func (s *PanoramaRegistrySuite) TestNewPanoramaRegistryFromEnv_EnablesCustomWithABaseUrl() {
+	s.T().Setenv("CUSTOM_PANORAMA_BASE_URL", "")
+	_, ok := NewPanoramaRegistryFromEnv().Provider(entities.PanoramaProviderCustom)
+	s.False(ok)
+
+	s.T().Setenv("CUSTOM_PANORAMA_BASE_URL", "https://panos.example.com")
+	s.T().Setenv("CUSTOM_PANORAMA_MAX_ZOOM", "5")
+	payload, err := NewPanoramaRegistryFromEnv().Payload(panoramaLocation(entities.PanoramaProviderCustom, "louvre"))
+	s.Require().NoError(err)
+	s.Equal(5, payload.Tiles.MaxZoom)
+
+	s.T().Setenv("CUSTOM_PANORAMA_TILE_SIZE", "big")
+	s.Panics(func() { NewPanoramaRegistryFromEnv() })
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PersonalAccessTokenServiceSuite struct {
+	suite.Suite
+	svc *PersonalAccessTokenService
+}
+
+func TestPersonalAccessTokenServiceSuite(t *testing.T) {
+	suite.Run(t, new(PersonalAccessTokenServiceSuite))
+}
+
+func (s *PersonalAccessTokenServiceSuite) SetupTest() {
+	s.svc = NewPersonalAccessTokenService()
+}
+
+func (s *PersonalAccessTokenServiceSuite) TestGenerate_ReturnsPrefixedUniqueTokens() {
+	token, displayPrefix, err := s.svc.Generate()
+	s.Require().NoError(err)
+	other, _, err := s.svc.Generate()
+	s.Require().NoError(err)
+
+	s.True(s.svc.IsPersonalAccessToken(token))
+	s.Equal(token[:len(displayPrefix)], displayPrefix)
+	s.Less(len(displayPrefix), len(token))
+	s.NotEqual(token, other)
+}
+
+func (s *PersonalAccessTokenServiceSuite) TestHash_IsDeterministicAndHidesToken() {
+	token, _, err := s.svc.Generate()
+	s.Require().NoError(err)
+
+	s.Equal(s.svc.Hash(token), s.svc.Hash(token))
+	s.NotContains(s.svc.Hash(token), token)
+	s.Len(s.svc.Hash(token), 64)
+}
+
+func (s *PersonalAccessTokenServiceSuite) TestIsPersonalAccessToken_RejectsJwt() {
+	s.False(s.svc.IsPersonalAccessToken("eyJhbGciOiJIUzI1NiJ9.e30.sig"))
+}
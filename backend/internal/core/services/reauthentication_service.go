@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+const reauthenticationCodeTTL = 15 * time.Minute
+
+// ReauthenticationService confirms the identity of users without a password before they change their
+// credentials, with a code mailed to their current address: a session alone is not enough, or a
+// hijacked one could take over the account.
+type ReauthenticationService struct {
+	codeRepository           repositories.ReauthenticationCodeRepository
+	emailVerificationService *EmailVerificationService
+}
+
+func NewReauthenticationService(codeRepository repositories.ReauthenticationCodeRepository, emailVerificationService *EmailVerificationService) *ReauthenticationService {
+	return &ReauthenticationService{
+		codeRepository:           codeRepository,
+		emailVerificationService: emailVerificationService,
+	}
+}
+
+// SendCode mails a new code to the user's current address, replacing any previous one.
+func (s *ReauthenticationService) SendCode(ctx context.Context, user *entities.User) error {
+	code, err := s.emailVerificationService.GenerateToken()
+	if err != nil {
+		return err
+	}
+	hash := s.emailVerificationService.HashToken(code)
+	if err := s.codeRepository.Save(ctx, entities.NewReauthenticationCode(user.ID, hash, time.Now().Add(reauthenticationCodeTTL))); err != nil {
+		return err
+	}
+	return s.emailVerificationService.SendReauthenticationCode(ctx, user.Email, code)
+}
+
+// VerifyCode consumes the user's code, returning an unauthorized error unless it matches code.
+func (s *ReauthenticationService) VerifyCode(ctx context.Context, userId, code string) error {
+	stored, err := s.codeRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	hash := s.emailVerificationService.HashToken(code)
+	if code == "" || stored == nil || stored.IsExpired() || subtle.ConstantTimeCompare([]byte(stored.CodeHash), []byte(hash)) != 1 {
+		return coreerrors.Unauthorized("invalid or expired confirmation code")
+	}
+	return s.codeRepository.Delete(ctx, stored)
+}
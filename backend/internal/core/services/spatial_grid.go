@@ -0,0 +1,50 @@
+package services
+
+import "math"
+
+type gridCell struct {
+	x, y, z int64
+}
+
+// spatialGrid buckets points on the globe into cubes of cellSize meters over their position in 3D
+// space. A straight line between two points is never longer than their distance along the surface,
+// so everything within cellSize of a point lies in the 27 cubes around it, with no special case at
+// the poles or across the antimeridian.
+type spatialGrid struct {
+	cellSize float64
+	cells    map[gridCell][]int
+}
+
+func newSpatialGrid(cellSize float64) *spatialGrid {
+	return &spatialGrid{cellSize: cellSize, cells: make(map[gridCell][]int)}
+}
+
+func (g *spatialGrid) cellOf(latitude, longitude float64) gridCell {
+	lat := latitude * math.Pi / 180
+	lon := longitude * math.Pi / 180
+	return gridCell{
+		x: int64(math.Floor(earthRadiusMeters * math.Cos(lat) * math.Cos(lon) / g.cellSize)),
+		y: int64(math.Floor(earthRadiusMeters * math.Cos(lat) * math.Sin(lon) / g.cellSize)),
+		z: int64(math.Floor(earthRadiusMeters * math.Sin(lat) / g.cellSize)),
+	}
+}
+
+func (g *spatialGrid) add(index int, latitude, longitude float64) {
+	cell := g.cellOf(latitude, longitude)
+	g.cells[cell] = append(g.cells[cell], index)
+}
+
+// near returns the points that may be within cellSize of the coordinates. Callers check the actual
+// distance.
+func (g *spatialGrid) near(latitude, longitude float64) []int {
+	center := g.cellOf(latitude, longitude)
+	var indexes []int
+	for dx := int64(-1); dx <= 1; dx++ {
+		for dy := int64(-1); dy <= 1; dy++ {
+			for dz := int64(-1); dz <= 1; dz++ {
+				indexes = append(indexes, g.cells[gridCell{center.x + dx, center.y + dy, center.z + dz}]...)
+			}
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// RFC 6238 appendix B test secret ("12345678901234567890") in base32.
+const rfcTestSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+type TotpServiceSuite struct {
+	suite.Suite
+	svc *TotpService
+}
+
+func TestTotpServiceSuite(t *testing.T) {
+	suite.Run(t, new(TotpServiceSuite))
+}
+
+func (s *TotpServiceSuite) SetupTest() {
+	s.svc = NewTotpService("Maya Guessr")
+}
+
+func (s *TotpServiceSuite) TestGenerateCode_MatchesRfcVectors() {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, expected := range vectors {
+		code, err := s.svc.GenerateCode(rfcTestSecret, time.Unix(unix, 0))
+		s.Require().NoError(err)
+		s.Equal(expected, code, "time %d", unix)
+	}
+}
+
+func (s *TotpServiceSuite) TestGenerateSecret_ReturnsBase32Secret() {
+	secret, err := s.svc.GenerateSecret()
+
+	s.Require().NoError(err)
+	s.Len(secret, 32)
+	_, err = decodeSecret(secret)
+	s.NoError(err)
+}
+
+func (s *TotpServiceSuite) TestValidateCode_AcceptsCurrentAndAdjacentSteps() {
+	now := time.Unix(1_700_000_000, 0)
+	secret, err := s.svc.GenerateSecret()
+	s.Require().NoError(err)
+
+	for _, offset := range []time.Duration{-30 * time.Second, 0, 30 * time.Second} {
+		code, err := s.svc.GenerateCode(secret, now.Add(offset))
+		s.Require().NoError(err)
+		step, ok := s.svc.ValidateCode(secret, code, now)
+		s.True(ok)
+		s.Equal(s.svc.Step(now.Add(offset)), step)
+	}
+}
+
+func (s *TotpServiceSuite) TestValidateCode_RejectsStaleAndMalformedCodes() {
+	now := time.Unix(1_700_000_000, 0)
+	secret, err := s.svc.GenerateSecret()
+	s.Require().NoError(err)
+	stale, err := s.svc.GenerateCode(secret, now.Add(-2*time.Minute))
+	s.Require().NoError(err)
+
+	_, ok := s.svc.ValidateCode(secret, stale, now)
+	s.False(ok)
+	_, ok = s.svc.ValidateCode(secret, "12345", now)
+	s.False(ok)
+	_, ok = s.svc.ValidateCode("not base32!", "123456", now)
+	s.False(ok)
+}
+
+func (s *TotpServiceSuite) TestProvisioningUri() {
+	uri := s.svc.ProvisioningUri("john@example.com", rfcTestSecret)
+
+	u, err := url.Parse(uri)
+	s.Require().NoError(err)
+	s.Equal("otpauth", u.Scheme)
+	s.Equal("totp", u.Host)
+	s.Equal("/Maya Guessr:john@example.com", u.Path)
+	s.Equal(rfcTestSecret, u.Query().Get("secret"))
+	s.Equal("Maya Guessr", u.Query().Get("issuer"))
+	s.Equal("6", u.Query().Get("digits"))
+	s.Equal("30", u.Query().Get("period"))
+}
+
+func (s *TotpServiceSuite) TestGenerateBackupCodes_ReturnsUniqueFormattedCodes() {
+	codes, err := s.svc.GenerateBackupCodes()
+
+	s.Require().NoError(err)
+	s.Len(codes, backupCodeCount)
+	seen := map[string]bool{}
+	for _, code := range codes {
+		s.Len(code, backupCodeLength+1)
+		s.Equal(byte('-'), code[backupCodeLength/2])
+		s.False(seen[code])
+		seen[code] = true
+	}
+}
+
+func (s *TotpServiceSuite) TestHashBackupCode_NormalizesInput() {
+	hash := s.svc.HashBackupCode("ABCDE-FGHIJ")
+
+	s.Equal(hash, s.svc.HashBackupCode("abcdefghij"))
+	s.Equal(hash, s.svc.HashBackupCode(" abcde fghij "))
+	s.NotEqual(hash, s.svc.HashBackupCode("ABCDE-FGHIK"))
+	s.Equal(64, len(hash))
+	s.Equal(strings.ToLower(hash), hash)
+}
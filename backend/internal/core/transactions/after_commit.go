@@ -0,0 +1,35 @@
+package transactions
+
+import "context"
+
+type commitHooksContextKey struct{}
+
+// CommitHooks holds the functions registered with AfterCommit while a transaction runs.
+type CommitHooks struct {
+	fns []func()
+}
+
+// WithCommitHooks returns a context in which AfterCommit defers its functions to the returned hooks.
+// Transaction managers call it when they begin a transaction and run the hooks once it is committed.
+func WithCommitHooks(ctx context.Context) (context.Context, *CommitHooks) {
+	hooks := &CommitHooks{}
+	return context.WithValue(ctx, commitHooksContextKey{}, hooks), hooks
+}
+
+// Run calls the registered functions in the order they were registered.
+func (h *CommitHooks) Run() {
+	for _, fn := range h.fns {
+		fn()
+	}
+}
+
+// AfterCommit runs fn once the transaction of ctx is committed, and never if it is rolled back.
+// Outside of a transaction, fn runs right away.
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(commitHooksContextKey{}).(*CommitHooks)
+	if !ok {
+		fn()
+		return
+	}
+	hooks.fns = append(hooks.fns, fn)
+}
@@ -0,0 +1,133 @@
+package achievement
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/events"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+// rule unlocks one achievement. Rules that only need the round or game at hand set onRound or
+// onGame; rules about a player's history read the stats passed to onGame, which already include
+// the completed game.
+type rule struct {
+	code    entities.AchievementCode
+	onRound func(event events.RoundFinished) bool
+	onGame  func(event events.GameCompleted, stats *entities.UserStats) bool
+}
+
+var rules = []rule{
+	{
+		code:   entities.AchievementFirstGame,
+		onGame: func(events.GameCompleted, *entities.UserStats) bool { return true },
+	},
+	{
+		code:    entities.AchievementFirstPerfectRound,
+		onRound: func(event events.RoundFinished) bool { return event.Round.Score >= entities.PerfectRoundScore },
+	},
+	{
+		code: entities.AchievementPerfectGame,
+		onGame: func(event events.GameCompleted, _ *entities.UserStats) bool {
+			return event.Game.Score >= event.Game.TotalRounds*entities.PerfectRoundScore
+		},
+	},
+	{
+		code:   entities.AchievementPerfectRounds5,
+		onGame: func(_ events.GameCompleted, stats *entities.UserStats) bool { return stats.PerfectRounds >= 5 },
+	},
+	{
+		code:   entities.AchievementPerfectRounds50,
+		onGame: func(_ events.GameCompleted, stats *entities.UserStats) bool { return stats.PerfectRounds >= 50 },
+	},
+	{
+		code:   entities.AchievementGamesPlayed100,
+		onGame: func(_ events.GameCompleted, stats *entities.UserStats) bool { return stats.GamesPlayed >= 100 },
+	},
+	{
+		code: entities.AchievementNmpzGamesPlayed100,
+		onGame: func(_ events.GameCompleted, stats *entities.UserStats) bool {
+			return stats.GamesPlayedInMode(entities.SinglePlayerGameModeNMPZ) >= 100
+		},
+	},
+	{
+		code: entities.AchievementCountriesGuessed10,
+		onGame: func(_ events.GameCompleted, stats *entities.UserStats) bool {
+			return stats.CountriesGuessedCorrectly() >= 10
+		},
+	},
+	{
+		code: entities.AchievementCountriesGuessed50,
+		onGame: func(_ events.GameCompleted, stats *entities.UserStats) bool {
+			return stats.CountriesGuessedCorrectly() >= 50
+		},
+	},
+}
+
+// Engine evaluates the achievement rules against single player events and unlocks the ones that
+// pass. Rules are re-evaluated on every event; unlocking is idempotent, so an achievement already
+// held is simply kept with its first timestamp.
+type Engine struct {
+	userStatsRepository       repositories.UserStatsRepository
+	userAchievementRepository repositories.UserAchievementRepository
+}
+
+func NewEngine(userStatsRepository repositories.UserStatsRepository, userAchievementRepository repositories.UserAchievementRepository) *Engine {
+	return &Engine{
+		userStatsRepository:       userStatsRepository,
+		userAchievementRepository: userAchievementRepository,
+	}
+}
+
+func (e *Engine) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.RoundFinishedEvent, e.HandleRoundFinished)
+	bus.Subscribe(events.GameCompletedEvent, e.HandleGameCompleted)
+}
+
+func (e *Engine) HandleRoundFinished(ctx context.Context, event events.Event) error {
+	roundFinished, ok := event.(events.RoundFinished)
+	if !ok {
+		return nil
+	}
+	var codes []entities.AchievementCode
+	for _, r := range rules {
+		if r.onRound != nil && r.onRound(roundFinished) {
+			codes = append(codes, r.code)
+		}
+	}
+	return e.unlock(ctx, roundFinished.Game.UserId, codes)
+}
+
+func (e *Engine) HandleGameCompleted(ctx context.Context, event events.Event) error {
+	gameCompleted, ok := event.(events.GameCompleted)
+	if !ok {
+		return nil
+	}
+	stats, err := e.userStatsRepository.FindByUserId(ctx, gameCompleted.Game.UserId)
+	if err != nil {
+		return err
+	}
+	if stats == nil {
+		stats = &entities.UserStats{UserId: gameCompleted.Game.UserId}
+	}
+	var codes []entities.AchievementCode
+	for _, r := range rules {
+		if r.onGame != nil && r.onGame(gameCompleted, stats) {
+			codes = append(codes, r.code)
+		}
+	}
+	return e.unlock(ctx, gameCompleted.Game.UserId, codes)
+}
+
+func (e *Engine) unlock(ctx context.Context, userId string, codes []entities.AchievementCode) error {
+	if len(codes) == 0 {
+		return nil
+	}
+	now := time.Now()
+	achievements := make([]*entities.UserAchievement, len(codes))
+	for i, code := range codes {
+		achievements[i] = entities.NewUserAchievement(userId, code, now)
+	}
+	return e.userAchievementRepository.Unlock(ctx, achievements)
+}
@@ -0,0 +1,124 @@
+package achievement
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/events"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var errMock = errors.New("mock error")
+
+func codesOf(achievements []*entities.UserAchievement) []entities.AchievementCode {
+	codes := make([]entities.AchievementCode, len(achievements))
+	for i, achievement := range achievements {
+		codes[i] = achievement.Code
+	}
+	return codes
+}
+
+type EngineSuite struct {
+	suite.Suite
+	statsRepo       *repomocks.MockUserStatsRepository
+	achievementRepo *repomocks.MockUserAchievementRepository
+	bus             *events.Bus
+}
+
+func TestEngineSuite(t *testing.T) {
+	suite.Run(t, new(EngineSuite))
+}
+
+func (s *EngineSuite) SetupTest() {
+	s.statsRepo = repomocks.NewMockUserStatsRepository(s.T())
+	s.achievementRepo = repomocks.NewMockUserAchievementRepository(s.T())
+	s.bus = events.NewBus()
+	NewEngine(s.statsRepo, s.achievementRepo).Subscribe(s.bus)
+}
+
+func (s *EngineSuite) game(score int) *entities.SinglePlayerGame {
+	game := entities.NewSinglePlayerGame("user-uuid", "map-uuid", entities.SinglePlayerGameModeNMPZ, 60)
+	game.Score = score
+	return game
+}
+
+func (s *EngineSuite) TestRoundFinished_WhenPerfect_UnlocksFirstPerfectRound() {
+	var unlocked []entities.AchievementCode
+	s.achievementRepo.EXPECT().Unlock(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, achievements []*entities.UserAchievement) error {
+			unlocked = codesOf(achievements)
+			s.Equal("user-uuid", achievements[0].UserId)
+			return nil
+		})
+
+	err := s.bus.Publish(context.Background(), events.RoundFinished{Game: s.game(5000), Round: &entities.SinglePlayerRound{Score: 5000}})
+
+	s.Require().NoError(err)
+	s.Equal([]entities.AchievementCode{entities.AchievementFirstPerfectRound}, unlocked)
+}
+
+func (s *EngineSuite) TestRoundFinished_WhenNoRulePasses_DoesNotWrite() {
+	err := s.bus.Publish(context.Background(), events.RoundFinished{Game: s.game(100), Round: &entities.SinglePlayerRound{Score: 100}})
+
+	s.Require().NoError(err)
+}
+
+func (s *EngineSuite) TestGameCompleted_EvaluatesHistoryRulesOnStats() {
+	countries := make([]*entities.UserCountryStats, 10)
+	for i := range countries {
+		countries[i] = &entities.UserCountryStats{CountryCode: string(rune('A'+i)) + "A", CorrectRounds: 1}
+	}
+	s.statsRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(&entities.UserStats{
+		UserId:        "user-uuid",
+		GamesPlayed:   120,
+		PerfectRounds: 7,
+		ModeMaps:      []*entities.UserModeMapStats{{Mode: entities.SinglePlayerGameModeNMPZ, MapId: "map-uuid", GamesPlayed: 100}},
+		Countries:     countries,
+	}, nil)
+	var unlocked []entities.AchievementCode
+	s.achievementRepo.EXPECT().Unlock(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, achievements []*entities.UserAchievement) error {
+			unlocked = codesOf(achievements)
+			return nil
+		})
+
+	err := s.bus.Publish(context.Background(), events.GameCompleted{Game: s.game(25000)})
+
+	s.Require().NoError(err)
+	s.ElementsMatch([]entities.AchievementCode{
+		entities.AchievementFirstGame,
+		entities.AchievementPerfectGame,
+		entities.AchievementPerfectRounds5,
+		entities.AchievementGamesPlayed100,
+		entities.AchievementNmpzGamesPlayed100,
+		entities.AchievementCountriesGuessed10,
+	}, unlocked)
+}
+
+func (s *EngineSuite) TestGameCompleted_WhenStatsMissing_UnlocksOnlyGameRules() {
+	s.statsRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(nil, nil)
+	var unlocked []entities.AchievementCode
+	s.achievementRepo.EXPECT().Unlock(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, achievements []*entities.UserAchievement) error {
+			unlocked = codesOf(achievements)
+			return nil
+		})
+
+	err := s.bus.Publish(context.Background(), events.GameCompleted{Game: s.game(12000)})
+
+	s.Require().NoError(err)
+	s.Equal([]entities.AchievementCode{entities.AchievementFirstGame}, unlocked)
+}
+
+func (s *EngineSuite) TestGameCompleted_WhenUnlockFails_ReturnsError() {
+	s.statsRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(nil, nil)
+	s.achievementRepo.EXPECT().Unlock(mock.Anything, mock.Anything).Return(errMock)
+
+	err := s.bus.Publish(context.Background(), events.GameCompleted{Game: s.game(12000)})
+
+	s.ErrorIs(err, errMock)
+}
@@ -0,0 +1,63 @@
+package achievement
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type AchievementOutput struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// UnlockedAt is nil while the achievement is locked.
+	UnlockedAt *time.Time `json:"unlocked_at"`
+}
+
+type ListUserAchievementsUseCase struct {
+	userRepository            repositories.UserRepository
+	userAchievementRepository repositories.UserAchievementRepository
+}
+
+func NewListUserAchievementsUseCase(userRepository repositories.UserRepository, userAchievementRepository repositories.UserAchievementRepository) *ListUserAchievementsUseCase {
+	return &ListUserAchievementsUseCase{
+		userRepository:            userRepository,
+		userAchievementRepository: userAchievementRepository,
+	}
+}
+
+// Execute returns the whole catalog for the user, locked achievements included. Banned users have none.
+func (uc *ListUserAchievementsUseCase) Execute(ctx context.Context, userId string) ([]AchievementOutput, error) {
+	user, err := uc.userRepository.FindById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.IsBanned() {
+		return nil, coreerrors.NotFound("user not found")
+	}
+
+	unlocked, err := uc.userAchievementRepository.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	unlockedAt := make(map[entities.AchievementCode]time.Time, len(unlocked))
+	for _, achievement := range unlocked {
+		unlockedAt[achievement.Code] = achievement.UnlockedAt
+	}
+
+	output := make([]AchievementOutput, len(entities.Achievements))
+	for i, achievement := range entities.Achievements {
+		output[i] = AchievementOutput{
+			Code:        string(achievement.Code),
+			Name:        achievement.Name,
+			Description: achievement.Description,
+		}
+		if at, ok := unlockedAt[achievement.Code]; ok {
+			output[i].UnlockedAt = &at
+		}
+	}
+	return output, nil
+}
@@ -0,0 +1,61 @@
+package achievement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListUserAchievementsSuite struct {
+	suite.Suite
+	userRepo        *repomocks.MockUserRepository
+	achievementRepo *repomocks.MockUserAchievementRepository
+	uc              *ListUserAchievementsUseCase
+}
+
+func TestListUserAchievementsSuite(t *testing.T) {
+	suite.Run(t, new(ListUserAchievementsSuite))
+}
+
+func (s *ListUserAchievementsSuite) SetupTest() {
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.achievementRepo = repomocks.NewMockUserAchievementRepository(s.T())
+	s.uc = NewListUserAchievementsUseCase(s.userRepo, s.achievementRepo)
+}
+
+func (s *ListUserAchievementsSuite) TestExecute_ReturnsCatalogWithUnlockTimes() {
+	unlockedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	s.userRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	s.achievementRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return([]*entities.UserAchievement{
+		entities.NewUserAchievement("user-uuid", entities.AchievementFirstGame, unlockedAt),
+	}, nil)
+
+	output, err := s.uc.Execute(context.Background(), "user-uuid")
+
+	s.Require().NoError(err)
+	s.Len(output, len(entities.Achievements))
+	for _, achievement := range output {
+		if achievement.Code == string(entities.AchievementFirstGame) {
+			s.Require().NotNil(achievement.UnlockedAt)
+			s.Equal(unlockedAt, *achievement.UnlockedAt)
+		} else {
+			s.Nil(achievement.UnlockedAt)
+		}
+	}
+}
+
+func (s *ListUserAchievementsSuite) TestExecute_WhenBanned_ReturnsNotFound() {
+	user := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash")
+	s.Require().NoError(user.Ban("cheating"))
+	s.userRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(user, nil)
+
+	_, err := s.uc.Execute(context.Background(), "user-uuid")
+
+	s.Require().Error(err)
+	s.Equal("user not found", err.Error())
+}
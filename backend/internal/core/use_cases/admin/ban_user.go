@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type BanUserInput struct {
+	ActorId string
+	UserId  string
+	Reason  string
+}
+
+// BanUserUseCase bans a user and revokes their refresh tokens. Their access tokens and personal
+// access tokens are turned away from then on, since authentication checks the ban on every request.
+type BanUserUseCase struct {
+	userRepository         repositories.UserRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	txManager              transactions.TransactionManager
+}
+
+func NewBanUserUseCase(userRepository repositories.UserRepository, refreshTokenRepository repositories.RefreshTokenRepository, txManager transactions.TransactionManager) *BanUserUseCase {
+	return &BanUserUseCase{
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		txManager:              txManager,
+	}
+}
+
+func (uc *BanUserUseCase) Execute(ctx context.Context, input BanUserInput) (*entities.User, error) {
+	var user *entities.User
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		_, target, err := findModerationTarget(ctx, uc.userRepository, input.ActorId, input.UserId, entities.UserRoleModerator)
+		if err != nil {
+			return err
+		}
+		if err := target.Ban(input.Reason); err != nil {
+			return err
+		}
+		if err := uc.userRepository.Update(ctx, target); err != nil {
+			return err
+		}
+		if err := uc.refreshTokenRepository.ExpireAllByUserId(ctx, target.ID); err != nil {
+			return err
+		}
+		user = target
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
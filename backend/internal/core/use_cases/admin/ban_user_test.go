@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var errMock = errors.New("mock error")
+
+func passThroughTx(mockTx *txmocks.MockTransactionManager) {
+	mockTx.EXPECT().
+		RunInTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+func newUserWithRole(id string, role entities.UserRole) *entities.User {
+	u := entities.RestoreUser(id, "Name "+id, id+"@example.com", id, "hash")
+	u.Role = role
+	return u
+}
+
+type BanUserSuite struct {
+	suite.Suite
+	userRepo    *repomocks.MockUserRepository
+	refreshRepo *repomocks.MockRefreshTokenRepository
+	txManager   *txmocks.MockTransactionManager
+	uc          *BanUserUseCase
+}
+
+func TestBanUserSuite(t *testing.T) {
+	suite.Run(t, new(BanUserSuite))
+}
+
+func (s *BanUserSuite) SetupTest() {
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.refreshRepo = repomocks.NewMockRefreshTokenRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.uc = NewBanUserUseCase(s.userRepo, s.refreshRepo, s.txManager)
+}
+
+func (s *BanUserSuite) TestExecute_WhenModeratorBansPlayer_BansAndRevokesTokens() {
+	passThroughTx(s.txManager)
+	s.userRepo.EXPECT().FindById(mock.Anything, "mod").Return(newUserWithRole("mod", entities.UserRoleModerator), nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "player").Return(newUserWithRole("player", entities.UserRolePlayer), nil)
+	s.userRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+			return u.ID == "player" && u.IsBanned() && u.BanReason == "cheating"
+		})).
+		Return(nil)
+	s.refreshRepo.EXPECT().ExpireAllByUserId(mock.Anything, "player").Return(nil)
+
+	user, err := s.uc.Execute(context.Background(), BanUserInput{ActorId: "mod", UserId: "player", Reason: "cheating"})
+
+	s.Require().NoError(err)
+	s.True(user.IsBanned())
+}
+
+func (s *BanUserSuite) TestExecute_WhenTargetOutranksActor_ReturnsForbidden() {
+	passThroughTx(s.txManager)
+	s.userRepo.EXPECT().FindById(mock.Anything, "mod").Return(newUserWithRole("mod", entities.UserRoleModerator), nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "admin").Return(newUserWithRole("admin", entities.UserRoleAdmin), nil)
+
+	user, err := s.uc.Execute(context.Background(), BanUserInput{ActorId: "mod", UserId: "admin"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions", err.Error())
+	s.Nil(user)
+}
+
+func (s *BanUserSuite) TestExecute_WhenModeratorWasDemoted_ReturnsForbidden() {
+	passThroughTx(s.txManager)
+	s.userRepo.EXPECT().FindById(mock.Anything, "former-mod").Return(newUserWithRole("former-mod", entities.UserRoleMapCurator), nil)
+
+	user, err := s.uc.Execute(context.Background(), BanUserInput{ActorId: "former-mod", UserId: "player"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions", err.Error())
+	s.Nil(user)
+}
+
+func (s *BanUserSuite) TestExecute_WhenAlreadyBanned_ReturnsConflict() {
+	target := newUserWithRole("player", entities.UserRolePlayer)
+	s.Require().NoError(target.Ban("spam"))
+	passThroughTx(s.txManager)
+	s.userRepo.EXPECT().FindById(mock.Anything, "admin").Return(newUserWithRole("admin", entities.UserRoleAdmin), nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "player").Return(target, nil)
+
+	_, err := s.uc.Execute(context.Background(), BanUserInput{ActorId: "admin", UserId: "player"})
+
+	s.Require().Error(err)
+	s.Equal("user is already banned", err.Error())
+}
+
+func (s *BanUserSuite) TestExecute_WhenUserNotFound_ReturnsNotFound() {
+	passThroughTx(s.txManager)
+	s.userRepo.EXPECT().FindById(mock.Anything, "admin").Return(newUserWithRole("admin", entities.UserRoleAdmin), nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "missing").Return((*entities.User)(nil), nil)
+
+	_, err := s.uc.Execute(context.Background(), BanUserInput{ActorId: "admin", UserId: "missing"})
+
+	s.Require().Error(err)
+	s.Equal("user not found", err.Error())
+}
+
+func (s *BanUserSuite) TestExecute_WhenRevokeFails_ReturnsError() {
+	passThroughTx(s.txManager)
+	s.userRepo.EXPECT().FindById(mock.Anything, "admin").Return(newUserWithRole("admin", entities.UserRoleAdmin), nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "player").Return(newUserWithRole("player", entities.UserRolePlayer), nil)
+	s.userRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+	s.refreshRepo.EXPECT().ExpireAllByUserId(mock.Anything, "player").Return(errMock)
+
+	_, err := s.uc.Execute(context.Background(), BanUserInput{ActorId: "admin", UserId: "player"})
+
+	s.ErrorIs(err, errMock)
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type DeleteMapInput struct {
+	ActorId string
+	MapId   string
+}
+
+// DeleteMapUseCase removes any map regardless of its owner.
+type DeleteMapUseCase struct {
+	mapRepository  repositories.MapRepository
+	userRepository repositories.UserRepository
+}
+
+func NewDeleteMapUseCase(mapRepository repositories.MapRepository, userRepository repositories.UserRepository) *DeleteMapUseCase {
+	return &DeleteMapUseCase{mapRepository: mapRepository, userRepository: userRepository}
+}
+
+func (uc *DeleteMapUseCase) Execute(ctx context.Context, input DeleteMapInput) error {
+	if _, err := findStaff(ctx, uc.userRepository, input.ActorId, entities.UserRoleMapCurator, entities.UserRoleModerator); err != nil {
+		return err
+	}
+	m, err := uc.mapRepository.FindById(ctx, input.MapId)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return coreerrors.NotFound("map not found")
+	}
+	return uc.mapRepository.Delete(ctx, m)
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type EndGameInput struct {
+	ActorId string
+	GameId  string
+}
+
+// EndGameUseCase abandons a single player game that is stuck pending or in progress, which
+// otherwise keeps its player from starting a new game.
+type EndGameUseCase struct {
+	gameRepository repositories.SinglePlayerGameRepository
+	userRepository repositories.UserRepository
+	txManager      transactions.TransactionManager
+}
+
+func NewEndGameUseCase(gameRepository repositories.SinglePlayerGameRepository, userRepository repositories.UserRepository, txManager transactions.TransactionManager) *EndGameUseCase {
+	return &EndGameUseCase{gameRepository: gameRepository, userRepository: userRepository, txManager: txManager}
+}
+
+func (uc *EndGameUseCase) Execute(ctx context.Context, input EndGameInput) (*entities.SinglePlayerGame, error) {
+	if _, err := findStaff(ctx, uc.userRepository, input.ActorId, entities.UserRoleModerator); err != nil {
+		return nil, err
+	}
+	var game *entities.SinglePlayerGame
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		game, err = uc.gameRepository.FindByIdWithLock(ctx, input.GameId)
+		if err != nil {
+			return err
+		}
+		if game == nil {
+			return coreerrors.NotFound("game not found")
+		}
+		if err := game.Abandon(); err != nil {
+			return err
+		}
+		return uc.gameRepository.Update(ctx, game)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return game, nil
+}
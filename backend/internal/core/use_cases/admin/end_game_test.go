@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EndGameSuite struct {
+	suite.Suite
+	gameRepo  *repomocks.MockSinglePlayerGameRepository
+	userRepo  *repomocks.MockUserRepository
+	txManager *txmocks.MockTransactionManager
+	uc        *EndGameUseCase
+}
+
+func TestEndGameSuite(t *testing.T) {
+	suite.Run(t, new(EndGameSuite))
+}
+
+func (s *EndGameSuite) SetupTest() {
+	s.gameRepo = repomocks.NewMockSinglePlayerGameRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.uc = NewEndGameUseCase(s.gameRepo, s.userRepo, s.txManager)
+	s.userRepo.EXPECT().FindById(mock.Anything, "mod").Return(newUserWithRole("mod", entities.UserRoleModerator), nil).Maybe()
+}
+
+func (s *EndGameSuite) TestExecute_WhenGameInProgress_AbandonsIt() {
+	game := entities.NewSinglePlayerGame("user-uuid", "map-uuid", entities.SinglePlayerGameModeMove, 60)
+	s.Require().NoError(game.Start())
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdWithLock(mock.Anything, game.ID).Return(game, nil)
+	s.gameRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(g *entities.SinglePlayerGame) bool {
+			return g.Status == entities.SinglePlayerGameStatusAbandoned && g.EndedAt != nil
+		})).
+		Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), EndGameInput{ActorId: "mod", GameId: game.ID})
+
+	s.Require().NoError(err)
+	s.Equal(entities.SinglePlayerGameStatusAbandoned, output.Status)
+}
+
+func (s *EndGameSuite) TestExecute_WhenGameCompleted_ReturnsBadRequest() {
+	game := entities.NewSinglePlayerGame("user-uuid", "map-uuid", entities.SinglePlayerGameModeMove, 60)
+	s.Require().NoError(game.Start())
+	s.Require().NoError(game.Complete())
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdWithLock(mock.Anything, game.ID).Return(game, nil)
+
+	_, err := s.uc.Execute(context.Background(), EndGameInput{ActorId: "mod", GameId: game.ID})
+
+	s.Require().Error(err)
+	s.Equal("game has already ended", err.Error())
+}
+
+func (s *EndGameSuite) TestExecute_WhenGameNotFound_ReturnsNotFound() {
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdWithLock(mock.Anything, "missing").Return((*entities.SinglePlayerGame)(nil), nil)
+
+	_, err := s.uc.Execute(context.Background(), EndGameInput{ActorId: "mod", GameId: "missing"})
+
+	s.Require().Error(err)
+	s.Equal("game not found", err.Error())
+}
+
+func (s *EndGameSuite) TestExecute_WhenActorIsBanned_ReturnsForbidden() {
+	banned := newUserWithRole("banned-mod", entities.UserRoleModerator)
+	s.Require().NoError(banned.Ban("abuse"))
+	s.userRepo.EXPECT().FindById(mock.Anything, "banned-mod").Return(banned, nil)
+
+	_, err := s.uc.Execute(context.Background(), EndGameInput{ActorId: "banned-mod", GameId: "game-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions", err.Error())
+}
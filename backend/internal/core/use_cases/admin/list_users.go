@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type ListUsersInput struct {
+	ActorId string
+	Query   string
+	Role    string
+	Banned  *bool
+	// Page is 1-based. Zero values fall back to the first page of defaultPageSize users.
+	Page     int
+	PageSize int
+}
+
+type ListUsersOutput struct {
+	Users    []*entities.User
+	Total    int64
+	Page     int
+	PageSize int
+}
+
+type ListUsersUseCase struct {
+	userRepository repositories.UserRepository
+}
+
+func NewListUsersUseCase(userRepository repositories.UserRepository) *ListUsersUseCase {
+	return &ListUsersUseCase{userRepository: userRepository}
+}
+
+func (uc *ListUsersUseCase) Execute(ctx context.Context, input ListUsersInput) (ListUsersOutput, error) {
+	if _, err := findStaff(ctx, uc.userRepository, input.ActorId, entities.UserRoleModerator); err != nil {
+		return ListUsersOutput{}, err
+	}
+	filter := repositories.UserSearchFilter{Query: input.Query, Banned: input.Banned}
+	if input.Role != "" {
+		role, ok := entities.ParseUserRole(input.Role)
+		if !ok {
+			return ListUsersOutput{}, coreerrors.BadRequest("invalid role")
+		}
+		filter.Role = role
+	}
+
+	page := max(input.Page, 1)
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+	filter.Limit = pageSize
+	filter.Offset = (page - 1) * pageSize
+
+	users, total, err := uc.userRepository.Search(ctx, filter)
+	if err != nil {
+		return ListUsersOutput{}, err
+	}
+	return ListUsersOutput{Users: users, Total: total, Page: page, PageSize: pageSize}, nil
+}
@@ -0,0 +1,78 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListUsersSuite struct {
+	suite.Suite
+	userRepo *repomocks.MockUserRepository
+	uc       *ListUsersUseCase
+}
+
+func TestListUsersSuite(t *testing.T) {
+	suite.Run(t, new(ListUsersSuite))
+}
+
+func (s *ListUsersSuite) SetupTest() {
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.uc = NewListUsersUseCase(s.userRepo)
+	s.userRepo.EXPECT().FindById(mock.Anything, "mod").Return(newUserWithRole("mod", entities.UserRoleModerator), nil).Maybe()
+}
+
+func (s *ListUsersSuite) TestExecute_TranslatesPageToLimitAndOffset() {
+	banned := true
+	users := []*entities.User{newUserWithRole("player", entities.UserRolePlayer)}
+	s.userRepo.EXPECT().
+		Search(mock.Anything, repositories.UserSearchFilter{Query: "jo", Role: entities.UserRoleModerator, Banned: &banned, Limit: 10, Offset: 20}).
+		Return(users, int64(21), nil)
+
+	output, err := s.uc.Execute(context.Background(), ListUsersInput{ActorId: "mod", Query: "jo", Role: "moderator", Banned: &banned, Page: 3, PageSize: 10})
+
+	s.Require().NoError(err)
+	s.Equal(users, output.Users)
+	s.Equal(int64(21), output.Total)
+	s.Equal(3, output.Page)
+	s.Equal(10, output.PageSize)
+}
+
+func (s *ListUsersSuite) TestExecute_AppliesDefaultAndMaximumPageSize() {
+	s.userRepo.EXPECT().
+		Search(mock.Anything, repositories.UserSearchFilter{Limit: defaultPageSize}).
+		Return([]*entities.User{}, int64(0), nil).Once()
+	s.userRepo.EXPECT().
+		Search(mock.Anything, repositories.UserSearchFilter{Limit: maxPageSize}).
+		Return([]*entities.User{}, int64(0), nil).Once()
+
+	output, err := s.uc.Execute(context.Background(), ListUsersInput{ActorId: "mod"})
+	s.Require().NoError(err)
+	s.Equal(1, output.Page)
+	s.Equal(defaultPageSize, output.PageSize)
+
+	output, err = s.uc.Execute(context.Background(), ListUsersInput{ActorId: "mod", PageSize: 1000})
+	s.Require().NoError(err)
+	s.Equal(maxPageSize, output.PageSize)
+}
+
+func (s *ListUsersSuite) TestExecute_WhenRoleUnknown_ReturnsBadRequest() {
+	_, err := s.uc.Execute(context.Background(), ListUsersInput{ActorId: "mod", Role: "root"})
+
+	s.Require().Error(err)
+	s.Equal("invalid role", err.Error())
+}
+
+func (s *ListUsersSuite) TestExecute_WhenActorWasDemoted_ReturnsForbidden() {
+	s.userRepo.EXPECT().FindById(mock.Anything, "former-mod").Return(newUserWithRole("former-mod", entities.UserRolePlayer), nil)
+
+	_, err := s.uc.Execute(context.Background(), ListUsersInput{ActorId: "former-mod"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions", err.Error())
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+// findStaff loads the acting staff member and checks, against the database rather than the access
+// token, that they are not banned and still have one of roles.
+func findStaff(ctx context.Context, userRepository repositories.UserRepository, actorId string, roles ...entities.UserRole) (*entities.User, error) {
+	actor, err := userRepository.FindById(ctx, actorId)
+	if err != nil {
+		return nil, err
+	}
+	if actor == nil {
+		return nil, coreerrors.Unauthorized("invalid or missing token")
+	}
+	if actor.IsBanned() || !actor.HasRole(roles...) {
+		return nil, coreerrors.Forbidden("insufficient permissions")
+	}
+	return actor, nil
+}
+
+// findModerationTarget loads the acting staff member, who must still have one of roles, and the user
+// they act on, and checks that the actor outranks the target. Roles are read from the database rather
+// than the access token so a demoted moderator loses their powers immediately.
+func findModerationTarget(ctx context.Context, userRepository repositories.UserRepository, actorId, userId string, roles ...entities.UserRole) (actor *entities.User, target *entities.User, err error) {
+	actor, err = findStaff(ctx, userRepository, actorId, roles...)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err = userRepository.FindById(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if target == nil {
+		return nil, nil, coreerrors.NotFound("user not found")
+	}
+	if !actor.CanModerate(target) {
+		return nil, nil, coreerrors.Forbidden("insufficient permissions")
+	}
+	return actor, target, nil
+}
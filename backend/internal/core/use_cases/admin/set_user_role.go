@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type SetUserRoleInput struct {
+	ActorId string
+	UserId  string
+	Role    string
+}
+
+// SetUserRoleUseCase changes a user's role. Only admins may assign roles, and since admins cannot
+// moderate each other an admin can neither be demoted nor demote themselves through the API.
+type SetUserRoleUseCase struct {
+	userRepository repositories.UserRepository
+}
+
+func NewSetUserRoleUseCase(userRepository repositories.UserRepository) *SetUserRoleUseCase {
+	return &SetUserRoleUseCase{userRepository: userRepository}
+}
+
+func (uc *SetUserRoleUseCase) Execute(ctx context.Context, input SetUserRoleInput) (*entities.User, error) {
+	role, ok := entities.ParseUserRole(input.Role)
+	if !ok {
+		return nil, coreerrors.BadRequest("invalid role")
+	}
+
+	_, target, err := findModerationTarget(ctx, uc.userRepository, input.ActorId, input.UserId, entities.UserRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	target.Role = role
+	if err := uc.userRepository.Update(ctx, target); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
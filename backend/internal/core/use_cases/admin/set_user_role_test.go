@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SetUserRoleSuite struct {
+	suite.Suite
+	userRepo *repomocks.MockUserRepository
+	uc       *SetUserRoleUseCase
+}
+
+func TestSetUserRoleSuite(t *testing.T) {
+	suite.Run(t, new(SetUserRoleSuite))
+}
+
+func (s *SetUserRoleSuite) SetupTest() {
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.uc = NewSetUserRoleUseCase(s.userRepo)
+}
+
+func (s *SetUserRoleSuite) TestExecute_WhenAdminPromotesPlayer_UpdatesRole() {
+	s.userRepo.EXPECT().FindById(mock.Anything, "admin").Return(newUserWithRole("admin", entities.UserRoleAdmin), nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "player").Return(newUserWithRole("player", entities.UserRolePlayer), nil)
+	s.userRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(u *entities.User) bool { return u.Role == entities.UserRoleMapCurator })).
+		Return(nil)
+
+	user, err := s.uc.Execute(context.Background(), SetUserRoleInput{ActorId: "admin", UserId: "player", Role: "map_curator"})
+
+	s.Require().NoError(err)
+	s.Equal(entities.UserRoleMapCurator, user.Role)
+}
+
+func (s *SetUserRoleSuite) TestExecute_WhenActorIsModerator_ReturnsForbidden() {
+	s.userRepo.EXPECT().FindById(mock.Anything, "mod").Return(newUserWithRole("mod", entities.UserRoleModerator), nil)
+
+	_, err := s.uc.Execute(context.Background(), SetUserRoleInput{ActorId: "mod", UserId: "player", Role: "moderator"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions", err.Error())
+}
+
+func (s *SetUserRoleSuite) TestExecute_WhenActorWasDemoted_ReturnsForbidden() {
+	s.userRepo.EXPECT().FindById(mock.Anything, "former-admin").Return(newUserWithRole("former-admin", entities.UserRoleMapCurator), nil)
+
+	_, err := s.uc.Execute(context.Background(), SetUserRoleInput{ActorId: "former-admin", UserId: "player", Role: "moderator"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions", err.Error())
+}
+
+func (s *SetUserRoleSuite) TestExecute_WhenChangingOwnRole_ReturnsForbidden() {
+	admin := newUserWithRole("admin", entities.UserRoleAdmin)
+	s.userRepo.EXPECT().FindById(mock.Anything, "admin").Return(admin, nil)
+
+	_, err := s.uc.Execute(context.Background(), SetUserRoleInput{ActorId: "admin", UserId: "admin", Role: "player"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions", err.Error())
+}
+
+func (s *SetUserRoleSuite) TestExecute_WhenRoleUnknown_ReturnsBadRequest() {
+	_, err := s.uc.Execute(context.Background(), SetUserRoleInput{ActorId: "admin", UserId: "player", Role: "root"})
+
+	s.Require().Error(err)
+	s.Equal("invalid role", err.Error())
+}
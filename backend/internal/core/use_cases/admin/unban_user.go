@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type UnbanUserInput struct {
+	ActorId string
+	UserId  string
+}
+
+type UnbanUserUseCase struct {
+	userRepository repositories.UserRepository
+}
+
+func NewUnbanUserUseCase(userRepository repositories.UserRepository) *UnbanUserUseCase {
+	return &UnbanUserUseCase{userRepository: userRepository}
+}
+
+func (uc *UnbanUserUseCase) Execute(ctx context.Context, input UnbanUserInput) (*entities.User, error) {
+	_, target, err := findModerationTarget(ctx, uc.userRepository, input.ActorId, input.UserId, entities.UserRoleModerator)
+	if err != nil {
+		return nil, err
+	}
+	if err := target.Unban(); err != nil {
+		return nil, err
+	}
+	if err := uc.userRepository.Update(ctx, target); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
@@ -0,0 +1,51 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnbanUserSuite struct {
+	suite.Suite
+	userRepo *repomocks.MockUserRepository
+	uc       *UnbanUserUseCase
+}
+
+func TestUnbanUserSuite(t *testing.T) {
+	suite.Run(t, new(UnbanUserSuite))
+}
+
+func (s *UnbanUserSuite) SetupTest() {
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.uc = NewUnbanUserUseCase(s.userRepo)
+}
+
+func (s *UnbanUserSuite) TestExecute_WhenModeratorUnbansPlayer_Unbans() {
+	target := newUserWithRole("player", entities.UserRolePlayer)
+	s.Require().NoError(target.Ban("spam"))
+	s.userRepo.EXPECT().FindById(mock.Anything, "mod").Return(newUserWithRole("mod", entities.UserRoleModerator), nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "player").Return(target, nil)
+	s.userRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(u *entities.User) bool { return u.ID == "player" && !u.IsBanned() })).
+		Return(nil)
+
+	user, err := s.uc.Execute(context.Background(), UnbanUserInput{ActorId: "mod", UserId: "player"})
+
+	s.Require().NoError(err)
+	s.False(user.IsBanned())
+}
+
+func (s *UnbanUserSuite) TestExecute_WhenModeratorWasDemoted_ReturnsForbidden() {
+	s.userRepo.EXPECT().FindById(mock.Anything, "former-mod").Return(newUserWithRole("former-mod", entities.UserRoleMapCurator), nil)
+
+	user, err := s.uc.Execute(context.Background(), UnbanUserInput{ActorId: "former-mod", UserId: "player"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions", err.Error())
+	s.Nil(user)
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type AuthenticateAccessTokenOutput struct {
+	UserId string
+	// Role is the user's current role rather than the one the token was issued with.
+	Role entities.UserRole
+}
+
+// AuthenticateAccessTokenUseCase resolves an access token to the user it was issued to. The user is
+// read from the database on every request so a ban or a demotion applies before the token expires.
+type AuthenticateAccessTokenUseCase struct {
+	jwtService     *services.JwtService
+	userRepository repositories.UserRepository
+}
+
+func NewAuthenticateAccessTokenUseCase(jwtService *services.JwtService, userRepository repositories.UserRepository) *AuthenticateAccessTokenUseCase {
+	return &AuthenticateAccessTokenUseCase{
+		jwtService:     jwtService,
+		userRepository: userRepository,
+	}
+}
+
+func (uc *AuthenticateAccessTokenUseCase) Execute(ctx context.Context, token string) (AuthenticateAccessTokenOutput, error) {
+	claims, err := uc.jwtService.ValidateAccessToken(token)
+	if err != nil {
+		return AuthenticateAccessTokenOutput{}, coreerrors.Unauthorized("invalid or missing token")
+	}
+
+	user, err := uc.userRepository.FindById(ctx, claims.UserId)
+	if err != nil {
+		return AuthenticateAccessTokenOutput{}, err
+	}
+	if user == nil {
+		return AuthenticateAccessTokenOutput{}, coreerrors.Unauthorized("invalid or missing token")
+	}
+	if err := ensureCanSignIn(user); err != nil {
+		return AuthenticateAccessTokenOutput{}, err
+	}
+	return AuthenticateAccessTokenOutput{UserId: user.ID, Role: user.Role}, nil
+}
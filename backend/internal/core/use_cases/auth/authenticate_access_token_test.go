@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthenticateAccessTokenSuite struct {
+	suite.Suite
+	mockUserRepo *repomocks.MockUserRepository
+	jwtService   *services.JwtService
+	uc           *AuthenticateAccessTokenUseCase
+}
+
+func TestAuthenticateAccessTokenSuite(t *testing.T) {
+	suite.Run(t, new(AuthenticateAccessTokenSuite))
+}
+
+func (s *AuthenticateAccessTokenSuite) SetupTest() {
+	s.T().Setenv("JWT_SECRET_KEY", "test-secret-for-access-token-tests")
+	s.mockUserRepo = repomocks.NewMockUserRepository(s.T())
+	s.jwtService = services.NewJwtService()
+	s.uc = NewAuthenticateAccessTokenUseCase(s.jwtService, s.mockUserRepo)
+}
+
+func (s *AuthenticateAccessTokenSuite) TestExecute_ReturnsTheUserWithTheirCurrentRole() {
+	token, err := s.jwtService.GenerateAccessToken("user-uuid", string(entities.UserRoleModerator))
+	s.Require().NoError(err)
+	user := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash")
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(user, nil)
+
+	output, err := s.uc.Execute(context.Background(), token)
+
+	s.Require().NoError(err)
+	s.Equal("user-uuid", output.UserId)
+	s.Equal(entities.UserRolePlayer, output.Role)
+}
+
+func (s *AuthenticateAccessTokenSuite) TestExecute_WhenUserIsBanned_ReturnsForbidden() {
+	token, err := s.jwtService.GenerateAccessToken("user-uuid", string(entities.UserRolePlayer))
+	s.Require().NoError(err)
+	user := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash")
+	s.Require().NoError(user.Ban("cheating"))
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(user, nil)
+
+	_, err = s.uc.Execute(context.Background(), token)
+
+	s.Require().Error(err)
+	s.Equal("account is banned", err.Error())
+}
+
+func (s *AuthenticateAccessTokenSuite) TestExecute_WhenRefreshToken_ReturnsUnauthorized() {
+	token, err := s.jwtService.GenerateRefreshToken("user-uuid", "refresh-uuid")
+	s.Require().NoError(err)
+
+	_, err = s.uc.Execute(context.Background(), token)
+
+	s.Require().Error(err)
+	s.Equal("invalid or missing token", err.Error())
+}
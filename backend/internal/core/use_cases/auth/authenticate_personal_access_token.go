@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type AuthenticatePersonalAccessTokenOutput struct {
+	UserId string
+	// Role is the owner's current role, so a demotion or ban applies to their tokens immediately.
+	Role  entities.UserRole
+	Token *entities.PersonalAccessToken
+}
+
+// AuthenticatePersonalAccessTokenUseCase resolves a plain personal access token to the user it acts for.
+type AuthenticatePersonalAccessTokenUseCase struct {
+	tokenRepository            repositories.PersonalAccessTokenRepository
+	userRepository             repositories.UserRepository
+	personalAccessTokenService *services.PersonalAccessTokenService
+}
+
+func NewAuthenticatePersonalAccessTokenUseCase(tokenRepository repositories.PersonalAccessTokenRepository, userRepository repositories.UserRepository, personalAccessTokenService *services.PersonalAccessTokenService) *AuthenticatePersonalAccessTokenUseCase {
+	return &AuthenticatePersonalAccessTokenUseCase{
+		tokenRepository:            tokenRepository,
+		userRepository:             userRepository,
+		personalAccessTokenService: personalAccessTokenService,
+	}
+}
+
+// IsPersonalAccessToken reports whether a bearer token should be authenticated by this use case
+// rather than as a JWT.
+func (uc *AuthenticatePersonalAccessTokenUseCase) IsPersonalAccessToken(token string) bool {
+	return uc.personalAccessTokenService.IsPersonalAccessToken(token)
+}
+
+// FindOwner returns the id of the user a token belongs to, false when the token is unknown. It does
+// not authenticate the token: expiry and bans are left to Execute, and no use is recorded.
+func (uc *AuthenticatePersonalAccessTokenUseCase) FindOwner(ctx context.Context, plain string) (string, bool, error) {
+	token, err := uc.tokenRepository.FindByTokenHash(ctx, uc.personalAccessTokenService.Hash(plain))
+	if err != nil {
+		return "", false, err
+	}
+	if token == nil {
+		return "", false, nil
+	}
+	return token.UserId, true, nil
+}
+
+func (uc *AuthenticatePersonalAccessTokenUseCase) Execute(ctx context.Context, plain string) (AuthenticatePersonalAccessTokenOutput, error) {
+	token, err := uc.tokenRepository.FindByTokenHash(ctx, uc.personalAccessTokenService.Hash(plain))
+	if err != nil {
+		return AuthenticatePersonalAccessTokenOutput{}, err
+	}
+	if token == nil || token.IsExpired() {
+		return AuthenticatePersonalAccessTokenOutput{}, coreerrors.Unauthorized("invalid or missing token")
+	}
+
+	user, err := uc.userRepository.FindById(ctx, token.UserId)
+	if err != nil {
+		return AuthenticatePersonalAccessTokenOutput{}, err
+	}
+	if user == nil {
+		return AuthenticatePersonalAccessTokenOutput{}, coreerrors.Unauthorized("invalid or missing token")
+	}
+	if err := ensureCanSignIn(user); err != nil {
+		return AuthenticatePersonalAccessTokenOutput{}, err
+	}
+
+	if token.MarkUsed(time.Now()) {
+		if err := uc.tokenRepository.UpdateLastUsedAt(ctx, token); err != nil {
+			return AuthenticatePersonalAccessTokenOutput{}, err
+		}
+	}
+	return AuthenticatePersonalAccessTokenOutput{UserId: user.ID, Role: user.Role, Token: token}, nil
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthenticatePersonalAccessTokenSuite struct {
+	suite.Suite
+	mockTokenRepo *repomocks.MockPersonalAccessTokenRepository
+	mockUserRepo  *repomocks.MockUserRepository
+	patService    *services.PersonalAccessTokenService
+	uc            *AuthenticatePersonalAccessTokenUseCase
+}
+
+func TestAuthenticatePersonalAccessTokenSuite(t *testing.T) {
+	suite.Run(t, new(AuthenticatePersonalAccessTokenSuite))
+}
+
+func (s *AuthenticatePersonalAccessTokenSuite) SetupTest() {
+	s.mockTokenRepo = repomocks.NewMockPersonalAccessTokenRepository(s.T())
+	s.mockUserRepo = repomocks.NewMockUserRepository(s.T())
+	s.patService = services.NewPersonalAccessTokenService()
+	s.uc = NewAuthenticatePersonalAccessTokenUseCase(s.mockTokenRepo, s.mockUserRepo, s.patService)
+}
+
+func (s *AuthenticatePersonalAccessTokenSuite) newToken(plain string, expiresAt time.Time) *entities.PersonalAccessToken {
+	token := entities.NewPersonalAccessToken("user-uuid", "bot", plain[:8], s.patService.Hash(plain), []entities.TokenScope{entities.TokenScopeGamesPlay}, expiresAt)
+	token.ID = "token-uuid"
+	return token
+}
+
+func (s *AuthenticatePersonalAccessTokenSuite) TestExecute_ReturnsOwnerAndRecordsUse() {
+	token := s.newToken("mgp_valid", time.Now().Add(time.Hour))
+	user := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash")
+	user.Role = entities.UserRoleModerator
+
+	s.mockTokenRepo.EXPECT().FindByTokenHash(mock.Anything, s.patService.Hash("mgp_valid")).Return(token, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(user, nil)
+	s.mockTokenRepo.EXPECT().UpdateLastUsedAt(mock.Anything, token).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), "mgp_valid")
+
+	s.Require().NoError(err)
+	s.Equal("user-uuid", output.UserId)
+	s.Equal(entities.UserRoleModerator, output.Role)
+	s.Same(token, output.Token)
+	s.NotNil(token.LastUsedAt)
+}
+
+func (s *AuthenticatePersonalAccessTokenSuite) TestExecute_WhenRecentlyUsed_SkipsWrite() {
+	token := s.newToken("mgp_valid", time.Now().Add(time.Hour))
+	recently := time.Now().Add(-time.Second)
+	token.LastUsedAt = &recently
+
+	s.mockTokenRepo.EXPECT().FindByTokenHash(mock.Anything, s.patService.Hash("mgp_valid")).Return(token, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+
+	_, err := s.uc.Execute(context.Background(), "mgp_valid")
+
+	s.Require().NoError(err)
+}
+
+func (s *AuthenticatePersonalAccessTokenSuite) TestExecute_WhenUnknown_ReturnsUnauthorized() {
+	s.mockTokenRepo.EXPECT().FindByTokenHash(mock.Anything, s.patService.Hash("mgp_unknown")).Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), "mgp_unknown")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or missing token")
+}
+
+func (s *AuthenticatePersonalAccessTokenSuite) TestExecute_WhenExpired_ReturnsUnauthorized() {
+	token := s.newToken("mgp_expired", time.Now().Add(-time.Hour))
+	s.mockTokenRepo.EXPECT().FindByTokenHash(mock.Anything, s.patService.Hash("mgp_expired")).Return(token, nil)
+
+	_, err := s.uc.Execute(context.Background(), "mgp_expired")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or missing token")
+}
+
+func (s *AuthenticatePersonalAccessTokenSuite) TestExecute_WhenOwnerBanned_ReturnsForbidden() {
+	token := s.newToken("mgp_valid", time.Now().Add(time.Hour))
+	user := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash")
+	s.Require().NoError(user.Ban("spam"))
+
+	s.mockTokenRepo.EXPECT().FindByTokenHash(mock.Anything, s.patService.Hash("mgp_valid")).Return(token, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(user, nil)
+
+	_, err := s.uc.Execute(context.Background(), "mgp_valid")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "account is banned")
+}
+
+func (s *AuthenticatePersonalAccessTokenSuite) TestFindOwner_ReturnsTheTokenOwnerWithoutRecordingUse() {
+	token := s.newToken("mgp_valid", time.Now().Add(-time.Hour))
+	s.mockTokenRepo.EXPECT().FindByTokenHash(mock.Anything, s.patService.Hash("mgp_valid")).Return(token, nil)
+	s.mockTokenRepo.EXPECT().FindByTokenHash(mock.Anything, s.patService.Hash("mgp_unknown")).Return(nil, nil)
+
+	owner, ok, err := s.uc.FindOwner(context.Background(), "mgp_valid")
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal("user-uuid", owner)
+
+	_, ok, err = s.uc.FindOwner(context.Background(), "mgp_unknown")
+	s.Require().NoError(err)
+	s.False(ok)
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type ConfirmTwoFactorInput struct {
+	UserId string
+	Code   string
+}
+
+type TwoFactorBackupCodesOutput struct {
+	BackupCodes []string `json:"backup_codes"`
+}
+
+// ConfirmTwoFactorUseCase activates a pending enrollment once the user submits a valid code,
+// and returns the initial set of backup codes. The codes are never retrievable again.
+type ConfirmTwoFactorUseCase struct {
+	twoFactorRepository  repositories.TwoFactorRepository
+	backupCodeRepository repositories.TwoFactorBackupCodeRepository
+	txManager            transactions.TransactionManager
+	totpService          *services.TotpService
+}
+
+func NewConfirmTwoFactorUseCase(twoFactorRepository repositories.TwoFactorRepository, backupCodeRepository repositories.TwoFactorBackupCodeRepository, txManager transactions.TransactionManager, totpService *services.TotpService) *ConfirmTwoFactorUseCase {
+	return &ConfirmTwoFactorUseCase{
+		twoFactorRepository:  twoFactorRepository,
+		backupCodeRepository: backupCodeRepository,
+		txManager:            txManager,
+		totpService:          totpService,
+	}
+}
+
+func (uc *ConfirmTwoFactorUseCase) Execute(ctx context.Context, input ConfirmTwoFactorInput) (TwoFactorBackupCodesOutput, error) {
+	var output TwoFactorBackupCodesOutput
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		twoFactor, err := uc.twoFactorRepository.FindByUserIdWithLock(ctx, input.UserId)
+		if err != nil {
+			return err
+		}
+		if twoFactor == nil {
+			return coreerrors.BadRequest("two-factor enrollment has not been started")
+		}
+		if twoFactor.IsEnabled() {
+			return coreerrors.Conflict("two-factor authentication is already enabled")
+		}
+
+		step, ok := uc.totpService.ValidateCode(twoFactor.Secret, input.Code, time.Now())
+		if !ok {
+			return coreerrors.Unauthorized("invalid two-factor code")
+		}
+		if err := twoFactor.Confirm(step); err != nil {
+			return err
+		}
+		if err := uc.twoFactorRepository.Update(ctx, twoFactor); err != nil {
+			return err
+		}
+
+		codes, err := replaceBackupCodes(ctx, uc.backupCodeRepository, uc.totpService, input.UserId)
+		if err != nil {
+			return err
+		}
+		output.BackupCodes = codes
+		return nil
+	})
+	if err != nil {
+		return TwoFactorBackupCodesOutput{}, err
+	}
+	return output, nil
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfirmTwoFactorSuite struct {
+	suite.Suite
+	twoFactorRepo  *repomocks.MockTwoFactorRepository
+	backupCodeRepo *repomocks.MockTwoFactorBackupCodeRepository
+	txManager      *txmocks.MockTransactionManager
+	totpService    *services.TotpService
+	twoFactor      *entities.UserTwoFactor
+}
+
+func TestConfirmTwoFactorSuite(t *testing.T) {
+	suite.Run(t, new(ConfirmTwoFactorSuite))
+}
+
+func (s *ConfirmTwoFactorSuite) SetupTest() {
+	s.twoFactorRepo = repomocks.NewMockTwoFactorRepository(s.T())
+	s.backupCodeRepo = repomocks.NewMockTwoFactorBackupCodeRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.totpService = services.NewTotpService("Maya Guessr")
+	secret, err := s.totpService.GenerateSecret()
+	s.Require().NoError(err)
+	s.twoFactor = entities.NewUserTwoFactor("user-uuid", secret)
+}
+
+func (s *ConfirmTwoFactorSuite) newUseCase() *ConfirmTwoFactorUseCase {
+	return NewConfirmTwoFactorUseCase(s.twoFactorRepo, s.backupCodeRepo, s.txManager, s.totpService)
+}
+
+func (s *ConfirmTwoFactorSuite) TestExecute_WhenCodeValid_EnablesAndReturnsBackupCodes() {
+	code, err := s.totpService.GenerateCode(s.twoFactor.Secret, time.Now())
+	s.Require().NoError(err)
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return(s.twoFactor, nil)
+	s.twoFactorRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(t *entities.UserTwoFactor) bool { return t.IsEnabled() })).
+		Return(nil)
+	s.backupCodeRepo.EXPECT().DeleteByUserId(mock.Anything, "user-uuid").Return(nil)
+	var stored []*entities.TwoFactorBackupCode
+	s.backupCodeRepo.EXPECT().
+		CreateMany(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, codes []*entities.TwoFactorBackupCode) error {
+			stored = codes
+			return nil
+		})
+
+	output, err := s.newUseCase().Execute(context.Background(), ConfirmTwoFactorInput{UserId: "user-uuid", Code: code})
+
+	s.Require().NoError(err)
+	s.Len(output.BackupCodes, 10)
+	s.Require().Len(stored, len(output.BackupCodes))
+	for i, plain := range output.BackupCodes {
+		s.Equal(s.totpService.HashBackupCode(plain), stored[i].CodeHash)
+		s.Equal("user-uuid", stored[i].UserId)
+	}
+}
+
+func (s *ConfirmTwoFactorSuite) TestExecute_WhenCodeInvalid_ReturnsUnauthorized() {
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return(s.twoFactor, nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), ConfirmTwoFactorInput{UserId: "user-uuid", Code: "000000x"})
+
+	s.Require().Error(err)
+	s.Equal("invalid two-factor code", err.Error())
+	s.Empty(output.BackupCodes)
+	s.False(s.twoFactor.IsEnabled())
+}
+
+func (s *ConfirmTwoFactorSuite) TestExecute_WhenAlreadyEnabled_ReturnsConflict() {
+	s.Require().NoError(s.twoFactor.Confirm(1))
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return(s.twoFactor, nil)
+
+	_, err := s.newUseCase().Execute(context.Background(), ConfirmTwoFactorInput{UserId: "user-uuid", Code: "123456"})
+
+	s.Require().Error(err)
+	s.Equal("two-factor authentication is already enabled", err.Error())
+}
+
+func (s *ConfirmTwoFactorSuite) TestExecute_WhenNotEnrolled_ReturnsBadRequest() {
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return((*entities.UserTwoFactor)(nil), nil)
+
+	_, err := s.newUseCase().Execute(context.Background(), ConfirmTwoFactorInput{UserId: "user-uuid", Code: "123456"})
+
+	s.Require().Error(err)
+	s.Equal("two-factor enrollment has not been started", err.Error())
+}
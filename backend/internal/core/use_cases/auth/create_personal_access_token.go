@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+const (
+	minPersonalAccessTokenTTL      = time.Hour * 24
+	defaultPersonalAccessTokenTTL  = time.Hour * 24 * 90
+	maxPersonalAccessTokenTTL      = time.Hour * 24 * 365
+	maxPersonalAccessTokensPerUser = 50
+)
+
+type CreatePersonalAccessTokenInput struct {
+	UserId string
+	Name   string
+	Scopes []string
+	// ExpiresIn defaults to 90 days when zero and may not exceed a year.
+	ExpiresIn time.Duration
+}
+
+type PersonalAccessTokenOutput struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type CreatePersonalAccessTokenOutput struct {
+	PersonalAccessTokenOutput
+	// Token is the plain token. It is only ever returned here.
+	Token string `json:"token"`
+}
+
+type CreatePersonalAccessTokenUseCase struct {
+	tokenRepository            repositories.PersonalAccessTokenRepository
+	personalAccessTokenService *services.PersonalAccessTokenService
+}
+
+func NewCreatePersonalAccessTokenUseCase(tokenRepository repositories.PersonalAccessTokenRepository, personalAccessTokenService *services.PersonalAccessTokenService) *CreatePersonalAccessTokenUseCase {
+	return &CreatePersonalAccessTokenUseCase{
+		tokenRepository:            tokenRepository,
+		personalAccessTokenService: personalAccessTokenService,
+	}
+}
+
+func (uc *CreatePersonalAccessTokenUseCase) Execute(ctx context.Context, input CreatePersonalAccessTokenInput) (CreatePersonalAccessTokenOutput, error) {
+	if len(input.Scopes) == 0 {
+		return CreatePersonalAccessTokenOutput{}, coreerrors.BadRequest("at least one scope is required")
+	}
+	scopes := make([]entities.TokenScope, 0, len(input.Scopes))
+	for _, name := range input.Scopes {
+		scope, ok := entities.ParseTokenScope(name)
+		if !ok {
+			return CreatePersonalAccessTokenOutput{}, coreerrors.BadRequest(fmt.Sprintf("unknown scope: %s", name))
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	expiresIn := input.ExpiresIn
+	if expiresIn == 0 {
+		expiresIn = defaultPersonalAccessTokenTTL
+	}
+	if expiresIn < minPersonalAccessTokenTTL || expiresIn > maxPersonalAccessTokenTTL {
+		return CreatePersonalAccessTokenOutput{}, coreerrors.BadRequest("token expiry must be between 1 day and 1 year")
+	}
+
+	existing, err := uc.tokenRepository.FindByUserIdAndName(ctx, input.UserId, input.Name)
+	if err != nil {
+		return CreatePersonalAccessTokenOutput{}, err
+	}
+	if existing != nil {
+		return CreatePersonalAccessTokenOutput{}, coreerrors.Conflict("a token with this name already exists")
+	}
+	count, err := uc.tokenRepository.CountByUserId(ctx, input.UserId)
+	if err != nil {
+		return CreatePersonalAccessTokenOutput{}, err
+	}
+	if count >= maxPersonalAccessTokensPerUser {
+		return CreatePersonalAccessTokenOutput{}, coreerrors.BadRequest(fmt.Sprintf("a user can have at most %d tokens", maxPersonalAccessTokensPerUser))
+	}
+
+	plain, displayPrefix, err := uc.personalAccessTokenService.Generate()
+	if err != nil {
+		return CreatePersonalAccessTokenOutput{}, err
+	}
+	token := entities.NewPersonalAccessToken(input.UserId, input.Name, displayPrefix, uc.personalAccessTokenService.Hash(plain), scopes, time.Now().Add(expiresIn))
+	if err := uc.tokenRepository.Create(ctx, token); err != nil {
+		return CreatePersonalAccessTokenOutput{}, err
+	}
+	return CreatePersonalAccessTokenOutput{
+		PersonalAccessTokenOutput: toPersonalAccessTokenOutput(token),
+		Token:                     plain,
+	}, nil
+}
+
+func toPersonalAccessTokenOutput(token *entities.PersonalAccessToken) PersonalAccessTokenOutput {
+	scopes := token.ScopeList()
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	return PersonalAccessTokenOutput{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      names,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		CreatedAt:   token.CreatedAt,
+	}
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CreatePersonalAccessTokenSuite struct {
+	suite.Suite
+	mockTokenRepo *repomocks.MockPersonalAccessTokenRepository
+	patService    *services.PersonalAccessTokenService
+	uc            *CreatePersonalAccessTokenUseCase
+}
+
+func TestCreatePersonalAccessTokenSuite(t *testing.T) {
+	suite.Run(t, new(CreatePersonalAccessTokenSuite))
+}
+
+func (s *CreatePersonalAccessTokenSuite) SetupTest() {
+	s.mockTokenRepo = repomocks.NewMockPersonalAccessTokenRepository(s.T())
+	s.patService = services.NewPersonalAccessTokenService()
+	s.uc = NewCreatePersonalAccessTokenUseCase(s.mockTokenRepo, s.patService)
+}
+
+func (s *CreatePersonalAccessTokenSuite) TestExecute_StoresHashAndReturnsPlainTokenOnce() {
+	var created *entities.PersonalAccessToken
+	s.mockTokenRepo.EXPECT().FindByUserIdAndName(mock.Anything, "user-uuid", "discord bot").Return(nil, nil)
+	s.mockTokenRepo.EXPECT().CountByUserId(mock.Anything, "user-uuid").Return(int64(0), nil)
+	s.mockTokenRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entities.PersonalAccessToken")).
+		Run(func(_ context.Context, token *entities.PersonalAccessToken) { created = token }).
+		Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), CreatePersonalAccessTokenInput{
+		UserId: "user-uuid",
+		Name:   "discord bot",
+		Scopes: []string{"stats:read", "maps:read", "stats:read"},
+	})
+
+	s.Require().NoError(err)
+	s.Require().NotNil(created)
+	s.True(s.patService.IsPersonalAccessToken(output.Token))
+	s.Equal(s.patService.Hash(output.Token), created.TokenHash)
+	s.NotContains(created.TokenHash, output.Token)
+	s.Equal([]string{"stats:read", "maps:read"}, output.Scopes)
+	s.Equal(created.TokenPrefix, output.TokenPrefix)
+	s.WithinDuration(time.Now().Add(defaultPersonalAccessTokenTTL), output.ExpiresAt, time.Minute)
+}
+
+func (s *CreatePersonalAccessTokenSuite) TestExecute_WhenScopeUnknown_ReturnsBadRequest() {
+	_, err := s.uc.Execute(context.Background(), CreatePersonalAccessTokenInput{
+		UserId: "user-uuid",
+		Name:   "bot",
+		Scopes: []string{"admin"},
+	})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "unknown scope: admin")
+}
+
+func (s *CreatePersonalAccessTokenSuite) TestExecute_WhenExpiryTooLong_ReturnsBadRequest() {
+	_, err := s.uc.Execute(context.Background(), CreatePersonalAccessTokenInput{
+		UserId:    "user-uuid",
+		Name:      "bot",
+		Scopes:    []string{"maps:read"},
+		ExpiresIn: 2 * maxPersonalAccessTokenTTL,
+	})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "token expiry")
+}
+
+func (s *CreatePersonalAccessTokenSuite) TestExecute_WhenNameTaken_ReturnsConflict() {
+	s.mockTokenRepo.EXPECT().FindByUserIdAndName(mock.Anything, "user-uuid", "bot").
+		Return(entities.NewPersonalAccessToken("user-uuid", "bot", "mgp_abc", "hash", nil, time.Now().Add(time.Hour)), nil)
+
+	_, err := s.uc.Execute(context.Background(), CreatePersonalAccessTokenInput{
+		UserId: "user-uuid",
+		Name:   "bot",
+		Scopes: []string{"maps:read"},
+	})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "already exists")
+}
+
+func (s *CreatePersonalAccessTokenSuite) TestExecute_WhenLimitReached_ReturnsBadRequest() {
+	s.mockTokenRepo.EXPECT().FindByUserIdAndName(mock.Anything, "user-uuid", "bot").Return(nil, nil)
+	s.mockTokenRepo.EXPECT().CountByUserId(mock.Anything, "user-uuid").Return(int64(maxPersonalAccessTokensPerUser), nil)
+
+	_, err := s.uc.Execute(context.Background(), CreatePersonalAccessTokenInput{
+		UserId: "user-uuid",
+		Name:   "bot",
+		Scopes: []string{"maps:read"},
+	})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "at most")
+}
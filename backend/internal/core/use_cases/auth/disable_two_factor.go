@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type DisableTwoFactorInput struct {
+	UserId string
+	// Code is a current TOTP code or one of the user's backup codes.
+	Code string
+}
+
+type DisableTwoFactorUseCase struct {
+	twoFactorRepository  repositories.TwoFactorRepository
+	backupCodeRepository repositories.TwoFactorBackupCodeRepository
+	txManager            transactions.TransactionManager
+	totpService          *services.TotpService
+}
+
+func NewDisableTwoFactorUseCase(twoFactorRepository repositories.TwoFactorRepository, backupCodeRepository repositories.TwoFactorBackupCodeRepository, txManager transactions.TransactionManager, totpService *services.TotpService) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		twoFactorRepository:  twoFactorRepository,
+		backupCodeRepository: backupCodeRepository,
+		txManager:            txManager,
+		totpService:          totpService,
+	}
+}
+
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, input DisableTwoFactorInput) error {
+	return uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		twoFactor, err := uc.twoFactorRepository.FindByUserIdWithLock(ctx, input.UserId)
+		if err != nil {
+			return err
+		}
+		if twoFactor == nil || !twoFactor.IsEnabled() {
+			return coreerrors.BadRequest("two-factor authentication is not enabled")
+		}
+		if err := verifySecondFactor(ctx, uc.backupCodeRepository, uc.totpService, twoFactor, input.Code, true); err != nil {
+			return err
+		}
+
+		if err := uc.backupCodeRepository.DeleteByUserId(ctx, input.UserId); err != nil {
+			return err
+		}
+		return uc.twoFactorRepository.Delete(ctx, twoFactor)
+	})
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type EnrollTwoFactorOutput struct {
+	Secret     string `json:"secret"`
+	OtpauthUri string `json:"otpauth_uri"`
+}
+
+// EnrollTwoFactorUseCase issues a new TOTP secret. The enrollment stays inactive until it is
+// confirmed with ConfirmTwoFactorUseCase; enrolling again before that replaces the secret.
+type EnrollTwoFactorUseCase struct {
+	userRepository      repositories.UserRepository
+	twoFactorRepository repositories.TwoFactorRepository
+	totpService         *services.TotpService
+}
+
+func NewEnrollTwoFactorUseCase(userRepository repositories.UserRepository, twoFactorRepository repositories.TwoFactorRepository, totpService *services.TotpService) *EnrollTwoFactorUseCase {
+	return &EnrollTwoFactorUseCase{
+		userRepository:      userRepository,
+		twoFactorRepository: twoFactorRepository,
+		totpService:         totpService,
+	}
+}
+
+func (uc *EnrollTwoFactorUseCase) Execute(ctx context.Context, userId string) (EnrollTwoFactorOutput, error) {
+	user, err := uc.userRepository.FindById(ctx, userId)
+	if err != nil {
+		return EnrollTwoFactorOutput{}, err
+	}
+	if user == nil {
+		return EnrollTwoFactorOutput{}, coreerrors.NotFound("user not found")
+	}
+
+	secret, err := uc.totpService.GenerateSecret()
+	if err != nil {
+		return EnrollTwoFactorOutput{}, err
+	}
+
+	twoFactor, err := uc.twoFactorRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return EnrollTwoFactorOutput{}, err
+	}
+	switch {
+	case twoFactor == nil:
+		if err := uc.twoFactorRepository.Create(ctx, entities.NewUserTwoFactor(userId, secret)); err != nil {
+			return EnrollTwoFactorOutput{}, err
+		}
+	case twoFactor.IsEnabled():
+		return EnrollTwoFactorOutput{}, coreerrors.Conflict("two-factor authentication is already enabled")
+	default:
+		twoFactor.Secret = secret
+		twoFactor.LastUsedStep = 0
+		if err := uc.twoFactorRepository.Update(ctx, twoFactor); err != nil {
+			return EnrollTwoFactorOutput{}, err
+		}
+	}
+
+	return EnrollTwoFactorOutput{
+		Secret:     secret,
+		OtpauthUri: uc.totpService.ProvisioningUri(user.Email, secret),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type TwoFactorStatusOutput struct {
+	Enabled              bool  `json:"enabled"`
+	BackupCodesRemaining int64 `json:"backup_codes_remaining"`
+}
+
+type GetTwoFactorStatusUseCase struct {
+	twoFactorRepository  repositories.TwoFactorRepository
+	backupCodeRepository repositories.TwoFactorBackupCodeRepository
+}
+
+func NewGetTwoFactorStatusUseCase(twoFactorRepository repositories.TwoFactorRepository, backupCodeRepository repositories.TwoFactorBackupCodeRepository) *GetTwoFactorStatusUseCase {
+	return &GetTwoFactorStatusUseCase{twoFactorRepository: twoFactorRepository, backupCodeRepository: backupCodeRepository}
+}
+
+func (uc *GetTwoFactorStatusUseCase) Execute(ctx context.Context, userId string) (TwoFactorStatusOutput, error) {
+	twoFactor, err := uc.twoFactorRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return TwoFactorStatusOutput{}, err
+	}
+	if twoFactor == nil || !twoFactor.IsEnabled() {
+		return TwoFactorStatusOutput{}, nil
+	}
+	remaining, err := uc.backupCodeRepository.CountUnusedByUserId(ctx, userId)
+	if err != nil {
+		return TwoFactorStatusOutput{}, err
+	}
+	return TwoFactorStatusOutput{Enabled: true, BackupCodesRemaining: remaining}, nil
+}
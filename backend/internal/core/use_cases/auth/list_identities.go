@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type IdentityOutput struct {
+	Provider  string    `json:"provider"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListIdentitiesUseCase struct {
+	identityRepository repositories.UserIdentityRepository
+}
+
+func NewListIdentitiesUseCase(identityRepository repositories.UserIdentityRepository) *ListIdentitiesUseCase {
+	return &ListIdentitiesUseCase{identityRepository: identityRepository}
+}
+
+func (uc *ListIdentitiesUseCase) Execute(ctx context.Context, userId string) ([]IdentityOutput, error) {
+	identities, err := uc.identityRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]IdentityOutput, 0, len(identities))
+	for _, identity := range identities {
+		output = append(output, IdentityOutput{
+			Provider:  identity.Provider,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+	return output, nil
+}
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type ListPersonalAccessTokensUseCase struct {
+	tokenRepository repositories.PersonalAccessTokenRepository
+}
+
+func NewListPersonalAccessTokensUseCase(tokenRepository repositories.PersonalAccessTokenRepository) *ListPersonalAccessTokensUseCase {
+	return &ListPersonalAccessTokensUseCase{tokenRepository: tokenRepository}
+}
+
+func (uc *ListPersonalAccessTokensUseCase) Execute(ctx context.Context, userId string) ([]PersonalAccessTokenOutput, error) {
+	tokens, err := uc.tokenRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]PersonalAccessTokenOutput, 0, len(tokens))
+	for _, token := range tokens {
+		output = append(output, toPersonalAccessTokenOutput(token))
+	}
+	return output, nil
+}
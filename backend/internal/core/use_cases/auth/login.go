@@ -8,6 +8,7 @@ import (
 	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
 )
 
 type LoginInput struct {
@@ -16,18 +17,25 @@ type LoginInput struct {
 }
 
 type LoginOutput struct {
-	AccessToken string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken string `json:"access_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// TwoFactorRequired is set instead of the token pair when the user has two-factor authentication
+	// enabled; ChallengeToken must then be exchanged through VerifyTwoFactorUseCase.
+	TwoFactorRequired bool `json:"two_factor_required,omitempty"`
+	ChallengeToken string `json:"challenge_token,omitempty"`
 }
 
 type LoginUseCase struct {
 	userRepository repositories.UserRepository
 	refreshTokenRepository repositories.RefreshTokenRepository
+	twoFactorRepository repositories.TwoFactorRepository
+	accountLockoutRepository repositories.AccountLockoutRepository
+	txManager transactions.TransactionManager
 	jwtService *services.JwtService
 }
 
-func NewLoginUseCase(userRepository repositories.UserRepository, refreshTokenRepository repositories.RefreshTokenRepository, jwtService *services.JwtService) *LoginUseCase {
-	return &LoginUseCase{userRepository: userRepository, refreshTokenRepository: refreshTokenRepository, jwtService: jwtService}
+func NewLoginUseCase(userRepository repositories.UserRepository, refreshTokenRepository repositories.RefreshTokenRepository, twoFactorRepository repositories.TwoFactorRepository, accountLockoutRepository repositories.AccountLockoutRepository, txManager transactions.TransactionManager, jwtService *services.JwtService) *LoginUseCase {
+	return &LoginUseCase{userRepository: userRepository, refreshTokenRepository: refreshTokenRepository, twoFactorRepository: twoFactorRepository, accountLockoutRepository: accountLockoutRepository, txManager: txManager, jwtService: jwtService}
 }
 
 func (uc *LoginUseCase) Execute(input LoginInput) (LoginOutput, error) {
@@ -40,24 +48,41 @@ func (uc *LoginUseCase) Execute(input LoginInput) (LoginOutput, error) {
 	if user == nil {
 		return LoginOutput{}, coreerrors.Unauthorized("invalid email or password")
 	}
-	if err := user.ComparePassword(input.Password); err != nil {
-		return LoginOutput{}, coreerrors.Unauthorized("invalid email or password")
-	}
-	refreshTokenEntity := entities.NewRefreshToken(user.ID, time.Now().Add(time.Hour*24*7))
-	if err := uc.refreshTokenRepository.Create(ctx, refreshTokenEntity); err != nil {
-		return LoginOutput{}, err
-	}
-	accessToken, err := uc.jwtService.GenerateAccessToken(user.ID)
+
+	// Locked accounts are rejected before the password is checked, so guessing costs no bcrypt work.
+	// They get the answer of a wrong password: a distinct one would tell which emails have accounts.
+	lockout, err := uc.accountLockoutRepository.FindByUserId(ctx, user.ID)
 	if err != nil {
 		return LoginOutput{}, err
 	}
-	refreshToken, err := uc.jwtService.GenerateRefreshToken(user.ID, refreshTokenEntity.ID)
-	if err != nil {
-		return LoginOutput{}, err
+	if lockout != nil && lockout.IsLocked(time.Now()) {
+		return LoginOutput{}, coreerrors.Unauthorized("invalid email or password")
 	}
 
-	return LoginOutput{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	if err := user.ComparePassword(input.Password); err != nil {
+		if err := uc.registerFailure(ctx, user.ID); err != nil {
+			return LoginOutput{}, err
+		}
+		return LoginOutput{}, coreerrors.Unauthorized("invalid email or password")
+	}
+	if lockout != nil {
+		if err := uc.accountLockoutRepository.DeleteByUserId(ctx, user.ID); err != nil {
+			return LoginOutput{}, err
+		}
+	}
+	return completeSignIn(ctx, uc.twoFactorRepository, uc.refreshTokenRepository, uc.jwtService, user)
+}
+
+func (uc *LoginUseCase) registerFailure(ctx context.Context, userId string) error {
+	return uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		lockout, err := uc.accountLockoutRepository.FindByUserIdWithLock(ctx, userId)
+		if err != nil {
+			return err
+		}
+		if lockout == nil {
+			lockout = entities.NewAccountLockout(userId)
+		}
+		lockout.RegisterFailure(time.Now())
+		return uc.accountLockoutRepository.Save(ctx, lockout)
+	})
 }
@@ -2,13 +2,17 @@ package auth
 
 import (
 	"errors"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
 	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/crypto/bcrypt"
@@ -34,8 +38,11 @@ func (s *LoginSuite) TestExecute_WhenCredentialsValid_ReturnsTokens() {
 
 	mockUserRepo := repomocks.NewMockUserRepository(s.T())
 	mockRefreshRepo := repomocks.NewMockRefreshTokenRepository(s.T())
+	mockTwoFactorRepo := repomocks.NewMockTwoFactorRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
 	jwtService := services.NewJwtService()
-	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, jwtService)
+	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, mockTwoFactorRepo, mockLockoutRepo, mockTx, jwtService)
 
 	input := LoginInput{
 		Email:    "john@example.com",
@@ -45,6 +52,12 @@ func (s *LoginSuite) TestExecute_WhenCredentialsValid_ReturnsTokens() {
 	mockUserRepo.EXPECT().
 		FindByEmail(mock.Anything, input.Email).
 		Return(user, nil)
+	mockLockoutRepo.EXPECT().
+		FindByUserId(mock.Anything, user.ID).
+		Return((*entities.AccountLockout)(nil), nil)
+	mockTwoFactorRepo.EXPECT().
+		FindByUserId(mock.Anything, user.ID).
+		Return((*entities.UserTwoFactor)(nil), nil)
 	mockRefreshRepo.EXPECT().
 		Create(mock.Anything, mock.MatchedBy(func(rt *entities.RefreshToken) bool {
 			return rt.UserId == user.ID && !rt.ExpiresAt.IsZero()
@@ -61,7 +74,10 @@ func (s *LoginSuite) TestExecute_WhenCredentialsValid_ReturnsTokens() {
 func (s *LoginSuite) TestExecute_WhenUserNotFound_ReturnsInvalidCredentials() {
 	mockUserRepo := repomocks.NewMockUserRepository(s.T())
 	mockRefreshRepo := repomocks.NewMockRefreshTokenRepository(s.T())
-	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, nil) // jwt not called
+	mockTwoFactorRepo := repomocks.NewMockTwoFactorRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, mockTwoFactorRepo, mockLockoutRepo, mockTx, nil) // jwt not called
 
 	input := LoginInput{
 		Email:    "unknown@example.com",
@@ -86,7 +102,10 @@ func (s *LoginSuite) TestExecute_WhenPasswordInvalid_ReturnsInvalidCredentials()
 
 	mockUserRepo := repomocks.NewMockUserRepository(s.T())
 	mockRefreshRepo := repomocks.NewMockRefreshTokenRepository(s.T())
-	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, nil)
+	mockTwoFactorRepo := repomocks.NewMockTwoFactorRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, mockTwoFactorRepo, mockLockoutRepo, mockTx, nil)
 
 	input := LoginInput{
 		Email:    "john@example.com",
@@ -96,6 +115,18 @@ func (s *LoginSuite) TestExecute_WhenPasswordInvalid_ReturnsInvalidCredentials()
 	mockUserRepo.EXPECT().
 		FindByEmail(mock.Anything, input.Email).
 		Return(user, nil)
+	mockLockoutRepo.EXPECT().
+		FindByUserId(mock.Anything, user.ID).
+		Return((*entities.AccountLockout)(nil), nil)
+	passThroughTx(mockTx)
+	mockLockoutRepo.EXPECT().
+		FindByUserIdWithLock(mock.Anything, user.ID).
+		Return((*entities.AccountLockout)(nil), nil)
+	mockLockoutRepo.EXPECT().
+		Save(mock.Anything, mock.MatchedBy(func(l *entities.AccountLockout) bool {
+			return l.UserId == user.ID && l.FailedAttempts == 1 && l.LockedUntil == nil
+		})).
+		Return(nil)
 
 	output, err := uc.Execute(input)
 
@@ -104,10 +135,95 @@ func (s *LoginSuite) TestExecute_WhenPasswordInvalid_ReturnsInvalidCredentials()
 	s.Equal(LoginOutput{}, output)
 }
 
+func (s *LoginSuite) TestExecute_WhenFailureReachesThreshold_LocksAccount() {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("correct"), bcrypt.DefaultCost)
+	s.Require().NoError(err)
+	user := entities.RestoreUser("user-id", "John", "john@example.com", "johndoe", string(hashedPassword))
+	lockout := entities.NewAccountLockout(user.ID)
+	lockout.FailedAttempts = entities.LoginFailuresBeforeLockout - 1
+
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	uc := NewLoginUseCase(mockUserRepo, repomocks.NewMockRefreshTokenRepository(s.T()), repomocks.NewMockTwoFactorRepository(s.T()), mockLockoutRepo, mockTx, nil)
+
+	mockUserRepo.EXPECT().FindByEmail(mock.Anything, user.Email).Return(user, nil)
+	mockLockoutRepo.EXPECT().FindByUserId(mock.Anything, user.ID).Return(lockout, nil)
+	passThroughTx(mockTx)
+	mockLockoutRepo.EXPECT().FindByUserIdWithLock(mock.Anything, user.ID).Return(lockout, nil)
+	mockLockoutRepo.EXPECT().
+		Save(mock.Anything, mock.MatchedBy(func(l *entities.AccountLockout) bool {
+			return l.IsLocked(time.Now()) && l.Lockouts == 1
+		})).
+		Return(nil)
+
+	_, err = uc.Execute(LoginInput{Email: user.Email, Password: "wrong"})
+
+	s.Require().Error(err)
+	s.Equal("invalid email or password", err.Error())
+}
+
+func (s *LoginSuite) TestExecute_WhenAccountLocked_ReturnsUnauthorizedWithoutCheckingPassword() {
+	// A password hash bcrypt cannot parse proves ComparePassword is never reached.
+	user := entities.RestoreUser("user-id", "John", "john@example.com", "johndoe", "not-a-bcrypt-hash")
+	lockedUntil := time.Now().Add(2 * time.Minute)
+	lockout := entities.NewAccountLockout(user.ID)
+	lockout.LockedUntil = &lockedUntil
+
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	uc := NewLoginUseCase(mockUserRepo, repomocks.NewMockRefreshTokenRepository(s.T()), repomocks.NewMockTwoFactorRepository(s.T()), mockLockoutRepo, txmocks.NewMockTransactionManager(s.T()), nil)
+
+	mockUserRepo.EXPECT().FindByEmail(mock.Anything, user.Email).Return(user, nil)
+	mockLockoutRepo.EXPECT().FindByUserId(mock.Anything, user.ID).Return(lockout, nil)
+
+	output, err := uc.Execute(LoginInput{Email: user.Email, Password: "password123"})
+
+	s.Require().Error(err)
+	status, _ := coreerrors.Status(err)
+	s.Equal(http.StatusUnauthorized, status)
+	s.Equal("invalid email or password", err.Error())
+	_, ok := coreerrors.RetryAfter(err)
+	s.False(ok)
+	s.Equal(LoginOutput{}, output)
+}
+
+func (s *LoginSuite) TestExecute_WhenLoginSucceedsAfterFailures_ClearsLockout() {
+	prev := os.Getenv("JWT_SECRET_KEY")
+	os.Setenv("JWT_SECRET_KEY", "test-secret")
+	defer func() { _ = os.Setenv("JWT_SECRET_KEY", prev) }()
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
+	s.Require().NoError(err)
+	user := entities.RestoreUser("user-id", "John", "john@example.com", "johndoe", string(hashedPassword))
+	lockout := entities.NewAccountLockout(user.ID)
+	lockout.FailedAttempts = 3
+
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockRefreshRepo := repomocks.NewMockRefreshTokenRepository(s.T())
+	mockTwoFactorRepo := repomocks.NewMockTwoFactorRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, mockTwoFactorRepo, mockLockoutRepo, txmocks.NewMockTransactionManager(s.T()), services.NewJwtService())
+
+	mockUserRepo.EXPECT().FindByEmail(mock.Anything, user.Email).Return(user, nil)
+	mockLockoutRepo.EXPECT().FindByUserId(mock.Anything, user.ID).Return(lockout, nil)
+	mockLockoutRepo.EXPECT().DeleteByUserId(mock.Anything, user.ID).Return(nil)
+	mockTwoFactorRepo.EXPECT().FindByUserId(mock.Anything, user.ID).Return((*entities.UserTwoFactor)(nil), nil)
+	mockRefreshRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	output, err := uc.Execute(LoginInput{Email: user.Email, Password: "password123"})
+
+	s.Require().NoError(err)
+	s.NotEmpty(output.AccessToken)
+}
+
 func (s *LoginSuite) TestExecute_WhenFindByEmailFails_ReturnsError() {
 	mockUserRepo := repomocks.NewMockUserRepository(s.T())
 	mockRefreshRepo := repomocks.NewMockRefreshTokenRepository(s.T())
-	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, nil)
+	mockTwoFactorRepo := repomocks.NewMockTwoFactorRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, mockTwoFactorRepo, mockLockoutRepo, mockTx, nil)
 
 	input := LoginInput{
 		Email:    "john@example.com",
@@ -137,8 +253,11 @@ func (s *LoginSuite) TestExecute_WhenRefreshTokenCreateFails_ReturnsError() {
 
 	mockUserRepo := repomocks.NewMockUserRepository(s.T())
 	mockRefreshRepo := repomocks.NewMockRefreshTokenRepository(s.T())
+	mockTwoFactorRepo := repomocks.NewMockTwoFactorRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
 	jwtService := services.NewJwtService()
-	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, jwtService)
+	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, mockTwoFactorRepo, mockLockoutRepo, mockTx, jwtService)
 
 	input := LoginInput{
 		Email:    "john@example.com",
@@ -149,6 +268,12 @@ func (s *LoginSuite) TestExecute_WhenRefreshTokenCreateFails_ReturnsError() {
 	mockUserRepo.EXPECT().
 		FindByEmail(mock.Anything, input.Email).
 		Return(user, nil)
+	mockLockoutRepo.EXPECT().
+		FindByUserId(mock.Anything, user.ID).
+		Return((*entities.AccountLockout)(nil), nil)
+	mockTwoFactorRepo.EXPECT().
+		FindByUserId(mock.Anything, user.ID).
+		Return((*entities.UserTwoFactor)(nil), nil)
 	mockRefreshRepo.EXPECT().
 		Create(mock.Anything, mock.MatchedBy(func(rt *entities.RefreshToken) bool {
 			return rt.UserId == user.ID
@@ -162,10 +287,72 @@ func (s *LoginSuite) TestExecute_WhenRefreshTokenCreateFails_ReturnsError() {
 	s.Equal(LoginOutput{}, output)
 }
 
+func (s *LoginSuite) TestExecute_WhenTwoFactorEnabled_ReturnsChallengeToken() {
+	prev := os.Getenv("JWT_SECRET_KEY")
+	os.Setenv("JWT_SECRET_KEY", "test-secret")
+	defer func() { _ = os.Setenv("JWT_SECRET_KEY", prev) }()
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
+	s.Require().NoError(err)
+	user := entities.RestoreUser("user-id", "John", "john@example.com", "johndoe", string(hashedPassword))
+	twoFactor := entities.NewUserTwoFactor(user.ID, "SECRET")
+	s.Require().NoError(twoFactor.Confirm(1))
+
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockRefreshRepo := repomocks.NewMockRefreshTokenRepository(s.T())
+	mockTwoFactorRepo := repomocks.NewMockTwoFactorRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	jwtService := services.NewJwtService()
+	uc := NewLoginUseCase(mockUserRepo, mockRefreshRepo, mockTwoFactorRepo, mockLockoutRepo, mockTx, jwtService)
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, user.Email).
+		Return(user, nil)
+	mockLockoutRepo.EXPECT().
+		FindByUserId(mock.Anything, user.ID).
+		Return((*entities.AccountLockout)(nil), nil)
+	mockTwoFactorRepo.EXPECT().
+		FindByUserId(mock.Anything, user.ID).
+		Return(twoFactor, nil)
+
+	output, err := uc.Execute(LoginInput{Email: user.Email, Password: "password123"})
+
+	s.Require().NoError(err)
+	s.True(output.TwoFactorRequired)
+	s.Empty(output.AccessToken)
+	s.Empty(output.RefreshToken)
+	claims, err := jwtService.ValidateTwoFactorChallengeToken(output.ChallengeToken)
+	s.Require().NoError(err)
+	s.Equal(user.ID, claims.Subject)
+}
+
+func (s *LoginSuite) TestExecute_WhenUserBanned_ReturnsForbidden() {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.DefaultCost)
+	s.Require().NoError(err)
+	user := entities.RestoreUser("user-id", "John", "john@example.com", "johndoe", string(hashedPassword))
+	s.Require().NoError(user.Ban("cheating"))
+
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockLockoutRepo := repomocks.NewMockAccountLockoutRepository(s.T())
+	uc := NewLoginUseCase(mockUserRepo, repomocks.NewMockRefreshTokenRepository(s.T()), repomocks.NewMockTwoFactorRepository(s.T()), mockLockoutRepo, txmocks.NewMockTransactionManager(s.T()), nil)
+
+	mockUserRepo.EXPECT().FindByEmail(mock.Anything, user.Email).Return(user, nil)
+	mockLockoutRepo.EXPECT().FindByUserId(mock.Anything, user.ID).Return((*entities.AccountLockout)(nil), nil)
+
+	output, err := uc.Execute(LoginInput{Email: user.Email, Password: "password123"})
+
+	s.Require().Error(err)
+	s.Equal("account is banned", err.Error())
+	s.Equal(LoginOutput{}, output)
+}
+
 func (s *LoginSuite) TestNewLoginUseCase() {
 	var userRepo repositories.UserRepository = repomocks.NewMockUserRepository(s.T())
 	var refreshRepo repositories.RefreshTokenRepository = repomocks.NewMockRefreshTokenRepository(s.T())
-	uc := NewLoginUseCase(userRepo, refreshRepo, &services.JwtService{})
+	var twoFactorRepo repositories.TwoFactorRepository = repomocks.NewMockTwoFactorRepository(s.T())
+	var lockoutRepo repositories.AccountLockoutRepository = repomocks.NewMockAccountLockoutRepository(s.T())
+	uc := NewLoginUseCase(userRepo, refreshRepo, twoFactorRepo, lockoutRepo, txmocks.NewMockTransactionManager(s.T()), &services.JwtService{})
 	s.NotNil(uc)
 }
 
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+const oidcStateTTL = 10 * time.Minute
+
+type OidcAuthorizeInput struct {
+	Provider string
+	// UserId is set when a signed-in user is linking a new provider to their account.
+	UserId string
+}
+
+type OidcAuthorizeOutput struct {
+	AuthorizationUrl string `json:"authorization_url"`
+	State            string `json:"state"`
+}
+
+// OidcAuthorizeUseCase starts an OIDC authorization code flow with PKCE. The state, nonce and
+// code verifier are kept server-side; only the state and the code challenge reach the user agent.
+type OidcAuthorizeUseCase struct {
+	identityRepository  repositories.UserIdentityRepository
+	authStateRepository repositories.OidcAuthStateRepository
+	oidcService         *services.OidcService
+}
+
+func NewOidcAuthorizeUseCase(identityRepository repositories.UserIdentityRepository, authStateRepository repositories.OidcAuthStateRepository, oidcService *services.OidcService) *OidcAuthorizeUseCase {
+	return &OidcAuthorizeUseCase{
+		identityRepository:  identityRepository,
+		authStateRepository: authStateRepository,
+		oidcService:         oidcService,
+	}
+}
+
+func (uc *OidcAuthorizeUseCase) Execute(ctx context.Context, input OidcAuthorizeInput) (OidcAuthorizeOutput, error) {
+	if !uc.oidcService.HasProvider(input.Provider) {
+		return OidcAuthorizeOutput{}, coreerrors.NotFound("oidc provider not found")
+	}
+
+	var userId *string
+	if input.UserId != "" {
+		existing, err := uc.identityRepository.FindByUserIdAndProvider(ctx, input.UserId, input.Provider)
+		if err != nil {
+			return OidcAuthorizeOutput{}, err
+		}
+		if existing != nil {
+			return OidcAuthorizeOutput{}, coreerrors.Conflict("provider is already linked to this account")
+		}
+		userId = &input.UserId
+	}
+
+	state, err := services.RandomToken(32)
+	if err != nil {
+		return OidcAuthorizeOutput{}, err
+	}
+	nonce, err := services.RandomToken(32)
+	if err != nil {
+		return OidcAuthorizeOutput{}, err
+	}
+	verifier, challenge, err := services.GeneratePkce()
+	if err != nil {
+		return OidcAuthorizeOutput{}, err
+	}
+
+	authorizationUrl, err := uc.oidcService.AuthorizationUrl(ctx, input.Provider, state, nonce, challenge)
+	if err != nil {
+		if errors.Is(err, services.ErrOidcProviderNotFound) {
+			return OidcAuthorizeOutput{}, coreerrors.NotFound("oidc provider not found")
+		}
+		return OidcAuthorizeOutput{}, coreerrors.InternalServerError("failed to reach identity provider")
+	}
+
+	authState := entities.NewOidcAuthState(state, input.Provider, verifier, nonce, userId, time.Now().Add(oidcStateTTL))
+	if err := uc.authStateRepository.Create(ctx, authState); err != nil {
+		return OidcAuthorizeOutput{}, err
+	}
+
+	return OidcAuthorizeOutput{
+		AuthorizationUrl: authorizationUrl,
+		State:            state,
+	}, nil
+}
@@ -0,0 +1,231 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+const (
+	minUsernameLength    = 3
+	maxUsernameLength    = 20
+	usernameSuffixDigits = 4
+	maxUsernameAttempts  = 5
+	fallbackUsernameStem = "player"
+)
+
+type OidcCallbackInput struct {
+	Provider string
+	Code     string
+	State    string
+}
+
+type OidcCallbackOutput struct {
+	LoginOutput
+	// Linked is true when the flow linked the provider to an existing account instead of signing in.
+	Linked bool `json:"linked"`
+}
+
+// OidcCallbackUseCase completes an OIDC flow started by OidcAuthorizeUseCase. Sign-in flows resolve the
+// provider identity to a user (creating one on first sign-in) and finish exactly like LoginUseCase;
+// link flows attach the identity to the user that started them.
+type OidcCallbackUseCase struct {
+	userRepository         repositories.UserRepository
+	identityRepository     repositories.UserIdentityRepository
+	authStateRepository    repositories.OidcAuthStateRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	twoFactorRepository    repositories.TwoFactorRepository
+	txManager              transactions.TransactionManager
+	oidcService            *services.OidcService
+	jwtService             *services.JwtService
+}
+
+func NewOidcCallbackUseCase(
+	userRepository repositories.UserRepository,
+	identityRepository repositories.UserIdentityRepository,
+	authStateRepository repositories.OidcAuthStateRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	twoFactorRepository repositories.TwoFactorRepository,
+	txManager transactions.TransactionManager,
+	oidcService *services.OidcService,
+	jwtService *services.JwtService,
+) *OidcCallbackUseCase {
+	return &OidcCallbackUseCase{
+		userRepository:         userRepository,
+		identityRepository:     identityRepository,
+		authStateRepository:    authStateRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		twoFactorRepository:    twoFactorRepository,
+		txManager:              txManager,
+		oidcService:            oidcService,
+		jwtService:             jwtService,
+	}
+}
+
+func (uc *OidcCallbackUseCase) Execute(ctx context.Context, input OidcCallbackInput) (OidcCallbackOutput, error) {
+	authState, err := uc.authStateRepository.FindByState(ctx, input.State)
+	if err != nil {
+		return OidcCallbackOutput{}, err
+	}
+	if authState == nil || authState.Provider != input.Provider {
+		return OidcCallbackOutput{}, coreerrors.Unauthorized("invalid or expired oidc state")
+	}
+	// States are single use: consume it before talking to the provider so a replayed callback fails.
+	if err := uc.authStateRepository.Delete(ctx, authState); err != nil {
+		return OidcCallbackOutput{}, err
+	}
+	if authState.IsExpired() {
+		return OidcCallbackOutput{}, coreerrors.Unauthorized("invalid or expired oidc state")
+	}
+
+	identity, err := uc.oidcService.Exchange(ctx, input.Provider, input.Code, authState.CodeVerifier, authState.Nonce)
+	if err != nil {
+		if errors.Is(err, services.ErrOidcProviderNotFound) {
+			return OidcCallbackOutput{}, coreerrors.NotFound("oidc provider not found")
+		}
+		return OidcCallbackOutput{}, coreerrors.Unauthorized("failed to verify identity provider response")
+	}
+
+	if authState.IsLink() {
+		if err := uc.link(ctx, *authState.UserId, identity); err != nil {
+			return OidcCallbackOutput{}, err
+		}
+		return OidcCallbackOutput{Linked: true}, nil
+	}
+
+	var user *entities.User
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		user, err = uc.findOrCreateUser(ctx, identity)
+		return err
+	})
+	if err != nil {
+		return OidcCallbackOutput{}, err
+	}
+
+	tokens, err := completeSignIn(ctx, uc.twoFactorRepository, uc.refreshTokenRepository, uc.jwtService, user)
+	if err != nil {
+		return OidcCallbackOutput{}, err
+	}
+	return OidcCallbackOutput{LoginOutput: tokens}, nil
+}
+
+func (uc *OidcCallbackUseCase) link(ctx context.Context, userId string, identity *services.OidcIdentity) error {
+	existing, err := uc.identityRepository.FindByProviderAndSubject(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if existing.UserId == userId {
+			return nil
+		}
+		return coreerrors.Conflict("identity is already linked to another account")
+	}
+
+	linked, err := uc.identityRepository.FindByUserIdAndProvider(ctx, userId, identity.Provider)
+	if err != nil {
+		return err
+	}
+	if linked != nil {
+		return coreerrors.Conflict("provider is already linked to this account")
+	}
+
+	return uc.identityRepository.Create(ctx, entities.NewUserIdentity(userId, identity.Provider, identity.Subject, identity.Email))
+}
+
+func (uc *OidcCallbackUseCase) findOrCreateUser(ctx context.Context, identity *services.OidcIdentity) (*entities.User, error) {
+	existing, err := uc.identityRepository.FindByProviderAndSubject(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		user, err := uc.userRepository.FindById(ctx, existing.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, coreerrors.NotFound("user not found")
+		}
+		return user, nil
+	}
+
+	if identity.Email == "" {
+		return nil, coreerrors.BadRequest("identity provider did not share an email address")
+	}
+	if !identity.EmailVerified {
+		return nil, coreerrors.BadRequest("identity provider email is not verified")
+	}
+	// Never attach a provider to an existing account by email alone: the owner must sign in and link it.
+	userWithEmail, err := uc.userRepository.FindByEmail(ctx, identity.Email)
+	if err != nil {
+		return nil, err
+	}
+	if userWithEmail != nil {
+		return nil, coreerrors.Conflict("an account with this email already exists; sign in and link the provider")
+	}
+
+	username, err := uc.availableUsername(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	name := identity.Name
+	if name == "" {
+		name = username
+	}
+
+	newUser := entities.NewUser(name, identity.Email, username, "")
+	if err := uc.userRepository.Create(ctx, newUser); err != nil {
+		return nil, err
+	}
+	if err := uc.identityRepository.Create(ctx, entities.NewUserIdentity(newUser.ID, identity.Provider, identity.Subject, identity.Email)); err != nil {
+		return nil, err
+	}
+	return newUser, nil
+}
+
+// availableUsername derives a username from the provider profile, appending a random numeric
+// suffix when the preferred one is taken.
+func (uc *OidcCallbackUseCase) availableUsername(ctx context.Context, identity *services.OidcIdentity) (string, error) {
+	stem := identity.PreferredUsername
+	if stem == "" {
+		stem, _, _ = strings.Cut(identity.Email, "@")
+	}
+	stem = sanitizeUsername(stem)
+
+	candidate := stem
+	for attempt := 0; attempt < maxUsernameAttempts; attempt++ {
+		existing, err := uc.userRepository.FindByUsername(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if existing == nil {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s%0*d", stem[:min(len(stem), maxUsernameLength-usernameSuffixDigits)], usernameSuffixDigits, rand.IntN(10000))
+	}
+	return "", coreerrors.Conflict("could not find an available username")
+}
+
+func sanitizeUsername(raw string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(raw) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+		}
+	}
+	username := b.String()
+	if len(username) < minUsernameLength || entities.IsReservedUsername(username) {
+		username = fallbackUsernameStem + username
+	}
+	if len(username) > maxUsernameLength {
+		username = username[:maxUsernameLength]
+	}
+	return username
+}
@@ -0,0 +1,299 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services/oidctest"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OidcCallbackSuite struct {
+	suite.Suite
+	provider      *oidctest.Provider
+	oidcService   *services.OidcService
+	userRepo      *repomocks.MockUserRepository
+	identityRepo  *repomocks.MockUserIdentityRepository
+	stateRepo     *repomocks.MockOidcAuthStateRepository
+	refreshRepo   *repomocks.MockRefreshTokenRepository
+	twoFactorRepo *repomocks.MockTwoFactorRepository
+	txManager     *txmocks.MockTransactionManager
+	restoreSecret func()
+}
+
+func TestOidcCallbackSuite(t *testing.T) {
+	suite.Run(t, new(OidcCallbackSuite))
+}
+
+func (s *OidcCallbackSuite) SetupTest() {
+	prev := os.Getenv("JWT_SECRET_KEY")
+	os.Setenv("JWT_SECRET_KEY", "test-secret-for-oidc-tests")
+	s.restoreSecret = func() { _ = os.Setenv("JWT_SECRET_KEY", prev) }
+
+	s.provider = oidctest.NewProvider(s.T())
+	s.oidcService = services.NewOidcService([]services.OidcProviderConfig{s.provider.Config("fake")}, nil)
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.identityRepo = repomocks.NewMockUserIdentityRepository(s.T())
+	s.stateRepo = repomocks.NewMockOidcAuthStateRepository(s.T())
+	s.refreshRepo = repomocks.NewMockRefreshTokenRepository(s.T())
+	s.twoFactorRepo = repomocks.NewMockTwoFactorRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+}
+
+func (s *OidcCallbackSuite) TearDownTest() {
+	s.restoreSecret()
+}
+
+func (s *OidcCallbackSuite) newUseCase() *OidcCallbackUseCase {
+	return NewOidcCallbackUseCase(s.userRepo, s.identityRepo, s.stateRepo, s.refreshRepo, s.twoFactorRepo, s.txManager, s.oidcService, services.NewJwtService())
+}
+
+// startFlow runs OidcAuthorizeUseCase against the fake provider, captures the persisted state and
+// returns the callback input the provider would redirect back with after the user signs in as identity.
+func (s *OidcCallbackSuite) startFlow(userId string, identity oidctest.Identity) (OidcCallbackInput, *entities.OidcAuthState) {
+	var saved *entities.OidcAuthState
+	stateRepo := repomocks.NewMockOidcAuthStateRepository(s.T())
+	stateRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, st *entities.OidcAuthState) error {
+			saved = st
+			return nil
+		})
+	identityRepo := repomocks.NewMockUserIdentityRepository(s.T())
+	if userId != "" {
+		identityRepo.EXPECT().
+			FindByUserIdAndProvider(mock.Anything, userId, "fake").
+			Return((*entities.UserIdentity)(nil), nil)
+	}
+
+	output, err := NewOidcAuthorizeUseCase(identityRepo, stateRepo, s.oidcService).
+		Execute(context.Background(), OidcAuthorizeInput{Provider: "fake", UserId: userId})
+	s.Require().NoError(err)
+	s.Require().NotNil(saved)
+	s.Equal(saved.State, output.State)
+
+	code, state := s.provider.Authorize(output.AuthorizationUrl, identity)
+	s.Equal(output.State, state)
+	return OidcCallbackInput{Provider: "fake", Code: code, State: state}, saved
+}
+
+func (s *OidcCallbackSuite) expectStateConsumed(saved *entities.OidcAuthState) {
+	s.stateRepo.EXPECT().FindByState(mock.Anything, saved.State).Return(saved, nil)
+	s.stateRepo.EXPECT().Delete(mock.Anything, saved).Return(nil)
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenNewIdentity_CreatesUserAndReturnsTokens() {
+	input, saved := s.startFlow("", oidctest.Identity{
+		Subject:           "sub-123",
+		Email:             "john@example.com",
+		EmailVerified:     true,
+		Name:              "John Doe",
+		PreferredUsername: "John.Doe",
+	})
+	s.expectStateConsumed(saved)
+	passThroughTx(s.txManager)
+	s.identityRepo.EXPECT().FindByProviderAndSubject(mock.Anything, "fake", "sub-123").Return((*entities.UserIdentity)(nil), nil)
+	s.userRepo.EXPECT().FindByEmail(mock.Anything, "john@example.com").Return((*entities.User)(nil), nil)
+	s.userRepo.EXPECT().FindByUsername(mock.Anything, "johndoe").Return((*entities.User)(nil), nil)
+	s.userRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+			return u.Name == "John Doe" && u.Email == "john@example.com" && u.Username == "johndoe" && !u.HasPassword()
+		})).
+		RunAndReturn(func(_ context.Context, u *entities.User) error {
+			u.ID = "user-uuid"
+			return nil
+		})
+	s.identityRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(i *entities.UserIdentity) bool {
+			return i.UserId == "user-uuid" && i.Provider == "fake" && i.Subject == "sub-123"
+		})).
+		Return(nil)
+	s.twoFactorRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return((*entities.UserTwoFactor)(nil), nil)
+	s.refreshRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(rt *entities.RefreshToken) bool { return rt.UserId == "user-uuid" })).
+		Return(nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), input)
+
+	s.Require().NoError(err)
+	s.False(output.Linked)
+	s.NotEmpty(output.AccessToken)
+	s.NotEmpty(output.RefreshToken)
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenIdentityAlreadyLinked_SignsInExistingUser() {
+	input, saved := s.startFlow("", oidctest.Identity{Subject: "sub-123", Email: "john@example.com", EmailVerified: true})
+	s.expectStateConsumed(saved)
+	passThroughTx(s.txManager)
+	s.identityRepo.EXPECT().
+		FindByProviderAndSubject(mock.Anything, "fake", "sub-123").
+		Return(entities.RestoreUserIdentity("identity-uuid", "existing-user", "fake", "sub-123", "john@example.com"), nil)
+	s.userRepo.EXPECT().
+		FindById(mock.Anything, "existing-user").
+		Return(entities.RestoreUser("existing-user", "John", "john@example.com", "john", ""), nil)
+	s.twoFactorRepo.EXPECT().FindByUserId(mock.Anything, "existing-user").Return((*entities.UserTwoFactor)(nil), nil)
+	s.refreshRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(rt *entities.RefreshToken) bool { return rt.UserId == "existing-user" })).
+		Return(nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), input)
+
+	s.Require().NoError(err)
+	s.NotEmpty(output.AccessToken)
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenTwoFactorEnabled_ReturnsChallengeToken() {
+	input, saved := s.startFlow("", oidctest.Identity{Subject: "sub-123", Email: "john@example.com", EmailVerified: true})
+	s.expectStateConsumed(saved)
+	passThroughTx(s.txManager)
+	twoFactor := entities.NewUserTwoFactor("existing-user", "SECRET")
+	s.Require().NoError(twoFactor.Confirm(1))
+	s.identityRepo.EXPECT().
+		FindByProviderAndSubject(mock.Anything, "fake", "sub-123").
+		Return(entities.RestoreUserIdentity("identity-uuid", "existing-user", "fake", "sub-123", "john@example.com"), nil)
+	s.userRepo.EXPECT().
+		FindById(mock.Anything, "existing-user").
+		Return(entities.RestoreUser("existing-user", "John", "john@example.com", "john", ""), nil)
+	s.twoFactorRepo.EXPECT().FindByUserId(mock.Anything, "existing-user").Return(twoFactor, nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), input)
+
+	s.Require().NoError(err)
+	s.True(output.TwoFactorRequired)
+	s.NotEmpty(output.ChallengeToken)
+	s.Empty(output.AccessToken)
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenEmailBelongsToPasswordAccount_ReturnsConflict() {
+	input, saved := s.startFlow("", oidctest.Identity{Subject: "sub-123", Email: "john@example.com", EmailVerified: true})
+	s.expectStateConsumed(saved)
+	passThroughTx(s.txManager)
+	s.identityRepo.EXPECT().FindByProviderAndSubject(mock.Anything, "fake", "sub-123").Return((*entities.UserIdentity)(nil), nil)
+	s.userRepo.EXPECT().
+		FindByEmail(mock.Anything, "john@example.com").
+		Return(entities.RestoreUser("user-uuid", "John", "john@example.com", "john", "hash"), nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), input)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "sign in and link the provider")
+	s.Equal(OidcCallbackOutput{}, output)
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenEmailNotVerified_ReturnsBadRequest() {
+	input, saved := s.startFlow("", oidctest.Identity{Subject: "sub-123", Email: "john@example.com"})
+	s.expectStateConsumed(saved)
+	passThroughTx(s.txManager)
+	s.identityRepo.EXPECT().FindByProviderAndSubject(mock.Anything, "fake", "sub-123").Return((*entities.UserIdentity)(nil), nil)
+
+	_, err := s.newUseCase().Execute(context.Background(), input)
+
+	s.Require().Error(err)
+	s.Equal("identity provider email is not verified", err.Error())
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenUsernameTaken_AppendsSuffix() {
+	input, saved := s.startFlow("", oidctest.Identity{Subject: "sub-123", Email: "jo@example.com", EmailVerified: true})
+	s.expectStateConsumed(saved)
+	passThroughTx(s.txManager)
+	s.identityRepo.EXPECT().FindByProviderAndSubject(mock.Anything, "fake", "sub-123").Return((*entities.UserIdentity)(nil), nil)
+	s.userRepo.EXPECT().FindByEmail(mock.Anything, "jo@example.com").Return((*entities.User)(nil), nil)
+	s.userRepo.EXPECT().
+		FindByUsername(mock.Anything, "playerjo").
+		Return(entities.RestoreUser("other", "Other", "other@example.com", "playerjo", "hash"), nil).Once()
+	s.userRepo.EXPECT().FindByUsername(mock.Anything, mock.Anything).Return((*entities.User)(nil), nil).Once()
+	s.userRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+			return len(u.Username) == len("playerjo")+4 && u.Username[:8] == "playerjo"
+		})).
+		Return(nil)
+	s.identityRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+	s.twoFactorRepo.EXPECT().FindByUserId(mock.Anything, mock.Anything).Return((*entities.UserTwoFactor)(nil), nil)
+	s.refreshRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	_, err := s.newUseCase().Execute(context.Background(), input)
+
+	s.Require().NoError(err)
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenLinkFlow_LinksIdentityToUser() {
+	input, saved := s.startFlow("user-uuid", oidctest.Identity{Subject: "sub-123", Email: "john@example.com"})
+	s.expectStateConsumed(saved)
+	s.identityRepo.EXPECT().FindByProviderAndSubject(mock.Anything, "fake", "sub-123").Return((*entities.UserIdentity)(nil), nil)
+	s.identityRepo.EXPECT().FindByUserIdAndProvider(mock.Anything, "user-uuid", "fake").Return((*entities.UserIdentity)(nil), nil)
+	s.identityRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(i *entities.UserIdentity) bool {
+			return i.UserId == "user-uuid" && i.Subject == "sub-123" && i.Email == "john@example.com"
+		})).
+		Return(nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), input)
+
+	s.Require().NoError(err)
+	s.True(output.Linked)
+	s.Empty(output.AccessToken)
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenLinkingIdentityOfAnotherUser_ReturnsConflict() {
+	input, saved := s.startFlow("user-uuid", oidctest.Identity{Subject: "sub-123"})
+	s.expectStateConsumed(saved)
+	s.identityRepo.EXPECT().
+		FindByProviderAndSubject(mock.Anything, "fake", "sub-123").
+		Return(entities.RestoreUserIdentity("identity-uuid", "other-user", "fake", "sub-123", ""), nil)
+
+	_, err := s.newUseCase().Execute(context.Background(), input)
+
+	s.Require().Error(err)
+	s.Equal("identity is already linked to another account", err.Error())
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenStateUnknown_ReturnsUnauthorized() {
+	s.stateRepo.EXPECT().FindByState(mock.Anything, "unknown").Return((*entities.OidcAuthState)(nil), nil)
+
+	_, err := s.newUseCase().Execute(context.Background(), OidcCallbackInput{Provider: "fake", Code: "code", State: "unknown"})
+
+	s.Require().Error(err)
+	s.Equal("invalid or expired oidc state", err.Error())
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenStateExpired_ReturnsUnauthorized() {
+	expired := entities.NewOidcAuthState("state", "fake", "verifier", "nonce", nil, time.Now().Add(-time.Minute))
+	s.expectStateConsumed(expired)
+
+	_, err := s.newUseCase().Execute(context.Background(), OidcCallbackInput{Provider: "fake", Code: "code", State: "state"})
+
+	s.Require().Error(err)
+	s.Equal("invalid or expired oidc state", err.Error())
+}
+
+func (s *OidcCallbackSuite) TestExecute_WhenCodeInvalid_ReturnsUnauthorized() {
+	_, saved := s.startFlow("", oidctest.Identity{Subject: "sub-123"})
+	s.expectStateConsumed(saved)
+
+	_, err := s.newUseCase().Execute(context.Background(), OidcCallbackInput{Provider: "fake", Code: "forged", State: saved.State})
+
+	s.Require().Error(err)
+	s.Equal("failed to verify identity provider response", err.Error())
+}
+
+func (s *OidcCallbackSuite) TestSanitizeUsername() {
+	s.Equal("johndoe", sanitizeUsername("John.Doe"))
+	s.Equal("playerjo", sanitizeUsername("jo"))
+	s.Equal("abcdefghijklmnopqrst", sanitizeUsername("abcdefghijklmnopqrstuvwxyz"))
+	s.Equal("playerdeleted_abc", sanitizeUsername("deleted_abc"))
+}
+
+func passThroughTx(mockTx *txmocks.MockTransactionManager) {
+	mockTx.EXPECT().
+		RunInTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type RegenerateBackupCodesInput struct {
+	UserId string
+	// Code must be a current TOTP code; backup codes cannot be used to mint new ones.
+	Code string
+}
+
+type RegenerateBackupCodesUseCase struct {
+	twoFactorRepository  repositories.TwoFactorRepository
+	backupCodeRepository repositories.TwoFactorBackupCodeRepository
+	txManager            transactions.TransactionManager
+	totpService          *services.TotpService
+}
+
+func NewRegenerateBackupCodesUseCase(twoFactorRepository repositories.TwoFactorRepository, backupCodeRepository repositories.TwoFactorBackupCodeRepository, txManager transactions.TransactionManager, totpService *services.TotpService) *RegenerateBackupCodesUseCase {
+	return &RegenerateBackupCodesUseCase{
+		twoFactorRepository:  twoFactorRepository,
+		backupCodeRepository: backupCodeRepository,
+		txManager:            txManager,
+		totpService:          totpService,
+	}
+}
+
+func (uc *RegenerateBackupCodesUseCase) Execute(ctx context.Context, input RegenerateBackupCodesInput) (TwoFactorBackupCodesOutput, error) {
+	var output TwoFactorBackupCodesOutput
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		twoFactor, err := uc.twoFactorRepository.FindByUserIdWithLock(ctx, input.UserId)
+		if err != nil {
+			return err
+		}
+		if twoFactor == nil || !twoFactor.IsEnabled() {
+			return coreerrors.BadRequest("two-factor authentication is not enabled")
+		}
+		if err := verifySecondFactor(ctx, uc.backupCodeRepository, uc.totpService, twoFactor, input.Code, false); err != nil {
+			return err
+		}
+		if err := uc.twoFactorRepository.Update(ctx, twoFactor); err != nil {
+			return err
+		}
+
+		codes, err := replaceBackupCodes(ctx, uc.backupCodeRepository, uc.totpService, input.UserId)
+		if err != nil {
+			return err
+		}
+		output.BackupCodes = codes
+		return nil
+	})
+	if err != nil {
+		return TwoFactorBackupCodesOutput{}, err
+	}
+	return output, nil
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type RevokePersonalAccessTokenInput struct {
+	UserId  string
+	TokenId string
+}
+
+type RevokePersonalAccessTokenUseCase struct {
+	tokenRepository repositories.PersonalAccessTokenRepository
+}
+
+func NewRevokePersonalAccessTokenUseCase(tokenRepository repositories.PersonalAccessTokenRepository) *RevokePersonalAccessTokenUseCase {
+	return &RevokePersonalAccessTokenUseCase{tokenRepository: tokenRepository}
+}
+
+func (uc *RevokePersonalAccessTokenUseCase) Execute(ctx context.Context, input RevokePersonalAccessTokenInput) error {
+	token, err := uc.tokenRepository.FindByIdAndUserId(ctx, input.TokenId, input.UserId)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return coreerrors.NotFound("token not found")
+	}
+	return uc.tokenRepository.Delete(ctx, token)
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+const refreshTokenTTL = time.Hour * 24 * 7
+
+// issueTokenPair persists a new refresh token for the user and returns a signed access/refresh token pair.
+// Every flow that ends in a signed-in session goes through here so they all issue identical credentials.
+func issueTokenPair(ctx context.Context, refreshTokenRepository repositories.RefreshTokenRepository, jwtService *services.JwtService, user *entities.User) (LoginOutput, error) {
+	if err := ensureCanSignIn(user); err != nil {
+		return LoginOutput{}, err
+	}
+	refreshTokenEntity := entities.NewRefreshToken(user.ID, time.Now().Add(refreshTokenTTL))
+	if err := refreshTokenRepository.Create(ctx, refreshTokenEntity); err != nil {
+		return LoginOutput{}, err
+	}
+	accessToken, err := jwtService.GenerateAccessToken(user.ID, string(user.Role))
+	if err != nil {
+		return LoginOutput{}, err
+	}
+	refreshToken, err := jwtService.GenerateRefreshToken(user.ID, refreshTokenEntity.ID)
+	if err != nil {
+		return LoginOutput{}, err
+	}
+
+	return LoginOutput{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// completeSignIn finishes a successful first-factor authentication. Users with two-factor authentication
+// enabled receive a short-lived challenge token instead of a token pair.
+func completeSignIn(ctx context.Context, twoFactorRepository repositories.TwoFactorRepository, refreshTokenRepository repositories.RefreshTokenRepository, jwtService *services.JwtService, user *entities.User) (LoginOutput, error) {
+	if err := ensureCanSignIn(user); err != nil {
+		return LoginOutput{}, err
+	}
+	twoFactor, err := twoFactorRepository.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return LoginOutput{}, err
+	}
+	if twoFactor != nil && twoFactor.IsEnabled() {
+		challengeToken, err := jwtService.GenerateTwoFactorChallengeToken(user.ID)
+		if err != nil {
+			return LoginOutput{}, err
+		}
+		return LoginOutput{TwoFactorRequired: true, ChallengeToken: challengeToken}, nil
+	}
+	return issueTokenPair(ctx, refreshTokenRepository, jwtService, user)
+}
+
+func ensureCanSignIn(user *entities.User) error {
+	if user.IsBanned() {
+		return coreerrors.Forbidden("account is banned")
+	}
+	return nil
+}
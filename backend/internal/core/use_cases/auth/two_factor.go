@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+// verifySecondFactor accepts a current TOTP code or, when allowBackupCode is set, an unused backup code.
+// The caller must hold a lock on twoFactor and persist it afterwards so the used TOTP step is recorded.
+func verifySecondFactor(ctx context.Context, backupCodeRepository repositories.TwoFactorBackupCodeRepository, totpService *services.TotpService, twoFactor *entities.UserTwoFactor, code string, allowBackupCode bool) error {
+	code = strings.TrimSpace(code)
+	if step, ok := totpService.ValidateCode(twoFactor.Secret, code, time.Now()); ok {
+		return twoFactor.UseStep(step)
+	}
+	if !allowBackupCode {
+		return coreerrors.Unauthorized("invalid two-factor code")
+	}
+
+	backupCode, err := backupCodeRepository.FindUnusedByUserIdAndCodeHash(ctx, twoFactor.UserId, totpService.HashBackupCode(code))
+	if err != nil {
+		return err
+	}
+	if backupCode == nil {
+		return coreerrors.Unauthorized("invalid two-factor code")
+	}
+	if err := backupCode.Use(); err != nil {
+		return err
+	}
+	return backupCodeRepository.Update(ctx, backupCode)
+}
+
+// replaceBackupCodes discards every backup code of the user and returns a new plain-text set.
+func replaceBackupCodes(ctx context.Context, backupCodeRepository repositories.TwoFactorBackupCodeRepository, totpService *services.TotpService, userId string) ([]string, error) {
+	codes, err := totpService.GenerateBackupCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := backupCodeRepository.DeleteByUserId(ctx, userId); err != nil {
+		return nil, err
+	}
+	entitiesToCreate := make([]*entities.TwoFactorBackupCode, len(codes))
+	for i, code := range codes {
+		entitiesToCreate[i] = entities.NewTwoFactorBackupCode(userId, totpService.HashBackupCode(code))
+	}
+	if err := backupCodeRepository.CreateMany(ctx, entitiesToCreate); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
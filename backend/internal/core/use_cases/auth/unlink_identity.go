@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type UnlinkIdentityInput struct {
+	UserId   string
+	Provider string
+}
+
+type UnlinkIdentityUseCase struct {
+	userRepository     repositories.UserRepository
+	identityRepository repositories.UserIdentityRepository
+}
+
+func NewUnlinkIdentityUseCase(userRepository repositories.UserRepository, identityRepository repositories.UserIdentityRepository) *UnlinkIdentityUseCase {
+	return &UnlinkIdentityUseCase{userRepository: userRepository, identityRepository: identityRepository}
+}
+
+// Execute removes the user's identity for the provider. The last sign-in method of an account
+// without a password cannot be removed, otherwise the user would be locked out.
+func (uc *UnlinkIdentityUseCase) Execute(ctx context.Context, input UnlinkIdentityInput) error {
+	identity, err := uc.identityRepository.FindByUserIdAndProvider(ctx, input.UserId, input.Provider)
+	if err != nil {
+		return err
+	}
+	if identity == nil {
+		return coreerrors.NotFound("linked identity not found")
+	}
+
+	user, err := uc.userRepository.FindById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return coreerrors.NotFound("user not found")
+	}
+	if !user.HasPassword() {
+		identities, err := uc.identityRepository.FindByUserId(ctx, input.UserId)
+		if err != nil {
+			return err
+		}
+		if len(identities) <= 1 {
+			return coreerrors.BadRequest("cannot unlink the only sign-in method of an account without a password")
+		}
+	}
+
+	return uc.identityRepository.Delete(ctx, identity)
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnlinkIdentitySuite struct {
+	suite.Suite
+}
+
+func TestUnlinkIdentitySuite(t *testing.T) {
+	suite.Run(t, new(UnlinkIdentitySuite))
+}
+
+func (s *UnlinkIdentitySuite) TestExecute_WhenUserHasPassword_DeletesIdentity() {
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockIdentityRepo := repomocks.NewMockUserIdentityRepository(s.T())
+	uc := NewUnlinkIdentityUseCase(mockUserRepo, mockIdentityRepo)
+	identity := entities.RestoreUserIdentity("identity-uuid", "user-uuid", "google", "sub", "")
+
+	mockIdentityRepo.EXPECT().FindByUserIdAndProvider(mock.Anything, "user-uuid", "google").Return(identity, nil)
+	mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	mockIdentityRepo.EXPECT().Delete(mock.Anything, identity).Return(nil)
+
+	err := uc.Execute(context.Background(), UnlinkIdentityInput{UserId: "user-uuid", Provider: "google"})
+
+	s.NoError(err)
+}
+
+func (s *UnlinkIdentitySuite) TestExecute_WhenOnlySignInMethod_ReturnsBadRequest() {
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockIdentityRepo := repomocks.NewMockUserIdentityRepository(s.T())
+	uc := NewUnlinkIdentityUseCase(mockUserRepo, mockIdentityRepo)
+	identity := entities.RestoreUserIdentity("identity-uuid", "user-uuid", "google", "sub", "")
+
+	mockIdentityRepo.EXPECT().FindByUserIdAndProvider(mock.Anything, "user-uuid", "google").Return(identity, nil)
+	mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", ""), nil)
+	mockIdentityRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return([]*entities.UserIdentity{identity}, nil)
+
+	err := uc.Execute(context.Background(), UnlinkIdentityInput{UserId: "user-uuid", Provider: "google"})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "cannot unlink the only sign-in method")
+}
+
+func (s *UnlinkIdentitySuite) TestExecute_WhenPasswordlessWithOtherIdentity_DeletesIdentity() {
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockIdentityRepo := repomocks.NewMockUserIdentityRepository(s.T())
+	uc := NewUnlinkIdentityUseCase(mockUserRepo, mockIdentityRepo)
+	identity := entities.RestoreUserIdentity("identity-uuid", "user-uuid", "google", "sub", "")
+	other := entities.RestoreUserIdentity("identity-uuid-2", "user-uuid", "discord", "sub-2", "")
+
+	mockIdentityRepo.EXPECT().FindByUserIdAndProvider(mock.Anything, "user-uuid", "google").Return(identity, nil)
+	mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", ""), nil)
+	mockIdentityRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return([]*entities.UserIdentity{identity, other}, nil)
+	mockIdentityRepo.EXPECT().Delete(mock.Anything, identity).Return(nil)
+
+	err := uc.Execute(context.Background(), UnlinkIdentityInput{UserId: "user-uuid", Provider: "google"})
+
+	s.NoError(err)
+}
+
+func (s *UnlinkIdentitySuite) TestExecute_WhenNotLinked_ReturnsNotFound() {
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockIdentityRepo := repomocks.NewMockUserIdentityRepository(s.T())
+	uc := NewUnlinkIdentityUseCase(mockUserRepo, mockIdentityRepo)
+
+	mockIdentityRepo.EXPECT().FindByUserIdAndProvider(mock.Anything, "user-uuid", "google").Return((*entities.UserIdentity)(nil), nil)
+
+	err := uc.Execute(context.Background(), UnlinkIdentityInput{UserId: "user-uuid", Provider: "google"})
+
+	s.Require().Error(err)
+	s.Equal("linked identity not found", err.Error())
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type VerifyTwoFactorInput struct {
+	ChallengeToken string
+	// Code is a current TOTP code or one of the user's backup codes.
+	Code string
+}
+
+// VerifyTwoFactorUseCase is the second step of a login for users with two-factor authentication:
+// it exchanges the challenge token returned by LoginUseCase and a valid code for a token pair.
+type VerifyTwoFactorUseCase struct {
+	userRepository         repositories.UserRepository
+	twoFactorRepository    repositories.TwoFactorRepository
+	backupCodeRepository   repositories.TwoFactorBackupCodeRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	txManager              transactions.TransactionManager
+	totpService            *services.TotpService
+	jwtService             *services.JwtService
+}
+
+func NewVerifyTwoFactorUseCase(
+	userRepository repositories.UserRepository,
+	twoFactorRepository repositories.TwoFactorRepository,
+	backupCodeRepository repositories.TwoFactorBackupCodeRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	txManager transactions.TransactionManager,
+	totpService *services.TotpService,
+	jwtService *services.JwtService,
+) *VerifyTwoFactorUseCase {
+	return &VerifyTwoFactorUseCase{
+		userRepository:         userRepository,
+		twoFactorRepository:    twoFactorRepository,
+		backupCodeRepository:   backupCodeRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		txManager:              txManager,
+		totpService:            totpService,
+		jwtService:             jwtService,
+	}
+}
+
+func (uc *VerifyTwoFactorUseCase) Execute(ctx context.Context, input VerifyTwoFactorInput) (LoginOutput, error) {
+	claims, err := uc.jwtService.ValidateTwoFactorChallengeToken(input.ChallengeToken)
+	if err != nil {
+		return LoginOutput{}, coreerrors.Unauthorized("invalid or expired challenge token")
+	}
+	userId := claims.Subject
+
+	var output LoginOutput
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		twoFactor, err := uc.twoFactorRepository.FindByUserIdWithLock(ctx, userId)
+		if err != nil {
+			return err
+		}
+		if twoFactor == nil || !twoFactor.IsEnabled() {
+			return coreerrors.Unauthorized("invalid or expired challenge token")
+		}
+		if err := verifySecondFactor(ctx, uc.backupCodeRepository, uc.totpService, twoFactor, input.Code, true); err != nil {
+			return err
+		}
+		if err := uc.twoFactorRepository.Update(ctx, twoFactor); err != nil {
+			return err
+		}
+
+		user, err := uc.userRepository.FindById(ctx, userId)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return coreerrors.Unauthorized("invalid or expired challenge token")
+		}
+		output, err = issueTokenPair(ctx, uc.refreshTokenRepository, uc.jwtService, user)
+		return err
+	})
+	if err != nil {
+		return LoginOutput{}, err
+	}
+	return output, nil
+}
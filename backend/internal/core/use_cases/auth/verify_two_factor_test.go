@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type VerifyTwoFactorSuite struct {
+	suite.Suite
+	userRepo       *repomocks.MockUserRepository
+	twoFactorRepo  *repomocks.MockTwoFactorRepository
+	backupCodeRepo *repomocks.MockTwoFactorBackupCodeRepository
+	refreshRepo    *repomocks.MockRefreshTokenRepository
+	txManager      *txmocks.MockTransactionManager
+	totpService    *services.TotpService
+	jwtService     *services.JwtService
+	twoFactor      *entities.UserTwoFactor
+	restoreSecret  func()
+}
+
+func TestVerifyTwoFactorSuite(t *testing.T) {
+	suite.Run(t, new(VerifyTwoFactorSuite))
+}
+
+func (s *VerifyTwoFactorSuite) SetupTest() {
+	prev := os.Getenv("JWT_SECRET_KEY")
+	os.Setenv("JWT_SECRET_KEY", "test-secret-for-2fa-tests")
+	s.restoreSecret = func() { _ = os.Setenv("JWT_SECRET_KEY", prev) }
+
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.twoFactorRepo = repomocks.NewMockTwoFactorRepository(s.T())
+	s.backupCodeRepo = repomocks.NewMockTwoFactorBackupCodeRepository(s.T())
+	s.refreshRepo = repomocks.NewMockRefreshTokenRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.totpService = services.NewTotpService("Maya Guessr")
+	s.jwtService = services.NewJwtService()
+
+	secret, err := s.totpService.GenerateSecret()
+	s.Require().NoError(err)
+	s.twoFactor = entities.NewUserTwoFactor("user-uuid", secret)
+	s.Require().NoError(s.twoFactor.Confirm(s.totpService.Step(time.Now()) - 10))
+}
+
+func (s *VerifyTwoFactorSuite) TearDownTest() {
+	s.restoreSecret()
+}
+
+func (s *VerifyTwoFactorSuite) newUseCase() *VerifyTwoFactorUseCase {
+	return NewVerifyTwoFactorUseCase(s.userRepo, s.twoFactorRepo, s.backupCodeRepo, s.refreshRepo, s.txManager, s.totpService, s.jwtService)
+}
+
+func (s *VerifyTwoFactorSuite) user() *entities.User {
+	return entities.RestoreUser("user-uuid", "John", "john@example.com", "johndoe", "hash")
+}
+
+func (s *VerifyTwoFactorSuite) challengeToken() string {
+	token, err := s.jwtService.GenerateTwoFactorChallengeToken("user-uuid")
+	s.Require().NoError(err)
+	return token
+}
+
+func (s *VerifyTwoFactorSuite) TestExecute_WhenTotpCodeValid_ReturnsTokens() {
+	code, err := s.totpService.GenerateCode(s.twoFactor.Secret, time.Now())
+	s.Require().NoError(err)
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return(s.twoFactor, nil)
+	s.twoFactorRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(t *entities.UserTwoFactor) bool {
+			return t.LastUsedStep >= s.totpService.Step(time.Now())-1
+		})).
+		Return(nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.user(), nil)
+	s.refreshRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), VerifyTwoFactorInput{ChallengeToken: s.challengeToken(), Code: code})
+
+	s.Require().NoError(err)
+	s.NotEmpty(output.AccessToken)
+	s.NotEmpty(output.RefreshToken)
+	s.False(output.TwoFactorRequired)
+}
+
+func (s *VerifyTwoFactorSuite) TestExecute_WhenTotpCodeReplayed_ReturnsUnauthorized() {
+	code, err := s.totpService.GenerateCode(s.twoFactor.Secret, time.Now())
+	s.Require().NoError(err)
+	s.twoFactor.LastUsedStep = s.totpService.Step(time.Now()) + 1
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return(s.twoFactor, nil)
+
+	_, err = s.newUseCase().Execute(context.Background(), VerifyTwoFactorInput{ChallengeToken: s.challengeToken(), Code: code})
+
+	s.Require().Error(err)
+	s.Equal("two-factor code has already been used", err.Error())
+}
+
+func (s *VerifyTwoFactorSuite) TestExecute_WhenBackupCodeValid_ConsumesItAndReturnsTokens() {
+	backupCode := entities.NewTwoFactorBackupCode("user-uuid", s.totpService.HashBackupCode("ABCDE-FGHIJ"))
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return(s.twoFactor, nil)
+	s.backupCodeRepo.EXPECT().
+		FindUnusedByUserIdAndCodeHash(mock.Anything, "user-uuid", s.totpService.HashBackupCode("abcdefghij")).
+		Return(backupCode, nil)
+	s.backupCodeRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(c *entities.TwoFactorBackupCode) bool { return c.IsUsed() })).
+		Return(nil)
+	s.twoFactorRepo.EXPECT().Update(mock.Anything, s.twoFactor).Return(nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.user(), nil)
+	s.refreshRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), VerifyTwoFactorInput{ChallengeToken: s.challengeToken(), Code: "abcdefghij"})
+
+	s.Require().NoError(err)
+	s.NotEmpty(output.AccessToken)
+}
+
+func (s *VerifyTwoFactorSuite) TestExecute_WhenUserBanned_ReturnsForbidden() {
+	code, err := s.totpService.GenerateCode(s.twoFactor.Secret, time.Now())
+	s.Require().NoError(err)
+	banned := s.user()
+	s.Require().NoError(banned.Ban("cheating"))
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return(s.twoFactor, nil)
+	s.twoFactorRepo.EXPECT().Update(mock.Anything, s.twoFactor).Return(nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(banned, nil)
+
+	_, err = s.newUseCase().Execute(context.Background(), VerifyTwoFactorInput{ChallengeToken: s.challengeToken(), Code: code})
+
+	s.Require().Error(err)
+	s.Equal("account is banned", err.Error())
+}
+
+func (s *VerifyTwoFactorSuite) TestExecute_WhenCodeInvalid_ReturnsUnauthorized() {
+	passThroughTx(s.txManager)
+	s.twoFactorRepo.EXPECT().FindByUserIdWithLock(mock.Anything, "user-uuid").Return(s.twoFactor, nil)
+	s.backupCodeRepo.EXPECT().
+		FindUnusedByUserIdAndCodeHash(mock.Anything, "user-uuid", mock.Anything).
+		Return((*entities.TwoFactorBackupCode)(nil), nil)
+
+	output, err := s.newUseCase().Execute(context.Background(), VerifyTwoFactorInput{ChallengeToken: s.challengeToken(), Code: "WRONG-CODES"})
+
+	s.Require().Error(err)
+	s.Equal("invalid two-factor code", err.Error())
+	s.Equal(LoginOutput{}, output)
+}
+
+func (s *VerifyTwoFactorSuite) TestExecute_WhenChallengeTokenIsAccessToken_ReturnsUnauthorized() {
+	accessToken, err := s.jwtService.GenerateAccessToken("user-uuid", "player")
+	s.Require().NoError(err)
+
+	_, err = s.newUseCase().Execute(context.Background(), VerifyTwoFactorInput{ChallengeToken: accessToken, Code: "123456"})
+
+	s.Require().Error(err)
+	s.Equal("invalid or expired challenge token", err.Error())
+}
@@ -0,0 +1,68 @@
+package friend
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type FriendRequestInput struct {
+	// UserId is the addressee of the request.
+	UserId      string
+	RequesterId string
+}
+
+type AcceptFriendRequestUseCase struct {
+	friendshipRepository repositories.FriendshipRepository
+	txManager            transactions.TransactionManager
+	notifier             services.Notifier
+}
+
+func NewAcceptFriendRequestUseCase(friendshipRepository repositories.FriendshipRepository, txManager transactions.TransactionManager, notifier services.Notifier) *AcceptFriendRequestUseCase {
+	return &AcceptFriendRequestUseCase{
+		friendshipRepository: friendshipRepository,
+		txManager:            txManager,
+		notifier:             notifier,
+	}
+}
+
+func (uc *AcceptFriendRequestUseCase) Execute(ctx context.Context, input FriendRequestInput) (*entities.Friendship, error) {
+	var friendship *entities.Friendship
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		friendship, err = findIncomingRequest(ctx, uc.friendshipRepository, input)
+		if err != nil {
+			return err
+		}
+		if err := ensureFriendCapacity(ctx, uc.friendshipRepository, input.UserId, input.RequesterId); err != nil {
+			return err
+		}
+		if err := friendship.Accept(input.UserId, time.Now()); err != nil {
+			return err
+		}
+		if err := uc.friendshipRepository.Update(ctx, friendship); err != nil {
+			return err
+		}
+		return uc.notifier.Notify(ctx, entities.NewNotification(input.RequesterId, entities.NotificationTypeFriendAccepted, input.UserId, input.UserId))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return friendship, nil
+}
+
+func findIncomingRequest(ctx context.Context, friendshipRepository repositories.FriendshipRepository, input FriendRequestInput) (*entities.Friendship, error) {
+	friendship, err := friendshipRepository.FindByUsers(ctx, input.UserId, input.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+	if friendship == nil || !friendship.IsPending() || friendship.RequesterId != input.RequesterId {
+		return nil, coreerrors.NotFound("friend request not found")
+	}
+	return friendship, nil
+}
@@ -0,0 +1,59 @@
+package friend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AcceptFriendRequestSuite struct {
+	suite.Suite
+	friendshipRepo *repomocks.MockFriendshipRepository
+	txManager      *txmocks.MockTransactionManager
+	notifier       *servicemocks.MockNotifier
+	uc             *AcceptFriendRequestUseCase
+}
+
+func TestAcceptFriendRequestSuite(t *testing.T) {
+	suite.Run(t, new(AcceptFriendRequestSuite))
+}
+
+func (s *AcceptFriendRequestSuite) SetupTest() {
+	s.friendshipRepo = repomocks.NewMockFriendshipRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.notifier = servicemocks.NewMockNotifier(s.T())
+	s.uc = NewAcceptFriendRequestUseCase(s.friendshipRepo, s.txManager, s.notifier)
+	passThroughTx(s.txManager)
+}
+
+func (s *AcceptFriendRequestSuite) TestExecute_AcceptsIncomingRequest() {
+	request := entities.NewFriendRequest("bob-uuid", "alice-uuid")
+	s.friendshipRepo.EXPECT().FindByUsers(mock.Anything, "alice-uuid", "bob-uuid").Return(request, nil)
+	s.friendshipRepo.EXPECT().CountFriends(mock.Anything, mock.Anything).Return(int64(0), nil)
+	s.friendshipRepo.EXPECT().Update(mock.Anything, request).Return(nil)
+	s.notifier.EXPECT().
+		Notify(mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == "bob-uuid" && n.Type == entities.NotificationTypeFriendAccepted && *n.ActorId == "alice-uuid"
+		})).
+		Return(nil)
+
+	friendship, err := s.uc.Execute(context.Background(), FriendRequestInput{UserId: "alice-uuid", RequesterId: "bob-uuid"})
+
+	s.Require().NoError(err)
+	s.True(friendship.IsAccepted())
+}
+
+func (s *AcceptFriendRequestSuite) TestExecute_WhenRequestIsOutgoing_ReturnsNotFound() {
+	s.friendshipRepo.EXPECT().FindByUsers(mock.Anything, "alice-uuid", "bob-uuid").Return(entities.NewFriendRequest("alice-uuid", "bob-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), FriendRequestInput{UserId: "alice-uuid", RequesterId: "bob-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("friend request not found", err.Error())
+}
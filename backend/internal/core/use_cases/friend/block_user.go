@@ -0,0 +1,64 @@
+package friend
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type BlockUserInput struct {
+	UserId    string
+	BlockedId string
+}
+
+type BlockUserUseCase struct {
+	userRepository       repositories.UserRepository
+	friendshipRepository repositories.FriendshipRepository
+	userBlockRepository  repositories.UserBlockRepository
+	txManager            transactions.TransactionManager
+}
+
+func NewBlockUserUseCase(
+	userRepository repositories.UserRepository,
+	friendshipRepository repositories.FriendshipRepository,
+	userBlockRepository repositories.UserBlockRepository,
+	txManager transactions.TransactionManager,
+) *BlockUserUseCase {
+	return &BlockUserUseCase{
+		userRepository:       userRepository,
+		friendshipRepository: friendshipRepository,
+		userBlockRepository:  userBlockRepository,
+		txManager:            txManager,
+	}
+}
+
+// Execute blocks a user and ends any friendship or pending request between the two.
+func (uc *BlockUserUseCase) Execute(ctx context.Context, input BlockUserInput) error {
+	if input.BlockedId == input.UserId {
+		return coreerrors.BadRequest("cannot block yourself")
+	}
+	blocked, err := uc.userRepository.FindById(ctx, input.BlockedId)
+	if err != nil {
+		return err
+	}
+	if blocked == nil {
+		return coreerrors.NotFound("user not found")
+	}
+
+	return uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.userBlockRepository.Create(ctx, entities.NewUserBlock(input.UserId, blocked.ID)); err != nil {
+			return err
+		}
+		friendship, err := uc.friendshipRepository.FindByUsers(ctx, input.UserId, blocked.ID)
+		if err != nil {
+			return err
+		}
+		if friendship == nil {
+			return nil
+		}
+		return uc.friendshipRepository.Delete(ctx, friendship)
+	})
+}
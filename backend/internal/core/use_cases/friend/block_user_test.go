@@ -0,0 +1,66 @@
+package friend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BlockUserSuite struct {
+	suite.Suite
+	userRepo       *repomocks.MockUserRepository
+	friendshipRepo *repomocks.MockFriendshipRepository
+	blockRepo      *repomocks.MockUserBlockRepository
+	txManager      *txmocks.MockTransactionManager
+	uc             *BlockUserUseCase
+}
+
+func TestBlockUserSuite(t *testing.T) {
+	suite.Run(t, new(BlockUserSuite))
+}
+
+func (s *BlockUserSuite) SetupTest() {
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.friendshipRepo = repomocks.NewMockFriendshipRepository(s.T())
+	s.blockRepo = repomocks.NewMockUserBlockRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.uc = NewBlockUserUseCase(s.userRepo, s.friendshipRepo, s.blockRepo, s.txManager)
+}
+
+func (s *BlockUserSuite) TestExecute_BlocksAndEndsFriendship() {
+	friendship := entities.NewFriendRequest("alice-uuid", "bob-uuid")
+	passThroughTx(s.txManager)
+	s.userRepo.EXPECT().FindById(mock.Anything, "bob-uuid").Return(entities.RestoreUser("bob-uuid", "Bob", "bob@example.com", "bob", "hash"), nil)
+	s.blockRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(b *entities.UserBlock) bool {
+			return b.BlockerId == "alice-uuid" && b.BlockedId == "bob-uuid"
+		})).
+		Return(nil)
+	s.friendshipRepo.EXPECT().FindByUsers(mock.Anything, "alice-uuid", "bob-uuid").Return(friendship, nil)
+	s.friendshipRepo.EXPECT().Delete(mock.Anything, friendship).Return(nil)
+
+	err := s.uc.Execute(context.Background(), BlockUserInput{UserId: "alice-uuid", BlockedId: "bob-uuid"})
+
+	s.Require().NoError(err)
+}
+
+func (s *BlockUserSuite) TestExecute_WhenUserMissing_ReturnsNotFound() {
+	s.userRepo.EXPECT().FindById(mock.Anything, "missing").Return(nil, nil)
+
+	err := s.uc.Execute(context.Background(), BlockUserInput{UserId: "alice-uuid", BlockedId: "missing"})
+
+	s.Require().Error(err)
+	s.Equal("user not found", err.Error())
+}
+
+func (s *BlockUserSuite) TestExecute_WhenSelf_ReturnsBadRequest() {
+	err := s.uc.Execute(context.Background(), BlockUserInput{UserId: "alice-uuid", BlockedId: "alice-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("cannot block yourself", err.Error())
+}
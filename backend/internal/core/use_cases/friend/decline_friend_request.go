@@ -0,0 +1,24 @@
+package friend
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type DeclineFriendRequestUseCase struct {
+	friendshipRepository repositories.FriendshipRepository
+}
+
+func NewDeclineFriendRequestUseCase(friendshipRepository repositories.FriendshipRepository) *DeclineFriendRequestUseCase {
+	return &DeclineFriendRequestUseCase{friendshipRepository: friendshipRepository}
+}
+
+// Execute deletes an incoming request. The requester is not told; they may ask again later.
+func (uc *DeclineFriendRequestUseCase) Execute(ctx context.Context, input FriendRequestInput) error {
+	friendship, err := findIncomingRequest(ctx, uc.friendshipRepository, input)
+	if err != nil {
+		return err
+	}
+	return uc.friendshipRepository.Delete(ctx, friendship)
+}
@@ -0,0 +1,149 @@
+package friend
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+const (
+	defaultFeedLimit = 20
+	maxFeedLimit     = 50
+)
+
+type FeedItemType string
+
+const (
+	FeedItemGameCompleted FeedItemType = "game_completed"
+	FeedItemMapPublished  FeedItemType = "map_published"
+)
+
+type FeedGameOutput struct {
+	ID      string `json:"id"`
+	MapId   string `json:"map_id"`
+	MapName string `json:"map_name"`
+	Mode    string `json:"mode"`
+	Score   int    `json:"score"`
+}
+
+type FeedMapOutput struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type FeedItemOutput struct {
+	Type     FeedItemType    `json:"type"`
+	UserId   string          `json:"user_id"`
+	Username string          `json:"username"`
+	At       time.Time       `json:"at"`
+	Game     *FeedGameOutput `json:"game,omitempty"`
+	Map      *FeedMapOutput  `json:"map,omitempty"`
+}
+
+type GetFriendsFeedInput struct {
+	UserId string
+	// Before is the cursor: only activity strictly older is returned. Zero means now.
+	Before time.Time
+	Limit  int
+}
+
+type GetFriendsFeedOutput struct {
+	Items []FeedItemOutput `json:"items"`
+	// NextBefore is the cursor of the next page, nil when there is none.
+	NextBefore *time.Time `json:"next_before"`
+}
+
+type GetFriendsFeedUseCase struct {
+	friendshipRepository       repositories.FriendshipRepository
+	singlePlayerGameRepository repositories.SinglePlayerGameRepository
+	mapRepository              repositories.MapRepository
+}
+
+func NewGetFriendsFeedUseCase(
+	friendshipRepository repositories.FriendshipRepository,
+	singlePlayerGameRepository repositories.SinglePlayerGameRepository,
+	mapRepository repositories.MapRepository,
+) *GetFriendsFeedUseCase {
+	return &GetFriendsFeedUseCase{
+		friendshipRepository:       friendshipRepository,
+		singlePlayerGameRepository: singlePlayerGameRepository,
+		mapRepository:              mapRepository,
+	}
+}
+
+// Execute lists the friends' recently completed games and published maps, latest first. Each source
+// is read up to the page size and the two are merged, so a page is exact without an offset.
+func (uc *GetFriendsFeedUseCase) Execute(ctx context.Context, input GetFriendsFeedInput) (GetFriendsFeedOutput, error) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultFeedLimit
+	}
+	limit = min(limit, maxFeedLimit)
+	before := input.Before
+	if before.IsZero() {
+		before = time.Now()
+	}
+
+	friendIds, err := uc.friendshipRepository.FindFriendIds(ctx, input.UserId)
+	if err != nil {
+		return GetFriendsFeedOutput{}, err
+	}
+	output := GetFriendsFeedOutput{Items: []FeedItemOutput{}}
+	if len(friendIds) == 0 {
+		return output, nil
+	}
+
+	games, err := uc.singlePlayerGameRepository.FindRecentCompletedByUserIds(ctx, friendIds, before, limit)
+	if err != nil {
+		return GetFriendsFeedOutput{}, err
+	}
+	maps, err := uc.mapRepository.FindRecentlyPublishedByOwnerIds(ctx, friendIds, before, limit)
+	if err != nil {
+		return GetFriendsFeedOutput{}, err
+	}
+
+	items := make([]FeedItemOutput, 0, len(games)+len(maps))
+	for _, game := range games {
+		if game.EndedAt == nil {
+			continue
+		}
+		item := FeedItemOutput{
+			Type:     FeedItemGameCompleted,
+			UserId:   game.UserId,
+			Username: usernameOf(game.User),
+			At:       *game.EndedAt,
+			Game: &FeedGameOutput{
+				ID:    game.ID,
+				MapId: game.MapId,
+				Mode:  string(game.Mode),
+				Score: game.Score,
+			},
+		}
+		if game.Map != nil {
+			item.Game.MapName = game.Map.Name
+		}
+		items = append(items, item)
+	}
+	for _, m := range maps {
+		items = append(items, FeedItemOutput{
+			Type:     FeedItemMapPublished,
+			UserId:   m.OwnerId,
+			Username: usernameOf(m.Owner),
+			At:       *m.PublishedAt,
+			Map:      &FeedMapOutput{ID: m.ID, Name: m.Name},
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].At.After(items[j].At) })
+
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	output.Items = items
+	if len(games) == limit || len(maps) == limit {
+		next := items[len(items)-1].At
+		output.NextBefore = &next
+	}
+	return output, nil
+}
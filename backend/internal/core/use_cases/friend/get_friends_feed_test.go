@@ -0,0 +1,74 @@
+package friend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetFriendsFeedSuite struct {
+	suite.Suite
+	friendshipRepo *repomocks.MockFriendshipRepository
+	gameRepo       *repomocks.MockSinglePlayerGameRepository
+	mapRepo        *repomocks.MockMapRepository
+	uc             *GetFriendsFeedUseCase
+}
+
+func TestGetFriendsFeedSuite(t *testing.T) {
+	suite.Run(t, new(GetFriendsFeedSuite))
+}
+
+func (s *GetFriendsFeedSuite) SetupTest() {
+	s.friendshipRepo = repomocks.NewMockFriendshipRepository(s.T())
+	s.gameRepo = repomocks.NewMockSinglePlayerGameRepository(s.T())
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.uc = NewGetFriendsFeedUseCase(s.friendshipRepo, s.gameRepo, s.mapRepo)
+}
+
+func (s *GetFriendsFeedSuite) TestExecute_MergesGamesAndMapsLatestFirst() {
+	base := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	before := base.Add(time.Hour)
+	endedAt := base.Add(-time.Minute)
+	game := entities.NewSinglePlayerGame("bob-uuid", "map-uuid", entities.SinglePlayerGameModeMove, 60)
+	game.EndedAt = &endedAt
+	game.Score = 19000
+	game.User = entities.RestoreUser("bob-uuid", "Bob", "bob@example.com", "bob", "hash")
+	newer := entities.NewMap("Brazil", "desc", "carol-uuid")
+	newer.ID = "map-new"
+	newer.PublishedAt = &base
+	older := entities.NewMap("Chile", "desc", "carol-uuid")
+	older.ID = "map-old"
+	olderPublishedAt := base.Add(-time.Hour)
+	older.PublishedAt = &olderPublishedAt
+
+	s.friendshipRepo.EXPECT().FindFriendIds(mock.Anything, "alice-uuid").Return([]string{"bob-uuid", "carol-uuid"}, nil)
+	s.gameRepo.EXPECT().FindRecentCompletedByUserIds(mock.Anything, []string{"bob-uuid", "carol-uuid"}, before, 2).Return([]*entities.SinglePlayerGame{game}, nil)
+	s.mapRepo.EXPECT().FindRecentlyPublishedByOwnerIds(mock.Anything, []string{"bob-uuid", "carol-uuid"}, before, 2).Return([]*entities.Map{newer, older}, nil)
+
+	output, err := s.uc.Execute(context.Background(), GetFriendsFeedInput{UserId: "alice-uuid", Before: before, Limit: 2})
+
+	s.Require().NoError(err)
+	s.Require().Len(output.Items, 2)
+	s.Equal(FeedItemMapPublished, output.Items[0].Type)
+	s.Equal("map-new", output.Items[0].Map.ID)
+	s.Equal(FeedItemGameCompleted, output.Items[1].Type)
+	s.Equal("bob", output.Items[1].Username)
+	s.Equal(19000, output.Items[1].Game.Score)
+	s.Require().NotNil(output.NextBefore)
+	s.Equal(endedAt, *output.NextBefore)
+}
+
+func (s *GetFriendsFeedSuite) TestExecute_WhenNoFriends_ReturnsEmptyFeed() {
+	s.friendshipRepo.EXPECT().FindFriendIds(mock.Anything, "alice-uuid").Return(nil, nil)
+
+	output, err := s.uc.Execute(context.Background(), GetFriendsFeedInput{UserId: "alice-uuid"})
+
+	s.Require().NoError(err)
+	s.Empty(output.Items)
+	s.Nil(output.NextBefore)
+}
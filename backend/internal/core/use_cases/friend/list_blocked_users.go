@@ -0,0 +1,38 @@
+package friend
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type BlockedUserOutput struct {
+	UserId    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	BlockedAt time.Time `json:"blocked_at"`
+}
+
+type ListBlockedUsersUseCase struct {
+	userBlockRepository repositories.UserBlockRepository
+}
+
+func NewListBlockedUsersUseCase(userBlockRepository repositories.UserBlockRepository) *ListBlockedUsersUseCase {
+	return &ListBlockedUsersUseCase{userBlockRepository: userBlockRepository}
+}
+
+func (uc *ListBlockedUsersUseCase) Execute(ctx context.Context, userId string) ([]BlockedUserOutput, error) {
+	blocks, err := uc.userBlockRepository.FindByBlockerId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]BlockedUserOutput, len(blocks))
+	for i, block := range blocks {
+		output[i] = BlockedUserOutput{
+			UserId:    block.BlockedId,
+			Username:  usernameOf(block.Blocked),
+			BlockedAt: block.CreatedAt,
+		}
+	}
+	return output, nil
+}
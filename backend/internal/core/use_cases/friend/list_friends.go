@@ -0,0 +1,67 @@
+package friend
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type FriendOutput struct {
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+	// Since is when the friendship was accepted for friends, and when the request was sent otherwise.
+	Since time.Time `json:"since"`
+}
+
+type ListFriendsOutput struct {
+	Friends  []FriendOutput `json:"friends"`
+	Incoming []FriendOutput `json:"incoming"`
+	Outgoing []FriendOutput `json:"outgoing"`
+}
+
+type ListFriendsUseCase struct {
+	friendshipRepository repositories.FriendshipRepository
+}
+
+func NewListFriendsUseCase(friendshipRepository repositories.FriendshipRepository) *ListFriendsUseCase {
+	return &ListFriendsUseCase{friendshipRepository: friendshipRepository}
+}
+
+func (uc *ListFriendsUseCase) Execute(ctx context.Context, userId string) (ListFriendsOutput, error) {
+	friendships, err := uc.friendshipRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return ListFriendsOutput{}, err
+	}
+
+	output := ListFriendsOutput{Friends: []FriendOutput{}, Incoming: []FriendOutput{}, Outgoing: []FriendOutput{}}
+	for _, friendship := range friendships {
+		friend := FriendOutput{UserId: friendship.OtherUserId(userId), Since: friendship.CreatedAt}
+		if other := friendship.OtherUser(userId); other != nil {
+			friend.Username = other.Username
+			friend.Name = other.Name
+		}
+		switch {
+		case friendship.IsAccepted():
+			if friendship.AcceptedAt != nil {
+				friend.Since = *friendship.AcceptedAt
+			}
+			output.Friends = append(output.Friends, friend)
+		case friendship.RequesterId == userId:
+			output.Outgoing = append(output.Outgoing, friend)
+		default:
+			output.Incoming = append(output.Incoming, friend)
+		}
+	}
+	return output, nil
+}
+
+// usernameOf returns the username of a loaded user, or an empty string when it was not loaded.
+func usernameOf(user *entities.User) string {
+	if user == nil {
+		return ""
+	}
+	return user.Username
+}
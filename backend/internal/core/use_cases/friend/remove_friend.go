@@ -0,0 +1,34 @@
+package friend
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type RemoveFriendInput struct {
+	UserId   string
+	FriendId string
+}
+
+type RemoveFriendUseCase struct {
+	friendshipRepository repositories.FriendshipRepository
+}
+
+func NewRemoveFriendUseCase(friendshipRepository repositories.FriendshipRepository) *RemoveFriendUseCase {
+	return &RemoveFriendUseCase{friendshipRepository: friendshipRepository}
+}
+
+// Execute ends a friendship, or cancels a request the caller sent. Incoming requests are declined
+// with DeclineFriendRequestUseCase instead.
+func (uc *RemoveFriendUseCase) Execute(ctx context.Context, input RemoveFriendInput) error {
+	friendship, err := uc.friendshipRepository.FindByUsers(ctx, input.UserId, input.FriendId)
+	if err != nil {
+		return err
+	}
+	if friendship == nil || (friendship.IsPending() && friendship.RequesterId != input.UserId) {
+		return coreerrors.NotFound("friendship not found")
+	}
+	return uc.friendshipRepository.Delete(ctx, friendship)
+}
@@ -0,0 +1,118 @@
+package friend
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+// maxFriends bounds the friends lists the friends leaderboard and feed fetch in one query.
+const maxFriends = 500
+
+type SendFriendRequestInput struct {
+	UserId   string
+	Username string
+}
+
+type SendFriendRequestUseCase struct {
+	userRepository       repositories.UserRepository
+	friendshipRepository repositories.FriendshipRepository
+	userBlockRepository  repositories.UserBlockRepository
+	txManager            transactions.TransactionManager
+	notifier             services.Notifier
+}
+
+func NewSendFriendRequestUseCase(
+	userRepository repositories.UserRepository,
+	friendshipRepository repositories.FriendshipRepository,
+	userBlockRepository repositories.UserBlockRepository,
+	txManager transactions.TransactionManager,
+	notifier services.Notifier,
+) *SendFriendRequestUseCase {
+	return &SendFriendRequestUseCase{
+		userRepository:       userRepository,
+		friendshipRepository: friendshipRepository,
+		userBlockRepository:  userBlockRepository,
+		txManager:            txManager,
+		notifier:             notifier,
+	}
+}
+
+// Execute sends a friend request to the user with Username. When that user already sent one to
+// the caller, the two requests meet and the friendship is accepted right away.
+func (uc *SendFriendRequestUseCase) Execute(ctx context.Context, input SendFriendRequestInput) (*entities.Friendship, error) {
+	target, err := uc.userRepository.FindByUsername(ctx, input.Username)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil || target.IsBanned() {
+		return nil, coreerrors.NotFound("user not found")
+	}
+	if target.ID == input.UserId {
+		return nil, coreerrors.BadRequest("cannot send a friend request to yourself")
+	}
+	blocked, err := uc.userBlockRepository.ExistsBetween(ctx, input.UserId, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, coreerrors.Forbidden("cannot send a friend request to this user")
+	}
+
+	var friendship *entities.Friendship
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		friendship, err = uc.friendshipRepository.FindByUsers(ctx, input.UserId, target.ID)
+		if err != nil {
+			return err
+		}
+		if friendship != nil {
+			if friendship.IsAccepted() {
+				return coreerrors.Conflict("already friends")
+			}
+			if friendship.RequesterId == input.UserId {
+				return coreerrors.Conflict("friend request already sent")
+			}
+			if err := ensureFriendCapacity(ctx, uc.friendshipRepository, input.UserId, target.ID); err != nil {
+				return err
+			}
+			if err := friendship.Accept(input.UserId, time.Now()); err != nil {
+				return err
+			}
+			if err := uc.friendshipRepository.Update(ctx, friendship); err != nil {
+				return err
+			}
+			return uc.notifier.Notify(ctx, entities.NewNotification(target.ID, entities.NotificationTypeFriendAccepted, input.UserId, input.UserId))
+		}
+
+		if err := ensureFriendCapacity(ctx, uc.friendshipRepository, input.UserId); err != nil {
+			return err
+		}
+		friendship = entities.NewFriendRequest(input.UserId, target.ID)
+		if err := uc.friendshipRepository.Create(ctx, friendship); err != nil {
+			return err
+		}
+		return uc.notifier.Notify(ctx, entities.NewNotification(target.ID, entities.NotificationTypeFriendRequest, input.UserId, input.UserId))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return friendship, nil
+}
+
+func ensureFriendCapacity(ctx context.Context, friendshipRepository repositories.FriendshipRepository, userIds ...string) error {
+	for _, userId := range userIds {
+		count, err := friendshipRepository.CountFriends(ctx, userId)
+		if err != nil {
+			return err
+		}
+		if count >= maxFriends {
+			return coreerrors.Conflict("friend limit reached")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package friend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var errMock = errors.New("mock error")
+
+func passThroughTx(mockTx *txmocks.MockTransactionManager) {
+	mockTx.EXPECT().
+		RunInTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+}
+
+type SendFriendRequestSuite struct {
+	suite.Suite
+	userRepo       *repomocks.MockUserRepository
+	friendshipRepo *repomocks.MockFriendshipRepository
+	blockRepo      *repomocks.MockUserBlockRepository
+	txManager      *txmocks.MockTransactionManager
+	notifier       *servicemocks.MockNotifier
+	uc             *SendFriendRequestUseCase
+}
+
+func TestSendFriendRequestSuite(t *testing.T) {
+	suite.Run(t, new(SendFriendRequestSuite))
+}
+
+func (s *SendFriendRequestSuite) SetupTest() {
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.friendshipRepo = repomocks.NewMockFriendshipRepository(s.T())
+	s.blockRepo = repomocks.NewMockUserBlockRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.notifier = servicemocks.NewMockNotifier(s.T())
+	s.uc = NewSendFriendRequestUseCase(s.userRepo, s.friendshipRepo, s.blockRepo, s.txManager, s.notifier)
+	s.userRepo.EXPECT().FindByUsername(mock.Anything, "bob").Return(entities.RestoreUser("bob-uuid", "Bob", "bob@example.com", "bob", "hash"), nil).Maybe()
+}
+
+func (s *SendFriendRequestSuite) TestExecute_CreatesPendingRequest() {
+	passThroughTx(s.txManager)
+	s.blockRepo.EXPECT().ExistsBetween(mock.Anything, "alice-uuid", "bob-uuid").Return(false, nil)
+	s.friendshipRepo.EXPECT().FindByUsers(mock.Anything, "alice-uuid", "bob-uuid").Return(nil, nil)
+	s.friendshipRepo.EXPECT().CountFriends(mock.Anything, "alice-uuid").Return(int64(3), nil)
+	s.friendshipRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(f *entities.Friendship) bool {
+			return f.RequesterId == "alice-uuid" && f.AddresseeId() == "bob-uuid" && f.IsPending()
+		})).
+		Return(nil)
+	s.notifier.EXPECT().
+		Notify(mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == "bob-uuid" && n.Type == entities.NotificationTypeFriendRequest && *n.ActorId == "alice-uuid"
+		})).
+		Return(nil)
+
+	friendship, err := s.uc.Execute(context.Background(), SendFriendRequestInput{UserId: "alice-uuid", Username: "bob"})
+
+	s.Require().NoError(err)
+	s.True(friendship.IsPending())
+}
+
+func (s *SendFriendRequestSuite) TestExecute_WhenTargetAlreadyAsked_AcceptsFriendship() {
+	incoming := entities.NewFriendRequest("bob-uuid", "alice-uuid")
+	passThroughTx(s.txManager)
+	s.blockRepo.EXPECT().ExistsBetween(mock.Anything, "alice-uuid", "bob-uuid").Return(false, nil)
+	s.friendshipRepo.EXPECT().FindByUsers(mock.Anything, "alice-uuid", "bob-uuid").Return(incoming, nil)
+	s.friendshipRepo.EXPECT().CountFriends(mock.Anything, mock.Anything).Return(int64(0), nil)
+	s.friendshipRepo.EXPECT().Update(mock.Anything, incoming).Return(nil)
+	s.notifier.EXPECT().
+		Notify(mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == "bob-uuid" && n.Type == entities.NotificationTypeFriendAccepted
+		})).
+		Return(nil)
+
+	friendship, err := s.uc.Execute(context.Background(), SendFriendRequestInput{UserId: "alice-uuid", Username: "bob"})
+
+	s.Require().NoError(err)
+	s.True(friendship.IsAccepted())
+}
+
+func (s *SendFriendRequestSuite) TestExecute_WhenAlreadySent_ReturnsConflict() {
+	passThroughTx(s.txManager)
+	s.blockRepo.EXPECT().ExistsBetween(mock.Anything, "alice-uuid", "bob-uuid").Return(false, nil)
+	s.friendshipRepo.EXPECT().FindByUsers(mock.Anything, "alice-uuid", "bob-uuid").Return(entities.NewFriendRequest("alice-uuid", "bob-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), SendFriendRequestInput{UserId: "alice-uuid", Username: "bob"})
+
+	s.Require().Error(err)
+	s.Equal("friend request already sent", err.Error())
+}
+
+func (s *SendFriendRequestSuite) TestExecute_WhenBlocked_ReturnsForbidden() {
+	s.blockRepo.EXPECT().ExistsBetween(mock.Anything, "alice-uuid", "bob-uuid").Return(true, nil)
+
+	_, err := s.uc.Execute(context.Background(), SendFriendRequestInput{UserId: "alice-uuid", Username: "bob"})
+
+	s.Require().Error(err)
+	s.Equal("cannot send a friend request to this user", err.Error())
+}
+
+func (s *SendFriendRequestSuite) TestExecute_WhenFriendLimitReached_ReturnsConflict() {
+	passThroughTx(s.txManager)
+	s.blockRepo.EXPECT().ExistsBetween(mock.Anything, "alice-uuid", "bob-uuid").Return(false, nil)
+	s.friendshipRepo.EXPECT().FindByUsers(mock.Anything, "alice-uuid", "bob-uuid").Return(nil, nil)
+	s.friendshipRepo.EXPECT().CountFriends(mock.Anything, "alice-uuid").Return(int64(maxFriends), nil)
+
+	_, err := s.uc.Execute(context.Background(), SendFriendRequestInput{UserId: "alice-uuid", Username: "bob"})
+
+	s.Require().Error(err)
+	s.Equal("friend limit reached", err.Error())
+}
+
+func (s *SendFriendRequestSuite) TestExecute_WhenSelf_ReturnsBadRequest() {
+	_, err := s.uc.Execute(context.Background(), SendFriendRequestInput{UserId: "bob-uuid", Username: "bob"})
+
+	s.Require().Error(err)
+	s.Equal("cannot send a friend request to yourself", err.Error())
+}
@@ -0,0 +1,21 @@
+package friend
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type UnblockUserUseCase struct {
+	userBlockRepository repositories.UserBlockRepository
+}
+
+func NewUnblockUserUseCase(userBlockRepository repositories.UserBlockRepository) *UnblockUserUseCase {
+	return &UnblockUserUseCase{userBlockRepository: userBlockRepository}
+}
+
+// Execute lifts a block. Lifting a block that does not exist is not an error. The former
+// friendship is not restored.
+func (uc *UnblockUserUseCase) Execute(ctx context.Context, input BlockUserInput) error {
+	return uc.userBlockRepository.Delete(ctx, input.UserId, input.BlockedId)
+}
@@ -0,0 +1,38 @@
+package leaderboard
+
+import (
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+)
+
+// BoardInput selects a board. An empty MapId selects the global board; PeriodStart is derived from
+// Period and the current time, so only the running week or month can be requested.
+type BoardInput struct {
+	MapId          string
+	Mode           entities.SinglePlayerGameMode
+	DurationBucket entities.LeaderboardDurationBucket
+	Period         entities.LeaderboardPeriod
+}
+
+func (i BoardInput) key(now time.Time) (entities.LeaderboardKey, error) {
+	switch i.Mode {
+	case entities.SinglePlayerGameModeMove, entities.SinglePlayerGameModeNoMove, entities.SinglePlayerGameModeNMPZ:
+	default:
+		return entities.LeaderboardKey{}, coreerrors.BadRequest("invalid mode")
+	}
+	if !i.DurationBucket.IsValid() {
+		return entities.LeaderboardKey{}, coreerrors.BadRequest("invalid duration bucket")
+	}
+	if !i.Period.IsValid() {
+		return entities.LeaderboardKey{}, coreerrors.BadRequest("invalid period")
+	}
+	return entities.LeaderboardKey{
+		MapId:          i.MapId,
+		Mode:           i.Mode,
+		DurationBucket: i.DurationBucket,
+		Period:         i.Period,
+		PeriodStart:    i.Period.Start(now),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package leaderboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type GetFriendsLeaderboardInput struct {
+	BoardInput
+	UserId string
+}
+
+type GetFriendsLeaderboardOutput struct {
+	PeriodStart time.Time     `json:"period_start"`
+	Entries     []EntryOutput `json:"entries"`
+}
+
+type GetFriendsLeaderboardUseCase struct {
+	friendshipRepository  repositories.FriendshipRepository
+	leaderboardRepository repositories.LeaderboardRepository
+}
+
+func NewGetFriendsLeaderboardUseCase(friendshipRepository repositories.FriendshipRepository, leaderboardRepository repositories.LeaderboardRepository) *GetFriendsLeaderboardUseCase {
+	return &GetFriendsLeaderboardUseCase{
+		friendshipRepository:  friendshipRepository,
+		leaderboardRepository: leaderboardRepository,
+	}
+}
+
+// Execute ranks the user and their friends on a board. Friend lists are bounded, so the whole
+// view fits in one response and ranks are positions within it, not global ranks.
+func (uc *GetFriendsLeaderboardUseCase) Execute(ctx context.Context, input GetFriendsLeaderboardInput) (GetFriendsLeaderboardOutput, error) {
+	key, err := input.key(time.Now())
+	if err != nil {
+		return GetFriendsLeaderboardOutput{}, err
+	}
+	friendIds, err := uc.friendshipRepository.FindFriendIds(ctx, input.UserId)
+	if err != nil {
+		return GetFriendsLeaderboardOutput{}, err
+	}
+	entries, err := uc.leaderboardRepository.FindByKeyAndUserIds(ctx, key, append(friendIds, input.UserId))
+	if err != nil {
+		return GetFriendsLeaderboardOutput{}, err
+	}
+
+	output := GetFriendsLeaderboardOutput{PeriodStart: key.PeriodStart, Entries: make([]EntryOutput, len(entries))}
+	for i, entry := range entries {
+		output.Entries[i] = EntryOutput{
+			Rank:       int64(i + 1),
+			UserId:     entry.UserId,
+			GameId:     entry.GameId,
+			Score:      entry.Score,
+			AchievedAt: entry.AchievedAt,
+		}
+		if entry.User != nil {
+			output.Entries[i].Username = entry.User.Username
+		}
+	}
+	return output, nil
+}
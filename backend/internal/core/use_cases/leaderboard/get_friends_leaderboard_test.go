@@ -0,0 +1,54 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetFriendsLeaderboardSuite struct {
+	suite.Suite
+	friendshipRepo *repomocks.MockFriendshipRepository
+	boardRepo      *repomocks.MockLeaderboardRepository
+	uc             *GetFriendsLeaderboardUseCase
+}
+
+func TestGetFriendsLeaderboardSuite(t *testing.T) {
+	suite.Run(t, new(GetFriendsLeaderboardSuite))
+}
+
+func (s *GetFriendsLeaderboardSuite) SetupTest() {
+	s.friendshipRepo = repomocks.NewMockFriendshipRepository(s.T())
+	s.boardRepo = repomocks.NewMockLeaderboardRepository(s.T())
+	s.uc = NewGetFriendsLeaderboardUseCase(s.friendshipRepo, s.boardRepo)
+}
+
+func (s *GetFriendsLeaderboardSuite) TestExecute_RanksFriendsAndUser() {
+	s.friendshipRepo.EXPECT().FindFriendIds(mock.Anything, "user-uuid").Return([]string{"friend-1", "friend-2"}, nil)
+	s.boardRepo.EXPECT().
+		FindByKeyAndUserIds(mock.Anything, mock.MatchedBy(matchesWeeklyBoard), []string{"friend-1", "friend-2", "user-uuid"}).
+		Return([]*entities.LeaderboardEntry{
+			{UserId: "friend-2", Score: 22000},
+			{UserId: "user-uuid", Score: 18000},
+		}, nil)
+
+	output, err := s.uc.Execute(context.Background(), GetFriendsLeaderboardInput{BoardInput: weeklyBoard(), UserId: "user-uuid"})
+
+	s.Require().NoError(err)
+	s.Require().Len(output.Entries, 2)
+	s.Equal(int64(1), output.Entries[0].Rank)
+	s.Equal("friend-2", output.Entries[0].UserId)
+	s.Equal(int64(2), output.Entries[1].Rank)
+}
+
+func (s *GetFriendsLeaderboardSuite) TestExecute_WhenFriendsLookupFails_ReturnsError() {
+	s.friendshipRepo.EXPECT().FindFriendIds(mock.Anything, "user-uuid").Return(nil, errMock)
+
+	_, err := s.uc.Execute(context.Background(), GetFriendsLeaderboardInput{BoardInput: weeklyBoard(), UserId: "user-uuid"})
+
+	s.ErrorIs(err, errMock)
+}
@@ -0,0 +1,90 @@
+package leaderboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+type GetLeaderboardInput struct {
+	BoardInput
+	// Page is 1-based. Zero values fall back to the first page of defaultPageSize entries.
+	Page     int
+	PageSize int
+}
+
+type EntryOutput struct {
+	Rank       int64     `json:"rank"`
+	UserId     string    `json:"user_id"`
+	Username   string    `json:"username"`
+	GameId     string    `json:"game_id"`
+	Score      int       `json:"score"`
+	AchievedAt time.Time `json:"achieved_at"`
+}
+
+type GetLeaderboardOutput struct {
+	PeriodStart time.Time     `json:"period_start"`
+	Entries     []EntryOutput `json:"entries"`
+	Total       int64         `json:"total"`
+	Page        int           `json:"page"`
+	PageSize    int           `json:"page_size"`
+}
+
+type GetLeaderboardUseCase struct {
+	leaderboardRepository repositories.LeaderboardRepository
+}
+
+func NewGetLeaderboardUseCase(leaderboardRepository repositories.LeaderboardRepository) *GetLeaderboardUseCase {
+	return &GetLeaderboardUseCase{leaderboardRepository: leaderboardRepository}
+}
+
+func (uc *GetLeaderboardUseCase) Execute(ctx context.Context, input GetLeaderboardInput) (GetLeaderboardOutput, error) {
+	key, err := input.key(time.Now())
+	if err != nil {
+		return GetLeaderboardOutput{}, err
+	}
+
+	page := max(input.Page, 1)
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+	offset := (page - 1) * pageSize
+
+	entries, err := uc.leaderboardRepository.FindTop(ctx, key, offset, pageSize)
+	if err != nil {
+		return GetLeaderboardOutput{}, err
+	}
+	total, err := uc.leaderboardRepository.CountByKey(ctx, key)
+	if err != nil {
+		return GetLeaderboardOutput{}, err
+	}
+
+	output := GetLeaderboardOutput{
+		PeriodStart: key.PeriodStart,
+		Entries:     make([]EntryOutput, len(entries)),
+		Total:       total,
+		Page:        page,
+		PageSize:    pageSize,
+	}
+	for i, entry := range entries {
+		output.Entries[i] = EntryOutput{
+			Rank:       int64(offset + i + 1),
+			UserId:     entry.UserId,
+			GameId:     entry.GameId,
+			Score:      entry.Score,
+			AchievedAt: entry.AchievedAt,
+		}
+		if entry.User != nil {
+			output.Entries[i].Username = entry.User.Username
+		}
+	}
+	return output, nil
+}
@@ -0,0 +1,93 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var errMock = errors.New("mock error")
+
+func weeklyBoard() BoardInput {
+	return BoardInput{
+		MapId:          "map-uuid",
+		Mode:           entities.SinglePlayerGameModeMove,
+		DurationBucket: entities.LeaderboardDurationRapid,
+		Period:         entities.LeaderboardPeriodWeekly,
+	}
+}
+
+func matchesWeeklyBoard(key entities.LeaderboardKey) bool {
+	return key.MapId == "map-uuid" && key.Period == entities.LeaderboardPeriodWeekly &&
+		key.PeriodStart.Equal(entities.LeaderboardPeriodWeekly.Start(time.Now()))
+}
+
+type GetLeaderboardSuite struct {
+	suite.Suite
+	repo *repomocks.MockLeaderboardRepository
+	uc   *GetLeaderboardUseCase
+}
+
+func TestGetLeaderboardSuite(t *testing.T) {
+	suite.Run(t, new(GetLeaderboardSuite))
+}
+
+func (s *GetLeaderboardSuite) SetupTest() {
+	s.repo = repomocks.NewMockLeaderboardRepository(s.T())
+	s.uc = NewGetLeaderboardUseCase(s.repo)
+}
+
+func (s *GetLeaderboardSuite) TestExecute_RanksFollowPageOffset() {
+	entries := []*entities.LeaderboardEntry{
+		{UserId: "u1", GameId: "g1", Score: 24000, User: entities.RestoreUser("u1", "One", "one@example.com", "one", "hash")},
+		{UserId: "u2", GameId: "g2", Score: 23000},
+	}
+	s.repo.EXPECT().FindTop(mock.Anything, mock.MatchedBy(matchesWeeklyBoard), 10, 10).Return(entries, nil)
+	s.repo.EXPECT().CountByKey(mock.Anything, mock.MatchedBy(matchesWeeklyBoard)).Return(int64(12), nil)
+
+	output, err := s.uc.Execute(context.Background(), GetLeaderboardInput{BoardInput: weeklyBoard(), Page: 2, PageSize: 10})
+
+	s.Require().NoError(err)
+	s.Equal(int64(12), output.Total)
+	s.Require().Len(output.Entries, 2)
+	s.Equal(int64(11), output.Entries[0].Rank)
+	s.Equal("one", output.Entries[0].Username)
+	s.Equal(int64(12), output.Entries[1].Rank)
+	s.Empty(output.Entries[1].Username)
+}
+
+func (s *GetLeaderboardSuite) TestExecute_ClampsPageSize() {
+	s.repo.EXPECT().FindTop(mock.Anything, mock.Anything, 0, maxPageSize).Return(nil, nil)
+	s.repo.EXPECT().CountByKey(mock.Anything, mock.Anything).Return(int64(0), nil)
+
+	output, err := s.uc.Execute(context.Background(), GetLeaderboardInput{BoardInput: weeklyBoard(), PageSize: 1000})
+
+	s.Require().NoError(err)
+	s.Equal(1, output.Page)
+	s.Equal(maxPageSize, output.PageSize)
+	s.Empty(output.Entries)
+}
+
+func (s *GetLeaderboardSuite) TestExecute_WhenBoardInvalid_ReturnsBadRequest() {
+	board := weeklyBoard()
+	board.Period = "daily"
+
+	_, err := s.uc.Execute(context.Background(), GetLeaderboardInput{BoardInput: board})
+
+	s.Require().Error(err)
+	s.Equal("invalid period", err.Error())
+}
+
+func (s *GetLeaderboardSuite) TestExecute_WhenRepositoryFails_ReturnsError() {
+	s.repo.EXPECT().FindTop(mock.Anything, mock.Anything, 0, defaultPageSize).Return(nil, errMock)
+
+	_, err := s.uc.Execute(context.Background(), GetLeaderboardInput{BoardInput: weeklyBoard()})
+
+	s.ErrorIs(err, errMock)
+}
@@ -0,0 +1,63 @@
+package leaderboard
+
+import (
+	"context"
+	"time"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type GetMyRankInput struct {
+	BoardInput
+	UserId string
+}
+
+type GetMyRankOutput struct {
+	PeriodStart time.Time `json:"period_start"`
+	Rank        int64     `json:"rank"`
+	Total       int64     `json:"total"`
+	GameId      string    `json:"game_id"`
+	Score       int       `json:"score"`
+	AchievedAt  time.Time `json:"achieved_at"`
+}
+
+type GetMyRankUseCase struct {
+	leaderboardRepository repositories.LeaderboardRepository
+}
+
+func NewGetMyRankUseCase(leaderboardRepository repositories.LeaderboardRepository) *GetMyRankUseCase {
+	return &GetMyRankUseCase{leaderboardRepository: leaderboardRepository}
+}
+
+func (uc *GetMyRankUseCase) Execute(ctx context.Context, input GetMyRankInput) (GetMyRankOutput, error) {
+	key, err := input.key(time.Now())
+	if err != nil {
+		return GetMyRankOutput{}, err
+	}
+
+	entry, err := uc.leaderboardRepository.FindByKeyAndUserId(ctx, key, input.UserId)
+	if err != nil {
+		return GetMyRankOutput{}, err
+	}
+	if entry == nil {
+		return GetMyRankOutput{}, coreerrors.NotFound("no ranked game on this leaderboard")
+	}
+	ahead, err := uc.leaderboardRepository.CountAhead(ctx, entry)
+	if err != nil {
+		return GetMyRankOutput{}, err
+	}
+	total, err := uc.leaderboardRepository.CountByKey(ctx, key)
+	if err != nil {
+		return GetMyRankOutput{}, err
+	}
+
+	return GetMyRankOutput{
+		PeriodStart: key.PeriodStart,
+		Rank:        ahead + 1,
+		Total:       total,
+		GameId:      entry.GameId,
+		Score:       entry.Score,
+		AchievedAt:  entry.AchievedAt,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetMyRankSuite struct {
+	suite.Suite
+	repo *repomocks.MockLeaderboardRepository
+	uc   *GetMyRankUseCase
+}
+
+func TestGetMyRankSuite(t *testing.T) {
+	suite.Run(t, new(GetMyRankSuite))
+}
+
+func (s *GetMyRankSuite) SetupTest() {
+	s.repo = repomocks.NewMockLeaderboardRepository(s.T())
+	s.uc = NewGetMyRankUseCase(s.repo)
+}
+
+func (s *GetMyRankSuite) TestExecute_ReturnsRankFromEntriesAhead() {
+	entry := &entities.LeaderboardEntry{UserId: "user-uuid", GameId: "game-uuid", Score: 20000}
+	s.repo.EXPECT().FindByKeyAndUserId(mock.Anything, mock.MatchedBy(matchesWeeklyBoard), "user-uuid").Return(entry, nil)
+	s.repo.EXPECT().CountAhead(mock.Anything, entry).Return(int64(41), nil)
+	s.repo.EXPECT().CountByKey(mock.Anything, mock.MatchedBy(matchesWeeklyBoard)).Return(int64(300), nil)
+
+	output, err := s.uc.Execute(context.Background(), GetMyRankInput{BoardInput: weeklyBoard(), UserId: "user-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal(int64(42), output.Rank)
+	s.Equal(int64(300), output.Total)
+	s.Equal(20000, output.Score)
+}
+
+func (s *GetMyRankSuite) TestExecute_WhenUnranked_ReturnsNotFound() {
+	s.repo.EXPECT().FindByKeyAndUserId(mock.Anything, mock.Anything, "user-uuid").Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), GetMyRankInput{BoardInput: weeklyBoard(), UserId: "user-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("no ranked game on this leaderboard", err.Error())
+}
@@ -0,0 +1,148 @@
+package mapuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type MapInvitationInput struct {
+	MapId string
+	// UserId is the invited user.
+	UserId string
+}
+
+type MapInvitationOutput struct {
+	MapId             string           `json:"map_id"`
+	MapName           string           `json:"map_name"`
+	UserId            string           `json:"user_id"`
+	Role              entities.MapRole `json:"role"`
+	InvitedById       string           `json:"invited_by_id"`
+	InvitedByUsername string           `json:"invited_by_username,omitempty"`
+	CreatedAt         time.Time        `json:"created_at"`
+}
+
+func toMapInvitationOutput(invitation *entities.MapInvitation) MapInvitationOutput {
+	output := MapInvitationOutput{
+		MapId:       invitation.MapId,
+		UserId:      invitation.UserId,
+		Role:        invitation.Role,
+		InvitedById: invitation.InvitedById,
+		CreatedAt:   invitation.CreatedAt,
+	}
+	if invitation.Map != nil {
+		output.MapName = invitation.Map.Name
+	}
+	if invitation.InvitedBy != nil {
+		output.InvitedByUsername = invitation.InvitedBy.Username
+	}
+	return output
+}
+
+type AcceptMapInvitationUseCase struct {
+	mapRepository          repositories.MapRepository
+	userRepository         repositories.UserRepository
+	collaboratorRepository repositories.MapCollaboratorRepository
+	invitationRepository   repositories.MapInvitationRepository
+	txManager              transactions.TransactionManager
+	notifier               services.Notifier
+}
+
+func NewAcceptMapInvitationUseCase(
+	mapRepository repositories.MapRepository,
+	userRepository repositories.UserRepository,
+	collaboratorRepository repositories.MapCollaboratorRepository,
+	invitationRepository repositories.MapInvitationRepository,
+	txManager transactions.TransactionManager,
+	notifier services.Notifier,
+) *AcceptMapInvitationUseCase {
+	return &AcceptMapInvitationUseCase{
+		mapRepository:          mapRepository,
+		userRepository:         userRepository,
+		collaboratorRepository: collaboratorRepository,
+		invitationRepository:   invitationRepository,
+		txManager:              txManager,
+		notifier:               notifier,
+	}
+}
+
+// Execute gives the user the role they were invited to, or the map itself. The previous owner of a
+// transferred map stays on as an editor, which the new owner can revoke, unless their account was
+// erased; the invitations they sent lapse.
+func (uc *AcceptMapInvitationUseCase) Execute(ctx context.Context, input MapInvitationInput) (MapOutput, error) {
+	var m *entities.Map
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		invitation, err := findPendingInvitation(ctx, uc.invitationRepository, input)
+		if err != nil {
+			return err
+		}
+		m, err = uc.mapRepository.FindById(ctx, invitation.MapId)
+		if err != nil {
+			return err
+		}
+		if m == nil || !invitation.IsValidFor(m) {
+			return coreerrors.NotFound("invitation not found")
+		}
+		if invitation.IsOwnershipTransfer() {
+			return uc.takeOwnership(ctx, m, invitation)
+		}
+		if err := uc.collaboratorRepository.Save(ctx, entities.NewMapCollaborator(m.ID, invitation.UserId, invitation.Role, invitation.InvitedById)); err != nil {
+			return err
+		}
+		if err := uc.invitationRepository.Delete(ctx, m.ID, invitation.UserId); err != nil {
+			return err
+		}
+		return uc.notifier.Notify(ctx, entities.NewNotification(invitation.InvitedById, entities.NotificationTypeMapCollaboratorAdded, invitation.UserId, m.ID))
+	})
+	if err != nil {
+		return MapOutput{}, err
+	}
+	return toMapOutput(m), nil
+}
+
+func (uc *AcceptMapInvitationUseCase) takeOwnership(ctx context.Context, m *entities.Map, invitation *entities.MapInvitation) error {
+	existing, err := uc.mapRepository.FindByOwnerIdAndName(ctx, invitation.UserId, m.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return coreerrors.Conflict("you already have a map with this name")
+	}
+	previousOwner, err := uc.userRepository.FindById(ctx, m.OwnerId)
+	if err != nil {
+		return err
+	}
+	if err := uc.collaboratorRepository.Delete(ctx, m.ID, invitation.UserId); err != nil {
+		return err
+	}
+	m.OwnerId = invitation.UserId
+	if err := uc.mapRepository.Update(ctx, m); err != nil {
+		return err
+	}
+	if err := uc.invitationRepository.DeleteByMapId(ctx, m.ID); err != nil {
+		return err
+	}
+	if previousOwner == nil {
+		return nil
+	}
+	if err := uc.collaboratorRepository.Save(ctx, entities.NewMapCollaborator(m.ID, previousOwner.ID, entities.MapRoleEditor, invitation.UserId)); err != nil {
+		return err
+	}
+	return uc.notifier.Notify(ctx, entities.NewNotification(previousOwner.ID, entities.NotificationTypeMapOwnershipTransferred, invitation.UserId, m.ID))
+}
+
+func findPendingInvitation(ctx context.Context, invitationRepository repositories.MapInvitationRepository, input MapInvitationInput) (*entities.MapInvitation, error) {
+	invitation, err := invitationRepository.FindByMapIdAndUserId(ctx, input.MapId, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, coreerrors.NotFound("invitation not found")
+	}
+	return invitation, nil
+}
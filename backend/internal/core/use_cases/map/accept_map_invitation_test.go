@@ -0,0 +1,134 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AcceptMapInvitationSuite struct {
+	suite.Suite
+	mapRepo    *repomocks.MockMapRepository
+	userRepo   *repomocks.MockUserRepository
+	collabRepo *repomocks.MockMapCollaboratorRepository
+	inviteRepo *repomocks.MockMapInvitationRepository
+	notifier   *servicemocks.MockNotifier
+	uc         *AcceptMapInvitationUseCase
+	m          *entities.Map
+}
+
+func TestAcceptMapInvitationSuite(t *testing.T) {
+	suite.Run(t, new(AcceptMapInvitationSuite))
+}
+
+func (s *AcceptMapInvitationSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.inviteRepo = repomocks.NewMockMapInvitationRepository(s.T())
+	s.notifier = servicemocks.NewMockNotifier(s.T())
+	txManager := txmocks.NewMockTransactionManager(s.T())
+	passThroughTx(txManager)
+	s.uc = NewAcceptMapInvitationUseCase(s.mapRepo, s.userRepo, s.collabRepo, s.inviteRepo, txManager, s.notifier)
+	s.m = entities.RestoreMap("map-uuid", "Brazil", "desc", "owner-uuid")
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil).Maybe()
+}
+
+func (s *AcceptMapInvitationSuite) TestExecute_AddsCollaboratorAndNotifiesOwner() {
+	s.inviteRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").
+		Return(entities.NewMapInvitation("map-uuid", "bob-uuid", entities.MapRoleEditor, "owner-uuid"), nil)
+	s.collabRepo.EXPECT().
+		Save(mock.Anything, mock.MatchedBy(func(c *entities.MapCollaborator) bool {
+			return c.UserId == "bob-uuid" && c.Role == entities.MapRoleEditor && c.InvitedById == "owner-uuid"
+		})).
+		Return(nil)
+	s.inviteRepo.EXPECT().Delete(mock.Anything, "map-uuid", "bob-uuid").Return(nil)
+	s.notifier.EXPECT().
+		Notify(mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == "owner-uuid" && n.Type == entities.NotificationTypeMapCollaboratorAdded && n.SubjectId == "map-uuid"
+		})).
+		Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), MapInvitationInput{MapId: "map-uuid", UserId: "bob-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal("owner-uuid", output.OwnerId)
+}
+
+func (s *AcceptMapInvitationSuite) TestExecute_TransfersAndKeepsPreviousOwnerAsEditor() {
+	s.inviteRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").
+		Return(entities.NewMapInvitation("map-uuid", "bob-uuid", entities.MapRoleOwner, "owner-uuid"), nil)
+	s.mapRepo.EXPECT().FindByOwnerIdAndName(mock.Anything, "bob-uuid", "Brazil").Return(nil, nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "owner-uuid").Return(entities.RestoreUser("owner-uuid", "Owner", "owner@example.com", "owner", "hash"), nil)
+	s.collabRepo.EXPECT().Delete(mock.Anything, "map-uuid", "bob-uuid").Return(nil)
+	s.mapRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(m *entities.Map) bool { return m.OwnerId == "bob-uuid" })).
+		Return(nil)
+	s.collabRepo.EXPECT().
+		Save(mock.Anything, mock.MatchedBy(func(c *entities.MapCollaborator) bool {
+			return c.UserId == "owner-uuid" && c.Role == entities.MapRoleEditor
+		})).
+		Return(nil)
+	s.inviteRepo.EXPECT().DeleteByMapId(mock.Anything, "map-uuid").Return(nil)
+	s.notifier.EXPECT().
+		Notify(mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == "owner-uuid" && n.Type == entities.NotificationTypeMapOwnershipTransferred && n.SubjectId == "map-uuid"
+		})).
+		Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), MapInvitationInput{MapId: "map-uuid", UserId: "bob-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal("bob-uuid", output.OwnerId)
+}
+
+func (s *AcceptMapInvitationSuite) TestExecute_WhenPreviousOwnerErased_TransfersWithoutKeepingThem() {
+	s.inviteRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").
+		Return(entities.NewMapInvitation("map-uuid", "bob-uuid", entities.MapRoleOwner, "owner-uuid"), nil)
+	s.mapRepo.EXPECT().FindByOwnerIdAndName(mock.Anything, "bob-uuid", "Brazil").Return(nil, nil)
+	s.userRepo.EXPECT().FindById(mock.Anything, "owner-uuid").Return(nil, nil)
+	s.collabRepo.EXPECT().Delete(mock.Anything, "map-uuid", "bob-uuid").Return(nil)
+	s.mapRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+	s.inviteRepo.EXPECT().DeleteByMapId(mock.Anything, "map-uuid").Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), MapInvitationInput{MapId: "map-uuid", UserId: "bob-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal("bob-uuid", output.OwnerId)
+}
+
+func (s *AcceptMapInvitationSuite) TestExecute_WhenRecipientHasSameName_ReturnsConflict() {
+	s.inviteRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").
+		Return(entities.NewMapInvitation("map-uuid", "bob-uuid", entities.MapRoleOwner, "owner-uuid"), nil)
+	s.mapRepo.EXPECT().FindByOwnerIdAndName(mock.Anything, "bob-uuid", "Brazil").Return(entities.RestoreMap("bob-map", "Brazil", "", "bob-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), MapInvitationInput{MapId: "map-uuid", UserId: "bob-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("you already have a map with this name", err.Error())
+}
+
+func (s *AcceptMapInvitationSuite) TestExecute_WhenInviterNoLongerOwnsTheMap_ReturnsNotFound() {
+	s.inviteRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").
+		Return(entities.NewMapInvitation("map-uuid", "bob-uuid", entities.MapRoleEditor, "former-owner-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), MapInvitationInput{MapId: "map-uuid", UserId: "bob-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("invitation not found", err.Error())
+}
+
+func (s *AcceptMapInvitationSuite) TestExecute_WhenNotInvited_ReturnsNotFound() {
+	s.inviteRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), MapInvitationInput{MapId: "map-uuid", UserId: "bob-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("invitation not found", err.Error())
+}
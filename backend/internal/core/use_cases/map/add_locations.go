@@ -0,0 +1,95 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type AddLocationsInput struct {
+	MapId     string
+	UserId    string
+	Locations []LocationInput
+}
+
+type AddLocationsOutput struct {
+	Locations []LocationOutput `json:"locations"`
+	// Warnings are the lint results of the locations that were added with warnings.
+	Warnings []services.LocationLintResult `json:"warnings"`
+}
+
+type AddLocationsUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	revisionRepository repositories.MapRevisionRepository
+	mapAuthorization   *services.MapAuthorizationService
+	locationLinter     *services.LocationLinter
+	txManager          transactions.TransactionManager
+}
+
+func NewAddLocationsUseCase(
+	mapRepository repositories.MapRepository,
+	locationRepository repositories.LocationRepository,
+	revisionRepository repositories.MapRevisionRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	locationLinter *services.LocationLinter,
+	txManager transactions.TransactionManager,
+) *AddLocationsUseCase {
+	return &AddLocationsUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		revisionRepository: revisionRepository,
+		mapAuthorization:   mapAuthorization,
+		locationLinter:     locationLinter,
+		txManager:          txManager,
+	}
+}
+
+// Execute adds the locations once they pass the lint against the map's current locations. Lint
+// warnings do not stop the locations from being added and are returned with them.
+func (uc *AddLocationsUseCase) Execute(ctx context.Context, input AddLocationsInput) (AddLocationsOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleEditor)
+	if err != nil {
+		return AddLocationsOutput{}, err
+	}
+
+	var locations []*entities.Location
+	var report services.LocationLintReport
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		revision, err := beginRevision(ctx, uc.mapRepository, m.ID, input.UserId)
+		if err != nil {
+			return err
+		}
+		count, err := uc.locationRepository.CountByMapId(ctx, m.ID)
+		if err != nil {
+			return err
+		}
+		if count+int64(len(input.Locations)) > maxLocationsPerMap {
+			return coreerrors.BadRequest("map cannot have more than 50 locations")
+		}
+		existing, err := uc.locationRepository.FindByMapId(ctx, m.ID)
+		if err != nil {
+			return err
+		}
+		report = uc.locationLinter.WithBoundary(m.Boundary).Lint(newLocations(m.ID, input.Locations), existing)
+		if err := report.Err(); err != nil {
+			return err
+		}
+		locations, err = createLocations(ctx, uc.locationRepository, m.ID, input.Locations)
+		if err != nil {
+			return err
+		}
+		for _, l := range locations {
+			revision.Changes = append(revision.Changes, entities.NewLocationAddedChange(l))
+		}
+		return uc.revisionRepository.Create(ctx, revision)
+	})
+	if err != nil {
+		return AddLocationsOutput{}, err
+	}
+	return AddLocationsOutput{Locations: toLocationOutputs(locations), Warnings: report.Locations}, nil
+}
@@ -0,0 +1,119 @@
+package mapuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type AddMapCollaboratorInput struct {
+	MapId    string
+	UserId   string
+	Username string
+	Role     entities.MapRole
+}
+
+type CollaboratorOutput struct {
+	UserId    string           `json:"user_id"`
+	Username  string           `json:"username"`
+	Role      entities.MapRole `json:"role"`
+	CreatedAt time.Time        `json:"created_at"`
+	// Pending is set when the user was invited and has yet to accept.
+	Pending bool `json:"pending,omitempty"`
+}
+
+type AddMapCollaboratorUseCase struct {
+	mapRepository          repositories.MapRepository
+	userRepository         repositories.UserRepository
+	collaboratorRepository repositories.MapCollaboratorRepository
+	invitationRepository   repositories.MapInvitationRepository
+	mapAuthorization       *services.MapAuthorizationService
+	txManager              transactions.TransactionManager
+	notifier               services.Notifier
+}
+
+func NewAddMapCollaboratorUseCase(
+	mapRepository repositories.MapRepository,
+	userRepository repositories.UserRepository,
+	collaboratorRepository repositories.MapCollaboratorRepository,
+	invitationRepository repositories.MapInvitationRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	txManager transactions.TransactionManager,
+	notifier services.Notifier,
+) *AddMapCollaboratorUseCase {
+	return &AddMapCollaboratorUseCase{
+		mapRepository:          mapRepository,
+		userRepository:         userRepository,
+		collaboratorRepository: collaboratorRepository,
+		invitationRepository:   invitationRepository,
+		mapAuthorization:       mapAuthorization,
+		txManager:              txManager,
+		notifier:               notifier,
+	}
+}
+
+// Execute changes the role of the user with Username if they already collaborate on the map, and
+// otherwise invites them: they only get the role once they accept the invitation.
+func (uc *AddMapCollaboratorUseCase) Execute(ctx context.Context, input AddMapCollaboratorInput) (CollaboratorOutput, error) {
+	if _, ok := entities.ParseMapCollaboratorRole(string(input.Role)); !ok {
+		return CollaboratorOutput{}, coreerrors.BadRequest("invalid role")
+	}
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleOwner)
+	if err != nil {
+		return CollaboratorOutput{}, err
+	}
+	target, err := uc.userRepository.FindByUsername(ctx, input.Username)
+	if err != nil {
+		return CollaboratorOutput{}, err
+	}
+	if target == nil || target.IsBanned() {
+		return CollaboratorOutput{}, coreerrors.NotFound("user not found")
+	}
+	if m.IsOwnedBy(target.ID) {
+		return CollaboratorOutput{}, coreerrors.BadRequest("the owner cannot be a collaborator")
+	}
+
+	existing, err := uc.collaboratorRepository.FindByMapIdAndUserId(ctx, m.ID, target.ID)
+	if err != nil {
+		return CollaboratorOutput{}, err
+	}
+	if existing == nil {
+		return uc.invite(ctx, m, target, input)
+	}
+	collaborator := entities.NewMapCollaborator(m.ID, target.ID, input.Role, input.UserId)
+	collaborator.CreatedAt = existing.CreatedAt
+	if err := uc.collaboratorRepository.Save(ctx, collaborator); err != nil {
+		return CollaboratorOutput{}, err
+	}
+	return CollaboratorOutput{
+		UserId:    target.ID,
+		Username:  target.Username,
+		Role:      collaborator.Role,
+		CreatedAt: collaborator.CreatedAt,
+	}, nil
+}
+
+func (uc *AddMapCollaboratorUseCase) invite(ctx context.Context, m *entities.Map, target *entities.User, input AddMapCollaboratorInput) (CollaboratorOutput, error) {
+	invitation := entities.NewMapInvitation(m.ID, target.ID, input.Role, input.UserId)
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.invitationRepository.Save(ctx, invitation); err != nil {
+			return err
+		}
+		return uc.notifier.Notify(ctx, entities.NewNotification(target.ID, entities.NotificationTypeMapInvitation, input.UserId, m.ID))
+	})
+	if err != nil {
+		return CollaboratorOutput{}, err
+	}
+	return CollaboratorOutput{
+		UserId:    target.ID,
+		Username:  target.Username,
+		Role:      invitation.Role,
+		CreatedAt: invitation.CreatedAt,
+		Pending:   true,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AddMapCollaboratorSuite struct {
+	suite.Suite
+	mapRepo    *repomocks.MockMapRepository
+	userRepo   *repomocks.MockUserRepository
+	collabRepo *repomocks.MockMapCollaboratorRepository
+	inviteRepo *repomocks.MockMapInvitationRepository
+	txManager  *txmocks.MockTransactionManager
+	notifier   *servicemocks.MockNotifier
+	uc         *AddMapCollaboratorUseCase
+}
+
+func TestAddMapCollaboratorSuite(t *testing.T) {
+	suite.Run(t, new(AddMapCollaboratorSuite))
+}
+
+func (s *AddMapCollaboratorSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.inviteRepo = repomocks.NewMockMapInvitationRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.notifier = servicemocks.NewMockNotifier(s.T())
+	s.uc = NewAddMapCollaboratorUseCase(s.mapRepo, s.userRepo, s.collabRepo, s.inviteRepo, services.NewMapAuthorizationService(s.collabRepo), s.txManager, s.notifier)
+	m := entities.NewMap("Brazil", "desc", "owner-uuid")
+	m.ID = "map-uuid"
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(m, nil).Maybe()
+	s.userRepo.EXPECT().FindByUsername(mock.Anything, "bob").Return(entities.RestoreUser("bob-uuid", "Bob", "bob@example.com", "bob", "hash"), nil).Maybe()
+}
+
+func (s *AddMapCollaboratorSuite) TestExecute_InvitesAndNotifiesNewCollaborator() {
+	passThroughTx(s.txManager)
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").Return(nil, nil)
+	s.inviteRepo.EXPECT().
+		Save(mock.Anything, mock.MatchedBy(func(i *entities.MapInvitation) bool {
+			return i.UserId == "bob-uuid" && i.Role == entities.MapRoleEditor && i.InvitedById == "owner-uuid"
+		})).
+		Return(nil)
+	s.notifier.EXPECT().
+		Notify(mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == "bob-uuid" && n.Type == entities.NotificationTypeMapInvitation && n.SubjectId == "map-uuid"
+		})).
+		Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), AddMapCollaboratorInput{MapId: "map-uuid", UserId: "owner-uuid", Username: "bob", Role: entities.MapRoleEditor})
+
+	s.Require().NoError(err)
+	s.Equal("bob", output.Username)
+	s.Equal(entities.MapRoleEditor, output.Role)
+	s.True(output.Pending)
+}
+
+func (s *AddMapCollaboratorSuite) TestExecute_ChangingRoleDoesNotNotify() {
+	existing := entities.NewMapCollaborator("map-uuid", "bob-uuid", entities.MapRoleViewer, "owner-uuid")
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "bob-uuid").Return(existing, nil)
+	s.collabRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), AddMapCollaboratorInput{MapId: "map-uuid", UserId: "owner-uuid", Username: "bob", Role: entities.MapRoleEditor})
+
+	s.Require().NoError(err)
+	s.Equal(entities.MapRoleEditor, output.Role)
+	s.Equal(existing.CreatedAt, output.CreatedAt)
+	s.False(output.Pending)
+}
+
+func (s *AddMapCollaboratorSuite) TestExecute_OwnerRole_ReturnsBadRequest() {
+	_, err := s.uc.Execute(context.Background(), AddMapCollaboratorInput{MapId: "map-uuid", UserId: "owner-uuid", Username: "bob", Role: entities.MapRoleOwner})
+
+	s.Require().Error(err)
+	s.Equal("invalid role", err.Error())
+}
@@ -0,0 +1,50 @@
+package mapuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type ChangeMapVisibilityInput struct {
+	MapId      string
+	UserId     string
+	Visibility entities.MapVisibility
+}
+
+type ChangeMapVisibilityUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	mapAuthorization   *services.MapAuthorizationService
+}
+
+func NewChangeMapVisibilityUseCase(mapRepository repositories.MapRepository, locationRepository repositories.LocationRepository, mapAuthorization *services.MapAuthorizationService) *ChangeMapVisibilityUseCase {
+	return &ChangeMapVisibilityUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		mapAuthorization:   mapAuthorization,
+	}
+}
+
+// Execute changes the visibility of a map of the user. Making it public publishes it, which needs
+// entities.MinPublicMapLocations locations.
+func (uc *ChangeMapVisibilityUseCase) Execute(ctx context.Context, input ChangeMapVisibilityInput) (MapOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleOwner)
+	if err != nil {
+		return MapOutput{}, err
+	}
+	count, err := uc.locationRepository.CountByMapId(ctx, m.ID)
+	if err != nil {
+		return MapOutput{}, err
+	}
+	if err := m.ChangeVisibility(input.Visibility, count, time.Now()); err != nil {
+		return MapOutput{}, err
+	}
+	if err := uc.mapRepository.Update(ctx, m); err != nil {
+		return MapOutput{}, err
+	}
+	return toMapOutput(m), nil
+}
@@ -0,0 +1,83 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangeMapVisibilitySuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	collabRepo   *repomocks.MockMapCollaboratorRepository
+	uc           *ChangeMapVisibilityUseCase
+	m            *entities.Map
+}
+
+func TestChangeMapVisibilitySuite(t *testing.T) {
+	suite.Run(t, new(ChangeMapVisibilitySuite))
+}
+
+func (s *ChangeMapVisibilitySuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.uc = NewChangeMapVisibilityUseCase(s.mapRepo, s.locationRepo, services.NewMapAuthorizationService(s.collabRepo))
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil).Maybe()
+}
+
+func (s *ChangeMapVisibilitySuite) TestExecute_PublishesMap() {
+	s.locationRepo.EXPECT().CountByMapId(mock.Anything, "map-uuid").Return(int64(entities.MinPublicMapLocations), nil)
+	s.mapRepo.EXPECT().Update(mock.Anything, s.m).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), ChangeMapVisibilityInput{MapId: "map-uuid", UserId: "owner-uuid", Visibility: entities.MapVisibilityPublic})
+
+	s.Require().NoError(err)
+	s.Equal(entities.MapVisibilityPublic, output.Visibility)
+	s.NotNil(output.PublishedAt)
+}
+
+func (s *ChangeMapVisibilitySuite) TestExecute_WhenTooFewLocations_ReturnsBadRequest() {
+	s.locationRepo.EXPECT().CountByMapId(mock.Anything, "map-uuid").Return(int64(2), nil)
+
+	_, err := s.uc.Execute(context.Background(), ChangeMapVisibilityInput{MapId: "map-uuid", UserId: "owner-uuid", Visibility: entities.MapVisibilityPublic})
+
+	s.Require().Error(err)
+	s.Equal("map needs at least 5 locations to be published", err.Error())
+}
+
+func (s *ChangeMapVisibilitySuite) TestExecute_WhenPrivateMapOfSomeoneElse_ReturnsNotFound() {
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "other-uuid").Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), ChangeMapVisibilityInput{MapId: "map-uuid", UserId: "other-uuid", Visibility: entities.MapVisibilityUnlisted})
+
+	s.Require().Error(err)
+	s.Equal("map not found", err.Error())
+}
+
+func (s *ChangeMapVisibilitySuite) TestExecute_WhenEditor_ReturnsForbidden() {
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "editor-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "editor-uuid", entities.MapRoleEditor, "owner-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), ChangeMapVisibilityInput{MapId: "map-uuid", UserId: "editor-uuid", Visibility: entities.MapVisibilityUnlisted})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions on this map", err.Error())
+}
+
+func (s *ChangeMapVisibilitySuite) TestExecute_WhenUpdateFails_ReturnsError() {
+	s.locationRepo.EXPECT().CountByMapId(mock.Anything, "map-uuid").Return(int64(0), nil)
+	s.mapRepo.EXPECT().Update(mock.Anything, s.m).Return(errMock)
+
+	_, err := s.uc.Execute(context.Background(), ChangeMapVisibilityInput{MapId: "map-uuid", UserId: "owner-uuid", Visibility: entities.MapVisibilityUnlisted})
+
+	s.ErrorIs(err, errMock)
+}
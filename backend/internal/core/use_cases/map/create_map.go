@@ -2,11 +2,13 @@ package mapuc
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
 	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
 )
 
@@ -18,43 +20,65 @@ type LocationInput struct {
 	Longitude float64
 	Heading   float64
 	Pitch     float64
+	// CountryCode is optional; when set it feeds the per-country player stats.
+	CountryCode string
+	// Provider defaults to google. CaptureDate, Tags and Note are optional.
+	Provider    string
+	CaptureDate string
+	Tags        []string
+	Note        string
 }
 
 type LocationOutput struct {
-	ID        string  `json:"id"`
-	PanoId    string  `json:"pano_id"`
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-	Heading   float64 `json:"heading"`
-	Pitch     float64 `json:"pitch"`
+	ID          string                    `json:"id"`
+	PanoId      string                    `json:"pano_id"`
+	Latitude    float64                   `json:"latitude"`
+	Longitude   float64                   `json:"longitude"`
+	Heading     float64                   `json:"heading"`
+	Pitch       float64                   `json:"pitch"`
+	CountryCode string                    `json:"country_code,omitempty"`
+	Provider    entities.PanoramaProvider `json:"provider"`
+	CaptureDate string                    `json:"capture_date,omitempty"`
+	Tags        entities.LocationTags     `json:"tags"`
+	Note        string                    `json:"note,omitempty"`
 }
 
 type CreateMapInput struct {
 	Name        string
 	Description string
 	OwnerId     string
-	Locations   []LocationInput
+	// Category and Tags are optional.
+	Category  string
+	Tags      []string
+	Locations []LocationInput
 }
 
 type CreateMapOutput struct {
-	ID          string           `json:"id"`
-	Name        string           `json:"name"`
-	Description string           `json:"description"`
-	OwnerId     string           `json:"owner_id"`
-	Locations   []LocationOutput `json:"locations"`
-	CreatedAt   time.Time        `json:"created_at"`
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	OwnerId     string                 `json:"owner_id"`
+	Visibility  entities.MapVisibility `json:"visibility"`
+	Category    entities.MapCategory   `json:"category"`
+	Tags        entities.MapTags       `json:"tags"`
+	Locations   []LocationOutput       `json:"locations"`
+	// Warnings are the lint results of the locations that were saved with warnings.
+	Warnings  []services.LocationLintResult `json:"warnings"`
+	CreatedAt time.Time                     `json:"created_at"`
 }
 
 type CreateMapUseCase struct {
 	mapRepository      repositories.MapRepository
 	locationRepository repositories.LocationRepository
+	locationLinter     *services.LocationLinter
 	txManager          transactions.TransactionManager
 }
 
-func NewCreateMapUseCase(mapRepository repositories.MapRepository, locationRepository repositories.LocationRepository, txManager transactions.TransactionManager) *CreateMapUseCase {
+func NewCreateMapUseCase(mapRepository repositories.MapRepository, locationRepository repositories.LocationRepository, locationLinter *services.LocationLinter, txManager transactions.TransactionManager) *CreateMapUseCase {
 	return &CreateMapUseCase{
 		mapRepository:      mapRepository,
 		locationRepository: locationRepository,
+		locationLinter:     locationLinter,
 		txManager:          txManager,
 	}
 }
@@ -66,7 +90,7 @@ func (uc *CreateMapUseCase) Execute(input CreateMapInput) (CreateMapOutput, erro
 
 	ctx := context.Background()
 
-	existingMap, err := uc.mapRepository.FindByName(ctx, input.Name)
+	existingMap, err := uc.mapRepository.FindByOwnerIdAndName(ctx, input.OwnerId, input.Name)
 	if err != nil {
 		return CreateMapOutput{}, err
 	}
@@ -77,27 +101,20 @@ func (uc *CreateMapUseCase) Execute(input CreateMapInput) (CreateMapOutput, erro
 	var output CreateMapOutput
 	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
 		newMap := entities.NewMap(input.Name, input.Description, input.OwnerId)
+		if err := newMap.Classify(input.Category, input.Tags); err != nil {
+			return err
+		}
 		if err := uc.mapRepository.Create(ctx, newMap); err != nil {
 			return err
 		}
 
-		locationOutputs := make([]LocationOutput, 0, len(input.Locations))
-		for _, loc := range input.Locations {
-			location := entities.NewLocation(loc.PanoId, newMap.ID, loc.Latitude, loc.Longitude, loc.Heading, loc.Pitch)
-			if err := location.Validate(); err != nil {
-				return err
-			}
-			if err := uc.locationRepository.Create(ctx, location); err != nil {
-				return err
-			}
-			locationOutputs = append(locationOutputs, LocationOutput{
-				ID:        location.ID,
-				PanoId:    location.PanoId,
-				Latitude:  location.Latitude,
-				Longitude: location.Longitude,
-				Heading:   location.Heading,
-				Pitch:     location.Pitch,
-			})
+		report := uc.locationLinter.Lint(newLocations(newMap.ID, input.Locations), nil)
+		if err := report.Err(); err != nil {
+			return err
+		}
+		locations, err := createLocations(ctx, uc.locationRepository, newMap.ID, input.Locations)
+		if err != nil {
+			return err
 		}
 
 		output = CreateMapOutput{
@@ -105,7 +122,11 @@ func (uc *CreateMapUseCase) Execute(input CreateMapInput) (CreateMapOutput, erro
 			Name:        newMap.Name,
 			Description: newMap.Description,
 			OwnerId:     newMap.OwnerId,
-			Locations:   locationOutputs,
+			Visibility:  newMap.Visibility,
+			Category:    newMap.Category,
+			Tags:        newMap.Tags,
+			Locations:   toLocationOutputs(locations),
+			Warnings:    report.Locations,
 			CreatedAt:   newMap.CreatedAt,
 		}
 		return nil
@@ -113,3 +134,58 @@ func (uc *CreateMapUseCase) Execute(input CreateMapInput) (CreateMapOutput, erro
 
 	return output, err
 }
+
+func toLocationOutput(l *entities.Location) LocationOutput {
+	return LocationOutput{
+		ID:          l.ID,
+		PanoId:      l.PanoId,
+		Latitude:    l.Latitude,
+		Longitude:   l.Longitude,
+		Heading:     l.Heading,
+		Pitch:       l.Pitch,
+		CountryCode: l.CountryCode,
+		Provider:    l.Provider,
+		CaptureDate: l.CaptureDate,
+		Tags:        l.Tags,
+		Note:        l.Note,
+	}
+}
+
+func toLocationOutputs(locations []*entities.Location) []LocationOutput {
+	outputs := make([]LocationOutput, len(locations))
+	for i, l := range locations {
+		outputs[i] = toLocationOutput(l)
+	}
+	return outputs
+}
+
+// createLocations validates and stores locations on the map, in order.
+// newLocations builds the locations of inputs, unsaved and unvalidated.
+func newLocations(mapId string, inputs []LocationInput) []*entities.Location {
+	locations := make([]*entities.Location, len(inputs))
+	for i, loc := range inputs {
+		locations[i] = entities.NewLocation(loc.PanoId, mapId, loc.Latitude, loc.Longitude, loc.Heading, loc.Pitch)
+		locations[i].CountryCode = strings.ToUpper(loc.CountryCode)
+		if loc.Provider != "" {
+			locations[i].Provider = entities.PanoramaProvider(strings.ToLower(loc.Provider))
+		}
+		locations[i].CaptureDate = loc.CaptureDate
+		locations[i].Tags = entities.NormalizeLocationTags(loc.Tags)
+		locations[i].Note = strings.TrimSpace(loc.Note)
+	}
+	return locations
+}
+
+func createLocations(ctx context.Context, locationRepository repositories.LocationRepository, mapId string, inputs []LocationInput) ([]*entities.Location, error) {
+	locations := make([]*entities.Location, 0, len(inputs))
+	for _, location := range newLocations(mapId, inputs) {
+		if err := location.Validate(); err != nil {
+			return nil, err
+		}
+		if err := locationRepository.Create(ctx, location); err != nil {
+			return nil, err
+		}
+		locations = append(locations, location)
+	}
+	return locations, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
 	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -35,7 +36,7 @@ func (s *CreateMapSuite) TestExecute_WhenMapDoesNotExist_CreatesAndReturnsMap()
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	input := CreateMapInput{
 		Name:        "My Map",
@@ -43,14 +44,14 @@ func (s *CreateMapSuite) TestExecute_WhenMapDoesNotExist_CreatesAndReturnsMap()
 		OwnerId:     "owner-123",
 		Locations: []LocationInput{
 			{PanoId: "pano-1", Latitude: 20.0, Longitude: -100.0, Heading: 0, Pitch: 0},
-			{PanoId: "pano-2", Latitude: 21.0, Longitude: -101.0, Heading: 90, Pitch: 10},
+			{PanoId: "pano-2", Latitude: 21.0, Longitude: -101.0, Heading: 90, Pitch: 10, Provider: "Mapillary", CaptureDate: "2019-07", Tags: []string{"Snow", "coastal"}, Note: " Blue roof "},
 		},
 	}
 
 	createdAt := time.Date(2025, 2, 9, 12, 0, 0, 0, time.UTC)
 
 	mockMapRepo.EXPECT().
-		FindByName(mock.Anything, input.Name).
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
 		Return((*entities.Map)(nil), nil)
 	passThroughTx(mockTx)
 	mockMapRepo.EXPECT().
@@ -91,15 +92,20 @@ func (s *CreateMapSuite) TestExecute_WhenMapDoesNotExist_CreatesAndReturnsMap()
 	s.Equal("loc-uuid-1", output.Locations[0].ID)
 	s.Equal("pano-1", output.Locations[0].PanoId)
 	s.Equal(20.0, output.Locations[0].Latitude)
+	s.Equal(entities.PanoramaProviderGoogle, output.Locations[0].Provider)
 	s.Equal("loc-uuid-2", output.Locations[1].ID)
 	s.Equal("pano-2", output.Locations[1].PanoId)
+	s.Equal(entities.PanoramaProviderMapillary, output.Locations[1].Provider)
+	s.Equal("2019-07", output.Locations[1].CaptureDate)
+	s.Equal(entities.LocationTags{"snow", "coastal"}, output.Locations[1].Tags)
+	s.Equal("Blue roof", output.Locations[1].Note)
 }
 
 func (s *CreateMapSuite) TestExecute_WhenNoLocations_CreatesMapWithEmptyLocations() {
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	input := CreateMapInput{
 		Name:        "Empty Map",
@@ -111,7 +117,7 @@ func (s *CreateMapSuite) TestExecute_WhenNoLocations_CreatesMapWithEmptyLocation
 	createdAt := time.Date(2025, 2, 9, 12, 0, 0, 0, time.UTC)
 
 	mockMapRepo.EXPECT().
-		FindByName(mock.Anything, input.Name).
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
 		Return((*entities.Map)(nil), nil)
 	passThroughTx(mockTx)
 	mockMapRepo.EXPECT().
@@ -135,7 +141,7 @@ func (s *CreateMapSuite) TestExecute_WhenTooManyLocations_ReturnsBadRequest() {
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	locations := make([]LocationInput, 51)
 	for i := 0; i < 51; i++ {
@@ -159,7 +165,7 @@ func (s *CreateMapSuite) TestExecute_WhenMapNameAlreadyExists_ReturnsConflict()
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	input := CreateMapInput{
 		Name:        "Existing Map",
@@ -170,7 +176,7 @@ func (s *CreateMapSuite) TestExecute_WhenMapNameAlreadyExists_ReturnsConflict()
 	existingMap := entities.RestoreMap("id-1", "Existing Map", "desc", "other-owner")
 
 	mockMapRepo.EXPECT().
-		FindByName(mock.Anything, input.Name).
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
 		Return(existingMap, nil)
 
 	output, err := uc.Execute(input)
@@ -184,7 +190,7 @@ func (s *CreateMapSuite) TestExecute_WhenFindByNameFails_ReturnsError() {
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	input := CreateMapInput{
 		Name:        "Map",
@@ -195,7 +201,7 @@ func (s *CreateMapSuite) TestExecute_WhenFindByNameFails_ReturnsError() {
 	findErr := errMock
 
 	mockMapRepo.EXPECT().
-		FindByName(mock.Anything, input.Name).
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
 		Return((*entities.Map)(nil), findErr)
 
 	output, err := uc.Execute(input)
@@ -209,7 +215,7 @@ func (s *CreateMapSuite) TestExecute_WhenMapCreateFails_ReturnsError() {
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	input := CreateMapInput{
 		Name:        "Map",
@@ -220,7 +226,7 @@ func (s *CreateMapSuite) TestExecute_WhenMapCreateFails_ReturnsError() {
 	createErr := errMock
 
 	mockMapRepo.EXPECT().
-		FindByName(mock.Anything, input.Name).
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
 		Return((*entities.Map)(nil), nil)
 	passThroughTx(mockTx)
 	mockMapRepo.EXPECT().
@@ -240,7 +246,7 @@ func (s *CreateMapSuite) TestExecute_WhenLocationHasInvalidLatitude_ReturnsError
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	input := CreateMapInput{
 		Name:        "Map",
@@ -252,7 +258,7 @@ func (s *CreateMapSuite) TestExecute_WhenLocationHasInvalidLatitude_ReturnsError
 	}
 
 	mockMapRepo.EXPECT().
-		FindByName(mock.Anything, input.Name).
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
 		Return((*entities.Map)(nil), nil)
 	passThroughTx(mockTx)
 	mockMapRepo.EXPECT().
@@ -276,7 +282,7 @@ func (s *CreateMapSuite) TestExecute_WhenLocationHasInvalidLongitude_ReturnsErro
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	input := CreateMapInput{
 		Name:        "Map",
@@ -288,7 +294,7 @@ func (s *CreateMapSuite) TestExecute_WhenLocationHasInvalidLongitude_ReturnsErro
 	}
 
 	mockMapRepo.EXPECT().
-		FindByName(mock.Anything, input.Name).
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
 		Return((*entities.Map)(nil), nil)
 	passThroughTx(mockTx)
 	mockMapRepo.EXPECT().
@@ -312,7 +318,7 @@ func (s *CreateMapSuite) TestExecute_WhenLocationCreateFails_ReturnsError() {
 	mockMapRepo := repomocks.NewMockMapRepository(s.T())
 	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, mockTx)
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
 
 	input := CreateMapInput{
 		Name:        "Map",
@@ -325,7 +331,7 @@ func (s *CreateMapSuite) TestExecute_WhenLocationCreateFails_ReturnsError() {
 	createErr := errMock
 
 	mockMapRepo.EXPECT().
-		FindByName(mock.Anything, input.Name).
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
 		Return((*entities.Map)(nil), nil)
 	passThroughTx(mockTx)
 	mockMapRepo.EXPECT().
@@ -350,12 +356,45 @@ func (s *CreateMapSuite) TestExecute_WhenLocationCreateFails_ReturnsError() {
 	s.Equal(CreateMapOutput{}, output)
 }
 
+func (s *CreateMapSuite) TestExecute_WhenPanoramaGivenTwice_ReportsEveryError() {
+	mockMapRepo := repomocks.NewMockMapRepository(s.T())
+	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	uc := NewCreateMapUseCase(mockMapRepo, mockLocationRepo, defaultLocationLinter(), mockTx)
+
+	input := CreateMapInput{
+		Name:        "Map",
+		Description: "Desc",
+		OwnerId:     "owner",
+		Locations: []LocationInput{
+			{PanoId: "pano", Latitude: 20.0, Longitude: -100.0, Heading: 400},
+			{PanoId: "pano", Latitude: 21.0, Longitude: -101.0},
+		},
+	}
+
+	mockMapRepo.EXPECT().
+		FindByOwnerIdAndName(mock.Anything, input.OwnerId, input.Name).
+		Return((*entities.Map)(nil), nil)
+	passThroughTx(mockTx)
+	mockMapRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	_, err := uc.Execute(input)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "location 0 (pano): invalid heading")
+	s.Contains(err.Error(), "location 1 (pano): panorama is given more than once")
+}
+
 func (s *CreateMapSuite) TestNewCreateMapUseCase() {
 	var mapRepo repositories.MapRepository = repomocks.NewMockMapRepository(s.T())
 	var locationRepo repositories.LocationRepository = repomocks.NewMockLocationRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateMapUseCase(mapRepo, locationRepo, mockTx)
+	uc := NewCreateMapUseCase(mapRepo, locationRepo, defaultLocationLinter(), mockTx)
 	s.NotNil(uc)
 }
 
 var errMock = errors.New("mock error")
+
+func defaultLocationLinter() *services.LocationLinter {
+	return services.NewLocationLinter(services.NewGeoService(), nil, services.DefaultLocationLintConfig())
+}
@@ -0,0 +1,24 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type DeclineMapInvitationUseCase struct {
+	invitationRepository repositories.MapInvitationRepository
+}
+
+func NewDeclineMapInvitationUseCase(invitationRepository repositories.MapInvitationRepository) *DeclineMapInvitationUseCase {
+	return &DeclineMapInvitationUseCase{invitationRepository: invitationRepository}
+}
+
+// Execute deletes a pending invitation. The owner is not told; they may invite the user again later.
+func (uc *DeclineMapInvitationUseCase) Execute(ctx context.Context, input MapInvitationInput) error {
+	invitation, err := findPendingInvitation(ctx, uc.invitationRepository, input)
+	if err != nil {
+		return err
+	}
+	return uc.invitationRepository.Delete(ctx, invitation.MapId, invitation.UserId)
+}
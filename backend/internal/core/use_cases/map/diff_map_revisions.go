@@ -0,0 +1,128 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type DiffMapRevisionsInput struct {
+	MapId  string
+	UserId string
+	From   int
+	// To is nil for the latest revision.
+	To *int
+}
+
+type LocationEditOutput struct {
+	Before LocationOutput `json:"before"`
+	After  LocationOutput `json:"after"`
+}
+
+type MapRevisionDiffOutput struct {
+	From    int                  `json:"from"`
+	To      int                  `json:"to"`
+	Added   []LocationOutput     `json:"added"`
+	Removed []LocationOutput     `json:"removed"`
+	Edited  []LocationEditOutput `json:"edited"`
+}
+
+type DiffMapRevisionsUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	revisionRepository repositories.MapRevisionRepository
+	mapAuthorization   *services.MapAuthorizationService
+}
+
+func NewDiffMapRevisionsUseCase(
+	mapRepository repositories.MapRepository,
+	locationRepository repositories.LocationRepository,
+	revisionRepository repositories.MapRevisionRepository,
+	mapAuthorization *services.MapAuthorizationService,
+) *DiffMapRevisionsUseCase {
+	return &DiffMapRevisionsUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		revisionRepository: revisionRepository,
+		mapAuthorization:   mapAuthorization,
+	}
+}
+
+// Execute compares the locations of the map at two revisions. Both states are rebuilt by undoing
+// the later revisions from the current locations.
+func (uc *DiffMapRevisionsUseCase) Execute(ctx context.Context, input DiffMapRevisionsInput) (MapRevisionDiffOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleViewer)
+	if err != nil {
+		return MapRevisionDiffOutput{}, err
+	}
+	to := m.Revision
+	if input.To != nil {
+		to = *input.To
+	}
+	if !isMapRevision(m, input.From) || !isMapRevision(m, to) {
+		return MapRevisionDiffOutput{}, coreerrors.NotFound("revision not found")
+	}
+
+	locations, err := uc.locationRepository.FindByMapId(ctx, m.ID)
+	if err != nil {
+		return MapRevisionDiffOutput{}, err
+	}
+	revisions, err := uc.revisionRepository.FindAfter(ctx, m.ID, min(input.From, to))
+	if err != nil {
+		return MapRevisionDiffOutput{}, err
+	}
+	current := locationState(locations)
+	return diffLocations(
+		input.From, locationsAt(current, revisions, input.From),
+		to, locationsAt(current, revisions, to),
+	), nil
+}
+
+func isMapRevision(m *entities.Map, number int) bool {
+	return number >= 0 && number <= m.Revision
+}
+
+func diffLocations(from int, fromState map[string]entities.LocationSnapshot, to int, toState map[string]entities.LocationSnapshot) MapRevisionDiffOutput {
+	output := MapRevisionDiffOutput{
+		From:    from,
+		To:      to,
+		Added:   []LocationOutput{},
+		Removed: []LocationOutput{},
+		Edited:  []LocationEditOutput{},
+	}
+	for _, id := range sortedLocationIds(toState) {
+		after := toState[id]
+		before, ok := fromState[id]
+		switch {
+		case !ok:
+			output.Added = append(output.Added, snapshotOutput(id, after))
+		case !before.Equal(after):
+			output.Edited = append(output.Edited, LocationEditOutput{Before: snapshotOutput(id, before), After: snapshotOutput(id, after)})
+		}
+	}
+	for _, id := range sortedLocationIds(fromState) {
+		if _, ok := toState[id]; !ok {
+			output.Removed = append(output.Removed, snapshotOutput(id, fromState[id]))
+		}
+	}
+	return output
+}
+
+func snapshotOutput(id string, snapshot entities.LocationSnapshot) LocationOutput {
+	return LocationOutput{
+		ID:          id,
+		PanoId:      snapshot.PanoId,
+		Latitude:    snapshot.Latitude,
+		Longitude:   snapshot.Longitude,
+		Heading:     snapshot.Heading,
+		Pitch:       snapshot.Pitch,
+		CountryCode: snapshot.CountryCode,
+		Provider:    snapshot.Provider,
+		CaptureDate: snapshot.CaptureDate,
+		Tags:        snapshot.Tags,
+		Note:        snapshot.Note,
+	}
+}
@@ -0,0 +1,71 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiffMapRevisionsSuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	revisionRepo *repomocks.MockMapRevisionRepository
+	uc           *DiffMapRevisionsUseCase
+	m            *entities.Map
+}
+
+func TestDiffMapRevisionsSuite(t *testing.T) {
+	suite.Run(t, new(DiffMapRevisionsSuite))
+}
+
+func (s *DiffMapRevisionsSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.revisionRepo = repomocks.NewMockMapRevisionRepository(s.T())
+	collabRepo := repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.uc = NewDiffMapRevisionsUseCase(s.mapRepo, s.locationRepo, s.revisionRepo, services.NewMapAuthorizationService(collabRepo))
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.m.Revision = 2
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil)
+}
+
+func (s *DiffMapRevisionsSuite) TestExecute_ComparesTwoRevisions() {
+	removed := entities.RestoreLocation("loc-1", "pano-1", "map-uuid", 1, 1, 0, 0)
+	added := entities.RestoreLocation("loc-2", "pano-2", "map-uuid", 2, 2, 0, 0)
+	edited := entities.RestoreLocation("loc-2", "pano-2", "map-uuid", 2, 2, 45, 0)
+	first := entities.NewMapRevision("map-uuid", 1, "owner-uuid")
+	first.Changes = []*entities.MapRevisionChange{entities.NewLocationRemovedChange(removed), entities.NewLocationAddedChange(added)}
+	second := entities.NewMapRevision("map-uuid", 2, "owner-uuid")
+	second.Changes = []*entities.MapRevisionChange{entities.NewLocationEditedChange(added, edited)}
+
+	s.locationRepo.EXPECT().FindByMapId(mock.Anything, "map-uuid").Return([]*entities.Location{edited}, nil)
+	s.revisionRepo.EXPECT().FindAfter(mock.Anything, "map-uuid", 0).Return([]*entities.MapRevision{first, second}, nil)
+
+	output, err := s.uc.Execute(context.Background(), DiffMapRevisionsInput{MapId: "map-uuid", UserId: "owner-uuid", From: 0})
+
+	s.Require().NoError(err)
+	s.Equal(0, output.From)
+	s.Equal(2, output.To)
+	s.Require().Len(output.Added, 1)
+	s.Equal("loc-2", output.Added[0].ID)
+	s.Equal(float64(45), output.Added[0].Heading)
+	s.Require().Len(output.Removed, 1)
+	s.Equal("pano-1", output.Removed[0].PanoId)
+	s.Empty(output.Edited)
+}
+
+func (s *DiffMapRevisionsSuite) TestExecute_WhenRevisionIsUnknown_ReturnsNotFound() {
+	to := 5
+
+	_, err := s.uc.Execute(context.Background(), DiffMapRevisionsInput{MapId: "map-uuid", UserId: "owner-uuid", From: 0, To: &to})
+
+	s.Require().Error(err)
+	s.Equal("revision not found", err.Error())
+}
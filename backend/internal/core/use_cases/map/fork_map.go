@@ -0,0 +1,100 @@
+package mapuc
+
+import (
+	"context"
+	"strings"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+// forkBatchSize bounds the locations copied per statement when forking a map.
+const forkBatchSize = 1000
+
+type ForkMapInput struct {
+	MapId  string
+	UserId string
+	// Name defaults to the source name followed by " (fork)"; Description to the source description.
+	Name        *string
+	Description *string
+}
+
+type ForkMapOutput struct {
+	MapOutput
+	LocationCount int64 `json:"location_count"`
+}
+
+type ForkMapUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	mapAuthorization   *services.MapAuthorizationService
+	txManager          transactions.TransactionManager
+}
+
+func NewForkMapUseCase(
+	mapRepository repositories.MapRepository,
+	locationRepository repositories.LocationRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	txManager transactions.TransactionManager,
+) *ForkMapUseCase {
+	return &ForkMapUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		mapAuthorization:   mapAuthorization,
+		txManager:          txManager,
+	}
+}
+
+// Execute copies a map the caller can play, with its locations, into a new private map of the
+// caller. Locations are copied in batches within the transaction so large maps do not hold one
+// huge statement.
+func (uc *ForkMapUseCase) Execute(ctx context.Context, input ForkMapInput) (ForkMapOutput, error) {
+	source, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, "")
+	if err != nil {
+		return ForkMapOutput{}, err
+	}
+	name := source.Name + " (fork)"
+	if input.Name != nil {
+		name = strings.TrimSpace(*input.Name)
+		if name == "" {
+			return ForkMapOutput{}, coreerrors.BadRequest("name cannot be empty")
+		}
+	}
+	description := source.Description
+	if input.Description != nil {
+		description = *input.Description
+	}
+	existing, err := uc.mapRepository.FindByOwnerIdAndName(ctx, input.UserId, name)
+	if err != nil {
+		return ForkMapOutput{}, err
+	}
+	if existing != nil {
+		return ForkMapOutput{}, coreerrors.Conflict("map with this name already exists")
+	}
+
+	fork := source.Fork(name, description, input.UserId)
+	var copied int64
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.mapRepository.Create(ctx, fork); err != nil {
+			return err
+		}
+		afterId := ""
+		for {
+			count, lastId, err := uc.locationRepository.CopyBatch(ctx, source.ID, fork.ID, afterId, forkBatchSize)
+			if err != nil {
+				return err
+			}
+			copied += int64(count)
+			if count < forkBatchSize {
+				return nil
+			}
+			afterId = lastId
+		}
+	})
+	if err != nil {
+		return ForkMapOutput{}, err
+	}
+	return ForkMapOutput{MapOutput: toMapOutput(fork), LocationCount: copied}, nil
+}
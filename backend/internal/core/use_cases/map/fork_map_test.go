@@ -0,0 +1,71 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ForkMapSuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	collabRepo   *repomocks.MockMapCollaboratorRepository
+	mockTx       *txmocks.MockTransactionManager
+	uc           *ForkMapUseCase
+	source       *entities.Map
+}
+
+func TestForkMapSuite(t *testing.T) {
+	suite.Run(t, new(ForkMapSuite))
+}
+
+func (s *ForkMapSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.mockTx = txmocks.NewMockTransactionManager(s.T())
+	s.uc = NewForkMapUseCase(s.mapRepo, s.locationRepo, services.NewMapAuthorizationService(s.collabRepo), s.mockTx)
+	s.source = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.source.ID = "map-uuid"
+	s.source.Visibility = entities.MapVisibilityPublic
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.source, nil)
+}
+
+func (s *ForkMapSuite) TestExecute_CopiesLocationsInBatches() {
+	s.mapRepo.EXPECT().FindByOwnerIdAndName(mock.Anything, "user-uuid", "Brazil (fork)").Return(nil, nil)
+	passThroughTx(s.mockTx)
+	s.mapRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(m *entities.Map) bool {
+		return m.OwnerId == "user-uuid" && m.ForkedFromId != nil && *m.ForkedFromId == "map-uuid" && m.IsPrivate()
+	})).RunAndReturn(func(_ context.Context, m *entities.Map) error {
+		m.ID = "fork-uuid"
+		return nil
+	})
+	s.locationRepo.EXPECT().CopyBatch(mock.Anything, "map-uuid", "fork-uuid", "", forkBatchSize).Return(forkBatchSize, "loc-1000", nil)
+	s.locationRepo.EXPECT().CopyBatch(mock.Anything, "map-uuid", "fork-uuid", "loc-1000", forkBatchSize).Return(12, "loc-1012", nil)
+
+	output, err := s.uc.Execute(context.Background(), ForkMapInput{MapId: "map-uuid", UserId: "user-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal("fork-uuid", output.ID)
+	s.Equal("desc", output.Description)
+	s.Equal(int64(forkBatchSize+12), output.LocationCount)
+	s.Require().NotNil(output.ForkedFromId)
+	s.Equal("map-uuid", *output.ForkedFromId)
+}
+
+func (s *ForkMapSuite) TestExecute_PrivateMapOfSomeoneElse_ReturnsNotFound() {
+	s.source.Visibility = entities.MapVisibilityPrivate
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "user-uuid").Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), ForkMapInput{MapId: "map-uuid", UserId: "user-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("map not found", err.Error())
+}
@@ -0,0 +1,101 @@
+package mapuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type MapOutput struct {
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	OwnerId       string                 `json:"owner_id"`
+	OwnerUsername string                 `json:"owner_username,omitempty"`
+	Visibility    entities.MapVisibility `json:"visibility"`
+	Category      entities.MapCategory   `json:"category"`
+	Tags          entities.MapTags       `json:"tags"`
+	ForkedFromId  *string                `json:"forked_from_id"`
+	LikeCount     int64                  `json:"like_count"`
+	PlayCount     int64                  `json:"play_count"`
+	// AverageDifficulty is nil until the map is rated.
+	AverageDifficulty     *float64   `json:"average_difficulty"`
+	DifficultyRatingCount int64      `json:"difficulty_rating_count"`
+	AllowRepeatLocations  bool       `json:"allow_repeat_locations"`
+	HasBoundary           bool       `json:"has_boundary"`
+	PublishedAt           *time.Time `json:"published_at"`
+	CreatedAt             time.Time  `json:"created_at"`
+}
+
+func toMapOutput(m *entities.Map) MapOutput {
+	output := MapOutput{
+		ID:                    m.ID,
+		Name:                  m.Name,
+		Description:           m.Description,
+		OwnerId:               m.OwnerId,
+		Visibility:            m.Visibility,
+		Category:              m.Category,
+		Tags:                  m.Tags,
+		ForkedFromId:          m.ForkedFromId,
+		LikeCount:             m.LikeCount,
+		PlayCount:             m.PlayCount,
+		AverageDifficulty:     m.AverageDifficulty(),
+		DifficultyRatingCount: m.DifficultyRatingCount,
+		AllowRepeatLocations:  m.AllowRepeatLocations,
+		HasBoundary:           m.Boundary != nil,
+		PublishedAt:           m.PublishedAt,
+		CreatedAt:             m.CreatedAt,
+	}
+	if output.Tags == nil {
+		output.Tags = entities.MapTags{}
+	}
+	if m.Owner != nil {
+		output.OwnerUsername = m.Owner.Username
+	}
+	return output
+}
+
+type GetMapInput struct {
+	MapId string
+	// UserId is empty for anonymous requests.
+	UserId string
+}
+
+type GetMapOutput struct {
+	MapOutput
+	LocationCount int64 `json:"location_count"`
+	ForkCount     int64 `json:"fork_count"`
+}
+
+type GetMapUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	mapAuthorization   *services.MapAuthorizationService
+}
+
+func NewGetMapUseCase(mapRepository repositories.MapRepository, locationRepository repositories.LocationRepository, mapAuthorization *services.MapAuthorizationService) *GetMapUseCase {
+	return &GetMapUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		mapAuthorization:   mapAuthorization,
+	}
+}
+
+func (uc *GetMapUseCase) Execute(ctx context.Context, input GetMapInput) (GetMapOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, "")
+	if err != nil {
+		return GetMapOutput{}, err
+	}
+	count, err := uc.locationRepository.CountByMapId(ctx, m.ID)
+	if err != nil {
+		return GetMapOutput{}, err
+	}
+	forkCount, err := uc.mapRepository.CountForks(ctx, m.ID)
+	if err != nil {
+		return GetMapOutput{}, err
+	}
+	return GetMapOutput{MapOutput: toMapOutput(m), LocationCount: count, ForkCount: forkCount}, nil
+}
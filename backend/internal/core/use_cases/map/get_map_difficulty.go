@@ -0,0 +1,78 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type GetMapDifficultyInput struct {
+	MapId  string
+	UserId string
+}
+
+// DifficultyBucket counts the rated locations whose difficulty score is in [Min, Max).
+type DifficultyBucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Count int64   `json:"count"`
+}
+
+type GetMapDifficultyOutput struct {
+	Easy    int64 `json:"easy"`
+	Medium  int64 `json:"medium"`
+	Hard    int64 `json:"hard"`
+	Unrated int64 `json:"unrated"`
+	// Buckets split the difficulty scores of the rated locations in tenths.
+	Buckets []DifficultyBucket `json:"buckets"`
+}
+
+type GetMapDifficultyUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	mapAuthorization   *services.MapAuthorizationService
+}
+
+func NewGetMapDifficultyUseCase(mapRepository repositories.MapRepository, locationRepository repositories.LocationRepository, mapAuthorization *services.MapAuthorizationService) *GetMapDifficultyUseCase {
+	return &GetMapDifficultyUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		mapAuthorization:   mapAuthorization,
+	}
+}
+
+// Execute returns the difficulty histogram of the map to its owner.
+func (uc *GetMapDifficultyUseCase) Execute(ctx context.Context, input GetMapDifficultyInput) (GetMapDifficultyOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleOwner)
+	if err != nil {
+		return GetMapDifficultyOutput{}, err
+	}
+	counts, err := uc.locationRepository.CountDifficulties(ctx, m.ID)
+	if err != nil {
+		return GetMapDifficultyOutput{}, err
+	}
+
+	output := GetMapDifficultyOutput{Buckets: make([]DifficultyBucket, 10)}
+	for i := range output.Buckets {
+		output.Buckets[i] = DifficultyBucket{Min: float64(i) / 10, Max: float64(i+1) / 10}
+	}
+	for _, count := range counts {
+		switch count.Difficulty {
+		case entities.LocationDifficultyEasy:
+			output.Easy += count.Count
+		case entities.LocationDifficultyMedium:
+			output.Medium += count.Count
+		case entities.LocationDifficultyHard:
+			output.Hard += count.Count
+		default:
+			output.Unrated += count.Count
+			continue
+		}
+		if count.Bucket >= 0 && count.Bucket < len(output.Buckets) {
+			output.Buckets[count.Bucket].Count += count.Count
+		}
+	}
+	return output, nil
+}
@@ -0,0 +1,68 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetMapDifficultySuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	collabRepo   *repomocks.MockMapCollaboratorRepository
+	uc           *GetMapDifficultyUseCase
+}
+
+func TestGetMapDifficultySuite(t *testing.T) {
+	suite.Run(t, new(GetMapDifficultySuite))
+}
+
+func (s *GetMapDifficultySuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.uc = NewGetMapDifficultyUseCase(s.mapRepo, s.locationRepo, services.NewMapAuthorizationService(s.collabRepo))
+	m := entities.NewMap("Brazil", "desc", "owner-uuid")
+	m.ID = "map-uuid"
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(m, nil)
+}
+
+func (s *GetMapDifficultySuite) TestExecute_ReturnsTheHistogramToTheOwner() {
+	s.locationRepo.EXPECT().CountDifficulties(mock.Anything, "map-uuid").Return([]repositories.LocationDifficultyCount{
+		{Difficulty: entities.LocationDifficultyEasy, Bucket: 0, Count: 4},
+		{Difficulty: entities.LocationDifficultyEasy, Bucket: 2, Count: 1},
+		{Difficulty: entities.LocationDifficultyUnrated, Bucket: 5, Count: 30},
+		{Difficulty: entities.LocationDifficultyMedium, Bucket: 5, Count: 6},
+		{Difficulty: entities.LocationDifficultyHard, Bucket: 9, Count: 2},
+	}, nil)
+
+	output, err := s.uc.Execute(context.Background(), GetMapDifficultyInput{MapId: "map-uuid", UserId: "owner-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal(int64(5), output.Easy)
+	s.Equal(int64(6), output.Medium)
+	s.Equal(int64(2), output.Hard)
+	s.Equal(int64(30), output.Unrated)
+	s.Require().Len(output.Buckets, 10)
+	s.Equal(DifficultyBucket{Min: 0, Max: 0.1, Count: 4}, output.Buckets[0])
+	s.Equal(int64(1), output.Buckets[2].Count)
+	s.Equal(int64(6), output.Buckets[5].Count)
+	s.Equal(int64(2), output.Buckets[9].Count)
+}
+
+func (s *GetMapDifficultySuite) TestExecute_WhenUserIsAnEditor_ReturnsForbidden() {
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "editor-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "editor-uuid", entities.MapRoleEditor, "owner-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), GetMapDifficultyInput{MapId: "map-uuid", UserId: "editor-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions on this map", err.Error())
+}
@@ -0,0 +1,77 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetMapSuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	collabRepo   *repomocks.MockMapCollaboratorRepository
+	uc           *GetMapUseCase
+	m            *entities.Map
+}
+
+func TestGetMapSuite(t *testing.T) {
+	suite.Run(t, new(GetMapSuite))
+}
+
+func (s *GetMapSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.uc = NewGetMapUseCase(s.mapRepo, s.locationRepo, services.NewMapAuthorizationService(s.collabRepo))
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil).Maybe()
+	s.mapRepo.EXPECT().CountForks(mock.Anything, "map-uuid").Return(int64(2), nil).Maybe()
+}
+
+func (s *GetMapSuite) TestExecute_UnlistedMapIsVisibleToAnonymousUsers() {
+	s.m.Visibility = entities.MapVisibilityUnlisted
+	s.locationRepo.EXPECT().CountByMapId(mock.Anything, "map-uuid").Return(int64(12), nil)
+
+	output, err := s.uc.Execute(context.Background(), GetMapInput{MapId: "map-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal("Brazil", output.Name)
+	s.Equal(int64(12), output.LocationCount)
+	s.Equal(int64(2), output.ForkCount)
+}
+
+func (s *GetMapSuite) TestExecute_PrivateMapIsVisibleToItsOwner() {
+	s.locationRepo.EXPECT().CountByMapId(mock.Anything, "map-uuid").Return(int64(0), nil)
+
+	output, err := s.uc.Execute(context.Background(), GetMapInput{MapId: "map-uuid", UserId: "owner-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal(entities.MapVisibilityPrivate, output.Visibility)
+}
+
+func (s *GetMapSuite) TestExecute_PrivateMapIsVisibleToViewers() {
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "viewer-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "viewer-uuid", entities.MapRoleViewer, "owner-uuid"), nil)
+	s.locationRepo.EXPECT().CountByMapId(mock.Anything, "map-uuid").Return(int64(3), nil)
+
+	output, err := s.uc.Execute(context.Background(), GetMapInput{MapId: "map-uuid", UserId: "viewer-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal(int64(3), output.LocationCount)
+}
+
+func (s *GetMapSuite) TestExecute_PrivateMapIsHiddenFromOthers() {
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "other-uuid").Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), GetMapInput{MapId: "map-uuid", UserId: "other-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("map not found", err.Error())
+}
@@ -0,0 +1,105 @@
+package mapuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type MapFeedbackInput struct {
+	MapId  string
+	UserId string
+}
+
+type MapLikeOutput struct {
+	Liked     bool  `json:"liked"`
+	LikeCount int64 `json:"like_count"`
+}
+
+type LikeMapUseCase struct {
+	mapRepository     repositories.MapRepository
+	mapLikeRepository repositories.MapLikeRepository
+	mapAuthorization  *services.MapAuthorizationService
+	txManager         transactions.TransactionManager
+	notifier          services.Notifier
+}
+
+func NewLikeMapUseCase(
+	mapRepository repositories.MapRepository,
+	mapLikeRepository repositories.MapLikeRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	txManager transactions.TransactionManager,
+	notifier services.Notifier,
+) *LikeMapUseCase {
+	return &LikeMapUseCase{
+		mapRepository:     mapRepository,
+		mapLikeRepository: mapLikeRepository,
+		mapAuthorization:  mapAuthorization,
+		txManager:         txManager,
+		notifier:          notifier,
+	}
+}
+
+// Execute likes a map the user can play and tells its owner, unless they liked their own map.
+// Liking it again changes nothing. The notification is part of the like's transaction, so it is
+// only pushed once the like is committed.
+func (uc *LikeMapUseCase) Execute(ctx context.Context, input MapFeedbackInput) (MapLikeOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, "")
+	if err != nil {
+		return MapLikeOutput{}, err
+	}
+	output := MapLikeOutput{Liked: true, LikeCount: m.LikeCount}
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		created, err := uc.mapLikeRepository.Create(ctx, entities.NewMapLike(m.ID, input.UserId))
+		if err != nil || !created {
+			return err
+		}
+		output.LikeCount++
+		if err := uc.mapRepository.RecordActivity(ctx, m.ID, entities.MapActivity{
+			Likes:          1,
+			TrendingWeight: entities.LikeTrendingWeight,
+			At:             time.Now(),
+		}); err != nil {
+			return err
+		}
+		if m.IsOwnedBy(input.UserId) {
+			return nil
+		}
+		return uc.notifier.Notify(ctx, entities.NewNotification(m.OwnerId, entities.NotificationTypeMapLiked, input.UserId, m.ID))
+	})
+	if err != nil {
+		return MapLikeOutput{}, err
+	}
+	return output, nil
+}
+
+type UnlikeMapUseCase struct {
+	mapRepository     repositories.MapRepository
+	mapLikeRepository repositories.MapLikeRepository
+	txManager         transactions.TransactionManager
+}
+
+func NewUnlikeMapUseCase(mapRepository repositories.MapRepository, mapLikeRepository repositories.MapLikeRepository, txManager transactions.TransactionManager) *UnlikeMapUseCase {
+	return &UnlikeMapUseCase{
+		mapRepository:     mapRepository,
+		mapLikeRepository: mapLikeRepository,
+		txManager:         txManager,
+	}
+}
+
+// Execute takes the like of the user back. The map may have become private since it was liked, so
+// the like can be removed without access to the map. The trending score keeps the like: it was
+// activity on the map all the same.
+func (uc *UnlikeMapUseCase) Execute(ctx context.Context, input MapFeedbackInput) error {
+	return uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		deleted, err := uc.mapLikeRepository.Delete(ctx, input.MapId, input.UserId)
+		if err != nil || !deleted {
+			return err
+		}
+		return uc.mapRepository.RecordActivity(ctx, input.MapId, entities.MapActivity{Likes: -1})
+	})
+}
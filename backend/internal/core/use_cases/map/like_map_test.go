@@ -0,0 +1,139 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// likeTxKey marks the context of the like's transaction in the tests.
+type likeTxKey struct{}
+
+type LikeMapSuite struct {
+	suite.Suite
+	mapRepo    *repomocks.MockMapRepository
+	likeRepo   *repomocks.MockMapLikeRepository
+	collabRepo *repomocks.MockMapCollaboratorRepository
+	mockTx     *txmocks.MockTransactionManager
+	notifier   *servicemocks.MockNotifier
+	uc         *LikeMapUseCase
+	m          *entities.Map
+}
+
+func TestLikeMapSuite(t *testing.T) {
+	suite.Run(t, new(LikeMapSuite))
+}
+
+func (s *LikeMapSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.likeRepo = repomocks.NewMockMapLikeRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.mockTx = txmocks.NewMockTransactionManager(s.T())
+	s.notifier = servicemocks.NewMockNotifier(s.T())
+	s.uc = NewLikeMapUseCase(s.mapRepo, s.likeRepo, services.NewMapAuthorizationService(s.collabRepo), s.mockTx, s.notifier)
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.m.Visibility = entities.MapVisibilityPublic
+	s.m.LikeCount = 4
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil)
+}
+
+func (s *LikeMapSuite) TestExecute_NewLikeRecordsActivityAndNotifiesOwner() {
+	s.mockTx.EXPECT().
+		RunInTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(context.WithValue(ctx, likeTxKey{}, true))
+		})
+	s.notifier.EXPECT().
+		Notify(mock.MatchedBy(func(ctx context.Context) bool { return ctx.Value(likeTxKey{}) != nil }), mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == "owner-uuid" && n.Type == entities.NotificationTypeMapLiked && *n.ActorId == "player-uuid" && n.SubjectId == "map-uuid"
+		})).
+		Return(nil)
+	s.likeRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *entities.MapLike) bool {
+		return l.MapId == "map-uuid" && l.UserId == "player-uuid"
+	})).Return(true, nil)
+	s.mapRepo.EXPECT().RecordActivity(mock.Anything, "map-uuid", mock.MatchedBy(func(a entities.MapActivity) bool {
+		return a.Likes == 1 && a.TrendingWeight == entities.LikeTrendingWeight && !a.At.IsZero()
+	})).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), MapFeedbackInput{MapId: "map-uuid", UserId: "player-uuid"})
+
+	s.Require().NoError(err)
+	s.True(output.Liked)
+	s.Equal(int64(5), output.LikeCount)
+}
+
+func (s *LikeMapSuite) TestExecute_RepeatedLikeChangesNothing() {
+	passThroughTx(s.mockTx)
+	s.likeRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(false, nil)
+
+	output, err := s.uc.Execute(context.Background(), MapFeedbackInput{MapId: "map-uuid", UserId: "player-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal(int64(4), output.LikeCount)
+	s.mapRepo.AssertNotCalled(s.T(), "RecordActivity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *LikeMapSuite) TestExecute_OwnLikeDoesNotNotify() {
+	passThroughTx(s.mockTx)
+	s.likeRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(true, nil)
+	s.mapRepo.EXPECT().RecordActivity(mock.Anything, "map-uuid", mock.Anything).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), MapFeedbackInput{MapId: "map-uuid", UserId: "owner-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal(int64(5), output.LikeCount)
+}
+
+func (s *LikeMapSuite) TestExecute_PrivateMapOfSomeoneElse_ReturnsNotFound() {
+	s.m.Visibility = entities.MapVisibilityPrivate
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "player-uuid").Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), MapFeedbackInput{MapId: "map-uuid", UserId: "player-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("map not found", err.Error())
+}
+
+type UnlikeMapSuite struct {
+	suite.Suite
+	mapRepo  *repomocks.MockMapRepository
+	likeRepo *repomocks.MockMapLikeRepository
+	uc       *UnlikeMapUseCase
+}
+
+func TestUnlikeMapSuite(t *testing.T) {
+	suite.Run(t, new(UnlikeMapSuite))
+}
+
+func (s *UnlikeMapSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.likeRepo = repomocks.NewMockMapLikeRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	passThroughTx(mockTx)
+	s.uc = NewUnlikeMapUseCase(s.mapRepo, s.likeRepo, mockTx)
+}
+
+func (s *UnlikeMapSuite) TestExecute_RemovedLikeDecrementsCount() {
+	s.likeRepo.EXPECT().Delete(mock.Anything, "map-uuid", "player-uuid").Return(true, nil)
+	s.mapRepo.EXPECT().RecordActivity(mock.Anything, "map-uuid", entities.MapActivity{Likes: -1}).Return(nil)
+
+	err := s.uc.Execute(context.Background(), MapFeedbackInput{MapId: "map-uuid", UserId: "player-uuid"})
+
+	s.NoError(err)
+}
+
+func (s *UnlikeMapSuite) TestExecute_WhenNotLiked_ChangesNothing() {
+	s.likeRepo.EXPECT().Delete(mock.Anything, "map-uuid", "player-uuid").Return(false, nil)
+
+	err := s.uc.Execute(context.Background(), MapFeedbackInput{MapId: "map-uuid", UserId: "player-uuid"})
+
+	s.NoError(err)
+}
@@ -0,0 +1,68 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+const maxDuplicateRadiusMeters = 10_000
+
+type LintLocationsInput struct {
+	MapId  string
+	UserId string
+	// Locations are checked against the map's locations. Without them the map's locations are
+	// checked against each other.
+	Locations []LocationInput
+	// DuplicateRadiusMeters overrides the near duplicate radius when positive.
+	DuplicateRadiusMeters float64
+}
+
+type LintLocationsUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	mapAuthorization   *services.MapAuthorizationService
+	locationLinter     *services.LocationLinter
+}
+
+func NewLintLocationsUseCase(
+	mapRepository repositories.MapRepository,
+	locationRepository repositories.LocationRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	locationLinter *services.LocationLinter,
+) *LintLocationsUseCase {
+	return &LintLocationsUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		mapAuthorization:   mapAuthorization,
+		locationLinter:     locationLinter,
+	}
+}
+
+// Execute reports the issues of locations without saving anything, so editors can clean up an
+// import before adding it.
+func (uc *LintLocationsUseCase) Execute(ctx context.Context, input LintLocationsInput) (services.LocationLintReport, error) {
+	if input.DuplicateRadiusMeters > maxDuplicateRadiusMeters {
+		return services.LocationLintReport{}, coreerrors.BadRequest("duplicate radius cannot exceed 10000 meters")
+	}
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleEditor)
+	if err != nil {
+		return services.LocationLintReport{}, err
+	}
+	existing, err := uc.locationRepository.FindByMapId(ctx, m.ID)
+	if err != nil {
+		return services.LocationLintReport{}, err
+	}
+
+	linter := uc.locationLinter.WithBoundary(m.Boundary)
+	if input.DuplicateRadiusMeters > 0 {
+		linter = linter.WithDuplicateRadius(input.DuplicateRadiusMeters)
+	}
+	if len(input.Locations) == 0 {
+		return linter.Lint(existing, nil), nil
+	}
+	return linter.Lint(newLocations(m.ID, input.Locations), existing), nil
+}
@@ -0,0 +1,60 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LintLocationsSuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	uc           *LintLocationsUseCase
+}
+
+func TestLintLocationsSuite(t *testing.T) {
+	suite.Run(t, new(LintLocationsSuite))
+}
+
+func (s *LintLocationsSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	collabRepo := repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.uc = NewLintLocationsUseCase(s.mapRepo, s.locationRepo, services.NewMapAuthorizationService(collabRepo), defaultLocationLinter())
+	m := entities.NewMap("Paris", "desc", "owner-uuid")
+	m.ID = "map-uuid"
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(m, nil)
+	s.locationRepo.EXPECT().FindByMapId(mock.Anything, "map-uuid").Return([]*entities.Location{
+		entities.RestoreLocation("loc-1", "louvre", "map-uuid", 48.8606, 2.3376, 0, 0),
+	}, nil)
+}
+
+func (s *LintLocationsSuite) TestExecute_ChecksCandidatesAgainstTheMap() {
+	report, err := s.uc.Execute(context.Background(), LintLocationsInput{
+		MapId:  "map-uuid",
+		UserId: "owner-uuid",
+		Locations: []LocationInput{
+			{PanoId: "louvre", Latitude: 48.87, Longitude: 2.33},
+			{PanoId: "pyramid", Latitude: 48.8607, Longitude: 2.3376},
+		},
+	})
+
+	s.Require().NoError(err)
+	s.Equal(1, report.Errors)
+	s.Equal(1, report.Warnings)
+	s.Equal(entities.LocationIssueDuplicatePano, report.Locations[0].Issues[0].Code)
+	s.Equal(entities.LocationIssueNearDuplicate, report.Locations[1].Issues[0].Code)
+}
+
+func (s *LintLocationsSuite) TestExecute_WithoutCandidates_LintsTheMap() {
+	report, err := s.uc.Execute(context.Background(), LintLocationsInput{MapId: "map-uuid", UserId: "owner-uuid"})
+
+	s.Require().NoError(err)
+	s.Empty(report.Locations)
+}
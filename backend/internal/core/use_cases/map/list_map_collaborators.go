@@ -0,0 +1,56 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type ListMapCollaboratorsInput struct {
+	MapId  string
+	UserId string
+}
+
+type ListMapCollaboratorsUseCase struct {
+	mapRepository          repositories.MapRepository
+	collaboratorRepository repositories.MapCollaboratorRepository
+	mapAuthorization       *services.MapAuthorizationService
+}
+
+func NewListMapCollaboratorsUseCase(
+	mapRepository repositories.MapRepository,
+	collaboratorRepository repositories.MapCollaboratorRepository,
+	mapAuthorization *services.MapAuthorizationService,
+) *ListMapCollaboratorsUseCase {
+	return &ListMapCollaboratorsUseCase{
+		mapRepository:          mapRepository,
+		collaboratorRepository: collaboratorRepository,
+		mapAuthorization:       mapAuthorization,
+	}
+}
+
+// Execute lists the collaborators of a map to its owner and collaborators.
+func (uc *ListMapCollaboratorsUseCase) Execute(ctx context.Context, input ListMapCollaboratorsInput) ([]CollaboratorOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	collaborators, err := uc.collaboratorRepository.FindByMapId(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]CollaboratorOutput, len(collaborators))
+	for i, collaborator := range collaborators {
+		outputs[i] = CollaboratorOutput{
+			UserId:    collaborator.UserId,
+			Role:      collaborator.Role,
+			CreatedAt: collaborator.CreatedAt,
+		}
+		if collaborator.User != nil {
+			outputs[i].Username = collaborator.User.Username
+		}
+	}
+	return outputs, nil
+}
@@ -0,0 +1,32 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type ListMapInvitationsUseCase struct {
+	invitationRepository repositories.MapInvitationRepository
+}
+
+func NewListMapInvitationsUseCase(invitationRepository repositories.MapInvitationRepository) *ListMapInvitationsUseCase {
+	return &ListMapInvitationsUseCase{invitationRepository: invitationRepository}
+}
+
+// Execute lists the pending invitations of the user, leaving out those whose map was deleted or
+// changed hands since.
+func (uc *ListMapInvitationsUseCase) Execute(ctx context.Context, userId string) ([]MapInvitationOutput, error) {
+	invitations, err := uc.invitationRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]MapInvitationOutput, 0, len(invitations))
+	for _, invitation := range invitations {
+		if invitation.Map == nil || !invitation.IsValidFor(invitation.Map) {
+			continue
+		}
+		outputs = append(outputs, toMapInvitationOutput(invitation))
+	}
+	return outputs, nil
+}
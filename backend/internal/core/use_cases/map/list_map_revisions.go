@@ -0,0 +1,76 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type ListMapRevisionsInput struct {
+	MapId  string
+	UserId string
+	// Page is 1-based. Zero values fall back to the first page of defaultMapsPageSize revisions.
+	Page     int
+	PageSize int
+}
+
+type ListMapRevisionsOutput struct {
+	Revisions []MapRevisionOutput `json:"revisions"`
+	Total     int64               `json:"total"`
+	Page      int                 `json:"page"`
+	PageSize  int                 `json:"page_size"`
+}
+
+type ListMapRevisionsUseCase struct {
+	mapRepository      repositories.MapRepository
+	revisionRepository repositories.MapRevisionRepository
+	mapAuthorization   *services.MapAuthorizationService
+}
+
+func NewListMapRevisionsUseCase(
+	mapRepository repositories.MapRepository,
+	revisionRepository repositories.MapRevisionRepository,
+	mapAuthorization *services.MapAuthorizationService,
+) *ListMapRevisionsUseCase {
+	return &ListMapRevisionsUseCase{
+		mapRepository:      mapRepository,
+		revisionRepository: revisionRepository,
+		mapAuthorization:   mapAuthorization,
+	}
+}
+
+// Execute lists the revisions of the map, latest first. The history is open to collaborators only.
+func (uc *ListMapRevisionsUseCase) Execute(ctx context.Context, input ListMapRevisionsInput) (ListMapRevisionsOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleViewer)
+	if err != nil {
+		return ListMapRevisionsOutput{}, err
+	}
+	page := max(input.Page, 1)
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultMapsPageSize
+	}
+	pageSize = min(pageSize, maxMapsPageSize)
+
+	revisions, err := uc.revisionRepository.FindByMapId(ctx, m.ID, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return ListMapRevisionsOutput{}, err
+	}
+	total, err := uc.revisionRepository.CountByMapId(ctx, m.ID)
+	if err != nil {
+		return ListMapRevisionsOutput{}, err
+	}
+
+	output := ListMapRevisionsOutput{
+		Revisions: make([]MapRevisionOutput, len(revisions)),
+		Total:     total,
+		Page:      page,
+		PageSize:  pageSize,
+	}
+	for i, revision := range revisions {
+		output.Revisions[i] = toMapRevisionOutput(revision)
+	}
+	return output, nil
+}
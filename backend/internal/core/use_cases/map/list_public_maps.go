@@ -0,0 +1,74 @@
+package mapuc
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+const (
+	defaultMapsPageSize = 20
+	maxMapsPageSize     = 100
+)
+
+type ListPublicMapsInput struct {
+	// Sort defaults to repositories.MapSortRecent.
+	Sort repositories.MapSort
+	// Page is 1-based. Zero values fall back to the first page of defaultMapsPageSize maps.
+	Page     int
+	PageSize int
+}
+
+type ListMapsOutput struct {
+	Maps     []MapOutput `json:"maps"`
+	Total    int64       `json:"total"`
+	Page     int         `json:"page"`
+	PageSize int         `json:"page_size"`
+}
+
+type ListPublicMapsUseCase struct {
+	mapRepository repositories.MapRepository
+}
+
+func NewListPublicMapsUseCase(mapRepository repositories.MapRepository) *ListPublicMapsUseCase {
+	return &ListPublicMapsUseCase{mapRepository: mapRepository}
+}
+
+func (uc *ListPublicMapsUseCase) Execute(ctx context.Context, input ListPublicMapsInput) (ListMapsOutput, error) {
+	page := max(input.Page, 1)
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultMapsPageSize
+	}
+	pageSize = min(pageSize, maxMapsPageSize)
+
+	sort := input.Sort
+	switch sort {
+	case "":
+		sort = repositories.MapSortRecent
+	case repositories.MapSortRecent, repositories.MapSortTrending, repositories.MapSortPopular:
+	default:
+		return ListMapsOutput{}, coreerrors.BadRequest("invalid sort")
+	}
+
+	maps, err := uc.mapRepository.FindPublic(ctx, sort, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return ListMapsOutput{}, err
+	}
+	total, err := uc.mapRepository.CountPublic(ctx)
+	if err != nil {
+		return ListMapsOutput{}, err
+	}
+
+	output := ListMapsOutput{
+		Maps:     make([]MapOutput, len(maps)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for i, m := range maps {
+		output.Maps[i] = toMapOutput(m)
+	}
+	return output, nil
+}
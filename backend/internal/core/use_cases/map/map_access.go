@@ -0,0 +1,42 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+// findAuthorizedMap returns the map when userId holds at least required on it. The empty role
+// only asks for the map to be playable by userId.
+func findAuthorizedMap(
+	ctx context.Context,
+	mapRepository repositories.MapRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	mapId, userId string,
+	required entities.MapRole,
+) (*entities.Map, error) {
+	m, err := mapRepository.FindById(ctx, mapId)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, coreerrors.NotFound("map not found")
+	}
+	if required != "" {
+		if err := mapAuthorization.Authorize(ctx, m, userId, required); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+	playable, err := mapAuthorization.CanPlay(ctx, m, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !playable {
+		return nil, coreerrors.NotFound("map not found")
+	}
+	return m, nil
+}
@@ -0,0 +1,178 @@
+package mapuc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+// maxReportedOutsideLocations bounds the panoramas named when a boundary leaves locations out.
+const maxReportedOutsideLocations = 5
+
+// MapBoundsSource tells what the bounds of a map were computed from.
+type MapBoundsSource string
+
+const (
+	MapBoundsSourceBoundary  MapBoundsSource = "boundary"
+	MapBoundsSourceLocations MapBoundsSource = "locations"
+)
+
+type MapBoundsOutput struct {
+	// Bounds is nil for a map without boundary nor locations.
+	Bounds              *entities.GeoBounds         `json:"bounds"`
+	CrossesAntimeridian bool                        `json:"crosses_antimeridian"`
+	Source              MapBoundsSource             `json:"source,omitempty"`
+	Boundary            *entities.MapBoundary       `json:"boundary"`
+	OutsideGuesses      entities.OutsideGuessPolicy `json:"outside_guesses"`
+}
+
+type SetMapBoundaryInput struct {
+	MapId  string
+	UserId string
+	// Boundary is a GeoJSON Polygon or MultiPolygon, or a Feature holding one.
+	Boundary []byte
+	// OutsideGuesses keeps the current policy when empty.
+	OutsideGuesses string
+}
+
+type SetMapBoundaryUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	mapAuthorization   *services.MapAuthorizationService
+}
+
+func NewSetMapBoundaryUseCase(mapRepository repositories.MapRepository, locationRepository repositories.LocationRepository, mapAuthorization *services.MapAuthorizationService) *SetMapBoundaryUseCase {
+	return &SetMapBoundaryUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		mapAuthorization:   mapAuthorization,
+	}
+}
+
+// Execute sets the boundary of the map. A boundary leaving out some of the map's locations is
+// refused: they have to be moved or removed first.
+func (uc *SetMapBoundaryUseCase) Execute(ctx context.Context, input SetMapBoundaryInput) (MapBoundsOutput, error) {
+	boundary, err := entities.ParseMapBoundary(input.Boundary)
+	if err != nil {
+		return MapBoundsOutput{}, err
+	}
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleEditor)
+	if err != nil {
+		return MapBoundsOutput{}, err
+	}
+	if input.OutsideGuesses != "" {
+		policy, ok := entities.ParseOutsideGuessPolicy(input.OutsideGuesses)
+		if !ok {
+			return MapBoundsOutput{}, coreerrors.BadRequest("outside guesses must be reject or clamp")
+		}
+		m.OutsideGuesses = policy
+	}
+
+	locations, err := uc.locationRepository.FindByMapId(ctx, m.ID)
+	if err != nil {
+		return MapBoundsOutput{}, err
+	}
+	var outside []string
+	for _, location := range locations {
+		if !boundary.Contains(location.Latitude, location.Longitude) {
+			outside = append(outside, location.PanoId)
+		}
+	}
+	if len(outside) > 0 {
+		named := outside[:min(len(outside), maxReportedOutsideLocations)]
+		return MapBoundsOutput{}, coreerrors.BadRequest(fmt.Sprintf("%d location(s) are outside the boundary: %s", len(outside), strings.Join(named, ", ")))
+	}
+
+	m.Boundary = boundary
+	if err := uc.mapRepository.Update(ctx, m); err != nil {
+		return MapBoundsOutput{}, err
+	}
+	return boundaryBounds(m), nil
+}
+
+type RemoveMapBoundaryInput struct {
+	MapId  string
+	UserId string
+}
+
+type RemoveMapBoundaryUseCase struct {
+	mapRepository    repositories.MapRepository
+	mapAuthorization *services.MapAuthorizationService
+}
+
+func NewRemoveMapBoundaryUseCase(mapRepository repositories.MapRepository, mapAuthorization *services.MapAuthorizationService) *RemoveMapBoundaryUseCase {
+	return &RemoveMapBoundaryUseCase{
+		mapRepository:    mapRepository,
+		mapAuthorization: mapAuthorization,
+	}
+}
+
+func (uc *RemoveMapBoundaryUseCase) Execute(ctx context.Context, input RemoveMapBoundaryInput) error {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleEditor)
+	if err != nil {
+		return err
+	}
+	if m.Boundary == nil {
+		return nil
+	}
+	m.Boundary = nil
+	return uc.mapRepository.Update(ctx, m)
+}
+
+type GetMapBoundsUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	mapAuthorization   *services.MapAuthorizationService
+}
+
+func NewGetMapBoundsUseCase(mapRepository repositories.MapRepository, locationRepository repositories.LocationRepository, mapAuthorization *services.MapAuthorizationService) *GetMapBoundsUseCase {
+	return &GetMapBoundsUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		mapAuthorization:   mapAuthorization,
+	}
+}
+
+// Execute returns the box clients fit their guess map to: around the boundary when the map has
+// one, and otherwise around the 1° cells of its locations.
+func (uc *GetMapBoundsUseCase) Execute(ctx context.Context, input GetMapInput) (MapBoundsOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, "")
+	if err != nil {
+		return MapBoundsOutput{}, err
+	}
+	if m.Boundary != nil {
+		return boundaryBounds(m), nil
+	}
+
+	strata, err := uc.locationRepository.CountStrata(ctx, m.ID, repositories.LocationFilter{})
+	if err != nil {
+		return MapBoundsOutput{}, err
+	}
+	cells := make([]int, 0, len(strata))
+	for _, stratum := range strata {
+		cells = append(cells, stratum.GeoCell)
+	}
+	output := MapBoundsOutput{OutsideGuesses: m.OutsideGuesses}
+	if bounds, ok := entities.GeoCellBounds(cells); ok {
+		output.Bounds = &bounds
+		output.CrossesAntimeridian = bounds.CrossesAntimeridian()
+		output.Source = MapBoundsSourceLocations
+	}
+	return output, nil
+}
+
+func boundaryBounds(m *entities.Map) MapBoundsOutput {
+	bounds := m.Boundary.Bounds()
+	return MapBoundsOutput{
+		Bounds:              &bounds,
+		CrossesAntimeridian: bounds.CrossesAntimeridian(),
+		Source:              MapBoundsSourceBoundary,
+		Boundary:            m.Boundary,
+		OutsideGuesses:      m.OutsideGuesses,
+	}
+}
@@ -0,0 +1,123 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// fijiBoundary crosses the antimeridian.
+const fijiBoundary = `{"type":"Polygon","coordinates":[[[177,-20],[-179,-20],[-179,-15],[177,-15],[177,-20]]]}`
+
+type MapBoundarySuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	collabRepo   *repomocks.MockMapCollaboratorRepository
+	set          *SetMapBoundaryUseCase
+	remove       *RemoveMapBoundaryUseCase
+	get          *GetMapBoundsUseCase
+	m            *entities.Map
+}
+
+func TestMapBoundarySuite(t *testing.T) {
+	suite.Run(t, new(MapBoundarySuite))
+}
+
+func (s *MapBoundarySuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	mapAuthorization := services.NewMapAuthorizationService(s.collabRepo)
+	s.set = NewSetMapBoundaryUseCase(s.mapRepo, s.locationRepo, mapAuthorization)
+	s.remove = NewRemoveMapBoundaryUseCase(s.mapRepo, mapAuthorization)
+	s.get = NewGetMapBoundsUseCase(s.mapRepo, s.locationRepo, mapAuthorization)
+	s.m = entities.NewMap("Fiji", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil).Maybe()
+}
+
+func (s *MapBoundarySuite) TestSet_StoresTheBoundaryAndPolicy() {
+	s.locationRepo.EXPECT().FindByMapId(mock.Anything, "map-uuid").Return([]*entities.Location{
+		entities.RestoreLocation("loc-1", "suva", "map-uuid", -18.14, 178.44, 0, 0),
+		entities.RestoreLocation("loc-2", "lakeba", "map-uuid", -18.2, -179.8, 0, 0),
+	}, nil)
+	s.mapRepo.EXPECT().Update(mock.Anything, s.m).Return(nil)
+
+	output, err := s.set.Execute(context.Background(), SetMapBoundaryInput{
+		MapId:          "map-uuid",
+		UserId:         "owner-uuid",
+		Boundary:       []byte(fijiBoundary),
+		OutsideGuesses: "clamp",
+	})
+
+	s.Require().NoError(err)
+	s.NotNil(s.m.Boundary)
+	s.Equal(entities.OutsideGuessClamp, s.m.OutsideGuesses)
+	s.Equal(MapBoundsSourceBoundary, output.Source)
+	s.Equal(&entities.GeoBounds{West: 177, South: -20, East: -179, North: -15}, output.Bounds)
+	s.True(output.CrossesAntimeridian)
+}
+
+func (s *MapBoundarySuite) TestSet_WhenLocationsAreOutside_ReturnsBadRequest() {
+	s.locationRepo.EXPECT().FindByMapId(mock.Anything, "map-uuid").Return([]*entities.Location{
+		entities.RestoreLocation("loc-1", "suva", "map-uuid", -18.14, 178.44, 0, 0),
+		entities.RestoreLocation("loc-2", "apia", "map-uuid", -13.83, -171.76, 0, 0),
+	}, nil)
+
+	_, err := s.set.Execute(context.Background(), SetMapBoundaryInput{MapId: "map-uuid", UserId: "owner-uuid", Boundary: []byte(fijiBoundary)})
+
+	s.Require().Error(err)
+	s.Equal("1 location(s) are outside the boundary: apia", err.Error())
+	s.Nil(s.m.Boundary)
+}
+
+func (s *MapBoundarySuite) TestSet_InvalidBoundary_ReturnsBadRequest() {
+	_, err := s.set.Execute(context.Background(), SetMapBoundaryInput{MapId: "map-uuid", UserId: "owner-uuid", Boundary: []byte(`{"type":"Point","coordinates":[0,0]}`)})
+
+	s.Require().Error(err)
+	s.Equal("boundary must be a GeoJSON Polygon or MultiPolygon", err.Error())
+}
+
+func (s *MapBoundarySuite) TestRemove_ClearsTheBoundary() {
+	boundary, err := entities.ParseMapBoundary([]byte(fijiBoundary))
+	s.Require().NoError(err)
+	s.m.Boundary = boundary
+	s.mapRepo.EXPECT().Update(mock.Anything, s.m).Return(nil)
+
+	s.Require().NoError(s.remove.Execute(context.Background(), RemoveMapBoundaryInput{MapId: "map-uuid", UserId: "owner-uuid"}))
+
+	s.Nil(s.m.Boundary)
+}
+
+func (s *MapBoundarySuite) TestGet_WithoutBoundary_FitsTheLocationCells() {
+	s.m.Visibility = entities.MapVisibilityPublic
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).Return([]repositories.LocationStratum{
+		{GeoCell: 70*360 + 359, Count: 3},
+		{GeoCell: 71*360 + 0, Count: 2},
+	}, nil)
+
+	output, err := s.get.Execute(context.Background(), GetMapInput{MapId: "map-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal(MapBoundsSourceLocations, output.Source)
+	s.Equal(&entities.GeoBounds{West: 179, South: -20, East: -179, North: -18}, output.Bounds)
+	s.True(output.CrossesAntimeridian)
+	s.Nil(output.Boundary)
+}
+
+func (s *MapBoundarySuite) TestGet_EmptyMap_HasNoBounds() {
+	s.locationRepo.EXPECT().CountStrata(mock.Anything, "map-uuid", repositories.LocationFilter{}).Return(nil, nil)
+
+	output, err := s.get.Execute(context.Background(), GetMapInput{MapId: "map-uuid", UserId: "owner-uuid"})
+
+	s.Require().NoError(err)
+	s.Nil(output.Bounds)
+	s.Empty(output.Source)
+}
@@ -0,0 +1,68 @@
+package mapuc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type MapRevisionOutput struct {
+	Number         int                           `json:"number"`
+	AuthorId       string                        `json:"author_id"`
+	AuthorUsername string                        `json:"author_username,omitempty"`
+	RevertedTo     *int                          `json:"reverted_to"`
+	Changes        []*entities.MapRevisionChange `json:"changes,omitempty"`
+	CreatedAt      time.Time                     `json:"created_at"`
+}
+
+func toMapRevisionOutput(revision *entities.MapRevision) MapRevisionOutput {
+	output := MapRevisionOutput{
+		Number:     revision.Number,
+		AuthorId:   revision.AuthorId,
+		RevertedTo: revision.RevertedTo,
+		Changes:    revision.Changes,
+		CreatedAt:  revision.CreatedAt,
+	}
+	if revision.Author != nil {
+		output.AuthorUsername = revision.Author.Username
+	}
+	return output
+}
+
+// beginRevision opens the next revision of the map. It has to be the first write of the
+// transaction: it locks the map so concurrent edits are recorded one after the other.
+func beginRevision(ctx context.Context, mapRepository repositories.MapRepository, mapId, authorId string) (*entities.MapRevision, error) {
+	number, err := mapRepository.IncrementRevision(ctx, mapId)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewMapRevision(mapId, number, authorId), nil
+}
+
+// locationState keys the snapshots of locations by id.
+func locationState(locations []*entities.Location) map[string]entities.LocationSnapshot {
+	state := make(map[string]entities.LocationSnapshot, len(locations))
+	for _, l := range locations {
+		state[l.ID] = entities.SnapshotLocation(l)
+	}
+	return state
+}
+
+// locationsAt rewinds current, the map's locations at its latest revision, to revision number.
+// revisions are the revisions after some number at most number, in ascending order.
+func locationsAt(current map[string]entities.LocationSnapshot, revisions []*entities.MapRevision, number int) map[string]entities.LocationSnapshot {
+	i := sort.Search(len(revisions), func(i int) bool { return revisions[i].Number > number })
+	return entities.RewindLocations(current, revisions[i:])
+}
+
+func sortedLocationIds(state map[string]entities.LocationSnapshot) []string {
+	ids := make([]string, 0, len(state))
+	for id := range state {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
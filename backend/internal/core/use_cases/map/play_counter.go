@@ -0,0 +1,35 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/events"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+// PlayCounter counts completed single player games on the play count and trending score of their
+// map. It runs in the transaction completing the game, so a game is counted exactly once.
+type PlayCounter struct {
+	mapRepository repositories.MapRepository
+}
+
+func NewPlayCounter(mapRepository repositories.MapRepository) *PlayCounter {
+	return &PlayCounter{mapRepository: mapRepository}
+}
+
+func (p *PlayCounter) Subscribe(bus *events.Bus) {
+	bus.Subscribe(events.GameCompletedEvent, p.HandleGameCompleted)
+}
+
+func (p *PlayCounter) HandleGameCompleted(ctx context.Context, event events.Event) error {
+	gameCompleted, ok := event.(events.GameCompleted)
+	if !ok || gameCompleted.Game.EndedAt == nil {
+		return nil
+	}
+	return p.mapRepository.RecordActivity(ctx, gameCompleted.Game.MapId, entities.MapActivity{
+		Plays:          1,
+		TrendingWeight: entities.PlayTrendingWeight,
+		At:             *gameCompleted.Game.EndedAt,
+	})
+}
@@ -0,0 +1,59 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/events"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type PlayCounterSuite struct {
+	suite.Suite
+	mapRepo *repomocks.MockMapRepository
+	counter *PlayCounter
+}
+
+func TestPlayCounterSuite(t *testing.T) {
+	suite.Run(t, new(PlayCounterSuite))
+}
+
+func (s *PlayCounterSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.counter = NewPlayCounter(s.mapRepo)
+}
+
+func (s *PlayCounterSuite) TestHandleGameCompleted_RecordsPlayAtGameEnd() {
+	endedAt := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	game := entities.NewSinglePlayerGame("player-uuid", "map-uuid", entities.SinglePlayerGameModeMove, 60)
+	game.EndedAt = &endedAt
+	s.mapRepo.EXPECT().RecordActivity(context.Background(), "map-uuid", entities.MapActivity{
+		Plays:          1,
+		TrendingWeight: entities.PlayTrendingWeight,
+		At:             endedAt,
+	}).Return(nil)
+
+	err := s.counter.HandleGameCompleted(context.Background(), events.GameCompleted{Game: game})
+
+	s.NoError(err)
+}
+
+func (s *PlayCounterSuite) TestSubscribe_CountsPublishedGames() {
+	bus := events.NewBus()
+	s.counter.Subscribe(bus)
+	endedAt := time.Now()
+	game := entities.NewSinglePlayerGame("player-uuid", "map-uuid", entities.SinglePlayerGameModeMove, 60)
+	game.EndedAt = &endedAt
+	s.mapRepo.EXPECT().RecordActivity(context.Background(), "map-uuid", entities.MapActivity{
+		Plays:          1,
+		TrendingWeight: entities.PlayTrendingWeight,
+		At:             endedAt,
+	}).Return(nil)
+
+	err := bus.Publish(context.Background(), events.GameCompleted{Game: game})
+
+	s.NoError(err)
+}
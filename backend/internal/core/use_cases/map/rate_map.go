@@ -0,0 +1,106 @@
+package mapuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type RateMapInput struct {
+	MapId      string
+	UserId     string
+	Difficulty int
+}
+
+type MapRatingOutput struct {
+	MapId      string    `json:"map_id"`
+	Difficulty int       `json:"difficulty"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type RateMapUseCase struct {
+	mapRepository       repositories.MapRepository
+	mapRatingRepository repositories.MapRatingRepository
+	mapAuthorization    *services.MapAuthorizationService
+	txManager           transactions.TransactionManager
+}
+
+func NewRateMapUseCase(
+	mapRepository repositories.MapRepository,
+	mapRatingRepository repositories.MapRatingRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	txManager transactions.TransactionManager,
+) *RateMapUseCase {
+	return &RateMapUseCase{
+		mapRepository:       mapRepository,
+		mapRatingRepository: mapRatingRepository,
+		mapAuthorization:    mapAuthorization,
+		txManager:           txManager,
+	}
+}
+
+// Execute sets the difficulty the user gives the map, replacing a previous rating.
+func (uc *RateMapUseCase) Execute(ctx context.Context, input RateMapInput) (MapRatingOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, "")
+	if err != nil {
+		return MapRatingOutput{}, err
+	}
+	rating, err := entities.NewMapRating(m.ID, input.UserId, input.Difficulty)
+	if err != nil {
+		return MapRatingOutput{}, err
+	}
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		previous, err := uc.mapRatingRepository.FindByMapIdAndUserIdForUpdate(ctx, m.ID, input.UserId)
+		if err != nil {
+			return err
+		}
+		activity := entities.MapActivity{DifficultyRatings: 1, DifficultyRatingSum: int64(rating.Difficulty)}
+		if previous != nil {
+			rating.CreatedAt = previous.CreatedAt
+			activity.DifficultyRatings = 0
+			activity.DifficultyRatingSum -= int64(previous.Difficulty)
+		}
+		if err := uc.mapRatingRepository.Save(ctx, rating); err != nil {
+			return err
+		}
+		return uc.mapRepository.RecordActivity(ctx, m.ID, activity)
+	})
+	if err != nil {
+		return MapRatingOutput{}, err
+	}
+	return MapRatingOutput{MapId: rating.MapId, Difficulty: rating.Difficulty, UpdatedAt: rating.UpdatedAt}, nil
+}
+
+type RemoveMapRatingUseCase struct {
+	mapRepository       repositories.MapRepository
+	mapRatingRepository repositories.MapRatingRepository
+	txManager           transactions.TransactionManager
+}
+
+func NewRemoveMapRatingUseCase(mapRepository repositories.MapRepository, mapRatingRepository repositories.MapRatingRepository, txManager transactions.TransactionManager) *RemoveMapRatingUseCase {
+	return &RemoveMapRatingUseCase{
+		mapRepository:       mapRepository,
+		mapRatingRepository: mapRatingRepository,
+		txManager:           txManager,
+	}
+}
+
+func (uc *RemoveMapRatingUseCase) Execute(ctx context.Context, input MapFeedbackInput) error {
+	return uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		rating, err := uc.mapRatingRepository.FindByMapIdAndUserIdForUpdate(ctx, input.MapId, input.UserId)
+		if err != nil || rating == nil {
+			return err
+		}
+		if err := uc.mapRatingRepository.Delete(ctx, rating); err != nil {
+			return err
+		}
+		return uc.mapRepository.RecordActivity(ctx, input.MapId, entities.MapActivity{
+			DifficultyRatings:   -1,
+			DifficultyRatingSum: -int64(rating.Difficulty),
+		})
+	})
+}
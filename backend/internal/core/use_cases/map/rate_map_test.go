@@ -0,0 +1,88 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateMapSuite struct {
+	suite.Suite
+	mapRepo    *repomocks.MockMapRepository
+	ratingRepo *repomocks.MockMapRatingRepository
+	mockTx     *txmocks.MockTransactionManager
+	uc         *RateMapUseCase
+}
+
+func TestRateMapSuite(t *testing.T) {
+	suite.Run(t, new(RateMapSuite))
+}
+
+func (s *RateMapSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.ratingRepo = repomocks.NewMockMapRatingRepository(s.T())
+	s.mockTx = txmocks.NewMockTransactionManager(s.T())
+	collabRepo := repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.uc = NewRateMapUseCase(s.mapRepo, s.ratingRepo, services.NewMapAuthorizationService(collabRepo), s.mockTx)
+}
+
+func (s *RateMapSuite) expectPublicMap() {
+	m := entities.NewMap("Brazil", "desc", "owner-uuid")
+	m.ID = "map-uuid"
+	m.Visibility = entities.MapVisibilityPublic
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(m, nil)
+}
+
+func (s *RateMapSuite) TestExecute_FirstRatingAddsToAggregates() {
+	s.expectPublicMap()
+	passThroughTx(s.mockTx)
+	s.ratingRepo.EXPECT().FindByMapIdAndUserIdForUpdate(mock.Anything, "map-uuid", "player-uuid").Return(nil, nil)
+	s.ratingRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(r *entities.MapRating) bool { return r.Difficulty == 4 })).Return(nil)
+	s.mapRepo.EXPECT().RecordActivity(mock.Anything, "map-uuid", entities.MapActivity{DifficultyRatings: 1, DifficultyRatingSum: 4}).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), RateMapInput{MapId: "map-uuid", UserId: "player-uuid", Difficulty: 4})
+
+	s.Require().NoError(err)
+	s.Equal(4, output.Difficulty)
+}
+
+func (s *RateMapSuite) TestExecute_ChangedRatingAppliesTheDifference() {
+	s.expectPublicMap()
+	passThroughTx(s.mockTx)
+	previous, err := entities.NewMapRating("map-uuid", "player-uuid", 5)
+	s.Require().NoError(err)
+	s.ratingRepo.EXPECT().FindByMapIdAndUserIdForUpdate(mock.Anything, "map-uuid", "player-uuid").Return(previous, nil)
+	s.ratingRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+	s.mapRepo.EXPECT().RecordActivity(mock.Anything, "map-uuid", entities.MapActivity{DifficultyRatingSum: -3}).Return(nil)
+
+	_, err = s.uc.Execute(context.Background(), RateMapInput{MapId: "map-uuid", UserId: "player-uuid", Difficulty: 2})
+
+	s.NoError(err)
+}
+
+func (s *RateMapSuite) TestExecute_OutOfRange_ReturnsBadRequest() {
+	s.expectPublicMap()
+	_, err := s.uc.Execute(context.Background(), RateMapInput{MapId: "map-uuid", UserId: "player-uuid", Difficulty: 6})
+
+	s.Require().Error(err)
+	s.Equal("difficulty must be between 1 and 5", err.Error())
+}
+
+func (s *RateMapSuite) TestRemove_SubtractsTheRating() {
+	passThroughTx(s.mockTx)
+	rating, err := entities.NewMapRating("map-uuid", "player-uuid", 3)
+	s.Require().NoError(err)
+	s.ratingRepo.EXPECT().FindByMapIdAndUserIdForUpdate(mock.Anything, "map-uuid", "player-uuid").Return(rating, nil)
+	s.ratingRepo.EXPECT().Delete(mock.Anything, rating).Return(nil)
+	s.mapRepo.EXPECT().RecordActivity(mock.Anything, "map-uuid", entities.MapActivity{DifficultyRatings: -1, DifficultyRatingSum: -3}).Return(nil)
+
+	err = NewRemoveMapRatingUseCase(s.mapRepo, s.ratingRepo, s.mockTx).Execute(context.Background(), MapFeedbackInput{MapId: "map-uuid", UserId: "player-uuid"})
+
+	s.NoError(err)
+}
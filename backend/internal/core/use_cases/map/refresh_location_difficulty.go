@@ -0,0 +1,68 @@
+package mapuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+const (
+	locationDifficultyBatchSize = 500
+	// locationDifficultyJob names the state of the refresh, which holds when its last successful run started.
+	locationDifficultyJob = "location_difficulty"
+)
+
+// RefreshLocationDifficultyUseCase rates locations from the rounds played on them. It is run
+// periodically, each run only revisiting the locations played since the previous one.
+type RefreshLocationDifficultyUseCase struct {
+	locationRepository repositories.LocationRepository
+	jobStateRepository repositories.JobStateRepository
+}
+
+func NewRefreshLocationDifficultyUseCase(locationRepository repositories.LocationRepository, jobStateRepository repositories.JobStateRepository) *RefreshLocationDifficultyUseCase {
+	return &RefreshLocationDifficultyUseCase{
+		locationRepository: locationRepository,
+		jobStateRepository: jobStateRepository,
+	}
+}
+
+// Execute rates the locations with a round completed since the previous successful run started,
+// every location on the first run, and returns how many were rated. A failed run leaves the
+// watermark alone, so the next one covers its locations again.
+func (uc *RefreshLocationDifficultyUseCase) Execute(ctx context.Context) (int, error) {
+	state, err := uc.jobStateRepository.FindByName(ctx, locationDifficultyJob)
+	if err != nil {
+		return 0, err
+	}
+	var since time.Time
+	if state != nil {
+		since = state.Watermark
+	}
+	started := time.Now()
+	refreshed, err := uc.refresh(ctx, since)
+	if err != nil {
+		return refreshed, err
+	}
+	return refreshed, uc.jobStateRepository.Save(ctx, entities.NewJobState(locationDifficultyJob, started))
+}
+
+func (uc *RefreshLocationDifficultyUseCase) refresh(ctx context.Context, since time.Time) (int, error) {
+	refreshed := 0
+	afterId := ""
+	for {
+		stats, next, err := uc.locationRepository.FindPlayStats(ctx, since, afterId, locationDifficultyBatchSize)
+		if err != nil {
+			return refreshed, err
+		}
+		if err := uc.locationRepository.UpdateDifficulties(ctx, stats); err != nil {
+			return refreshed, err
+		}
+		refreshed += len(stats)
+		if next == "" {
+			return refreshed, nil
+		}
+		afterId = next
+	}
+}
@@ -0,0 +1,85 @@
+package mapuc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshLocationDifficultySuite struct {
+	suite.Suite
+	locationRepo *repomocks.MockLocationRepository
+	jobStateRepo *repomocks.MockJobStateRepository
+	uc           *RefreshLocationDifficultyUseCase
+}
+
+func TestRefreshLocationDifficultySuite(t *testing.T) {
+	suite.Run(t, new(RefreshLocationDifficultySuite))
+}
+
+func (s *RefreshLocationDifficultySuite) SetupTest() {
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.jobStateRepo = repomocks.NewMockJobStateRepository(s.T())
+	s.uc = NewRefreshLocationDifficultyUseCase(s.locationRepo, s.jobStateRepo)
+}
+
+// expectWatermarkSaved expects the run to record that it started after before.
+func (s *RefreshLocationDifficultySuite) expectWatermarkSaved(before time.Time) {
+	s.jobStateRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(state *entities.JobState) bool {
+		return state.Name == locationDifficultyJob && !state.Watermark.Before(before)
+	})).Return(nil)
+}
+
+func (s *RefreshLocationDifficultySuite) TestExecute_RatesTheLocationsPlayedSinceTheStoredWatermark() {
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	s.jobStateRepo.EXPECT().FindByName(mock.Anything, locationDifficultyJob).Return(entities.NewJobState(locationDifficultyJob, since), nil)
+	s.expectWatermarkSaved(time.Now())
+	full := make([]entities.LocationPlayStats, locationDifficultyBatchSize)
+	for i := range full {
+		full[i] = entities.LocationPlayStats{LocationId: fmt.Sprintf("loc-%04d", i), Plays: 10}
+	}
+	rest := []entities.LocationPlayStats{{LocationId: "loc-9999", Plays: 1}}
+	s.locationRepo.EXPECT().FindPlayStats(mock.Anything, since, "", locationDifficultyBatchSize).Return(full, full[len(full)-1].LocationId, nil)
+	s.locationRepo.EXPECT().FindPlayStats(mock.Anything, since, full[len(full)-1].LocationId, locationDifficultyBatchSize).Return(rest, "", nil)
+	s.locationRepo.EXPECT().UpdateDifficulties(mock.Anything, full).Return(nil)
+	s.locationRepo.EXPECT().UpdateDifficulties(mock.Anything, rest).Return(nil)
+
+	refreshed, err := s.uc.Execute(context.Background())
+
+	s.Require().NoError(err)
+	s.Equal(locationDifficultyBatchSize+1, refreshed)
+}
+
+func (s *RefreshLocationDifficultySuite) TestExecute_WithoutWatermark_FollowsTheCursorPastAShortPage() {
+	s.jobStateRepo.EXPECT().FindByName(mock.Anything, locationDifficultyJob).Return(nil, nil)
+	s.expectWatermarkSaved(time.Now())
+	short := []entities.LocationPlayStats{{LocationId: "loc-1", Plays: 3}}
+	rest := []entities.LocationPlayStats{{LocationId: "loc-9", Plays: 2}}
+	s.locationRepo.EXPECT().FindPlayStats(mock.Anything, time.Time{}, "", locationDifficultyBatchSize).Return(short, "loc-5", nil)
+	s.locationRepo.EXPECT().FindPlayStats(mock.Anything, time.Time{}, "loc-5", locationDifficultyBatchSize).Return(rest, "", nil)
+	s.locationRepo.EXPECT().UpdateDifficulties(mock.Anything, short).Return(nil)
+	s.locationRepo.EXPECT().UpdateDifficulties(mock.Anything, rest).Return(nil)
+
+	refreshed, err := s.uc.Execute(context.Background())
+
+	s.Require().NoError(err)
+	s.Equal(2, refreshed)
+}
+
+func (s *RefreshLocationDifficultySuite) TestExecute_WhenUpdateFails_KeepsTheWatermark() {
+	s.jobStateRepo.EXPECT().FindByName(mock.Anything, locationDifficultyJob).Return(nil, nil)
+	stats := []entities.LocationPlayStats{{LocationId: "loc-1", Plays: 10}}
+	s.locationRepo.EXPECT().FindPlayStats(mock.Anything, time.Time{}, "", locationDifficultyBatchSize).Return(stats, "", nil)
+	s.locationRepo.EXPECT().UpdateDifficulties(mock.Anything, stats).Return(errMock)
+
+	refreshed, err := s.uc.Execute(context.Background())
+
+	s.Require().ErrorIs(err, errMock)
+	s.Zero(refreshed)
+}
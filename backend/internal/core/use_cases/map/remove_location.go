@@ -0,0 +1,78 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type RemoveLocationInput struct {
+	MapId      string
+	UserId     string
+	LocationId string
+}
+
+type RemoveLocationUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	revisionRepository repositories.MapRevisionRepository
+	mapAuthorization   *services.MapAuthorizationService
+	txManager          transactions.TransactionManager
+}
+
+func NewRemoveLocationUseCase(
+	mapRepository repositories.MapRepository,
+	locationRepository repositories.LocationRepository,
+	revisionRepository repositories.MapRevisionRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	txManager transactions.TransactionManager,
+) *RemoveLocationUseCase {
+	return &RemoveLocationUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		revisionRepository: revisionRepository,
+		mapAuthorization:   mapAuthorization,
+		txManager:          txManager,
+	}
+}
+
+// Execute removes a location from the map. A public map cannot drop below
+// entities.MinPublicMapLocations; it has to be unpublished first.
+func (uc *RemoveLocationUseCase) Execute(ctx context.Context, input RemoveLocationInput) error {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleEditor)
+	if err != nil {
+		return err
+	}
+
+	return uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		revision, err := beginRevision(ctx, uc.mapRepository, m.ID, input.UserId)
+		if err != nil {
+			return err
+		}
+		location, err := uc.locationRepository.FindByIdAndMapId(ctx, input.LocationId, m.ID)
+		if err != nil {
+			return err
+		}
+		if location == nil {
+			return coreerrors.NotFound("location not found")
+		}
+		if m.Visibility == entities.MapVisibilityPublic {
+			count, err := uc.locationRepository.CountByMapId(ctx, m.ID)
+			if err != nil {
+				return err
+			}
+			if count <= entities.MinPublicMapLocations {
+				return coreerrors.BadRequest("public maps need at least 5 locations")
+			}
+		}
+		if err := uc.locationRepository.Delete(ctx, location); err != nil {
+			return err
+		}
+		revision.Changes = []*entities.MapRevisionChange{entities.NewLocationRemovedChange(location)}
+		return uc.revisionRepository.Create(ctx, revision)
+	})
+}
@@ -0,0 +1,78 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RemoveLocationSuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	collabRepo   *repomocks.MockMapCollaboratorRepository
+	revisionRepo *repomocks.MockMapRevisionRepository
+	uc           *RemoveLocationUseCase
+	m            *entities.Map
+	location     *entities.Location
+}
+
+func TestRemoveLocationSuite(t *testing.T) {
+	suite.Run(t, new(RemoveLocationSuite))
+}
+
+func (s *RemoveLocationSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.revisionRepo = repomocks.NewMockMapRevisionRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	passThroughTx(mockTx)
+	s.uc = NewRemoveLocationUseCase(s.mapRepo, s.locationRepo, s.revisionRepo, services.NewMapAuthorizationService(s.collabRepo), mockTx)
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.location = entities.RestoreLocation("loc-uuid", "pano", "map-uuid", 1, 2, 0, 0)
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil)
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "editor-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "editor-uuid", entities.MapRoleEditor, "owner-uuid"), nil)
+	s.mapRepo.EXPECT().IncrementRevision(mock.Anything, "map-uuid").Return(3, nil)
+}
+
+func (s *RemoveLocationSuite) TestExecute_EditorRemovesLocation() {
+	s.locationRepo.EXPECT().FindByIdAndMapId(mock.Anything, "loc-uuid", "map-uuid").Return(s.location, nil)
+	s.locationRepo.EXPECT().Delete(mock.Anything, s.location).Return(nil)
+	s.revisionRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(r *entities.MapRevision) bool {
+		return r.Number == 3 && r.AuthorId == "editor-uuid" && len(r.Changes) == 1 &&
+			r.Changes[0].Action == entities.MapRevisionActionRemoved && r.Changes[0].Before.PanoId == "pano"
+	})).Return(nil)
+
+	err := s.uc.Execute(context.Background(), RemoveLocationInput{MapId: "map-uuid", UserId: "editor-uuid", LocationId: "loc-uuid"})
+
+	s.NoError(err)
+}
+
+func (s *RemoveLocationSuite) TestExecute_PublicMapAtMinimum_ReturnsBadRequest() {
+	s.m.Visibility = entities.MapVisibilityPublic
+	s.locationRepo.EXPECT().FindByIdAndMapId(mock.Anything, "loc-uuid", "map-uuid").Return(s.location, nil)
+	s.locationRepo.EXPECT().CountByMapId(mock.Anything, "map-uuid").Return(int64(entities.MinPublicMapLocations), nil)
+
+	err := s.uc.Execute(context.Background(), RemoveLocationInput{MapId: "map-uuid", UserId: "editor-uuid", LocationId: "loc-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("public maps need at least 5 locations", err.Error())
+}
+
+func (s *RemoveLocationSuite) TestExecute_WhenLocationMissing_ReturnsNotFound() {
+	s.locationRepo.EXPECT().FindByIdAndMapId(mock.Anything, "loc-uuid", "map-uuid").Return(nil, nil)
+
+	err := s.uc.Execute(context.Background(), RemoveLocationInput{MapId: "map-uuid", UserId: "editor-uuid", LocationId: "loc-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("location not found", err.Error())
+}
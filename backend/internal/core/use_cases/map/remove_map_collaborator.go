@@ -0,0 +1,69 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+type RemoveMapCollaboratorInput struct {
+	MapId          string
+	UserId         string
+	CollaboratorId string
+}
+
+type RemoveMapCollaboratorUseCase struct {
+	mapRepository          repositories.MapRepository
+	collaboratorRepository repositories.MapCollaboratorRepository
+	invitationRepository   repositories.MapInvitationRepository
+	mapAuthorization       *services.MapAuthorizationService
+}
+
+func NewRemoveMapCollaboratorUseCase(
+	mapRepository repositories.MapRepository,
+	collaboratorRepository repositories.MapCollaboratorRepository,
+	invitationRepository repositories.MapInvitationRepository,
+	mapAuthorization *services.MapAuthorizationService,
+) *RemoveMapCollaboratorUseCase {
+	return &RemoveMapCollaboratorUseCase{
+		mapRepository:          mapRepository,
+		collaboratorRepository: collaboratorRepository,
+		invitationRepository:   invitationRepository,
+		mapAuthorization:       mapAuthorization,
+	}
+}
+
+// Execute lets the owner remove any collaborator or withdraw a pending invitation, and collaborators
+// leave the map themselves.
+func (uc *RemoveMapCollaboratorUseCase) Execute(ctx context.Context, input RemoveMapCollaboratorInput) error {
+	required := entities.MapRoleOwner
+	if input.CollaboratorId == input.UserId {
+		required = entities.MapRoleViewer
+	}
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, required)
+	if err != nil {
+		return err
+	}
+	existing, err := uc.collaboratorRepository.FindByMapIdAndUserId(ctx, m.ID, input.CollaboratorId)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return uc.withdrawInvitation(ctx, m, input)
+	}
+	return uc.collaboratorRepository.Delete(ctx, m.ID, input.CollaboratorId)
+}
+
+func (uc *RemoveMapCollaboratorUseCase) withdrawInvitation(ctx context.Context, m *entities.Map, input RemoveMapCollaboratorInput) error {
+	invitation, err := uc.invitationRepository.FindByMapIdAndUserId(ctx, m.ID, input.CollaboratorId)
+	if err != nil {
+		return err
+	}
+	if invitation == nil || !m.IsOwnedBy(input.UserId) {
+		return coreerrors.NotFound("collaborator not found")
+	}
+	return uc.invitationRepository.Delete(ctx, m.ID, input.CollaboratorId)
+}
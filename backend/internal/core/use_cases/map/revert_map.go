@@ -0,0 +1,131 @@
+package mapuc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type RevertMapInput struct {
+	MapId    string
+	UserId   string
+	Revision int
+}
+
+type RevertMapUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	revisionRepository repositories.MapRevisionRepository
+	mapAuthorization   *services.MapAuthorizationService
+	txManager          transactions.TransactionManager
+}
+
+func NewRevertMapUseCase(
+	mapRepository repositories.MapRepository,
+	locationRepository repositories.LocationRepository,
+	revisionRepository repositories.MapRevisionRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	txManager transactions.TransactionManager,
+) *RevertMapUseCase {
+	return &RevertMapUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		revisionRepository: revisionRepository,
+		mapAuthorization:   mapAuthorization,
+		txManager:          txManager,
+	}
+}
+
+// Execute brings the locations of the map back to how they were at input.Revision. The revert is
+// recorded as a new revision, so it can be reverted in turn.
+func (uc *RevertMapUseCase) Execute(ctx context.Context, input RevertMapInput) (MapRevisionOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleEditor)
+	if err != nil {
+		return MapRevisionOutput{}, err
+	}
+	if !isMapRevision(m, input.Revision) {
+		return MapRevisionOutput{}, coreerrors.NotFound("revision not found")
+	}
+
+	var revision *entities.MapRevision
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		revision, err = beginRevision(ctx, uc.mapRepository, m.ID, input.UserId)
+		if err != nil {
+			return err
+		}
+		locations, err := uc.locationRepository.FindByMapId(ctx, m.ID)
+		if err != nil {
+			return err
+		}
+		revisions, err := uc.revisionRepository.FindAfter(ctx, m.ID, input.Revision)
+		if err != nil {
+			return err
+		}
+		target := entities.RewindLocations(locationState(locations), revisions)
+		if m.Visibility == entities.MapVisibilityPublic && len(target) < entities.MinPublicMapLocations {
+			return coreerrors.BadRequest("public maps need at least 5 locations")
+		}
+		// The boundary may have come after the revision: like any edit, a revert keeps inside it.
+		if outside := outsideBoundary(m, target); len(outside) > 0 {
+			named := outside[:min(len(outside), maxReportedOutsideLocations)]
+			return coreerrors.BadRequest(fmt.Sprintf("%d location(s) of revision %d are outside the boundary: %s", len(outside), input.Revision, strings.Join(named, ", ")))
+		}
+
+		for _, location := range locations {
+			snapshot, ok := target[location.ID]
+			if !ok {
+				if err := uc.locationRepository.Delete(ctx, location); err != nil {
+					return err
+				}
+				revision.Changes = append(revision.Changes, entities.NewLocationRemovedChange(location))
+				continue
+			}
+			delete(target, location.ID)
+			if snapshot.Equal(entities.SnapshotLocation(location)) {
+				continue
+			}
+			before := *location
+			snapshot.ApplyTo(location)
+			if err := uc.locationRepository.Update(ctx, location); err != nil {
+				return err
+			}
+			revision.Changes = append(revision.Changes, entities.NewLocationEditedChange(&before, location))
+		}
+		// What is left of the target was removed since the revision.
+		for _, id := range sortedLocationIds(target) {
+			location := &entities.Location{ID: id, MapId: m.ID}
+			target[id].ApplyTo(location)
+			if err := uc.locationRepository.Restore(ctx, location); err != nil {
+				return err
+			}
+			revision.Changes = append(revision.Changes, entities.NewLocationAddedChange(location))
+		}
+
+		if len(revision.Changes) == 0 {
+			return coreerrors.Conflict("map already matches this revision")
+		}
+		revision.RevertedTo = &input.Revision
+		return uc.revisionRepository.Create(ctx, revision)
+	})
+	if err != nil {
+		return MapRevisionOutput{}, err
+	}
+	return toMapRevisionOutput(revision), nil
+}
+
+// outsideBoundary returns the panoramas of state outside the boundary of m, in location id order.
+func outsideBoundary(m *entities.Map, state map[string]entities.LocationSnapshot) []string {
+	var outside []string
+	for _, id := range sortedLocationIds(state) {
+		if snapshot := state[id]; !m.InBoundary(snapshot.Latitude, snapshot.Longitude) {
+			outside = append(outside, snapshot.PanoId)
+		}
+	}
+	return outside
+}
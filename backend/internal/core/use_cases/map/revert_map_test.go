@@ -0,0 +1,104 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RevertMapSuite struct {
+	suite.Suite
+	mapRepo      *repomocks.MockMapRepository
+	locationRepo *repomocks.MockLocationRepository
+	revisionRepo *repomocks.MockMapRevisionRepository
+	mockTx       *txmocks.MockTransactionManager
+	uc           *RevertMapUseCase
+	m            *entities.Map
+}
+
+func TestRevertMapSuite(t *testing.T) {
+	suite.Run(t, new(RevertMapSuite))
+}
+
+func (s *RevertMapSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.locationRepo = repomocks.NewMockLocationRepository(s.T())
+	s.revisionRepo = repomocks.NewMockMapRevisionRepository(s.T())
+	collabRepo := repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.mockTx = txmocks.NewMockTransactionManager(s.T())
+	s.uc = NewRevertMapUseCase(s.mapRepo, s.locationRepo, s.revisionRepo, services.NewMapAuthorizationService(collabRepo), s.mockTx)
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.m.Revision = 2
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil)
+}
+
+// The map had loc-1 and loc-2 at revision 0; revision 1 removed loc-2 and added loc-3, revision 2
+// turned loc-1 around.
+func (s *RevertMapSuite) TestExecute_RestoresLocationsOfRevision() {
+	original := entities.RestoreLocation("loc-1", "pano-1", "map-uuid", 1, 1, 0, 0)
+	removed := entities.RestoreLocation("loc-2", "pano-2", "map-uuid", 2, 2, 0, 0)
+	added := entities.RestoreLocation("loc-3", "pano-3", "map-uuid", 3, 3, 0, 0)
+	edited := entities.RestoreLocation("loc-1", "pano-1", "map-uuid", 1, 1, 180, 0)
+	first := entities.NewMapRevision("map-uuid", 1, "owner-uuid")
+	first.Changes = []*entities.MapRevisionChange{entities.NewLocationRemovedChange(removed), entities.NewLocationAddedChange(added)}
+	second := entities.NewMapRevision("map-uuid", 2, "owner-uuid")
+	second.Changes = []*entities.MapRevisionChange{entities.NewLocationEditedChange(original, edited)}
+
+	passThroughTx(s.mockTx)
+	s.mapRepo.EXPECT().IncrementRevision(mock.Anything, "map-uuid").Return(3, nil)
+	s.locationRepo.EXPECT().FindByMapId(mock.Anything, "map-uuid").Return([]*entities.Location{edited, added}, nil)
+	s.revisionRepo.EXPECT().FindAfter(mock.Anything, "map-uuid", 0).Return([]*entities.MapRevision{first, second}, nil)
+	s.locationRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(l *entities.Location) bool {
+		return l.ID == "loc-1" && l.Heading == 0
+	})).Return(nil)
+	s.locationRepo.EXPECT().Delete(mock.Anything, added).Return(nil)
+	s.locationRepo.EXPECT().Restore(mock.Anything, mock.MatchedBy(func(l *entities.Location) bool {
+		return l.ID == "loc-2" && l.MapId == "map-uuid" && l.PanoId == "pano-2"
+	})).Return(nil)
+	s.revisionRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), RevertMapInput{MapId: "map-uuid", UserId: "owner-uuid", Revision: 0})
+
+	s.Require().NoError(err)
+	s.Equal(3, output.Number)
+	s.Require().NotNil(output.RevertedTo)
+	s.Equal(0, *output.RevertedTo)
+	s.Require().Len(output.Changes, 3)
+	s.Equal(entities.MapRevisionActionEdited, output.Changes[0].Action)
+	s.Equal(entities.MapRevisionActionRemoved, output.Changes[1].Action)
+	s.Equal(entities.MapRevisionActionAdded, output.Changes[2].Action)
+}
+
+func (s *RevertMapSuite) TestExecute_WhenRevisionHasLocationsOutsideTheBoundary_ReturnsBadRequest() {
+	boundary, err := entities.ParseMapBoundary([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1.5,0],[1.5,1.5],[0,1.5],[0,0]]]}`))
+	s.Require().NoError(err)
+	s.m.Boundary = boundary
+	kept := entities.RestoreLocation("loc-1", "pano-1", "map-uuid", 1, 1, 0, 0)
+	removed := entities.RestoreLocation("loc-2", "pano-2", "map-uuid", 2, 2, 0, 0)
+	revision := entities.NewMapRevision("map-uuid", 1, "owner-uuid")
+	revision.Changes = []*entities.MapRevisionChange{entities.NewLocationRemovedChange(removed)}
+
+	passThroughTx(s.mockTx)
+	s.mapRepo.EXPECT().IncrementRevision(mock.Anything, "map-uuid").Return(3, nil)
+	s.locationRepo.EXPECT().FindByMapId(mock.Anything, "map-uuid").Return([]*entities.Location{kept}, nil)
+	s.revisionRepo.EXPECT().FindAfter(mock.Anything, "map-uuid", 0).Return([]*entities.MapRevision{revision}, nil)
+
+	_, err = s.uc.Execute(context.Background(), RevertMapInput{MapId: "map-uuid", UserId: "owner-uuid", Revision: 0})
+
+	s.Require().Error(err)
+	s.Equal("1 location(s) of revision 0 are outside the boundary: pano-2", err.Error())
+}
+
+func (s *RevertMapSuite) TestExecute_WhenRevisionIsAhead_ReturnsNotFound() {
+	_, err := s.uc.Execute(context.Background(), RevertMapInput{MapId: "map-uuid", UserId: "owner-uuid", Revision: 3})
+
+	s.Require().Error(err)
+	s.Equal("revision not found", err.Error())
+}
@@ -0,0 +1,105 @@
+package mapuc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type SearchMapsInput struct {
+	Query    string
+	Category string
+	Tags     []string
+	// CountryCode keeps the maps with locations in the country.
+	CountryCode string
+	// Creator is the username of the map owner.
+	Creator      string
+	MinLocations int64
+	MaxLocations int64
+	// Page is 1-based. Zero values fall back to the first page of defaultMapsPageSize maps.
+	Page     int
+	PageSize int
+}
+
+type MapSearchResultOutput struct {
+	MapOutput
+	LocationCount int64 `json:"location_count"`
+}
+
+type SearchMapsOutput struct {
+	Maps     []MapSearchResultOutput `json:"maps"`
+	Total    int64                   `json:"total"`
+	Page     int                     `json:"page"`
+	PageSize int                     `json:"page_size"`
+}
+
+type SearchMapsUseCase struct {
+	mapSearch      repositories.MapSearch
+	userRepository repositories.UserRepository
+}
+
+func NewSearchMapsUseCase(mapSearch repositories.MapSearch, userRepository repositories.UserRepository) *SearchMapsUseCase {
+	return &SearchMapsUseCase{
+		mapSearch:      mapSearch,
+		userRepository: userRepository,
+	}
+}
+
+// Execute searches the public maps. With a query, maps come by relevance; without one, most
+// recently published first.
+func (uc *SearchMapsUseCase) Execute(ctx context.Context, input SearchMapsInput) (SearchMapsOutput, error) {
+	page := max(input.Page, 1)
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultMapsPageSize
+	}
+	pageSize = min(pageSize, maxMapsPageSize)
+	output := SearchMapsOutput{Maps: []MapSearchResultOutput{}, Page: page, PageSize: pageSize}
+
+	query := repositories.MapSearchQuery{
+		Text:         strings.TrimSpace(input.Query),
+		CountryCode:  strings.ToUpper(input.CountryCode),
+		MinLocations: input.MinLocations,
+		MaxLocations: input.MaxLocations,
+		Offset:       (page - 1) * pageSize,
+		Limit:        pageSize,
+	}
+	if input.Category != "" {
+		category, ok := entities.ParseMapCategory(input.Category)
+		if !ok {
+			return SearchMapsOutput{}, coreerrors.BadRequest("invalid category")
+		}
+		query.Category = category
+	}
+	tags, err := entities.NormalizeMapTags(input.Tags)
+	if err != nil {
+		return SearchMapsOutput{}, err
+	}
+	query.Tags = tags
+	if input.MaxLocations > 0 && input.MinLocations > input.MaxLocations {
+		return SearchMapsOutput{}, coreerrors.BadRequest("min locations cannot exceed max locations")
+	}
+	if input.Creator != "" {
+		creator, err := uc.userRepository.FindByUsername(ctx, input.Creator)
+		if err != nil {
+			return SearchMapsOutput{}, err
+		}
+		if creator == nil {
+			return output, nil
+		}
+		query.CreatorId = creator.ID
+	}
+
+	hits, total, err := uc.mapSearch.Search(ctx, query)
+	if err != nil {
+		return SearchMapsOutput{}, err
+	}
+	output.Total = total
+	for _, hit := range hits {
+		output.Maps = append(output.Maps, MapSearchResultOutput{MapOutput: toMapOutput(hit.Map), LocationCount: hit.LocationCount})
+	}
+	return output, nil
+}
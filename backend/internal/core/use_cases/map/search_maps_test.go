@@ -0,0 +1,109 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/memory"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchMapsSuite struct {
+	suite.Suite
+	search   *memory.MapMemorySearch
+	userRepo *repomocks.MockUserRepository
+	uc       *SearchMapsUseCase
+}
+
+func TestSearchMapsSuite(t *testing.T) {
+	suite.Run(t, new(SearchMapsSuite))
+}
+
+func (s *SearchMapsSuite) SetupTest() {
+	s.search = memory.NewMapMemorySearch()
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.uc = NewSearchMapsUseCase(s.search, s.userRepo)
+
+	published := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s.put("map-paris", "Paris streets", "Cobblestones and cafes", "alice-uuid", entities.MapCategoryUrban, []string{"france", "city"}, published, "FR", 6)
+	s.put("map-alps", "Alpine villages", "Small villages around Paris and Lyon", "bob-uuid", entities.MapCategoryRural, []string{"france", "mountains"}, published.Add(time.Hour), "FR", 12)
+	s.put("map-tokyo", "Tokyo", "Neon nights", "alice-uuid", entities.MapCategoryUrban, []string{"japan", "city"}, published.Add(2*time.Hour), "JP", 30)
+	private := entities.NewMap("Paris secrets", "", "alice-uuid")
+	private.ID = "map-private"
+	s.search.Put(private, nil)
+}
+
+func (s *SearchMapsSuite) put(id, name, description, ownerId string, category entities.MapCategory, tags []string, publishedAt time.Time, countryCode string, locations int) {
+	m := entities.NewMap(name, description, ownerId)
+	m.ID = id
+	m.Visibility = entities.MapVisibilityPublic
+	m.PublishedAt = &publishedAt
+	s.Require().NoError(m.Classify(string(category), tags))
+	ls := make([]*entities.Location, locations)
+	for i := range ls {
+		ls[i] = entities.NewLocation("pano", id, 0, 0, 0, 0)
+		ls[i].CountryCode = countryCode
+	}
+	s.search.Put(m, ls)
+}
+
+func (s *SearchMapsSuite) ids(output SearchMapsOutput) []string {
+	ids := make([]string, len(output.Maps))
+	for i, m := range output.Maps {
+		ids[i] = m.ID
+	}
+	return ids
+}
+
+func (s *SearchMapsSuite) TestExecute_RanksNameMatchesFirst() {
+	output, err := s.uc.Execute(context.Background(), SearchMapsInput{Query: "paris"})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"map-paris", "map-alps"}, s.ids(output))
+	s.Equal(int64(2), output.Total)
+}
+
+func (s *SearchMapsSuite) TestExecute_WithoutQuery_ListsLatestFirst() {
+	output, err := s.uc.Execute(context.Background(), SearchMapsInput{Tags: []string{"City"}})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"map-tokyo", "map-paris"}, s.ids(output))
+}
+
+func (s *SearchMapsSuite) TestExecute_FiltersByCategoryCountryAndLocationCount() {
+	output, err := s.uc.Execute(context.Background(), SearchMapsInput{Category: "urban", CountryCode: "fr", MinLocations: 5, MaxLocations: 10})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"map-paris"}, s.ids(output))
+	s.Equal(int64(6), output.Maps[0].LocationCount)
+}
+
+func (s *SearchMapsSuite) TestExecute_FiltersByCreator() {
+	s.userRepo.EXPECT().FindByUsername(mock.Anything, "bob").Return(entities.RestoreUser("bob-uuid", "Bob", "bob@example.com", "bob", "hash"), nil)
+
+	output, err := s.uc.Execute(context.Background(), SearchMapsInput{Creator: "bob"})
+
+	s.Require().NoError(err)
+	s.Equal([]string{"map-alps"}, s.ids(output))
+}
+
+func (s *SearchMapsSuite) TestExecute_UnknownCreator_ReturnsNoMaps() {
+	s.userRepo.EXPECT().FindByUsername(mock.Anything, "nobody").Return(nil, nil)
+
+	output, err := s.uc.Execute(context.Background(), SearchMapsInput{Creator: "nobody"})
+
+	s.Require().NoError(err)
+	s.Empty(output.Maps)
+	s.Equal(int64(0), output.Total)
+}
+
+func (s *SearchMapsSuite) TestExecute_InvalidCategory_ReturnsBadRequest() {
+	_, err := s.uc.Execute(context.Background(), SearchMapsInput{Category: "space"})
+
+	s.Require().Error(err)
+	s.Equal("invalid category", err.Error())
+}
@@ -0,0 +1,83 @@
+package mapuc
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type TransferMapOwnershipInput struct {
+	MapId    string
+	UserId   string
+	Username string
+}
+
+type TransferMapOwnershipUseCase struct {
+	mapRepository          repositories.MapRepository
+	userRepository         repositories.UserRepository
+	invitationRepository   repositories.MapInvitationRepository
+	mapAuthorization       *services.MapAuthorizationService
+	txManager              transactions.TransactionManager
+	notifier               services.Notifier
+}
+
+func NewTransferMapOwnershipUseCase(
+	mapRepository repositories.MapRepository,
+	userRepository repositories.UserRepository,
+	invitationRepository repositories.MapInvitationRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	txManager transactions.TransactionManager,
+	notifier services.Notifier,
+) *TransferMapOwnershipUseCase {
+	return &TransferMapOwnershipUseCase{
+		mapRepository:          mapRepository,
+		userRepository:         userRepository,
+		invitationRepository:   invitationRepository,
+		mapAuthorization:       mapAuthorization,
+		txManager:              txManager,
+		notifier:               notifier,
+	}
+}
+
+// Execute offers the map to the user with Username, who only becomes its owner once they accept
+// the invitation with AcceptMapInvitationUseCase.
+func (uc *TransferMapOwnershipUseCase) Execute(ctx context.Context, input TransferMapOwnershipInput) (MapInvitationOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleOwner)
+	if err != nil {
+		return MapInvitationOutput{}, err
+	}
+	target, err := uc.userRepository.FindByUsername(ctx, input.Username)
+	if err != nil {
+		return MapInvitationOutput{}, err
+	}
+	if target == nil || target.IsBanned() {
+		return MapInvitationOutput{}, coreerrors.NotFound("user not found")
+	}
+	if m.IsOwnedBy(target.ID) {
+		return MapInvitationOutput{}, coreerrors.BadRequest("you already own this map")
+	}
+	existing, err := uc.mapRepository.FindByOwnerIdAndName(ctx, target.ID, m.Name)
+	if err != nil {
+		return MapInvitationOutput{}, err
+	}
+	if existing != nil {
+		return MapInvitationOutput{}, coreerrors.Conflict("the new owner already has a map with this name")
+	}
+
+	invitation := entities.NewMapInvitation(m.ID, target.ID, entities.MapRoleOwner, input.UserId)
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.invitationRepository.Save(ctx, invitation); err != nil {
+			return err
+		}
+		return uc.notifier.Notify(ctx, entities.NewNotification(target.ID, entities.NotificationTypeMapInvitation, input.UserId, m.ID))
+	})
+	if err != nil {
+		return MapInvitationOutput{}, err
+	}
+	invitation.Map = m
+	return toMapInvitationOutput(invitation), nil
+}
@@ -0,0 +1,86 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransferMapOwnershipSuite struct {
+	suite.Suite
+	mapRepo    *repomocks.MockMapRepository
+	userRepo   *repomocks.MockUserRepository
+	collabRepo *repomocks.MockMapCollaboratorRepository
+	inviteRepo *repomocks.MockMapInvitationRepository
+	txManager  *txmocks.MockTransactionManager
+	notifier   *servicemocks.MockNotifier
+	uc         *TransferMapOwnershipUseCase
+	m          *entities.Map
+}
+
+func TestTransferMapOwnershipSuite(t *testing.T) {
+	suite.Run(t, new(TransferMapOwnershipSuite))
+}
+
+func (s *TransferMapOwnershipSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.inviteRepo = repomocks.NewMockMapInvitationRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.notifier = servicemocks.NewMockNotifier(s.T())
+	s.uc = NewTransferMapOwnershipUseCase(s.mapRepo, s.userRepo, s.inviteRepo, services.NewMapAuthorizationService(s.collabRepo), s.txManager, s.notifier)
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil)
+	s.userRepo.EXPECT().FindByUsername(mock.Anything, "bob").Return(entities.RestoreUser("bob-uuid", "Bob", "bob@example.com", "bob", "hash"), nil).Maybe()
+}
+
+func (s *TransferMapOwnershipSuite) TestExecute_OffersTheMapWithoutTransferringIt() {
+	passThroughTx(s.txManager)
+	s.mapRepo.EXPECT().FindByOwnerIdAndName(mock.Anything, "bob-uuid", "Brazil").Return(nil, nil)
+	s.inviteRepo.EXPECT().
+		Save(mock.Anything, mock.MatchedBy(func(i *entities.MapInvitation) bool {
+			return i.UserId == "bob-uuid" && i.IsOwnershipTransfer() && i.InvitedById == "owner-uuid"
+		})).
+		Return(nil)
+	s.notifier.EXPECT().
+		Notify(mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == "bob-uuid" && n.Type == entities.NotificationTypeMapInvitation && n.SubjectId == "map-uuid"
+		})).
+		Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), TransferMapOwnershipInput{MapId: "map-uuid", UserId: "owner-uuid", Username: "bob"})
+
+	s.Require().NoError(err)
+	s.Equal("bob-uuid", output.UserId)
+	s.Equal("Brazil", output.MapName)
+	s.Equal(entities.MapRoleOwner, output.Role)
+	s.Equal("owner-uuid", s.m.OwnerId)
+}
+
+func (s *TransferMapOwnershipSuite) TestExecute_WhenNewOwnerHasSameName_ReturnsConflict() {
+	s.mapRepo.EXPECT().FindByOwnerIdAndName(mock.Anything, "bob-uuid", "Brazil").Return(entities.RestoreMap("bob-map", "Brazil", "", "bob-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), TransferMapOwnershipInput{MapId: "map-uuid", UserId: "owner-uuid", Username: "bob"})
+
+	s.Require().Error(err)
+	s.Equal("the new owner already has a map with this name", err.Error())
+}
+
+func (s *TransferMapOwnershipSuite) TestExecute_WhenEditor_ReturnsForbidden() {
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "editor-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "editor-uuid", entities.MapRoleEditor, "owner-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), TransferMapOwnershipInput{MapId: "map-uuid", UserId: "editor-uuid", Username: "bob"})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions on this map", err.Error())
+}
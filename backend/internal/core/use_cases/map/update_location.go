@@ -0,0 +1,117 @@
+package mapuc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+// UpdateLocationInput leaves nil fields unchanged. The panorama of a location cannot change, nor
+// its provider.
+type UpdateLocationInput struct {
+	MapId       string
+	UserId      string
+	LocationId  string
+	Latitude    *float64
+	Longitude   *float64
+	Heading     *float64
+	Pitch       *float64
+	CountryCode *string
+	CaptureDate *string
+	Tags        *[]string
+	Note        *string
+}
+
+type UpdateLocationUseCase struct {
+	mapRepository      repositories.MapRepository
+	locationRepository repositories.LocationRepository
+	revisionRepository repositories.MapRevisionRepository
+	mapAuthorization   *services.MapAuthorizationService
+	txManager          transactions.TransactionManager
+}
+
+func NewUpdateLocationUseCase(
+	mapRepository repositories.MapRepository,
+	locationRepository repositories.LocationRepository,
+	revisionRepository repositories.MapRevisionRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	txManager transactions.TransactionManager,
+) *UpdateLocationUseCase {
+	return &UpdateLocationUseCase{
+		mapRepository:      mapRepository,
+		locationRepository: locationRepository,
+		revisionRepository: revisionRepository,
+		mapAuthorization:   mapAuthorization,
+		txManager:          txManager,
+	}
+}
+
+func (uc *UpdateLocationUseCase) Execute(ctx context.Context, input UpdateLocationInput) (LocationOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleEditor)
+	if err != nil {
+		return LocationOutput{}, err
+	}
+
+	var location *entities.Location
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		revision, err := beginRevision(ctx, uc.mapRepository, m.ID, input.UserId)
+		if err != nil {
+			return err
+		}
+		location, err = uc.locationRepository.FindByIdAndMapId(ctx, input.LocationId, m.ID)
+		if err != nil {
+			return err
+		}
+		if location == nil {
+			return coreerrors.NotFound("location not found")
+		}
+		before := *location
+		if input.Latitude != nil {
+			location.Latitude = *input.Latitude
+		}
+		if input.Longitude != nil {
+			location.Longitude = *input.Longitude
+		}
+		if input.Heading != nil {
+			location.Heading = *input.Heading
+		}
+		if input.Pitch != nil {
+			location.Pitch = *input.Pitch
+		}
+		if input.CountryCode != nil {
+			location.CountryCode = strings.ToUpper(*input.CountryCode)
+		}
+		if input.CaptureDate != nil {
+			location.CaptureDate = *input.CaptureDate
+		}
+		if input.Tags != nil {
+			location.Tags = entities.NormalizeLocationTags(*input.Tags)
+		}
+		if input.Note != nil {
+			location.Note = strings.TrimSpace(*input.Note)
+		}
+		if entities.SnapshotLocation(location).Equal(entities.SnapshotLocation(&before)) {
+			return coreerrors.BadRequest("nothing to update")
+		}
+		if err := location.Validate(); err != nil {
+			return err
+		}
+		if !m.InBoundary(location.Latitude, location.Longitude) {
+			return coreerrors.BadRequest("location is outside the map boundary")
+		}
+		if err := uc.locationRepository.Update(ctx, location); err != nil {
+			return err
+		}
+		revision.Changes = []*entities.MapRevisionChange{entities.NewLocationEditedChange(&before, location)}
+		return uc.revisionRepository.Create(ctx, revision)
+	})
+	if err != nil {
+		return LocationOutput{}, err
+	}
+	return toLocationOutput(location), nil
+}
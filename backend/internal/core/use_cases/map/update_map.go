@@ -0,0 +1,88 @@
+package mapuc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+// UpdateMapInput leaves nil fields unchanged.
+type UpdateMapInput struct {
+	MapId       string
+	UserId      string
+	Name        *string
+	Description *string
+	Category    *string
+	Tags        *[]string
+	// AllowRepeatLocations is owner only, like renaming.
+	AllowRepeatLocations *bool
+}
+
+type UpdateMapUseCase struct {
+	mapRepository    repositories.MapRepository
+	mapAuthorization *services.MapAuthorizationService
+}
+
+func NewUpdateMapUseCase(mapRepository repositories.MapRepository, mapAuthorization *services.MapAuthorizationService) *UpdateMapUseCase {
+	return &UpdateMapUseCase{
+		mapRepository:    mapRepository,
+		mapAuthorization: mapAuthorization,
+	}
+}
+
+// Execute lets editors change the description, category and tags; renaming the map and allowing
+// repeat locations are left to its owner.
+func (uc *UpdateMapUseCase) Execute(ctx context.Context, input UpdateMapInput) (MapOutput, error) {
+	m, err := findAuthorizedMap(ctx, uc.mapRepository, uc.mapAuthorization, input.MapId, input.UserId, entities.MapRoleEditor)
+	if err != nil {
+		return MapOutput{}, err
+	}
+
+	if input.Name != nil && *input.Name != m.Name {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return MapOutput{}, coreerrors.BadRequest("name cannot be empty")
+		}
+		if err := uc.mapAuthorization.Authorize(ctx, m, input.UserId, entities.MapRoleOwner); err != nil {
+			return MapOutput{}, err
+		}
+		existing, err := uc.mapRepository.FindByOwnerIdAndName(ctx, m.OwnerId, name)
+		if err != nil {
+			return MapOutput{}, err
+		}
+		if existing != nil && existing.ID != m.ID {
+			return MapOutput{}, coreerrors.Conflict("map with this name already exists")
+		}
+		m.Name = name
+	}
+	if input.AllowRepeatLocations != nil && *input.AllowRepeatLocations != m.AllowRepeatLocations {
+		if err := uc.mapAuthorization.Authorize(ctx, m, input.UserId, entities.MapRoleOwner); err != nil {
+			return MapOutput{}, err
+		}
+		m.AllowRepeatLocations = *input.AllowRepeatLocations
+	}
+	if input.Description != nil {
+		m.Description = *input.Description
+	}
+	if input.Category != nil || input.Tags != nil {
+		category, tags := string(m.Category), []string(m.Tags)
+		if input.Category != nil {
+			category = *input.Category
+		}
+		if input.Tags != nil {
+			tags = *input.Tags
+		}
+		if err := m.Classify(category, tags); err != nil {
+			return MapOutput{}, err
+		}
+	}
+
+	if err := uc.mapRepository.Update(ctx, m); err != nil {
+		return MapOutput{}, err
+	}
+	return toMapOutput(m), nil
+}
@@ -0,0 +1,107 @@
+package mapuc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateMapSuite struct {
+	suite.Suite
+	mapRepo    *repomocks.MockMapRepository
+	collabRepo *repomocks.MockMapCollaboratorRepository
+	uc         *UpdateMapUseCase
+	m          *entities.Map
+}
+
+func TestUpdateMapSuite(t *testing.T) {
+	suite.Run(t, new(UpdateMapSuite))
+}
+
+func (s *UpdateMapSuite) SetupTest() {
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.collabRepo = repomocks.NewMockMapCollaboratorRepository(s.T())
+	s.uc = NewUpdateMapUseCase(s.mapRepo, services.NewMapAuthorizationService(s.collabRepo))
+	s.m = entities.NewMap("Brazil", "desc", "owner-uuid")
+	s.m.ID = "map-uuid"
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.m, nil).Maybe()
+	s.collabRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "editor-uuid").
+		Return(entities.NewMapCollaborator("map-uuid", "editor-uuid", entities.MapRoleEditor, "owner-uuid"), nil).Maybe()
+}
+
+func (s *UpdateMapSuite) TestExecute_EditorChangesDescription() {
+	description := "new desc"
+	s.mapRepo.EXPECT().Update(mock.Anything, s.m).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), UpdateMapInput{MapId: "map-uuid", UserId: "editor-uuid", Description: &description})
+
+	s.Require().NoError(err)
+	s.Equal("new desc", output.Description)
+}
+
+func (s *UpdateMapSuite) TestExecute_EditorRetagsKeepingCategory() {
+	s.Require().NoError(s.m.Classify("country", []string{"brazil"}))
+	tags := []string{"Brazil", "beaches"}
+	s.mapRepo.EXPECT().Update(mock.Anything, s.m).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), UpdateMapInput{MapId: "map-uuid", UserId: "editor-uuid", Tags: &tags})
+
+	s.Require().NoError(err)
+	s.Equal(entities.MapCategoryCountry, output.Category)
+	s.Equal(entities.MapTags{"brazil", "beaches"}, output.Tags)
+}
+
+func (s *UpdateMapSuite) TestExecute_EditorCannotRename() {
+	name := "Chile"
+
+	_, err := s.uc.Execute(context.Background(), UpdateMapInput{MapId: "map-uuid", UserId: "editor-uuid", Name: &name})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions on this map", err.Error())
+}
+
+func (s *UpdateMapSuite) TestExecute_EditorCannotAllowRepeatLocations() {
+	allow := true
+
+	_, err := s.uc.Execute(context.Background(), UpdateMapInput{MapId: "map-uuid", UserId: "editor-uuid", AllowRepeatLocations: &allow})
+
+	s.Require().Error(err)
+	s.Equal("insufficient permissions on this map", err.Error())
+	s.False(s.m.AllowRepeatLocations)
+}
+
+func (s *UpdateMapSuite) TestExecute_OwnerAllowsRepeatLocations() {
+	allow := true
+	s.mapRepo.EXPECT().Update(mock.Anything, s.m).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), UpdateMapInput{MapId: "map-uuid", UserId: "owner-uuid", AllowRepeatLocations: &allow})
+
+	s.Require().NoError(err)
+	s.True(output.AllowRepeatLocations)
+}
+
+func (s *UpdateMapSuite) TestExecute_OwnerRenames() {
+	name := "Chile"
+	s.mapRepo.EXPECT().FindByOwnerIdAndName(mock.Anything, "owner-uuid", "Chile").Return(nil, nil)
+	s.mapRepo.EXPECT().Update(mock.Anything, s.m).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), UpdateMapInput{MapId: "map-uuid", UserId: "owner-uuid", Name: &name})
+
+	s.Require().NoError(err)
+	s.Equal("Chile", output.Name)
+}
+
+func (s *UpdateMapSuite) TestExecute_RenameToTakenName_ReturnsConflict() {
+	name := "Chile"
+	s.mapRepo.EXPECT().FindByOwnerIdAndName(mock.Anything, "owner-uuid", "Chile").Return(entities.RestoreMap("other-map", "Chile", "", "owner-uuid"), nil)
+
+	_, err := s.uc.Execute(context.Background(), UpdateMapInput{MapId: "map-uuid", UserId: "owner-uuid", Name: &name})
+
+	s.Require().Error(err)
+	s.Equal("map with this name already exists", err.Error())
+}
@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type ListNotificationsInput struct {
+	UserId     string
+	UnreadOnly bool
+	// Page is 1-based. Zero values fall back to the first page of defaultPageSize notifications.
+	Page     int
+	PageSize int
+}
+
+type NotificationOutput struct {
+	ID            string                    `json:"id"`
+	Type          entities.NotificationType `json:"type"`
+	ActorId       *string                   `json:"actor_id"`
+	ActorUsername string                    `json:"actor_username,omitempty"`
+	SubjectId     string                    `json:"subject_id"`
+	Read          bool                      `json:"read"`
+	ReadAt        *time.Time                `json:"read_at"`
+	CreatedAt     time.Time                 `json:"created_at"`
+}
+
+type ListNotificationsOutput struct {
+	Notifications []NotificationOutput `json:"notifications"`
+	Total         int64                `json:"total"`
+	Unread        int64                `json:"unread"`
+	Page          int                  `json:"page"`
+	PageSize      int                  `json:"page_size"`
+}
+
+type ListNotificationsUseCase struct {
+	notificationRepository repositories.NotificationRepository
+}
+
+func NewListNotificationsUseCase(notificationRepository repositories.NotificationRepository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{notificationRepository: notificationRepository}
+}
+
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, input ListNotificationsInput) (ListNotificationsOutput, error) {
+	page := max(input.Page, 1)
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	notifications, err := uc.notificationRepository.FindByUserId(ctx, input.UserId, input.UnreadOnly, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return ListNotificationsOutput{}, err
+	}
+	total, err := uc.notificationRepository.CountByUserId(ctx, input.UserId, input.UnreadOnly)
+	if err != nil {
+		return ListNotificationsOutput{}, err
+	}
+	unread := total
+	if !input.UnreadOnly {
+		unread, err = uc.notificationRepository.CountByUserId(ctx, input.UserId, true)
+		if err != nil {
+			return ListNotificationsOutput{}, err
+		}
+	}
+
+	output := ListNotificationsOutput{
+		Notifications: make([]NotificationOutput, len(notifications)),
+		Total:         total,
+		Unread:        unread,
+		Page:          page,
+		PageSize:      pageSize,
+	}
+	for i, notification := range notifications {
+		output.Notifications[i] = ToNotificationOutput(notification)
+	}
+	return output, nil
+}
+
+// ToNotificationOutput maps a notification for the inbox and the stream alike.
+func ToNotificationOutput(notification *entities.Notification) NotificationOutput {
+	output := NotificationOutput{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		ActorId:   notification.ActorId,
+		SubjectId: notification.SubjectId,
+		Read:      notification.IsRead(),
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+	if notification.Actor != nil {
+		output.ActorUsername = notification.Actor.Username
+	}
+	return output
+}
@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ListNotificationsSuite struct {
+	suite.Suite
+	notificationRepo *repomocks.MockNotificationRepository
+	uc               *ListNotificationsUseCase
+}
+
+func TestListNotificationsSuite(t *testing.T) {
+	suite.Run(t, new(ListNotificationsSuite))
+}
+
+func (s *ListNotificationsSuite) SetupTest() {
+	s.notificationRepo = repomocks.NewMockNotificationRepository(s.T())
+	s.uc = NewListNotificationsUseCase(s.notificationRepo)
+}
+
+func (s *ListNotificationsSuite) TestExecute_ReturnsPageWithUnreadCount() {
+	notification := entities.NewNotification("bob-uuid", entities.NotificationTypeFriendRequest, "alice-uuid", "alice-uuid")
+	notification.Actor = entities.RestoreUser("alice-uuid", "Alice", "alice@example.com", "alice", "hash")
+	s.notificationRepo.EXPECT().FindByUserId(mock.Anything, "bob-uuid", false, 10, 10).Return([]*entities.Notification{notification}, nil)
+	s.notificationRepo.EXPECT().CountByUserId(mock.Anything, "bob-uuid", false).Return(int64(11), nil)
+	s.notificationRepo.EXPECT().CountByUserId(mock.Anything, "bob-uuid", true).Return(int64(4), nil)
+
+	output, err := s.uc.Execute(context.Background(), ListNotificationsInput{UserId: "bob-uuid", Page: 2, PageSize: 10})
+
+	s.Require().NoError(err)
+	s.Equal(int64(11), output.Total)
+	s.Equal(int64(4), output.Unread)
+	s.Require().Len(output.Notifications, 1)
+	s.Equal("alice", output.Notifications[0].ActorUsername)
+	s.False(output.Notifications[0].Read)
+}
+
+func (s *ListNotificationsSuite) TestExecute_UnreadOnly_CountsOnce() {
+	s.notificationRepo.EXPECT().FindByUserId(mock.Anything, "bob-uuid", true, 0, defaultPageSize).Return(nil, nil)
+	s.notificationRepo.EXPECT().CountByUserId(mock.Anything, "bob-uuid", true).Return(int64(0), nil).Once()
+
+	output, err := s.uc.Execute(context.Background(), ListNotificationsInput{UserId: "bob-uuid", UnreadOnly: true})
+
+	s.Require().NoError(err)
+	s.Empty(output.Notifications)
+	s.Equal(1, output.Page)
+	s.Equal(defaultPageSize, output.PageSize)
+}
+
+func (s *ListNotificationsSuite) TestExecute_WhenRepositoryFails_ReturnsError() {
+	s.notificationRepo.EXPECT().FindByUserId(mock.Anything, "bob-uuid", false, 0, defaultPageSize).Return(nil, errMock)
+
+	_, err := s.uc.Execute(context.Background(), ListNotificationsInput{UserId: "bob-uuid"})
+
+	s.ErrorIs(err, errMock)
+}
@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type MarkNotificationReadInput struct {
+	UserId         string
+	NotificationId string
+}
+
+type MarkNotificationReadUseCase struct {
+	notificationRepository repositories.NotificationRepository
+}
+
+func NewMarkNotificationReadUseCase(notificationRepository repositories.NotificationRepository) *MarkNotificationReadUseCase {
+	return &MarkNotificationReadUseCase{notificationRepository: notificationRepository}
+}
+
+func (uc *MarkNotificationReadUseCase) Execute(ctx context.Context, input MarkNotificationReadInput) error {
+	found, err := uc.notificationRepository.MarkRead(ctx, input.UserId, input.NotificationId, time.Now())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return coreerrors.NotFound("notification not found")
+	}
+	return nil
+}
+
+type MarkAllNotificationsReadOutput struct {
+	Updated int64 `json:"updated"`
+}
+
+type MarkAllNotificationsReadUseCase struct {
+	notificationRepository repositories.NotificationRepository
+}
+
+func NewMarkAllNotificationsReadUseCase(notificationRepository repositories.NotificationRepository) *MarkAllNotificationsReadUseCase {
+	return &MarkAllNotificationsReadUseCase{notificationRepository: notificationRepository}
+}
+
+func (uc *MarkAllNotificationsReadUseCase) Execute(ctx context.Context, userId string) (MarkAllNotificationsReadOutput, error) {
+	updated, err := uc.notificationRepository.MarkAllRead(ctx, userId, time.Now())
+	if err != nil {
+		return MarkAllNotificationsReadOutput{}, err
+	}
+	return MarkAllNotificationsReadOutput{Updated: updated}, nil
+}
@@ -0,0 +1,35 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+// NotificationService is the services.Notifier backed by the inbox table and a broker.
+type NotificationService struct {
+	notificationRepository repositories.NotificationRepository
+	broker                 services.NotificationBroker
+}
+
+func NewNotificationService(notificationRepository repositories.NotificationRepository, broker services.NotificationBroker) *NotificationService {
+	return &NotificationService{
+		notificationRepository: notificationRepository,
+		broker:                 broker,
+	}
+}
+
+// Notify stores notification and pushes it to the user's streams. Called inside a transaction, the
+// push waits for the commit, so clients are never told about a notification that was rolled back.
+func (s *NotificationService) Notify(ctx context.Context, notification *entities.Notification) error {
+	if err := s.notificationRepository.Create(ctx, notification); err != nil {
+		return err
+	}
+	transactions.AfterCommit(ctx, func() {
+		s.broker.Publish(notification)
+	})
+	return nil
+}
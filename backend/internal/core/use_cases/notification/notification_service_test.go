@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+var errMock = errors.New("mock error")
+
+type NotificationServiceSuite struct {
+	suite.Suite
+	notificationRepo *repomocks.MockNotificationRepository
+	broker           *servicemocks.MockNotificationBroker
+	service          *NotificationService
+}
+
+func TestNotificationServiceSuite(t *testing.T) {
+	suite.Run(t, new(NotificationServiceSuite))
+}
+
+func (s *NotificationServiceSuite) SetupTest() {
+	s.notificationRepo = repomocks.NewMockNotificationRepository(s.T())
+	s.broker = servicemocks.NewMockNotificationBroker(s.T())
+	s.service = NewNotificationService(s.notificationRepo, s.broker)
+}
+
+func (s *NotificationServiceSuite) TestNotify_StoresThenPublishes() {
+	notification := entities.NewNotification("bob-uuid", entities.NotificationTypeFriendRequest, "alice-uuid", "alice-uuid")
+	s.notificationRepo.EXPECT().Create(mock.Anything, notification).Return(nil)
+	s.broker.EXPECT().Publish(notification).Return()
+
+	err := s.service.Notify(context.Background(), notification)
+
+	s.NoError(err)
+}
+
+func (s *NotificationServiceSuite) TestNotify_InATransaction_PublishesOnceCommitted() {
+	notification := entities.NewNotification("bob-uuid", entities.NotificationTypeFriendRequest, "alice-uuid", "alice-uuid")
+	s.notificationRepo.EXPECT().Create(mock.Anything, notification).Return(nil)
+	ctx, hooks := transactions.WithCommitHooks(context.Background())
+
+	err := s.service.Notify(ctx, notification)
+
+	s.Require().NoError(err)
+	s.broker.AssertNotCalled(s.T(), "Publish", notification)
+	s.broker.EXPECT().Publish(notification).Return()
+	hooks.Run()
+}
+
+func (s *NotificationServiceSuite) TestNotify_WhenStoreFails_DoesNotPublish() {
+	notification := entities.NewNotification("bob-uuid", entities.NotificationTypeFriendRequest, "alice-uuid", "alice-uuid")
+	s.notificationRepo.EXPECT().Create(mock.Anything, notification).Return(errMock)
+
+	err := s.service.Notify(context.Background(), notification)
+
+	s.ErrorIs(err, errMock)
+}
@@ -2,19 +2,69 @@ package singleplayer
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
 	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
 )
 
+// roundsPerGame is the number of rounds of a single player game.
+const roundsPerGame = 5
+
+// SeenLocationsConfig bounds the locations of a player's earlier games on a map that new games on
+// the map avoid.
+type SeenLocationsConfig struct {
+	// Window is how far back the earlier games go.
+	Window time.Duration
+	// Limit caps the avoided locations, keeping the most recently seen, so that the query and the
+	// sampler stay cheap for players with many games on a map.
+	Limit int
+}
+
+func DefaultSeenLocationsConfig() SeenLocationsConfig {
+	return SeenLocationsConfig{
+		Window: 90 * 24 * time.Hour,
+		Limit:  500,
+	}
+}
+
+// SeenLocationsConfigFromEnv reads SEEN_LOCATIONS_WINDOW, a duration such as "720h", and
+// SEEN_LOCATIONS_LIMIT, keeping the defaults for unset variables.
+func SeenLocationsConfigFromEnv() (SeenLocationsConfig, error) {
+	config := DefaultSeenLocationsConfig()
+	if value := os.Getenv("SEEN_LOCATIONS_WINDOW"); value != "" {
+		window, err := time.ParseDuration(value)
+		if err != nil || window < 0 {
+			return SeenLocationsConfig{}, fmt.Errorf("invalid SEEN_LOCATIONS_WINDOW %q", value)
+		}
+		config.Window = window
+	}
+	if value := os.Getenv("SEEN_LOCATIONS_LIMIT"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return SeenLocationsConfig{}, fmt.Errorf("invalid SEEN_LOCATIONS_LIMIT %q", value)
+		}
+		config.Limit = limit
+	}
+	return config, nil
+}
+
 type CreateSinglePlayerGameInput struct {
 	UserId string
 	MapId string
 	Mode entities.SinglePlayerGameMode
 	RoundSecondsDuration int
+	// Difficulty targets the locations of the rounds: easy, medium, hard or mixed, any when empty.
+	Difficulty string
+	// Tags restricts the rounds to the locations having every one of the tags.
+	Tags []string
 }
 
 type CreateSinglePlayerGameOutput struct {
@@ -23,34 +73,92 @@ type CreateSinglePlayerGameOutput struct {
 	MapId string
 	Mode entities.SinglePlayerGameMode
 	CreatedAt time.Time
+	// Round is the first round, started with the game.
+	Round SinglePlayerRoundOutput
+}
+
+// SinglePlayerRoundOutput is a round to play: clients render its panorama without learning the
+// location.
+type SinglePlayerRoundOutput struct {
+	ID          string
+	RoundNumber int
+	Panorama    services.PanoramaPayload
+}
+
+func newSinglePlayerRoundOutput(panoramas *services.PanoramaRegistry, round *entities.SinglePlayerRound, location *entities.Location) (SinglePlayerRoundOutput, error) {
+	panorama, err := panoramas.Payload(location)
+	if err != nil {
+		return SinglePlayerRoundOutput{}, err
+	}
+	return SinglePlayerRoundOutput{ID: round.ID, RoundNumber: round.RoundNumber, Panorama: panorama}, nil
 }
 
 type CreateSinglePlayerGameUseCase struct {
 	singlePlayerGameRepository repositories.SinglePlayerGameRepository
 	singlePlayerRoundRepository repositories.SinglePlayerRoundRepository
-	locationRepository        repositories.LocationRepository
+	locationSampler           services.LocationSampler
+	mapRepository             repositories.MapRepository
+	mapAuthorization          *services.MapAuthorizationService
+	panoramas                 *services.PanoramaRegistry
 	txManager                 transactions.TransactionManager
+	seenLocations             SeenLocationsConfig
 }
 
 func NewCreateSinglePlayerGameUseCase(
 	singlePlayerGameRepository repositories.SinglePlayerGameRepository,
 	singlePlayerRoundRepository repositories.SinglePlayerRoundRepository,
-	locationRepository repositories.LocationRepository,
+	locationSampler services.LocationSampler,
+	mapRepository repositories.MapRepository,
+	mapAuthorization *services.MapAuthorizationService,
+	panoramas *services.PanoramaRegistry,
 	txManager transactions.TransactionManager,
+	seenLocations SeenLocationsConfig,
 ) *CreateSinglePlayerGameUseCase {
 	return &CreateSinglePlayerGameUseCase{
 		singlePlayerGameRepository: singlePlayerGameRepository,
-		locationRepository:        locationRepository,
+		locationSampler:           locationSampler,
 		singlePlayerRoundRepository: singlePlayerRoundRepository,
+		mapRepository:             mapRepository,
+		mapAuthorization:          mapAuthorization,
+		panoramas:                 panoramas,
 		txManager:                 txManager,
+		seenLocations:             seenLocations,
 	}
 }
 
 func (uc *CreateSinglePlayerGameUseCase) Execute(input CreateSinglePlayerGameInput) (CreateSinglePlayerGameOutput, error) {
 	ctx := context.Background()
 
+	difficulty, err := entities.ParseDifficultyTarget(input.Difficulty)
+	if err != nil {
+		return CreateSinglePlayerGameOutput{}, err
+	}
+	var tags entities.LocationTags
+	if len(input.Tags) > 0 {
+		tags = entities.NormalizeLocationTags(input.Tags)
+		if err := tags.Validate(); err != nil {
+			return CreateSinglePlayerGameOutput{}, err
+		}
+	}
+
+	gameMap, err := uc.mapRepository.FindById(ctx, input.MapId)
+	if err != nil {
+		return CreateSinglePlayerGameOutput{}, coreerrors.InternalServerError("failed to find map")
+	}
+	if gameMap == nil {
+		return CreateSinglePlayerGameOutput{}, coreerrors.NotFound("map not found")
+	}
+	playable, err := uc.mapAuthorization.CanPlay(ctx, gameMap, input.UserId)
+	if err != nil {
+		return CreateSinglePlayerGameOutput{}, coreerrors.InternalServerError("failed to authorize map")
+	}
+	// Private maps are reported missing so their existence does not leak.
+	if !playable {
+		return CreateSinglePlayerGameOutput{}, coreerrors.NotFound("map not found")
+	}
+
 	var output CreateSinglePlayerGameOutput
-	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
 		userAlreadyInGame, err := uc.singlePlayerGameRepository.FindByUserIdAndStatuses(ctx, input.UserId, []entities.SinglePlayerGameStatus{entities.SinglePlayerGameStatusInProgress, entities.SinglePlayerGameStatusPending})
 		if err != nil {
 			return coreerrors.InternalServerError("failed to find user in game")
@@ -60,12 +168,20 @@ func (uc *CreateSinglePlayerGameUseCase) Execute(input CreateSinglePlayerGameInp
 			return coreerrors.Conflict("user already in a game")
 		}
 
-		randomLocations, err := uc.locationRepository.FindRandomLocationByMapId(ctx, input.MapId, 5)
+		seenLocationIds, err := uc.findSeenLocationIds(ctx, gameMap, input.UserId)
+		if err != nil {
+			return coreerrors.InternalServerError("failed to find seen locations")
+		}
+		randomLocations, err := uc.sampleLocations(ctx, gameMap, difficulty, tags, seenLocationIds)
 		if err != nil {
 			return coreerrors.InternalServerError("failed to find random locations")
 		}
+		if len(tags) > 0 && len(randomLocations) < roundsPerGame {
+			return coreerrors.BadRequest(fmt.Sprintf("map has fewer than %d locations tagged %s", roundsPerGame, strings.Join(tags, ", ")))
+		}
 
 		newGame := entities.NewSinglePlayerGame(input.UserId, input.MapId, input.Mode, input.RoundSecondsDuration)
+		newGame.MapRevision = gameMap.Revision
 		newGame.AddRoundsFromLocations(randomLocations)
 		if err := uc.singlePlayerGameRepository.Create(ctx, newGame); err != nil {
 			return coreerrors.InternalServerError("failed to create game")
@@ -78,7 +194,10 @@ func (uc *CreateSinglePlayerGameUseCase) Execute(input CreateSinglePlayerGameInp
 		if err != nil {
 			return err
 		}
-
+		round, err := newSinglePlayerRoundOutput(uc.panoramas, nextRound, randomLocations[nextRound.RoundNumber-1])
+		if err != nil {
+			return err
+		}
 
 		if err := uc.singlePlayerGameRepository.Update(ctx, newGame); err != nil {
 			return coreerrors.InternalServerError("failed to update game")
@@ -93,6 +212,7 @@ func (uc *CreateSinglePlayerGameUseCase) Execute(input CreateSinglePlayerGameInp
 			MapId:     newGame.MapId,
 			Mode:      newGame.Mode,
 			CreatedAt: newGame.CreatedAt,
+			Round:     round,
 		}
 		return nil
 	})
@@ -102,3 +222,74 @@ func (uc *CreateSinglePlayerGameUseCase) Execute(input CreateSinglePlayerGameInp
 
 	return output, nil
 }
+
+// findSeenLocationIds returns the locations the new game should avoid. The sampler still falls back
+// on them, the ones seen longest ago first, once the rest of the map is used up.
+func (uc *CreateSinglePlayerGameUseCase) findSeenLocationIds(ctx context.Context, gameMap *entities.Map, userId string) ([]string, error) {
+	if gameMap.AllowRepeatLocations || uc.seenLocations.Limit == 0 {
+		return nil, nil
+	}
+	since := time.Now().Add(-uc.seenLocations.Window)
+	return uc.singlePlayerRoundRepository.FindSeenLocationIds(ctx, userId, gameMap.ID, since, uc.seenLocations.Limit)
+}
+
+type difficultyTarget struct {
+	difficulty entities.LocationDifficulty
+	rounds     int
+}
+
+// difficultyPlan splits the rounds of a game by difficulty. Mixed games go from easy to hard in
+// thirds.
+func difficultyPlan(difficulty entities.LocationDifficulty, rounds int) []difficultyTarget {
+	if difficulty != entities.LocationDifficultyMixed {
+		return []difficultyTarget{{difficulty: difficulty, rounds: rounds}}
+	}
+	plan := []difficultyTarget{
+		{difficulty: entities.LocationDifficultyEasy},
+		{difficulty: entities.LocationDifficultyMedium},
+		{difficulty: entities.LocationDifficultyHard},
+	}
+	for i := 0; i < rounds; i++ {
+		plan[i*len(plan)/rounds].rounds++
+	}
+	return plan
+}
+
+// sampleLocations draws the rounds of each difficulty of the plan in turn, among the locations
+// having the tags. When the map lacks locations of a difficulty, the missing rounds come from any
+// difficulty.
+func (uc *CreateSinglePlayerGameUseCase) sampleLocations(ctx context.Context, gameMap *entities.Map, difficulty entities.LocationDifficulty, tags []string, exclude []string) ([]*entities.Location, error) {
+	strata, err := uc.locationSampler.CountStrata(ctx, gameMap.ID, gameMap.Revision, tags)
+	if err != nil {
+		return nil, err
+	}
+	var picked []*entities.Location
+	for _, target := range difficultyPlan(difficulty, roundsPerGame) {
+		if target.rounds == 0 {
+			continue
+		}
+		request := services.LocationSampleRequest{
+			MapId:      gameMap.ID,
+			Quantity:   target.rounds,
+			Exclude:    exclude,
+			Difficulty: target.difficulty,
+			Tags:       tags,
+			Strata:     strata,
+			Picked:     picked,
+		}
+		locations, err := uc.locationSampler.Sample(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		picked = append(picked, locations...)
+		if missing := target.rounds - len(locations); missing > 0 && target.difficulty != "" {
+			request.Quantity, request.Difficulty, request.Picked = missing, "", picked
+			locations, err := uc.locationSampler.Sample(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			picked = append(picked, locations...)
+		}
+	}
+	return picked, nil
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
 	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -31,6 +34,9 @@ func passThroughTx(mockTx *txmocks.MockTransactionManager) {
 		})
 }
 
+// mapStrata are the strata of the map of defaultInput.
+var mapStrata = []repositories.LocationStratum{{GeoCell: 1, Count: 5}}
+
 func makeLocations(n int) []*entities.Location {
 	locations := make([]*entities.Location, n)
 	for i := 0; i < n; i++ {
@@ -46,6 +52,23 @@ func makeLocations(n int) []*entities.Location {
 	return locations
 }
 
+// playableMapRepo returns a map repository holding the public map of defaultInput.
+// playableMapRevision is the revision of the map of playableMapRepo, whose strata games count.
+const playableMapRevision = 4
+
+func playableMapRepo(s *CreateSinglePlayerGameSuite) *repomocks.MockMapRepository {
+	mockMapRepo := repomocks.NewMockMapRepository(s.T())
+	gameMap := entities.RestoreMap("map-uuid", "Map", "desc", "owner-uuid")
+	gameMap.Visibility = entities.MapVisibilityPublic
+	gameMap.Revision = playableMapRevision
+	mockMapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(gameMap, nil).Maybe()
+	return mockMapRepo
+}
+
+func googlePanoramas() *services.PanoramaRegistry {
+	return services.NewPanoramaRegistry(services.NewGooglePanoramaProvider())
+}
+
 func defaultInput() CreateSinglePlayerGameInput {
 	return CreateSinglePlayerGameInput{
 		UserId:               "user-uuid",
@@ -58,19 +81,20 @@ func defaultInput() CreateSinglePlayerGameInput {
 func (s *CreateSinglePlayerGameSuite) TestNewCreateSinglePlayerGameUseCase() {
 	var gameRepo repositories.SinglePlayerGameRepository = repomocks.NewMockSinglePlayerGameRepository(s.T())
 	var roundRepo repositories.SinglePlayerRoundRepository = repomocks.NewMockSinglePlayerRoundRepository(s.T())
-	var locationRepo repositories.LocationRepository = repomocks.NewMockLocationRepository(s.T())
+	var locationSampler services.LocationSampler = servicemocks.NewMockLocationSampler(s.T())
+	var mapRepo repositories.MapRepository = repomocks.NewMockMapRepository(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
 
-	uc := NewCreateSinglePlayerGameUseCase(gameRepo, roundRepo, locationRepo, mockTx)
+	uc := NewCreateSinglePlayerGameUseCase(gameRepo, roundRepo, locationSampler, mapRepo, services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
 	s.NotNil(uc)
 }
 
 func (s *CreateSinglePlayerGameSuite) TestExecute_WhenSuccess_CreatesGameAndStartsFirstRound() {
 	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
 	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
-	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockLocationRepo, mockTx)
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
 
 	input := defaultInput()
 	locations := makeLocations(5)
@@ -82,8 +106,14 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenSuccess_CreatesGameAndStar
 			entities.SinglePlayerGameStatusPending,
 		}).
 		Return((*entities.SinglePlayerGame)(nil), nil)
-	mockLocationRepo.EXPECT().
-		FindRandomLocationByMapId(mock.Anything, input.MapId, 5).
+	mockRoundRepo.EXPECT().
+		FindSeenLocationIds(mock.Anything, input.UserId, input.MapId, mock.MatchedBy(func(since time.Time) bool {
+			return time.Since(since).Round(time.Hour) == 90*24*time.Hour
+		}), 500).
+		Return([]string{"loc-seen"}, nil)
+	mockSampler.EXPECT().CountStrata(mock.Anything, input.MapId, playableMapRevision, []string(nil)).Return(mapStrata, nil).Once()
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 5, Exclude: []string{"loc-seen"}}).
 		Return(locations, nil)
 	mockGameRepo.EXPECT().
 		Create(mock.Anything, mock.MatchedBy(func(g *entities.SinglePlayerGame) bool {
@@ -115,14 +145,16 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenSuccess_CreatesGameAndStar
 	s.Equal(input.Mode, output.Mode)
 	s.NotEmpty(output.ID)
 	s.NotZero(output.CreatedAt)
+	s.Equal(1, output.Round.RoundNumber)
+	s.Equal(services.PanoramaPayload{Provider: entities.PanoramaProviderGoogle, PanoId: "pano-id"}, output.Round.Panorama)
 }
 
 func (s *CreateSinglePlayerGameSuite) TestExecute_WhenFindByUserIdAndStatusesFails_ReturnsError() {
 	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
 	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
-	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockLocationRepo, mockTx)
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
 
 	input := defaultInput()
 
@@ -141,9 +173,9 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenFindByUserIdAndStatusesFai
 func (s *CreateSinglePlayerGameSuite) TestExecute_WhenUserAlreadyInGame_ReturnsConflict() {
 	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
 	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
-	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockLocationRepo, mockTx)
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
 
 	input := defaultInput()
 	existingGame := entities.NewSinglePlayerGame(input.UserId, input.MapId, entities.SinglePlayerGameModeMove, 60)
@@ -160,12 +192,63 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenUserAlreadyInGame_ReturnsC
 	s.Equal(CreateSinglePlayerGameOutput{}, output)
 }
 
+func (s *CreateSinglePlayerGameSuite) TestExecute_WhenMapAllowsRepeats_DoesNotExcludeSeenLocations() {
+	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	mockMapRepo := repomocks.NewMockMapRepository(s.T())
+	gameMap := entities.RestoreMap("map-uuid", "Map", "desc", "owner-uuid")
+	gameMap.Visibility = entities.MapVisibilityPublic
+	gameMap.AllowRepeatLocations = true
+	gameMap.Revision = playableMapRevision
+	mockMapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(gameMap, nil)
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, repomocks.NewMockSinglePlayerRoundRepository(s.T()), mockSampler, mockMapRepo, services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
+
+	input := defaultInput()
+
+	passThroughTx(mockTx)
+	mockGameRepo.EXPECT().
+		FindByUserIdAndStatuses(mock.Anything, input.UserId, mock.Anything).
+		Return((*entities.SinglePlayerGame)(nil), nil)
+	mockSampler.EXPECT().CountStrata(mock.Anything, input.MapId, playableMapRevision, []string(nil)).Return(mapStrata, nil).Once()
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 5}).
+		Return(nil, errMock)
+
+	_, err := uc.Execute(input)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "failed to find random locations")
+}
+
+func (s *CreateSinglePlayerGameSuite) TestExecute_WhenFindSeenLocationIdsFails_ReturnsError() {
+	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
+	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, servicemocks.NewMockLocationSampler(s.T()), playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
+
+	input := defaultInput()
+
+	passThroughTx(mockTx)
+	mockGameRepo.EXPECT().
+		FindByUserIdAndStatuses(mock.Anything, input.UserId, mock.Anything).
+		Return((*entities.SinglePlayerGame)(nil), nil)
+	mockRoundRepo.EXPECT().
+		FindSeenLocationIds(mock.Anything, input.UserId, input.MapId, mock.AnythingOfType("time.Time"), 500).
+		Return(nil, errMock)
+
+	_, err := uc.Execute(input)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "failed to find seen locations")
+}
+
 func (s *CreateSinglePlayerGameSuite) TestExecute_WhenFindRandomLocationsFails_ReturnsError() {
 	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
 	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
-	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockLocationRepo, mockTx)
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
 
 	input := defaultInput()
 
@@ -173,8 +256,12 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenFindRandomLocationsFails_R
 	mockGameRepo.EXPECT().
 		FindByUserIdAndStatuses(mock.Anything, input.UserId, mock.Anything).
 		Return((*entities.SinglePlayerGame)(nil), nil)
-	mockLocationRepo.EXPECT().
-		FindRandomLocationByMapId(mock.Anything, input.MapId, 5).
+	mockRoundRepo.EXPECT().
+		FindSeenLocationIds(mock.Anything, input.UserId, input.MapId, mock.AnythingOfType("time.Time"), 500).
+		Return([]string{"loc-seen"}, nil)
+	mockSampler.EXPECT().CountStrata(mock.Anything, input.MapId, playableMapRevision, []string(nil)).Return(mapStrata, nil).Once()
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 5, Exclude: []string{"loc-seen"}}).
 		Return(nil, errMock)
 
 	output, err := uc.Execute(input)
@@ -187,9 +274,9 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenFindRandomLocationsFails_R
 func (s *CreateSinglePlayerGameSuite) TestExecute_WhenGameCreateFails_ReturnsError() {
 	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
 	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
-	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockLocationRepo, mockTx)
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
 
 	input := defaultInput()
 	locations := makeLocations(5)
@@ -198,8 +285,12 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenGameCreateFails_ReturnsErr
 	mockGameRepo.EXPECT().
 		FindByUserIdAndStatuses(mock.Anything, input.UserId, mock.Anything).
 		Return((*entities.SinglePlayerGame)(nil), nil)
-	mockLocationRepo.EXPECT().
-		FindRandomLocationByMapId(mock.Anything, input.MapId, 5).
+	mockRoundRepo.EXPECT().
+		FindSeenLocationIds(mock.Anything, input.UserId, input.MapId, mock.AnythingOfType("time.Time"), 500).
+		Return([]string{"loc-seen"}, nil)
+	mockSampler.EXPECT().CountStrata(mock.Anything, input.MapId, playableMapRevision, []string(nil)).Return(mapStrata, nil).Once()
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 5, Exclude: []string{"loc-seen"}}).
 		Return(locations, nil)
 	mockGameRepo.EXPECT().
 		Create(mock.Anything, mock.Anything).
@@ -215,9 +306,9 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenGameCreateFails_ReturnsErr
 func (s *CreateSinglePlayerGameSuite) TestExecute_WhenGameUpdateFails_ReturnsError() {
 	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
 	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
-	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockLocationRepo, mockTx)
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
 
 	input := defaultInput()
 	locations := makeLocations(5)
@@ -226,8 +317,12 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenGameUpdateFails_ReturnsErr
 	mockGameRepo.EXPECT().
 		FindByUserIdAndStatuses(mock.Anything, input.UserId, mock.Anything).
 		Return((*entities.SinglePlayerGame)(nil), nil)
-	mockLocationRepo.EXPECT().
-		FindRandomLocationByMapId(mock.Anything, input.MapId, 5).
+	mockRoundRepo.EXPECT().
+		FindSeenLocationIds(mock.Anything, input.UserId, input.MapId, mock.AnythingOfType("time.Time"), 500).
+		Return([]string{"loc-seen"}, nil)
+	mockSampler.EXPECT().CountStrata(mock.Anything, input.MapId, playableMapRevision, []string(nil)).Return(mapStrata, nil).Once()
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 5, Exclude: []string{"loc-seen"}}).
 		Return(locations, nil)
 	mockGameRepo.EXPECT().
 		Create(mock.Anything, mock.Anything).
@@ -246,9 +341,9 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenGameUpdateFails_ReturnsErr
 func (s *CreateSinglePlayerGameSuite) TestExecute_WhenRoundUpdateFails_ReturnsError() {
 	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
 	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
-	mockLocationRepo := repomocks.NewMockLocationRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
 	mockTx := txmocks.NewMockTransactionManager(s.T())
-	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockLocationRepo, mockTx)
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
 
 	input := defaultInput()
 	locations := makeLocations(5)
@@ -257,8 +352,12 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenRoundUpdateFails_ReturnsEr
 	mockGameRepo.EXPECT().
 		FindByUserIdAndStatuses(mock.Anything, input.UserId, mock.Anything).
 		Return((*entities.SinglePlayerGame)(nil), nil)
-	mockLocationRepo.EXPECT().
-		FindRandomLocationByMapId(mock.Anything, input.MapId, 5).
+	mockRoundRepo.EXPECT().
+		FindSeenLocationIds(mock.Anything, input.UserId, input.MapId, mock.AnythingOfType("time.Time"), 500).
+		Return([]string{"loc-seen"}, nil)
+	mockSampler.EXPECT().CountStrata(mock.Anything, input.MapId, playableMapRevision, []string(nil)).Return(mapStrata, nil).Once()
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 5, Exclude: []string{"loc-seen"}}).
 		Return(locations, nil)
 	mockGameRepo.EXPECT().
 		Create(mock.Anything, mock.Anything).
@@ -276,3 +375,181 @@ func (s *CreateSinglePlayerGameSuite) TestExecute_WhenRoundUpdateFails_ReturnsEr
 	s.Contains(err.Error(), "failed to update round")
 	s.Equal(CreateSinglePlayerGameOutput{}, output)
 }
+
+func (s *CreateSinglePlayerGameSuite) TestExecute_WhenMapIsPrivateToSomeoneElse_ReturnsNotFound() {
+	mockMapRepo := repomocks.NewMockMapRepository(s.T())
+	privateMap := entities.NewMap("Map", "desc", "owner-uuid")
+	privateMap.ID = "map-uuid"
+	mockMapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(privateMap, nil)
+	mockCollaboratorRepo := repomocks.NewMockMapCollaboratorRepository(s.T())
+	mockCollaboratorRepo.EXPECT().FindByMapIdAndUserId(mock.Anything, "map-uuid", "user-uuid").Return(nil, nil)
+	uc := NewCreateSinglePlayerGameUseCase(
+		repomocks.NewMockSinglePlayerGameRepository(s.T()),
+		repomocks.NewMockSinglePlayerRoundRepository(s.T()),
+		servicemocks.NewMockLocationSampler(s.T()),
+		mockMapRepo,
+		services.NewMapAuthorizationService(mockCollaboratorRepo),
+		googlePanoramas(),
+		txmocks.NewMockTransactionManager(s.T()),
+		DefaultSeenLocationsConfig(),
+	)
+
+	_, err := uc.Execute(defaultInput())
+
+	s.Require().Error(err)
+	s.Equal("map not found", err.Error())
+}
+
+func (s *CreateSinglePlayerGameSuite) TestExecute_WhenMapIsMissing_ReturnsNotFound() {
+	mockMapRepo := repomocks.NewMockMapRepository(s.T())
+	mockMapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(nil, nil)
+	uc := NewCreateSinglePlayerGameUseCase(
+		repomocks.NewMockSinglePlayerGameRepository(s.T()),
+		repomocks.NewMockSinglePlayerRoundRepository(s.T()),
+		servicemocks.NewMockLocationSampler(s.T()),
+		mockMapRepo,
+		services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())),
+		googlePanoramas(),
+		txmocks.NewMockTransactionManager(s.T()),
+		DefaultSeenLocationsConfig(),
+	)
+
+	_, err := uc.Execute(defaultInput())
+
+	s.Require().Error(err)
+	s.Equal("map not found", err.Error())
+}
+
+func (s *CreateSinglePlayerGameSuite) TestExecute_WhenDifficultyRunsShort_FillsFromAnyDifficulty() {
+	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
+	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
+
+	input := defaultInput()
+	input.Difficulty = "hard"
+	locations := makeLocations(5)
+
+	passThroughTx(mockTx)
+	mockGameRepo.EXPECT().
+		FindByUserIdAndStatuses(mock.Anything, input.UserId, mock.Anything).
+		Return((*entities.SinglePlayerGame)(nil), nil)
+	mockRoundRepo.EXPECT().
+		FindSeenLocationIds(mock.Anything, input.UserId, input.MapId, mock.AnythingOfType("time.Time"), 500).
+		Return(nil, nil)
+	mockSampler.EXPECT().CountStrata(mock.Anything, input.MapId, playableMapRevision, []string(nil)).Return(mapStrata, nil).Once()
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 5, Difficulty: entities.LocationDifficultyHard}).
+		Return(locations[:3], nil)
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 2, Picked: locations[:3]}).
+		Return(locations[3:], nil)
+	mockGameRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(g *entities.SinglePlayerGame) bool {
+			return len(g.Rounds) == 5 && g.Rounds[4].LocationId == locations[4].ID
+		})).
+		Return(errMock)
+
+	_, err := uc.Execute(input)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "failed to create game")
+}
+
+func (s *CreateSinglePlayerGameSuite) TestExecute_WhenDifficultyIsUnknown_ReturnsBadRequest() {
+	uc := NewCreateSinglePlayerGameUseCase(
+		repomocks.NewMockSinglePlayerGameRepository(s.T()),
+		repomocks.NewMockSinglePlayerRoundRepository(s.T()),
+		servicemocks.NewMockLocationSampler(s.T()),
+		repomocks.NewMockMapRepository(s.T()),
+		services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())),
+		googlePanoramas(),
+		txmocks.NewMockTransactionManager(s.T()),
+		DefaultSeenLocationsConfig(),
+	)
+	input := defaultInput()
+	input.Difficulty = "insane"
+
+	_, err := uc.Execute(input)
+
+	s.Require().Error(err)
+	s.Equal("difficulty must be one of easy, medium, hard or mixed", err.Error())
+}
+
+func (s *CreateSinglePlayerGameSuite) TestExecute_WhenTaggedLocationsRunShort_ReturnsBadRequest() {
+	mockGameRepo := repomocks.NewMockSinglePlayerGameRepository(s.T())
+	mockRoundRepo := repomocks.NewMockSinglePlayerRoundRepository(s.T())
+	mockSampler := servicemocks.NewMockLocationSampler(s.T())
+	mockTx := txmocks.NewMockTransactionManager(s.T())
+	uc := NewCreateSinglePlayerGameUseCase(mockGameRepo, mockRoundRepo, mockSampler, playableMapRepo(s), services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())), googlePanoramas(), mockTx, DefaultSeenLocationsConfig())
+
+	input := defaultInput()
+	input.Tags = []string{"Snow", " coastal"}
+
+	passThroughTx(mockTx)
+	mockGameRepo.EXPECT().
+		FindByUserIdAndStatuses(mock.Anything, input.UserId, mock.Anything).
+		Return((*entities.SinglePlayerGame)(nil), nil)
+	mockRoundRepo.EXPECT().
+		FindSeenLocationIds(mock.Anything, input.UserId, input.MapId, mock.AnythingOfType("time.Time"), 500).
+		Return(nil, nil)
+	mockSampler.EXPECT().CountStrata(mock.Anything, input.MapId, playableMapRevision, []string{"snow", "coastal"}).Return(mapStrata, nil).Once()
+	mockSampler.EXPECT().
+		Sample(mock.Anything, services.LocationSampleRequest{MapId: input.MapId, Strata: mapStrata, Quantity: 5, Tags: []string{"snow", "coastal"}}).
+		Return(makeLocations(3), nil)
+
+	_, err := uc.Execute(input)
+
+	s.Require().Error(err)
+	s.Equal("map has fewer than 5 locations tagged snow, coastal", err.Error())
+}
+
+func (s *CreateSinglePlayerGameSuite) TestExecute_WhenTagIsInvalid_ReturnsBadRequest() {
+	uc := NewCreateSinglePlayerGameUseCase(
+		repomocks.NewMockSinglePlayerGameRepository(s.T()),
+		repomocks.NewMockSinglePlayerRoundRepository(s.T()),
+		servicemocks.NewMockLocationSampler(s.T()),
+		repomocks.NewMockMapRepository(s.T()),
+		services.NewMapAuthorizationService(repomocks.NewMockMapCollaboratorRepository(s.T())),
+		googlePanoramas(),
+		txmocks.NewMockTransactionManager(s.T()),
+		DefaultSeenLocationsConfig(),
+	)
+	input := defaultInput()
+	input.Tags = []string{"snow day"}
+
+	_, err := uc.Execute(input)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), `invalid tag: "snow day"`)
+}
+
+func (s *CreateSinglePlayerGameSuite) TestDifficultyPlan_MixedGoesFromEasyToHard() {
+	plan := difficultyPlan(entities.LocationDifficultyMixed, 5)
+
+	s.Equal([]difficultyTarget{
+		{difficulty: entities.LocationDifficultyEasy, rounds: 2},
+		{difficulty: entities.LocationDifficultyMedium, rounds: 2},
+		{difficulty: entities.LocationDifficultyHard, rounds: 1},
+	}, plan)
+}
+
+func (s *CreateSinglePlayerGameSuite) TestSeenLocationsConfigFromEnv() {
+	s.T().Setenv("SEEN_LOCATIONS_WINDOW", "720h")
+	s.T().Setenv("SEEN_LOCATIONS_LIMIT", "")
+
+	config, err := SeenLocationsConfigFromEnv()
+
+	s.Require().NoError(err)
+	s.Equal(SeenLocationsConfig{Window: 30 * 24 * time.Hour, Limit: 500}, config)
+}
+
+func (s *CreateSinglePlayerGameSuite) TestSeenLocationsConfigFromEnv_WhenLimitIsInvalid_ReturnsError() {
+	s.T().Setenv("SEEN_LOCATIONS_LIMIT", "many")
+
+	_, err := SeenLocationsConfigFromEnv()
+
+	s.Require().Error(err)
+	s.Equal(`invalid SEEN_LOCATIONS_LIMIT "many"`, err.Error())
+}
@@ -0,0 +1,74 @@
+package singleplayer
+
+import (
+	"context"
+	"strings"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type GetCurrentRoundInput struct {
+	GameId string
+	UserId string
+}
+
+// GetCurrentRoundUseCase returns the round a player is on, so that clients can resume a game.
+type GetCurrentRoundUseCase struct {
+	gameRepository  repositories.SinglePlayerGameRepository
+	roundRepository repositories.SinglePlayerRoundRepository
+	panoramas       *services.PanoramaRegistry
+	txManager       transactions.TransactionManager
+}
+
+func NewGetCurrentRoundUseCase(
+	gameRepository repositories.SinglePlayerGameRepository,
+	roundRepository repositories.SinglePlayerRoundRepository,
+	panoramas *services.PanoramaRegistry,
+	txManager transactions.TransactionManager,
+) *GetCurrentRoundUseCase {
+	return &GetCurrentRoundUseCase{
+		gameRepository:  gameRepository,
+		roundRepository: roundRepository,
+		panoramas:       panoramas,
+		txManager:       txManager,
+	}
+}
+
+func (uc *GetCurrentRoundUseCase) Execute(ctx context.Context, input GetCurrentRoundInput) (SinglePlayerRoundOutput, error) {
+	if strings.TrimSpace(input.GameId) == "" {
+		return SinglePlayerRoundOutput{}, coreerrors.BadRequest("game id is required")
+	}
+
+	var output SinglePlayerRoundOutput
+	// The game and round are read in one transaction so that a concurrent guess cannot advance the
+	// game between the two reads.
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		game, err := uc.gameRepository.FindByIdAndUserIdWithLock(ctx, input.GameId, input.UserId)
+		if err != nil {
+			return err
+		}
+		if game == nil {
+			return coreerrors.NotFound("game not found")
+		}
+		if !game.IsInProgress() {
+			return coreerrors.BadRequest("game is not in progress")
+		}
+
+		round, err := uc.roundRepository.FindByGameIdAndRoundNumberWithLock(ctx, game.ID, game.CurrentRound)
+		if err != nil {
+			return err
+		}
+		if round == nil || round.Location == nil {
+			return coreerrors.InternalServerError("current round not found")
+		}
+		output, err = newSinglePlayerRoundOutput(uc.panoramas, round, round.Location)
+		return err
+	})
+	if err != nil {
+		return SinglePlayerRoundOutput{}, err
+	}
+	return output, nil
+}
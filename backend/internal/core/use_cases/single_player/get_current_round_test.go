@@ -0,0 +1,59 @@
+package singleplayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetCurrentRoundSuite struct {
+	suite.Suite
+	gameRepo  *repomocks.MockSinglePlayerGameRepository
+	roundRepo *repomocks.MockSinglePlayerRoundRepository
+	uc        *GetCurrentRoundUseCase
+}
+
+func TestGetCurrentRoundSuite(t *testing.T) {
+	suite.Run(t, new(GetCurrentRoundSuite))
+}
+
+func (s *GetCurrentRoundSuite) SetupTest() {
+	s.gameRepo = repomocks.NewMockSinglePlayerGameRepository(s.T())
+	s.roundRepo = repomocks.NewMockSinglePlayerRoundRepository(s.T())
+	txManager := txmocks.NewMockTransactionManager(s.T())
+	passThroughTx(txManager)
+	s.uc = NewGetCurrentRoundUseCase(s.gameRepo, s.roundRepo, services.NewPanoramaRegistry(services.NewGooglePanoramaProvider()), txManager)
+}
+
+func (s *GetCurrentRoundSuite) TestExecute_ReturnsTheRoundBeingPlayed() {
+	game := entities.NewSinglePlayerGame("user-uuid", "map-uuid", entities.SinglePlayerGameModeMove, 60)
+	s.Require().NoError(game.Start())
+	game.CurrentRound = 2
+	round := entities.NewSinglePlayerRound(game.ID, "loc-uuid", 2, 60)
+	round.ID = "round-uuid"
+	round.Location = entities.RestoreLocation("loc-uuid", "pano-id", "map-uuid", 10, 10, 45, 0)
+	s.gameRepo.EXPECT().FindByIdAndUserIdWithLock(mock.Anything, game.ID, "user-uuid").Return(game, nil)
+	s.roundRepo.EXPECT().FindByGameIdAndRoundNumberWithLock(mock.Anything, game.ID, 2).Return(round, nil)
+
+	output, err := s.uc.Execute(context.Background(), GetCurrentRoundInput{GameId: game.ID, UserId: "user-uuid"})
+
+	s.Require().NoError(err)
+	s.Equal("round-uuid", output.ID)
+	s.Equal(2, output.RoundNumber)
+	s.Equal(services.PanoramaPayload{Provider: entities.PanoramaProviderGoogle, PanoId: "pano-id", Heading: 45}, output.Panorama)
+}
+
+func (s *GetCurrentRoundSuite) TestExecute_WhenGameIsNotTheUsers_ReturnsNotFound() {
+	s.gameRepo.EXPECT().FindByIdAndUserIdWithLock(mock.Anything, "game-uuid", "other-uuid").Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), GetCurrentRoundInput{GameId: "game-uuid", UserId: "other-uuid"})
+
+	s.Require().Error(err)
+	s.Equal("game not found", err.Error())
+}
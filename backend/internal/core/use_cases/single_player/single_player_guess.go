@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/events"
 	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	"github.com/mvcris/maya-guessr/backend/internal/core/services"
@@ -44,26 +46,49 @@ type SinglePlayerGuessOutput struct {
 	Score       int
 	TotalScore  int
 	GameEnded   bool
+	// GuessLatitude and GuessLongitude are where the guess landed, moved onto the boundary of
+	// maps clamping the guesses outside it.
+	GuessLatitude  float64
+	GuessLongitude float64
+	// LocationNote is the note of the map maker on the location, shown once the round is over.
+	LocationNote string
+	// NextRound is the round started by the guess, nil once the game ended.
+	NextRound *SinglePlayerRoundOutput
 }
 
 type SinglePlayerGuessUseCase struct {
 	gameRepository  repositories.SinglePlayerGameRepository
 	roundRepository repositories.SinglePlayerRoundRepository
+	statsRepository repositories.UserStatsRepository
+	leaderboardRepository repositories.LeaderboardRepository
+	mapRepository   repositories.MapRepository
+	panoramas       *services.PanoramaRegistry
 	txManager       transactions.TransactionManager
 	geoService      *services.GeoService
+	publisher       events.Publisher
 }
 
 func NewSinglePlayerGuessUseCase(
 	gameRepository repositories.SinglePlayerGameRepository,
 	roundRepository repositories.SinglePlayerRoundRepository,
+	statsRepository repositories.UserStatsRepository,
+	leaderboardRepository repositories.LeaderboardRepository,
+	mapRepository repositories.MapRepository,
+	panoramas *services.PanoramaRegistry,
 	txManager transactions.TransactionManager,
 	geoService *services.GeoService,
+	publisher events.Publisher,
 ) *SinglePlayerGuessUseCase {
 	return &SinglePlayerGuessUseCase{
 		gameRepository:  gameRepository,
 		roundRepository: roundRepository,
+		statsRepository: statsRepository,
+		leaderboardRepository: leaderboardRepository,
+		mapRepository:   mapRepository,
+		panoramas:       panoramas,
 		txManager:       txManager,
 		geoService:      geoService,
+		publisher:       publisher,
 	}
 }
 
@@ -102,9 +127,13 @@ func (uc *SinglePlayerGuessUseCase) Execute(ctx context.Context, input SinglePla
 			return coreerrors.BadRequest("round is not current")
 		}
 
-		distance := uc.geoService.CalculateDistance(round.Location.Latitude, round.Location.Longitude, input.GuessLatitude, input.GuessLongitude)
+		guessLatitude, guessLongitude, err := uc.placeGuess(ctx, game, input)
+		if err != nil {
+			return err
+		}
+		distance := uc.geoService.CalculateDistance(round.Location.Latitude, round.Location.Longitude, guessLatitude, guessLongitude)
 		score := uc.geoService.CalculateScoreFromDistance(distance)
-		round.ApplyGuess(input.GuessLatitude, input.GuessLongitude, distance, score)
+		round.ApplyGuess(guessLatitude, guessLongitude, distance, score)
 		if err := round.Finish(); err != nil {
 			return err
 		}
@@ -113,6 +142,10 @@ func (uc *SinglePlayerGuessUseCase) Execute(ctx context.Context, input SinglePla
 		}
 
 		game.AddScore(round.Score)
+		// Events are published inside the transaction: a failing subscriber rolls the guess back.
+		if err := uc.publisher.Publish(ctx, events.RoundFinished{Game: game, Round: round}); err != nil {
+			return err
+		}
 
 		if game.HasNextRound() {
 			nextRoundNumber := round.RoundNumber + 1
@@ -132,10 +165,32 @@ func (uc *SinglePlayerGuessUseCase) Execute(ctx context.Context, input SinglePla
 			if err := uc.roundRepository.Update(ctx, nextRound); err != nil {
 				return err
 			}
+			if nextRound.Location == nil {
+				return coreerrors.InternalServerError("next round location is missing")
+			}
+			next, err := newSinglePlayerRoundOutput(uc.panoramas, nextRound, nextRound.Location)
+			if err != nil {
+				return err
+			}
+			output.NextRound = &next
 		} else {
 			if err := game.Complete(); err != nil {
 				return err
 			}
+			// Stats are updated in the same transaction so they can never count a game twice or miss one.
+			rounds, err := uc.roundRepository.FindByGameId(ctx, game.ID)
+			if err != nil {
+				return err
+			}
+			if err := uc.statsRepository.Increment(ctx, entities.NewUserStatsFromGame(game, rounds)); err != nil {
+				return err
+			}
+			if err := uc.leaderboardRepository.SubmitBest(ctx, entities.NewLeaderboardEntriesFromGame(game)); err != nil {
+				return err
+			}
+			if err := uc.publisher.Publish(ctx, events.GameCompleted{Game: game, Rounds: rounds}); err != nil {
+				return err
+			}
 			output.GameEnded = true
 		}
 
@@ -145,6 +200,9 @@ func (uc *SinglePlayerGuessUseCase) Execute(ctx context.Context, input SinglePla
 
 		output.Score = round.Score
 		output.TotalScore = game.Score
+		output.GuessLatitude = guessLatitude
+		output.GuessLongitude = guessLongitude
+		output.LocationNote = round.Location.Note
 		return nil
 	})
 	if err != nil {
@@ -153,3 +211,16 @@ func (uc *SinglePlayerGuessUseCase) Execute(ctx context.Context, input SinglePla
 
 	return output, nil
 }
+
+// placeGuess checks the guess against the boundary of the game's map. Games on a map deleted since
+// they started are played without boundary.
+func (uc *SinglePlayerGuessUseCase) placeGuess(ctx context.Context, game *entities.SinglePlayerGame, input SinglePlayerGuessInput) (float64, float64, error) {
+	gameMap, err := uc.mapRepository.FindById(ctx, game.MapId)
+	if err != nil {
+		return 0, 0, err
+	}
+	if gameMap == nil {
+		return input.GuessLatitude, input.GuessLongitude, nil
+	}
+	return gameMap.PlaceGuess(input.GuessLatitude, input.GuessLongitude)
+}
@@ -0,0 +1,204 @@
+package singleplayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/events"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SinglePlayerGuessSuite struct {
+	suite.Suite
+	gameRepo  *repomocks.MockSinglePlayerGameRepository
+	roundRepo *repomocks.MockSinglePlayerRoundRepository
+	statsRepo *repomocks.MockUserStatsRepository
+	boardRepo *repomocks.MockLeaderboardRepository
+	mapRepo   *repomocks.MockMapRepository
+	gameMap   *entities.Map
+	txManager *txmocks.MockTransactionManager
+	bus       *events.Bus
+	published []events.Name
+	uc        *SinglePlayerGuessUseCase
+}
+
+func TestSinglePlayerGuessSuite(t *testing.T) {
+	suite.Run(t, new(SinglePlayerGuessSuite))
+}
+
+func (s *SinglePlayerGuessSuite) SetupTest() {
+	s.gameRepo = repomocks.NewMockSinglePlayerGameRepository(s.T())
+	s.roundRepo = repomocks.NewMockSinglePlayerRoundRepository(s.T())
+	s.statsRepo = repomocks.NewMockUserStatsRepository(s.T())
+	s.boardRepo = repomocks.NewMockLeaderboardRepository(s.T())
+	s.mapRepo = repomocks.NewMockMapRepository(s.T())
+	s.gameMap = entities.RestoreMap("map-uuid", "Map", "desc", "owner-uuid")
+	s.mapRepo.EXPECT().FindById(mock.Anything, "map-uuid").Return(s.gameMap, nil).Maybe()
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.bus = events.NewBus()
+	s.published = nil
+	record := func(ctx context.Context, event events.Event) error {
+		s.published = append(s.published, event.Name())
+		return nil
+	}
+	s.bus.Subscribe(events.RoundFinishedEvent, record)
+	s.bus.Subscribe(events.GameCompletedEvent, record)
+	s.uc = NewSinglePlayerGuessUseCase(s.gameRepo, s.roundRepo, s.statsRepo, s.boardRepo, s.mapRepo, services.NewPanoramaRegistry(services.NewGooglePanoramaProvider()), s.txManager, services.NewGeoService(), s.bus)
+}
+
+func (s *SinglePlayerGuessSuite) gameAtRound(roundNumber int) (*entities.SinglePlayerGame, *entities.SinglePlayerRound) {
+	game := entities.NewSinglePlayerGame("user-uuid", "map-uuid", entities.SinglePlayerGameModeMove, 60)
+	s.Require().NoError(game.Start())
+	game.CurrentRound = roundNumber
+	game.Score = 4000 * (roundNumber - 1)
+
+	location := entities.RestoreLocation("loc-uuid", "pano-id", "map-uuid", 10, 10, 0, 0)
+	location.CountryCode = "BR"
+	round := entities.NewSinglePlayerRound(game.ID, location.ID, roundNumber, 60)
+	round.ID = "round-uuid"
+	round.Location = location
+	s.Require().NoError(round.Start())
+	return game, round
+}
+
+func (s *SinglePlayerGuessSuite) TestExecute_WhenLastRound_CompletesGameAndRecordsStats() {
+	game, round := s.gameAtRound(5)
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdAndUserIdWithLock(mock.Anything, game.ID, "user-uuid").Return(game, nil)
+	s.roundRepo.EXPECT().FindByIdAndGameIdWithLock(mock.Anything, round.ID, game.ID).Return(round, nil)
+	s.roundRepo.EXPECT().Update(mock.Anything, round).Return(nil)
+	s.roundRepo.EXPECT().FindByGameId(mock.Anything, game.ID).Return([]*entities.SinglePlayerRound{round}, nil)
+	s.statsRepo.EXPECT().
+		Increment(mock.Anything, mock.MatchedBy(func(delta *entities.UserStats) bool {
+			return delta.UserId == "user-uuid" && delta.GamesPlayed == 1 && delta.TotalScore == int64(16000+round.Score) &&
+				len(delta.Countries) == 1 && delta.Countries[0].CountryCode == "BR"
+		})).
+		Return(nil)
+	s.boardRepo.EXPECT().
+		SubmitBest(mock.Anything, mock.MatchedBy(func(entries []*entities.LeaderboardEntry) bool {
+			return len(entries) == 6 && entries[0].GameId == game.ID && entries[0].Score == game.Score
+		})).
+		Return(nil)
+	s.gameRepo.EXPECT().Update(mock.Anything, game).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), SinglePlayerGuessInput{
+		GameId: game.ID, RoundId: round.ID, UserId: "user-uuid", GuessLatitude: 10, GuessLongitude: 10,
+	})
+
+	s.Require().NoError(err)
+	s.True(output.GameEnded)
+	s.Equal(entities.SinglePlayerGameStatusCompleted, game.Status)
+	s.Equal([]events.Name{events.RoundFinishedEvent, events.GameCompletedEvent}, s.published)
+}
+
+func (s *SinglePlayerGuessSuite) TestExecute_WhenStatsFail_ReturnsError() {
+	game, round := s.gameAtRound(5)
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdAndUserIdWithLock(mock.Anything, game.ID, "user-uuid").Return(game, nil)
+	s.roundRepo.EXPECT().FindByIdAndGameIdWithLock(mock.Anything, round.ID, game.ID).Return(round, nil)
+	s.roundRepo.EXPECT().Update(mock.Anything, round).Return(nil)
+	s.roundRepo.EXPECT().FindByGameId(mock.Anything, game.ID).Return([]*entities.SinglePlayerRound{round}, nil)
+	s.statsRepo.EXPECT().Increment(mock.Anything, mock.Anything).Return(errMock)
+
+	_, err := s.uc.Execute(context.Background(), SinglePlayerGuessInput{
+		GameId: game.ID, RoundId: round.ID, UserId: "user-uuid", GuessLatitude: 10, GuessLongitude: 10,
+	})
+
+	s.ErrorIs(err, errMock)
+}
+
+func (s *SinglePlayerGuessSuite) TestExecute_WhenNotLastRound_DoesNotRecordStats() {
+	game, round := s.gameAtRound(2)
+	round.Location.Note = "The church has a blue roof."
+	next := entities.NewSinglePlayerRound(game.ID, "loc-uuid-2", 3, 60)
+	next.Location = entities.RestoreLocation("loc-uuid-2", "pano-2", "map-uuid", 12, 12, 90, 5)
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdAndUserIdWithLock(mock.Anything, game.ID, "user-uuid").Return(game, nil)
+	s.roundRepo.EXPECT().FindByIdAndGameIdWithLock(mock.Anything, round.ID, game.ID).Return(round, nil)
+	s.roundRepo.EXPECT().Update(mock.Anything, round).Return(nil)
+	s.roundRepo.EXPECT().FindByGameIdAndRoundNumberWithLock(mock.Anything, game.ID, 3).Return(next, nil)
+	s.roundRepo.EXPECT().Update(mock.Anything, next).Return(nil)
+	s.gameRepo.EXPECT().Update(mock.Anything, game).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), SinglePlayerGuessInput{
+		GameId: game.ID, RoundId: round.ID, UserId: "user-uuid", GuessLatitude: 10, GuessLongitude: 10,
+	})
+
+	s.Require().NoError(err)
+	s.False(output.GameEnded)
+	s.Equal("The church has a blue roof.", output.LocationNote)
+	s.Equal(3, game.CurrentRound)
+	s.Require().NotNil(output.NextRound)
+	s.Equal(next.ID, output.NextRound.ID)
+	s.Equal(3, output.NextRound.RoundNumber)
+	s.Equal(services.PanoramaPayload{Provider: entities.PanoramaProviderGoogle, PanoId: "pano-2", Heading: 90, Pitch: 5}, output.NextRound.Panorama)
+	s.Equal([]events.Name{events.RoundFinishedEvent}, s.published)
+}
+
+func (s *SinglePlayerGuessSuite) TestExecute_WhenSubscriberFails_ReturnsError() {
+	game, round := s.gameAtRound(2)
+	s.bus.Subscribe(events.RoundFinishedEvent, func(ctx context.Context, event events.Event) error {
+		return errMock
+	})
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdAndUserIdWithLock(mock.Anything, game.ID, "user-uuid").Return(game, nil)
+	s.roundRepo.EXPECT().FindByIdAndGameIdWithLock(mock.Anything, round.ID, game.ID).Return(round, nil)
+	s.roundRepo.EXPECT().Update(mock.Anything, round).Return(nil)
+
+	_, err := s.uc.Execute(context.Background(), SinglePlayerGuessInput{
+		GameId: game.ID, RoundId: round.ID, UserId: "user-uuid", GuessLatitude: 10, GuessLongitude: 10,
+	})
+
+	s.ErrorIs(err, errMock)
+}
+
+func (s *SinglePlayerGuessSuite) setSquareBoundary(policy entities.OutsideGuessPolicy) {
+	boundary, err := entities.ParseMapBoundary([]byte(`{"type":"Polygon","coordinates":[[[0,0],[20,0],[20,20],[0,20],[0,0]]]}`))
+	s.Require().NoError(err)
+	s.gameMap.Boundary = boundary
+	s.gameMap.OutsideGuesses = policy
+}
+
+func (s *SinglePlayerGuessSuite) TestExecute_WhenGuessIsOutsideARejectingBoundary_ReturnsBadRequest() {
+	s.setSquareBoundary(entities.OutsideGuessReject)
+	game, round := s.gameAtRound(2)
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdAndUserIdWithLock(mock.Anything, game.ID, "user-uuid").Return(game, nil)
+	s.roundRepo.EXPECT().FindByIdAndGameIdWithLock(mock.Anything, round.ID, game.ID).Return(round, nil)
+
+	_, err := s.uc.Execute(context.Background(), SinglePlayerGuessInput{
+		GameId: game.ID, RoundId: round.ID, UserId: "user-uuid", GuessLatitude: 10, GuessLongitude: 30,
+	})
+
+	s.Require().Error(err)
+	s.Equal("guess is outside the map boundary", err.Error())
+	s.Zero(round.Score)
+}
+
+func (s *SinglePlayerGuessSuite) TestExecute_WhenGuessIsOutsideAClampingBoundary_ScoresTheClosestEdge() {
+	s.setSquareBoundary(entities.OutsideGuessClamp)
+	game, round := s.gameAtRound(2)
+	next := entities.NewSinglePlayerRound(game.ID, "loc-uuid-2", 3, 60)
+	next.Location = entities.RestoreLocation("loc-uuid-2", "pano-2", "map-uuid", 12, 12, 90, 5)
+	passThroughTx(s.txManager)
+	s.gameRepo.EXPECT().FindByIdAndUserIdWithLock(mock.Anything, game.ID, "user-uuid").Return(game, nil)
+	s.roundRepo.EXPECT().FindByIdAndGameIdWithLock(mock.Anything, round.ID, game.ID).Return(round, nil)
+	s.roundRepo.EXPECT().Update(mock.Anything, round).Return(nil)
+	s.roundRepo.EXPECT().FindByGameIdAndRoundNumberWithLock(mock.Anything, game.ID, 3).Return(next, nil)
+	s.roundRepo.EXPECT().Update(mock.Anything, next).Return(nil)
+	s.gameRepo.EXPECT().Update(mock.Anything, game).Return(nil)
+
+	output, err := s.uc.Execute(context.Background(), SinglePlayerGuessInput{
+		GameId: game.ID, RoundId: round.ID, UserId: "user-uuid", GuessLatitude: 10, GuessLongitude: 30,
+	})
+
+	s.Require().NoError(err)
+	s.InDelta(10, output.GuessLatitude, 1e-9)
+	s.InDelta(20, output.GuessLongitude, 1e-9)
+	s.InDelta(20, round.GuessLongitude, 1e-9)
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type CancelAccountDeletionUseCase struct {
+	accountDeletionRepository repositories.AccountDeletionRepository
+}
+
+func NewCancelAccountDeletionUseCase(accountDeletionRepository repositories.AccountDeletionRepository) *CancelAccountDeletionUseCase {
+	return &CancelAccountDeletionUseCase{accountDeletionRepository: accountDeletionRepository}
+}
+
+func (uc *CancelAccountDeletionUseCase) Execute(ctx context.Context, userId string) error {
+	deletion, err := uc.accountDeletionRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if deletion == nil {
+		return coreerrors.NotFound("no account deletion is pending")
+	}
+	return uc.accountDeletionRepository.Delete(ctx, deletion)
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type ChangePasswordInput struct {
+	UserId          string
+	CurrentPassword string
+	// ConfirmationCode is required instead of CurrentPassword from users setting their first password,
+	// as mailed by RequestReauthenticationUseCase.
+	ConfirmationCode string
+	NewPassword      string
+}
+
+// ChangePasswordUseCase changes the user's password and signs out their other sessions by revoking
+// every refresh token.
+type ChangePasswordUseCase struct {
+	userRepository          repositories.UserRepository
+	refreshTokenRepository  repositories.RefreshTokenRepository
+	reauthenticationService *services.ReauthenticationService
+	txManager               transactions.TransactionManager
+}
+
+func NewChangePasswordUseCase(userRepository repositories.UserRepository, refreshTokenRepository repositories.RefreshTokenRepository, reauthenticationService *services.ReauthenticationService, txManager transactions.TransactionManager) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepository:          userRepository,
+		refreshTokenRepository:  refreshTokenRepository,
+		reauthenticationService: reauthenticationService,
+		txManager:               txManager,
+	}
+}
+
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, input ChangePasswordInput) error {
+	return uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		user, err := uc.userRepository.FindById(ctx, input.UserId)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return coreerrors.NotFound("user not found")
+		}
+		if !user.HasPassword() {
+			if err := uc.reauthenticationService.VerifyCode(ctx, user.ID, input.ConfirmationCode); err != nil {
+				return err
+			}
+		}
+		if err := user.ChangePassword(input.CurrentPassword, input.NewPassword); err != nil {
+			return err
+		}
+		if err := uc.userRepository.Update(ctx, user); err != nil {
+			return err
+		}
+		return uc.refreshTokenRepository.ExpireAllByUserId(ctx, user.ID)
+	})
+}
@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func passThroughTx(mockTx *txmocks.MockTransactionManager) {
+	mockTx.EXPECT().
+		RunInTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Maybe()
+}
+
+type ChangePasswordSuite struct {
+	suite.Suite
+	mockUserRepo    *repomocks.MockUserRepository
+	mockRefreshRepo *repomocks.MockRefreshTokenRepository
+	mockCodeRepo    *repomocks.MockReauthenticationCodeRepository
+	verification    *services.EmailVerificationService
+	uc              *ChangePasswordUseCase
+}
+
+func TestChangePasswordSuite(t *testing.T) {
+	suite.Run(t, new(ChangePasswordSuite))
+}
+
+func (s *ChangePasswordSuite) SetupTest() {
+	s.mockUserRepo = repomocks.NewMockUserRepository(s.T())
+	s.mockRefreshRepo = repomocks.NewMockRefreshTokenRepository(s.T())
+	s.mockCodeRepo = repomocks.NewMockReauthenticationCodeRepository(s.T())
+	s.verification = services.NewEmailVerificationService(servicemocks.NewMockMailer(s.T()), "")
+	txManager := txmocks.NewMockTransactionManager(s.T())
+	passThroughTx(txManager)
+	reauthentication := services.NewReauthenticationService(s.mockCodeRepo, s.verification)
+	s.uc = NewChangePasswordUseCase(s.mockUserRepo, s.mockRefreshRepo, reauthentication, txManager)
+}
+
+func (s *ChangePasswordSuite) newUser() *entities.User {
+	u := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "secret123")
+	s.Require().NoError(u.EncryptPassword())
+	return u
+}
+
+func (s *ChangePasswordSuite) TestExecute_ChangesPasswordAndRevokesSessions() {
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+	s.mockUserRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+		return u.ComparePassword("new-secret") == nil
+	})).Return(nil)
+	s.mockRefreshRepo.EXPECT().ExpireAllByUserId(mock.Anything, "user-uuid").Return(nil)
+
+	err := s.uc.Execute(context.Background(), ChangePasswordInput{UserId: "user-uuid", CurrentPassword: "secret123", NewPassword: "new-secret"})
+
+	s.Require().NoError(err)
+}
+
+func (s *ChangePasswordSuite) TestExecute_WhenCurrentPasswordWrong_ReturnsUnauthorized() {
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+
+	err := s.uc.Execute(context.Background(), ChangePasswordInput{UserId: "user-uuid", CurrentPassword: "wrong", NewPassword: "new-secret"})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "current password is incorrect")
+}
+
+func (s *ChangePasswordSuite) TestExecute_WhenNoPasswordYet_ConsumesConfirmationCode() {
+	code := entities.NewReauthenticationCode("user-uuid", s.verification.HashToken("mailed-code"), time.Now().Add(time.Minute))
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", ""), nil)
+	s.mockCodeRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(code, nil)
+	s.mockCodeRepo.EXPECT().Delete(mock.Anything, code).Return(nil)
+	s.mockUserRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+		return u.ComparePassword("new-secret") == nil
+	})).Return(nil)
+	s.mockRefreshRepo.EXPECT().ExpireAllByUserId(mock.Anything, "user-uuid").Return(nil)
+
+	err := s.uc.Execute(context.Background(), ChangePasswordInput{UserId: "user-uuid", ConfirmationCode: "mailed-code", NewPassword: "new-secret"})
+
+	s.Require().NoError(err)
+}
+
+func (s *ChangePasswordSuite) TestExecute_WhenNoPasswordYetAndCodeWrong_ReturnsUnauthorized() {
+	code := entities.NewReauthenticationCode("user-uuid", s.verification.HashToken("mailed-code"), time.Now().Add(time.Minute))
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", ""), nil)
+	s.mockCodeRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(code, nil)
+
+	err := s.uc.Execute(context.Background(), ChangePasswordInput{UserId: "user-uuid", ConfirmationCode: "guess", NewPassword: "new-secret"})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or expired confirmation code")
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+// ConfirmEmailChangeUseCase applies the email change whose token was mailed by RequestEmailChangeUseCase.
+type ConfirmEmailChangeUseCase struct {
+	userRepository               repositories.UserRepository
+	emailChangeRequestRepository repositories.EmailChangeRequestRepository
+	txManager                    transactions.TransactionManager
+	emailVerificationService     *services.EmailVerificationService
+}
+
+func NewConfirmEmailChangeUseCase(userRepository repositories.UserRepository, emailChangeRequestRepository repositories.EmailChangeRequestRepository, txManager transactions.TransactionManager, emailVerificationService *services.EmailVerificationService) *ConfirmEmailChangeUseCase {
+	return &ConfirmEmailChangeUseCase{
+		userRepository:               userRepository,
+		emailChangeRequestRepository: emailChangeRequestRepository,
+		txManager:                    txManager,
+		emailVerificationService:     emailVerificationService,
+	}
+}
+
+func (uc *ConfirmEmailChangeUseCase) Execute(ctx context.Context, token string) (*entities.User, error) {
+	var user *entities.User
+	err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		request, err := uc.emailChangeRequestRepository.FindByTokenHash(ctx, uc.emailVerificationService.HashToken(token))
+		if err != nil {
+			return err
+		}
+		if request == nil || request.IsExpired() {
+			return coreerrors.BadRequest("invalid or expired email confirmation token")
+		}
+		// The address may have been claimed by someone else since the change was requested.
+		existingUserByEmail, err := uc.userRepository.FindByEmail(ctx, request.NewEmail)
+		if err != nil {
+			return err
+		}
+		if existingUserByEmail != nil {
+			return coreerrors.Conflict("user with email already exists")
+		}
+
+		user, err = uc.userRepository.FindById(ctx, request.UserId)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return coreerrors.NotFound("user not found")
+		}
+		user.Email = request.NewEmail
+		if err := uc.userRepository.Update(ctx, user); err != nil {
+			return err
+		}
+		return uc.emailChangeRequestRepository.Delete(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -36,6 +36,10 @@ func NewCreateUserUseCase(userRepository repositories.UserRepository) *CreateUse
 func (uc *CreateUserUseCase) Execute(input CreateUserInput) (CreateUserOutput, error) {
 	ctx := context.Background()
 
+	if entities.IsReservedUsername(input.Username) {
+		return CreateUserOutput{}, coreerrors.BadRequest("username is reserved")
+	}
+
 	existingUserByEmail, err := uc.userRepository.FindByEmail(ctx, input.Email)
 	if err != nil {
 		return CreateUserOutput{}, err
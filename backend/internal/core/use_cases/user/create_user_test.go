@@ -108,6 +108,22 @@ func (s *CreateUserSuite) TestExecute_WhenUsernameAlreadyExists_ReturnsError() {
 	s.Equal(CreateUserOutput{}, output)
 }
 
+func (s *CreateUserSuite) TestExecute_WhenUsernameReserved_ReturnsError() {
+	mockRepo := repomocks.NewMockUserRepository(s.T())
+	uc := NewCreateUserUseCase(mockRepo)
+
+	output, err := uc.Execute(CreateUserInput{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Username: "deleted_0b8f5f3e8f2a",
+		Password: "secret123",
+	})
+
+	s.Require().Error(err)
+	s.Equal("username is reserved", err.Error())
+	s.Equal(CreateUserOutput{}, output)
+}
+
 func (s *CreateUserSuite) TestExecute_WhenFindByEmailFails_ReturnsError() {
 	mockRepo := repomocks.NewMockUserRepository(s.T())
 	uc := NewCreateUserUseCase(mockRepo)
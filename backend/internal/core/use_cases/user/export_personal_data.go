@@ -0,0 +1,76 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+// PersonalDataExportOutput is the archive handed to a user asking for a copy of their data.
+type PersonalDataExportOutput struct {
+	ExportedAt           time.Time                       `json:"exported_at"`
+	Profile              *entities.User                  `json:"profile"`
+	PendingDeletion      *entities.AccountDeletion       `json:"pending_deletion"`
+	TwoFactorEnabled     bool                            `json:"two_factor_enabled"`
+	Maps                 []*entities.Map                 `json:"maps"`
+	Locations            []*entities.Location            `json:"locations"`
+	Games                []*entities.SinglePlayerGame    `json:"games"`
+	Sessions             []*entities.RefreshToken        `json:"sessions"`
+	Identities           []*entities.UserIdentity        `json:"identities"`
+	PersonalAccessTokens []*entities.PersonalAccessToken `json:"personal_access_tokens"`
+	Achievements         []*entities.UserAchievement     `json:"achievements"`
+	Friendships          []*entities.Friendship          `json:"friendships"`
+	Blocks               []*entities.UserBlock           `json:"blocks"`
+	Notifications        []*entities.Notification        `json:"notifications"`
+	Collaborations       []*entities.MapCollaborator     `json:"collaborations"`
+	MapLikes             []*entities.MapLike             `json:"map_likes"`
+	MapRatings           []*entities.MapRating           `json:"map_ratings"`
+}
+
+type ExportPersonalDataUseCase struct {
+	personalDataRepository    repositories.PersonalDataRepository
+	accountDeletionRepository repositories.AccountDeletionRepository
+}
+
+func NewExportPersonalDataUseCase(personalDataRepository repositories.PersonalDataRepository, accountDeletionRepository repositories.AccountDeletionRepository) *ExportPersonalDataUseCase {
+	return &ExportPersonalDataUseCase{
+		personalDataRepository:    personalDataRepository,
+		accountDeletionRepository: accountDeletionRepository,
+	}
+}
+
+func (uc *ExportPersonalDataUseCase) Execute(ctx context.Context, userId string) (PersonalDataExportOutput, error) {
+	export, err := uc.personalDataRepository.Export(ctx, userId)
+	if err != nil {
+		return PersonalDataExportOutput{}, err
+	}
+	if export == nil {
+		return PersonalDataExportOutput{}, coreerrors.NotFound("user not found")
+	}
+	deletion, err := uc.accountDeletionRepository.FindByUserId(ctx, userId)
+	if err != nil {
+		return PersonalDataExportOutput{}, err
+	}
+	return PersonalDataExportOutput{
+		ExportedAt:           time.Now(),
+		Profile:              export.User,
+		PendingDeletion:      deletion,
+		TwoFactorEnabled:     export.TwoFactorEnabled,
+		Maps:                 export.Maps,
+		Locations:            export.Locations,
+		Games:                export.Games,
+		Sessions:             export.Sessions,
+		Identities:           export.Identities,
+		PersonalAccessTokens: export.PersonalAccessTokens,
+		Achievements:         export.Achievements,
+		Friendships:          export.Friendships,
+		Blocks:               export.Blocks,
+		Notifications:        export.Notifications,
+		Collaborations:       export.Collaborations,
+		MapLikes:             export.MapLikes,
+		MapRatings:           export.MapRatings,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type ProfileStatsOutput struct {
+	GamesPlayed  int64   `json:"games_played"`
+	TotalScore   int64   `json:"total_score"`
+	BestScore    int     `json:"best_score"`
+	AverageScore float64 `json:"average_score"`
+}
+
+// ProfileOutput is the public view of a user; it must only hold fields safe to show anyone.
+type ProfileOutput struct {
+	ID        string             `json:"id"`
+	Name      string             `json:"name"`
+	Username  string             `json:"username"`
+	Role      string             `json:"role"`
+	CreatedAt time.Time          `json:"created_at"`
+	Stats     ProfileStatsOutput `json:"stats"`
+}
+
+type GetProfileUseCase struct {
+	userRepository      repositories.UserRepository
+	userStatsRepository repositories.UserStatsRepository
+}
+
+func NewGetProfileUseCase(userRepository repositories.UserRepository, userStatsRepository repositories.UserStatsRepository) *GetProfileUseCase {
+	return &GetProfileUseCase{
+		userRepository:      userRepository,
+		userStatsRepository: userStatsRepository,
+	}
+}
+
+// Execute returns the public profile of the user with username. Banned users have no public profile.
+func (uc *GetProfileUseCase) Execute(ctx context.Context, username string) (ProfileOutput, error) {
+	user, err := uc.userRepository.FindByUsername(ctx, username)
+	if err != nil {
+		return ProfileOutput{}, err
+	}
+	if user == nil || user.IsBanned() {
+		return ProfileOutput{}, coreerrors.NotFound("user not found")
+	}
+
+	stats, err := uc.userStatsRepository.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return ProfileOutput{}, err
+	}
+	if stats == nil {
+		stats = &entities.UserStats{UserId: user.ID}
+	}
+
+	return ProfileOutput{
+		ID:        user.ID,
+		Name:      user.Name,
+		Username:  user.Username,
+		Role:      string(user.Role),
+		CreatedAt: user.CreatedAt,
+		Stats: ProfileStatsOutput{
+			GamesPlayed:  stats.GamesPlayed,
+			TotalScore:   stats.TotalScore,
+			BestScore:    stats.BestGameScore,
+			AverageScore: stats.AverageScore(),
+		},
+	}, nil
+}
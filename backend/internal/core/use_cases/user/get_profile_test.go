@@ -0,0 +1,66 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetProfileSuite struct {
+	suite.Suite
+}
+
+func TestGetProfileSuite(t *testing.T) {
+	suite.Run(t, new(GetProfileSuite))
+}
+
+func (s *GetProfileSuite) TestExecute_ReturnsPublicFieldsAndStats() {
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockStatsRepo := repomocks.NewMockUserStatsRepository(s.T())
+	uc := NewGetProfileUseCase(mockUserRepo, mockStatsRepo)
+
+	mockUserRepo.EXPECT().FindByUsername(mock.Anything, "john").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	mockStatsRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(&entities.UserStats{UserId: "user-uuid", GamesPlayed: 4, TotalScore: 50000, BestGameScore: 20000}, nil)
+
+	profile, err := uc.Execute(context.Background(), "john")
+
+	s.Require().NoError(err)
+	s.Equal("john", profile.Username)
+	s.Equal("player", profile.Role)
+	s.Equal(int64(4), profile.Stats.GamesPlayed)
+	s.Equal(20000, profile.Stats.BestScore)
+	s.InDelta(12500, profile.Stats.AverageScore, 0.001)
+}
+
+func (s *GetProfileSuite) TestExecute_WhenBanned_ReturnsNotFound() {
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	uc := NewGetProfileUseCase(mockUserRepo, repomocks.NewMockUserStatsRepository(s.T()))
+	user := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash")
+	s.Require().NoError(user.Ban("cheating"))
+
+	mockUserRepo.EXPECT().FindByUsername(mock.Anything, "john").Return(user, nil)
+
+	_, err := uc.Execute(context.Background(), "john")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "user not found")
+}
+
+func (s *GetProfileSuite) TestExecute_WhenNoGamesPlayed_ReturnsZeroStats() {
+	mockUserRepo := repomocks.NewMockUserRepository(s.T())
+	mockStatsRepo := repomocks.NewMockUserStatsRepository(s.T())
+	uc := NewGetProfileUseCase(mockUserRepo, mockStatsRepo)
+
+	mockUserRepo.EXPECT().FindByUsername(mock.Anything, "john").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	mockStatsRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(nil, nil)
+
+	profile, err := uc.Execute(context.Background(), "john")
+
+	s.Require().NoError(err)
+	s.Equal(int64(0), profile.Stats.GamesPlayed)
+	s.Zero(profile.Stats.AverageScore)
+}
@@ -0,0 +1,163 @@
+package user
+
+import (
+	"context"
+	"sort"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+type BestGameOutput struct {
+	GameId string `json:"game_id"`
+	Score  int    `json:"score"`
+}
+
+type ModeStatsOutput struct {
+	Mode         string  `json:"mode"`
+	GamesPlayed  int64   `json:"games_played"`
+	AverageScore float64 `json:"average_score"`
+}
+
+type MapStatsOutput struct {
+	MapId        string  `json:"map_id"`
+	GamesPlayed  int64   `json:"games_played"`
+	AverageScore float64 `json:"average_score"`
+	BestScore    int     `json:"best_score"`
+}
+
+type CountryStatsOutput struct {
+	CountryCode           string  `json:"country_code"`
+	RoundsPlayed          int64   `json:"rounds_played"`
+	CorrectRounds         int64   `json:"correct_rounds"`
+	AverageScore          float64 `json:"average_score"`
+	AverageDistanceMeters float64 `json:"average_distance_meters"`
+	// Accuracy is the share of the maximum round score obtained, between 0 and 1.
+	Accuracy float64 `json:"accuracy"`
+}
+
+type UserStatsOutput struct {
+	UserId        string  `json:"user_id"`
+	GamesPlayed   int64   `json:"games_played"`
+	RoundsPlayed  int64   `json:"rounds_played"`
+	AverageScore  float64 `json:"average_score"`
+	PerfectRounds int64   `json:"perfect_rounds"`
+	// MedianDistanceMeters is estimated from a log-scale histogram; it is nil until a round is played.
+	MedianDistanceMeters *float64             `json:"median_distance_meters"`
+	AverageGuessSeconds  float64              `json:"average_guess_seconds"`
+	BestGame             *BestGameOutput      `json:"best_game"`
+	ByMode               []ModeStatsOutput    `json:"by_mode"`
+	ByMap                []MapStatsOutput     `json:"by_map"`
+	ByCountry            []CountryStatsOutput `json:"by_country"`
+}
+
+type GetUserStatsUseCase struct {
+	userRepository      repositories.UserRepository
+	userStatsRepository repositories.UserStatsRepository
+}
+
+func NewGetUserStatsUseCase(userRepository repositories.UserRepository, userStatsRepository repositories.UserStatsRepository) *GetUserStatsUseCase {
+	return &GetUserStatsUseCase{
+		userRepository:      userRepository,
+		userStatsRepository: userStatsRepository,
+	}
+}
+
+// Execute returns the detailed single player stats of a user. Like profiles, banned users have none.
+func (uc *GetUserStatsUseCase) Execute(ctx context.Context, userId string) (UserStatsOutput, error) {
+	user, err := uc.userRepository.FindById(ctx, userId)
+	if err != nil {
+		return UserStatsOutput{}, err
+	}
+	if user == nil || user.IsBanned() {
+		return UserStatsOutput{}, coreerrors.NotFound("user not found")
+	}
+
+	stats, err := uc.userStatsRepository.FindByUserId(ctx, user.ID)
+	if err != nil {
+		return UserStatsOutput{}, err
+	}
+	if stats == nil {
+		stats = &entities.UserStats{UserId: user.ID}
+	}
+
+	output := UserStatsOutput{
+		UserId:              user.ID,
+		GamesPlayed:         stats.GamesPlayed,
+		RoundsPlayed:        stats.RoundsPlayed,
+		AverageScore:        stats.AverageScore(),
+		PerfectRounds:       stats.PerfectRounds,
+		AverageGuessSeconds: stats.AverageGuessSeconds(),
+		ByMode:              []ModeStatsOutput{},
+		ByMap:               []MapStatsOutput{},
+		ByCountry:           []CountryStatsOutput{},
+	}
+	if median, ok := stats.MedianDistance(); ok {
+		output.MedianDistanceMeters = &median
+	}
+	if stats.BestGameId != nil {
+		output.BestGame = &BestGameOutput{GameId: *stats.BestGameId, Score: stats.BestGameScore}
+	}
+
+	// Mode/map rows are stored per pair; fold them into one breakdown per mode and one per map.
+	modes := map[entities.SinglePlayerGameMode]*entities.UserModeMapStats{}
+	maps := map[string]*entities.UserModeMapStats{}
+	for _, row := range stats.ModeMaps {
+		if modes[row.Mode] == nil {
+			modes[row.Mode] = &entities.UserModeMapStats{Mode: row.Mode}
+		}
+		modes[row.Mode].GamesPlayed += row.GamesPlayed
+		modes[row.Mode].TotalScore += row.TotalScore
+
+		if maps[row.MapId] == nil {
+			maps[row.MapId] = &entities.UserModeMapStats{MapId: row.MapId}
+		}
+		maps[row.MapId].GamesPlayed += row.GamesPlayed
+		maps[row.MapId].TotalScore += row.TotalScore
+		maps[row.MapId].BestScore = max(maps[row.MapId].BestScore, row.BestScore)
+	}
+	for _, mode := range modes {
+		output.ByMode = append(output.ByMode, ModeStatsOutput{
+			Mode:         string(mode.Mode),
+			GamesPlayed:  mode.GamesPlayed,
+			AverageScore: averageOf(mode.TotalScore, mode.GamesPlayed),
+		})
+	}
+	sort.Slice(output.ByMode, func(i, j int) bool { return output.ByMode[i].Mode < output.ByMode[j].Mode })
+	for _, m := range maps {
+		output.ByMap = append(output.ByMap, MapStatsOutput{
+			MapId:        m.MapId,
+			GamesPlayed:  m.GamesPlayed,
+			AverageScore: averageOf(m.TotalScore, m.GamesPlayed),
+			BestScore:    m.BestScore,
+		})
+	}
+	sort.Slice(output.ByMap, func(i, j int) bool {
+		if output.ByMap[i].GamesPlayed != output.ByMap[j].GamesPlayed {
+			return output.ByMap[i].GamesPlayed > output.ByMap[j].GamesPlayed
+		}
+		return output.ByMap[i].MapId < output.ByMap[j].MapId
+	})
+
+	for _, country := range stats.Countries {
+		output.ByCountry = append(output.ByCountry, CountryStatsOutput{
+			CountryCode:           country.CountryCode,
+			RoundsPlayed:          country.RoundsPlayed,
+			CorrectRounds:         country.CorrectRounds,
+			AverageScore:          averageOf(country.TotalScore, country.RoundsPlayed),
+			AverageDistanceMeters: averageOf(country.TotalDistance, country.RoundsPlayed),
+			Accuracy:              country.Accuracy(),
+		})
+	}
+	sort.Slice(output.ByCountry, func(i, j int) bool { return output.ByCountry[i].CountryCode < output.ByCountry[j].CountryCode })
+
+	return output, nil
+}
+
+func averageOf[T int64 | float64](total T, count int64) float64 {
+	if count == 0 {
+		return 0
+	}
+	return float64(total) / float64(count)
+}
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetUserStatsSuite struct {
+	suite.Suite
+	userRepo  *repomocks.MockUserRepository
+	statsRepo *repomocks.MockUserStatsRepository
+	uc        *GetUserStatsUseCase
+}
+
+func TestGetUserStatsSuite(t *testing.T) {
+	suite.Run(t, new(GetUserStatsSuite))
+}
+
+func (s *GetUserStatsSuite) SetupTest() {
+	s.userRepo = repomocks.NewMockUserRepository(s.T())
+	s.statsRepo = repomocks.NewMockUserStatsRepository(s.T())
+	s.uc = NewGetUserStatsUseCase(s.userRepo, s.statsRepo)
+}
+
+func (s *GetUserStatsSuite) TestExecute_FoldsModeMapRowsAndComputesAverages() {
+	bestGameId := "game-uuid"
+	s.userRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	s.statsRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(&entities.UserStats{
+		UserId:            "user-uuid",
+		GamesPlayed:       3,
+		RoundsPlayed:      15,
+		TotalScore:        45000,
+		PerfectRounds:     2,
+		TimedRounds:       15,
+		TotalGuessSeconds: 300,
+		BestGameId:        &bestGameId,
+		BestGameScore:     20000,
+		ModeMaps: []*entities.UserModeMapStats{
+			{Mode: entities.SinglePlayerGameModeMove, MapId: "map-a", GamesPlayed: 1, TotalScore: 20000, BestScore: 20000},
+			{Mode: entities.SinglePlayerGameModeNMPZ, MapId: "map-a", GamesPlayed: 1, TotalScore: 10000, BestScore: 10000},
+			{Mode: entities.SinglePlayerGameModeMove, MapId: "map-b", GamesPlayed: 1, TotalScore: 15000, BestScore: 15000},
+		},
+		Countries: []*entities.UserCountryStats{
+			{CountryCode: "BR", RoundsPlayed: 4, TotalScore: 16000, TotalDistance: 4000},
+		},
+		DistanceBuckets: []*entities.UserDistanceBucket{{Bucket: entities.DistanceBucket(1000), Rounds: 15}},
+	}, nil)
+
+	output, err := s.uc.Execute(context.Background(), "user-uuid")
+
+	s.Require().NoError(err)
+	s.InDelta(15000, output.AverageScore, 0.001)
+	s.InDelta(20, output.AverageGuessSeconds, 0.001)
+	s.Require().NotNil(output.BestGame)
+	s.Equal("game-uuid", output.BestGame.GameId)
+	s.Require().NotNil(output.MedianDistanceMeters)
+
+	s.Require().Len(output.ByMode, 2)
+	s.Equal("move", output.ByMode[0].Mode)
+	s.Equal(int64(2), output.ByMode[0].GamesPlayed)
+	s.InDelta(17500, output.ByMode[0].AverageScore, 0.001)
+
+	s.Require().Len(output.ByMap, 2)
+	s.Equal("map-a", output.ByMap[0].MapId)
+	s.Equal(int64(2), output.ByMap[0].GamesPlayed)
+	s.Equal(20000, output.ByMap[0].BestScore)
+
+	s.Require().Len(output.ByCountry, 1)
+	s.InDelta(0.8, output.ByCountry[0].Accuracy, 0.001)
+	s.InDelta(1000, output.ByCountry[0].AverageDistanceMeters, 0.001)
+}
+
+func (s *GetUserStatsSuite) TestExecute_WhenNoGamesPlayed_ReturnsEmptyStats() {
+	s.userRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	s.statsRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(nil, nil)
+
+	output, err := s.uc.Execute(context.Background(), "user-uuid")
+
+	s.Require().NoError(err)
+	s.Zero(output.GamesPlayed)
+	s.Nil(output.MedianDistanceMeters)
+	s.Nil(output.BestGame)
+	s.Empty(output.ByMode)
+}
+
+func (s *GetUserStatsSuite) TestExecute_WhenUserMissing_ReturnsNotFound() {
+	s.userRepo.EXPECT().FindById(mock.Anything, "missing").Return(nil, nil)
+
+	_, err := s.uc.Execute(context.Background(), "missing")
+
+	s.Require().Error(err)
+	s.Equal("user not found", err.Error())
+}
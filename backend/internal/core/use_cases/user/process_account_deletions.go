@@ -0,0 +1,129 @@
+package user
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+const accountDeletionBatchSize = 50
+
+// ProcessAccountDeletionsUseCase erases the accounts whose grace period is over. Each account is
+// erased in its own transaction: maps are offered to another user or deleted, credentials are hard-deleted, and
+// the user row is anonymized and soft-deleted so game history stays consistent without naming them.
+type ProcessAccountDeletionsUseCase struct {
+	accountDeletionRepository repositories.AccountDeletionRepository
+	userRepository            repositories.UserRepository
+	mapRepository             repositories.MapRepository
+	mapInvitationRepository   repositories.MapInvitationRepository
+	personalDataRepository    repositories.PersonalDataRepository
+	txManager                 transactions.TransactionManager
+	notifier                  services.Notifier
+}
+
+func NewProcessAccountDeletionsUseCase(accountDeletionRepository repositories.AccountDeletionRepository, userRepository repositories.UserRepository, mapRepository repositories.MapRepository, mapInvitationRepository repositories.MapInvitationRepository, personalDataRepository repositories.PersonalDataRepository, txManager transactions.TransactionManager, notifier services.Notifier) *ProcessAccountDeletionsUseCase {
+	return &ProcessAccountDeletionsUseCase{
+		accountDeletionRepository: accountDeletionRepository,
+		userRepository:            userRepository,
+		mapRepository:             mapRepository,
+		mapInvitationRepository:   mapInvitationRepository,
+		personalDataRepository:    personalDataRepository,
+		txManager:                 txManager,
+		notifier:                  notifier,
+	}
+}
+
+// Execute erases every due account and returns how many were erased. An account that cannot be
+// erased is logged and left for the next run, so that it does not hold back the others.
+func (uc *ProcessAccountDeletionsUseCase) Execute(ctx context.Context) (int, error) {
+	processed := 0
+	failed := make(map[string]bool)
+	for {
+		deletions, err := uc.accountDeletionRepository.FindDue(ctx, time.Now(), accountDeletionBatchSize)
+		if err != nil {
+			return processed, err
+		}
+		erased := 0
+		for _, deletion := range deletions {
+			if failed[deletion.UserId] {
+				continue
+			}
+			if err := uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+				return uc.erase(ctx, deletion)
+			}); err != nil {
+				log.Printf("failed to erase account %s: %v", deletion.UserId, err)
+				failed[deletion.UserId] = true
+				continue
+			}
+			erased++
+		}
+		processed += erased
+		// The failed deletions are due again in the next batch: once a batch holds nothing else,
+		// the rest waits for the next run.
+		if len(deletions) < accountDeletionBatchSize || erased == 0 {
+			return processed, nil
+		}
+	}
+}
+
+func (uc *ProcessAccountDeletionsUseCase) erase(ctx context.Context, deletion *entities.AccountDeletion) error {
+	user, err := uc.userRepository.FindById(ctx, deletion.UserId)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return uc.accountDeletionRepository.Delete(ctx, deletion)
+	}
+
+	if err := uc.disposeMaps(ctx, deletion); err != nil {
+		return err
+	}
+	if err := uc.personalDataRepository.EraseCredentials(ctx, user.ID); err != nil {
+		return err
+	}
+	user.Anonymize()
+	if err := uc.userRepository.Update(ctx, user); err != nil {
+		return err
+	}
+	if err := uc.userRepository.Delete(ctx, user); err != nil {
+		return err
+	}
+	return uc.accountDeletionRepository.Delete(ctx, deletion)
+}
+
+// disposeMaps offers the maps to the recipient, who only takes each one over by accepting its
+// invitation: until then, or if they decline, the map stays with the erased account. The maps are
+// deleted instead when the recipient is gone by the time the account is erased.
+func (uc *ProcessAccountDeletionsUseCase) disposeMaps(ctx context.Context, deletion *entities.AccountDeletion) error {
+	if deletion.MapsAction == entities.AccountDeletionMapsActionTransfer && deletion.TransferToUserId != nil {
+		recipient, err := uc.userRepository.FindById(ctx, *deletion.TransferToUserId)
+		if err != nil {
+			return err
+		}
+		if recipient != nil {
+			return uc.offerMaps(ctx, deletion.UserId, recipient.ID)
+		}
+	}
+	return uc.mapRepository.DeleteByOwnerId(ctx, deletion.UserId)
+}
+
+func (uc *ProcessAccountDeletionsUseCase) offerMaps(ctx context.Context, ownerId, recipientId string) error {
+	maps, err := uc.mapRepository.FindByOwnerId(ctx, ownerId)
+	if err != nil {
+		return err
+	}
+	for _, m := range maps {
+		if err := uc.mapInvitationRepository.Save(ctx, entities.NewMapInvitation(m.ID, recipientId, entities.MapRoleOwner, ownerId)); err != nil {
+			return err
+		}
+		if err := uc.notifier.Notify(ctx, entities.NewNotification(recipientId, entities.NotificationTypeMapInvitation, ownerId, m.ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProcessAccountDeletionsSuite struct {
+	suite.Suite
+	mockDeletionRepo     *repomocks.MockAccountDeletionRepository
+	mockUserRepo         *repomocks.MockUserRepository
+	mockMapRepo          *repomocks.MockMapRepository
+	mockInvitationRepo   *repomocks.MockMapInvitationRepository
+	mockNotifier         *servicemocks.MockNotifier
+	mockPersonalDataRepo *repomocks.MockPersonalDataRepository
+	uc                   *ProcessAccountDeletionsUseCase
+}
+
+func TestProcessAccountDeletionsSuite(t *testing.T) {
+	suite.Run(t, new(ProcessAccountDeletionsSuite))
+}
+
+func (s *ProcessAccountDeletionsSuite) SetupTest() {
+	s.mockDeletionRepo = repomocks.NewMockAccountDeletionRepository(s.T())
+	s.mockUserRepo = repomocks.NewMockUserRepository(s.T())
+	s.mockMapRepo = repomocks.NewMockMapRepository(s.T())
+	s.mockInvitationRepo = repomocks.NewMockMapInvitationRepository(s.T())
+	s.mockNotifier = servicemocks.NewMockNotifier(s.T())
+	s.mockPersonalDataRepo = repomocks.NewMockPersonalDataRepository(s.T())
+	txManager := txmocks.NewMockTransactionManager(s.T())
+	passThroughTx(txManager)
+	s.uc = NewProcessAccountDeletionsUseCase(s.mockDeletionRepo, s.mockUserRepo, s.mockMapRepo, s.mockInvitationRepo, s.mockPersonalDataRepo, txManager, s.mockNotifier)
+}
+
+func (s *ProcessAccountDeletionsSuite) expectErasure(user *entities.User, deletion *entities.AccountDeletion) {
+	s.mockPersonalDataRepo.EXPECT().EraseCredentials(mock.Anything, user.ID).Return(nil)
+	s.mockUserRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+		return u.Name == "Deleted user" && !u.HasPassword()
+	})).Return(nil)
+	s.mockUserRepo.EXPECT().Delete(mock.Anything, user).Return(nil)
+	s.mockDeletionRepo.EXPECT().Delete(mock.Anything, deletion).Return(nil)
+}
+
+func (s *ProcessAccountDeletionsSuite) TestExecute_OffersMapsToRecipientAndErasesAccount() {
+	recipientId := "jane-uuid"
+	deletion := entities.NewAccountDeletion("user-uuid", entities.AccountDeletionMapsActionTransfer, &recipientId, time.Now().Add(-entities.AccountDeletionGracePeriod))
+	user := entities.RestoreUser("0b8f5f3e-8f2a-4c43-9a0e-5d4c3b2a1f00", "John", "j@example.com", "john", "hash")
+	deletion.UserId = user.ID
+
+	s.mockDeletionRepo.EXPECT().FindDue(mock.Anything, mock.Anything, accountDeletionBatchSize).Return([]*entities.AccountDeletion{deletion}, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, user.ID).Return(user, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, recipientId).Return(entities.RestoreUser(recipientId, "Jane", "jane@example.com", "jane", "hash"), nil)
+	s.mockMapRepo.EXPECT().FindByOwnerId(mock.Anything, user.ID).Return([]*entities.Map{entities.RestoreMap("map-uuid", "Brazil", "", user.ID)}, nil)
+	s.mockInvitationRepo.EXPECT().
+		Save(mock.Anything, mock.MatchedBy(func(i *entities.MapInvitation) bool {
+			return i.MapId == "map-uuid" && i.UserId == recipientId && i.IsOwnershipTransfer() && i.InvitedById == user.ID
+		})).
+		Return(nil)
+	s.mockNotifier.EXPECT().
+		Notify(mock.Anything, mock.MatchedBy(func(n *entities.Notification) bool {
+			return n.UserId == recipientId && n.Type == entities.NotificationTypeMapInvitation && n.SubjectId == "map-uuid"
+		})).
+		Return(nil)
+	s.expectErasure(user, deletion)
+
+	processed, err := s.uc.Execute(context.Background())
+
+	s.Require().NoError(err)
+	s.Equal(1, processed)
+	s.Equal("Deleted user", user.Name)
+}
+
+func (s *ProcessAccountDeletionsSuite) TestExecute_WhenRecipientGone_DeletesMaps() {
+	recipientId := "jane-uuid"
+	deletion := entities.NewAccountDeletion("user-uuid", entities.AccountDeletionMapsActionTransfer, &recipientId, time.Now().Add(-entities.AccountDeletionGracePeriod))
+	user := entities.RestoreUser("user-uuid-0000-0000", "John", "j@example.com", "john", "hash")
+	deletion.UserId = user.ID
+
+	s.mockDeletionRepo.EXPECT().FindDue(mock.Anything, mock.Anything, accountDeletionBatchSize).Return([]*entities.AccountDeletion{deletion}, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, user.ID).Return(user, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, recipientId).Return(nil, nil)
+	s.mockMapRepo.EXPECT().DeleteByOwnerId(mock.Anything, user.ID).Return(nil)
+	s.expectErasure(user, deletion)
+
+	processed, err := s.uc.Execute(context.Background())
+
+	s.Require().NoError(err)
+	s.Equal(1, processed)
+}
+
+func (s *ProcessAccountDeletionsSuite) TestExecute_WhenAnAccountFails_ErasesTheOthers() {
+	failing := entities.NewAccountDeletion("failing-uuid", entities.AccountDeletionMapsActionDelete, nil, time.Now().Add(-entities.AccountDeletionGracePeriod))
+	deletion := entities.NewAccountDeletion("user-uuid", entities.AccountDeletionMapsActionDelete, nil, time.Now().Add(-entities.AccountDeletionGracePeriod))
+	user := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash")
+
+	s.mockDeletionRepo.EXPECT().FindDue(mock.Anything, mock.Anything, accountDeletionBatchSize).Return([]*entities.AccountDeletion{failing, deletion}, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "failing-uuid").Return(nil, errMock)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, user.ID).Return(user, nil)
+	s.mockMapRepo.EXPECT().DeleteByOwnerId(mock.Anything, user.ID).Return(nil)
+	s.expectErasure(user, deletion)
+
+	processed, err := s.uc.Execute(context.Background())
+
+	s.Require().NoError(err)
+	s.Equal(1, processed)
+}
+
+func (s *ProcessAccountDeletionsSuite) TestExecute_WhenABatchOnlyHoldsFailures_Stops() {
+	deletions := make([]*entities.AccountDeletion, accountDeletionBatchSize)
+	for i := range deletions {
+		deletions[i] = entities.NewAccountDeletion(fmt.Sprintf("user-%02d", i), entities.AccountDeletionMapsActionDelete, nil, time.Now().Add(-entities.AccountDeletionGracePeriod))
+	}
+	s.mockDeletionRepo.EXPECT().FindDue(mock.Anything, mock.Anything, accountDeletionBatchSize).Return(deletions, nil).Once()
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, mock.Anything).Return(nil, errMock).Times(accountDeletionBatchSize)
+
+	processed, err := s.uc.Execute(context.Background())
+
+	s.Require().NoError(err)
+	s.Zero(processed)
+}
+
+func (s *ProcessAccountDeletionsSuite) TestExecute_WhenNothingDue_DoesNothing() {
+	s.mockDeletionRepo.EXPECT().FindDue(mock.Anything, mock.Anything, accountDeletionBatchSize).Return(nil, nil)
+
+	processed, err := s.uc.Execute(context.Background())
+
+	s.Require().NoError(err)
+	s.Zero(processed)
+}
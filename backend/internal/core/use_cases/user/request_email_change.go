@@ -0,0 +1,88 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+const emailChangeRequestTTL = time.Hour * 24
+
+type RequestEmailChangeInput struct {
+	UserId   string
+	NewEmail string
+	// Password is required from users who have one, so a hijacked session cannot take over the account.
+	Password string
+	// ConfirmationCode is required from users without a password instead, as mailed to their current
+	// address by RequestReauthenticationUseCase.
+	ConfirmationCode string
+}
+
+// RequestEmailChangeUseCase mails a confirmation token to the new address. The email only changes
+// once ConfirmEmailChangeUseCase receives that token; a new request replaces any pending one.
+type RequestEmailChangeUseCase struct {
+	userRepository               repositories.UserRepository
+	emailChangeRequestRepository repositories.EmailChangeRequestRepository
+	txManager                    transactions.TransactionManager
+	emailVerificationService     *services.EmailVerificationService
+	reauthenticationService      *services.ReauthenticationService
+}
+
+func NewRequestEmailChangeUseCase(userRepository repositories.UserRepository, emailChangeRequestRepository repositories.EmailChangeRequestRepository, txManager transactions.TransactionManager, emailVerificationService *services.EmailVerificationService, reauthenticationService *services.ReauthenticationService) *RequestEmailChangeUseCase {
+	return &RequestEmailChangeUseCase{
+		userRepository:               userRepository,
+		emailChangeRequestRepository: emailChangeRequestRepository,
+		txManager:                    txManager,
+		emailVerificationService:     emailVerificationService,
+		reauthenticationService:      reauthenticationService,
+	}
+}
+
+func (uc *RequestEmailChangeUseCase) Execute(ctx context.Context, input RequestEmailChangeInput) error {
+	user, err := uc.userRepository.FindById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return coreerrors.NotFound("user not found")
+	}
+	if strings.EqualFold(input.NewEmail, user.Email) {
+		return coreerrors.BadRequest("new email must differ from the current one")
+	}
+	if user.HasPassword() {
+		if user.ComparePassword(input.Password) != nil {
+			return coreerrors.Unauthorized("password is incorrect")
+		}
+	} else if err := uc.reauthenticationService.VerifyCode(ctx, user.ID, input.ConfirmationCode); err != nil {
+		return err
+	}
+	existingUserByEmail, err := uc.userRepository.FindByEmail(ctx, input.NewEmail)
+	if err != nil {
+		return err
+	}
+	if existingUserByEmail != nil {
+		return coreerrors.Conflict("user with email already exists")
+	}
+
+	token, err := uc.emailVerificationService.GenerateToken()
+	if err != nil {
+		return err
+	}
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.emailChangeRequestRepository.DeleteByUserId(ctx, user.ID); err != nil {
+			return err
+		}
+		request := entities.NewEmailChangeRequest(user.ID, input.NewEmail, uc.emailVerificationService.HashToken(token), time.Now().Add(emailChangeRequestTTL))
+		return uc.emailChangeRequestRepository.Create(ctx, request)
+	})
+	if err != nil {
+		return err
+	}
+	return uc.emailVerificationService.SendEmailChangeConfirmation(ctx, input.NewEmail, token)
+}
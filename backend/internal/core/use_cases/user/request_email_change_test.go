@@ -0,0 +1,144 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmailChangeSuite struct {
+	suite.Suite
+	mockUserRepo        *repomocks.MockUserRepository
+	mockEmailChangeRepo *repomocks.MockEmailChangeRequestRepository
+	mockCodeRepo        *repomocks.MockReauthenticationCodeRepository
+	mockMailer          *servicemocks.MockMailer
+	verification        *services.EmailVerificationService
+	requestUC           *RequestEmailChangeUseCase
+	confirmUC           *ConfirmEmailChangeUseCase
+}
+
+func TestEmailChangeSuite(t *testing.T) {
+	suite.Run(t, new(EmailChangeSuite))
+}
+
+func (s *EmailChangeSuite) SetupTest() {
+	s.mockUserRepo = repomocks.NewMockUserRepository(s.T())
+	s.mockEmailChangeRepo = repomocks.NewMockEmailChangeRequestRepository(s.T())
+	s.mockCodeRepo = repomocks.NewMockReauthenticationCodeRepository(s.T())
+	s.mockMailer = servicemocks.NewMockMailer(s.T())
+	s.verification = services.NewEmailVerificationService(s.mockMailer, "https://maya.example/confirm-email")
+	txManager := txmocks.NewMockTransactionManager(s.T())
+	passThroughTx(txManager)
+	reauthentication := services.NewReauthenticationService(s.mockCodeRepo, s.verification)
+	s.requestUC = NewRequestEmailChangeUseCase(s.mockUserRepo, s.mockEmailChangeRepo, txManager, s.verification, reauthentication)
+	s.confirmUC = NewConfirmEmailChangeUseCase(s.mockUserRepo, s.mockEmailChangeRepo, txManager, s.verification)
+}
+
+func (s *EmailChangeSuite) newUser() *entities.User {
+	u := entities.RestoreUser("user-uuid", "John", "old@example.com", "john", "secret123")
+	s.Require().NoError(u.EncryptPassword())
+	return u
+}
+
+func (s *EmailChangeSuite) TestRequest_StoresHashedTokenAndMailsNewAddress() {
+	var stored *entities.EmailChangeRequest
+	var sent services.MailMessage
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+	s.mockUserRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+	s.mockEmailChangeRepo.EXPECT().DeleteByUserId(mock.Anything, "user-uuid").Return(nil)
+	s.mockEmailChangeRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entities.EmailChangeRequest")).
+		Run(func(_ context.Context, request *entities.EmailChangeRequest) { stored = request }).
+		Return(nil)
+	s.mockMailer.EXPECT().Send(mock.Anything, mock.AnythingOfType("services.MailMessage")).
+		Run(func(_ context.Context, message services.MailMessage) { sent = message }).
+		Return(nil)
+
+	err := s.requestUC.Execute(context.Background(), RequestEmailChangeInput{UserId: "user-uuid", NewEmail: "new@example.com", Password: "secret123"})
+
+	s.Require().NoError(err)
+	s.Equal("new@example.com", stored.NewEmail)
+	s.Equal("new@example.com", sent.To)
+	_, token, found := strings.Cut(sent.Body, "token=")
+	s.Require().True(found)
+	token, _, _ = strings.Cut(token, "\n")
+	s.Equal(s.verification.HashToken(token), stored.TokenHash)
+}
+
+func (s *EmailChangeSuite) TestRequest_WhenPasswordWrong_ReturnsUnauthorized() {
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+
+	err := s.requestUC.Execute(context.Background(), RequestEmailChangeInput{UserId: "user-uuid", NewEmail: "new@example.com", Password: "wrong"})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "password is incorrect")
+}
+
+func (s *EmailChangeSuite) TestRequest_WhenEmailTaken_ReturnsConflict() {
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+	s.mockUserRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(entities.RestoreUser("other-uuid", "Other", "new@example.com", "other", "hash"), nil)
+
+	err := s.requestUC.Execute(context.Background(), RequestEmailChangeInput{UserId: "user-uuid", NewEmail: "new@example.com", Password: "secret123"})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "user with email already exists")
+}
+
+func (s *EmailChangeSuite) TestRequest_WhenNoPasswordAndCodeMissing_ReturnsUnauthorized() {
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "old@example.com", "john", ""), nil)
+	s.mockCodeRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(nil, nil)
+
+	err := s.requestUC.Execute(context.Background(), RequestEmailChangeInput{UserId: "user-uuid", NewEmail: "new@example.com"})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or expired confirmation code")
+}
+
+func (s *EmailChangeSuite) TestRequest_WhenNoPassword_AcceptsMailedCode() {
+	code := entities.NewReauthenticationCode("user-uuid", s.verification.HashToken("mailed-code"), time.Now().Add(time.Minute))
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "old@example.com", "john", ""), nil)
+	s.mockCodeRepo.EXPECT().FindByUserId(mock.Anything, "user-uuid").Return(code, nil)
+	s.mockCodeRepo.EXPECT().Delete(mock.Anything, code).Return(nil)
+	s.mockUserRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+	s.mockEmailChangeRepo.EXPECT().DeleteByUserId(mock.Anything, "user-uuid").Return(nil)
+	s.mockEmailChangeRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entities.EmailChangeRequest")).Return(nil)
+	s.mockMailer.EXPECT().Send(mock.Anything, mock.AnythingOfType("services.MailMessage")).Return(nil)
+
+	err := s.requestUC.Execute(context.Background(), RequestEmailChangeInput{UserId: "user-uuid", NewEmail: "new@example.com", ConfirmationCode: "mailed-code"})
+
+	s.Require().NoError(err)
+}
+
+func (s *EmailChangeSuite) TestConfirm_ChangesEmailAndConsumesRequest() {
+	request := entities.NewEmailChangeRequest("user-uuid", "new@example.com", s.verification.HashToken("token"), time.Now().Add(time.Hour))
+	s.mockEmailChangeRepo.EXPECT().FindByTokenHash(mock.Anything, s.verification.HashToken("token")).Return(request, nil)
+	s.mockUserRepo.EXPECT().FindByEmail(mock.Anything, "new@example.com").Return(nil, nil)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+	s.mockUserRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+		return u.Email == "new@example.com"
+	})).Return(nil)
+	s.mockEmailChangeRepo.EXPECT().Delete(mock.Anything, request).Return(nil)
+
+	user, err := s.confirmUC.Execute(context.Background(), "token")
+
+	s.Require().NoError(err)
+	s.Equal("new@example.com", user.Email)
+}
+
+func (s *EmailChangeSuite) TestConfirm_WhenExpired_ReturnsBadRequest() {
+	request := entities.NewEmailChangeRequest("user-uuid", "new@example.com", s.verification.HashToken("token"), time.Now().Add(-time.Hour))
+	s.mockEmailChangeRepo.EXPECT().FindByTokenHash(mock.Anything, s.verification.HashToken("token")).Return(request, nil)
+
+	_, err := s.confirmUC.Execute(context.Background(), "token")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "invalid or expired")
+}
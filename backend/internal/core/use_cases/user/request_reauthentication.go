@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+// RequestReauthenticationUseCase mails a confirmation code to users without a password, signed up
+// through an identity provider, which they give to set a password or change their email. Users with
+// a password confirm with it instead.
+type RequestReauthenticationUseCase struct {
+	userRepository          repositories.UserRepository
+	reauthenticationService *services.ReauthenticationService
+}
+
+func NewRequestReauthenticationUseCase(userRepository repositories.UserRepository, reauthenticationService *services.ReauthenticationService) *RequestReauthenticationUseCase {
+	return &RequestReauthenticationUseCase{
+		userRepository:          userRepository,
+		reauthenticationService: reauthenticationService,
+	}
+}
+
+func (uc *RequestReauthenticationUseCase) Execute(ctx context.Context, userId string) error {
+	user, err := uc.userRepository.FindById(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return coreerrors.NotFound("user not found")
+	}
+	if user.HasPassword() {
+		return coreerrors.BadRequest("account has a password: confirm changes with it")
+	}
+	return uc.reauthenticationService.SendCode(ctx, user)
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	servicemocks "github.com/mvcris/maya-guessr/backend/internal/core/services/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RequestReauthenticationSuite struct {
+	suite.Suite
+	mockUserRepo *repomocks.MockUserRepository
+	mockCodeRepo *repomocks.MockReauthenticationCodeRepository
+	mockMailer   *servicemocks.MockMailer
+	verification *services.EmailVerificationService
+	uc           *RequestReauthenticationUseCase
+}
+
+func TestRequestReauthenticationSuite(t *testing.T) {
+	suite.Run(t, new(RequestReauthenticationSuite))
+}
+
+func (s *RequestReauthenticationSuite) SetupTest() {
+	s.mockUserRepo = repomocks.NewMockUserRepository(s.T())
+	s.mockCodeRepo = repomocks.NewMockReauthenticationCodeRepository(s.T())
+	s.mockMailer = servicemocks.NewMockMailer(s.T())
+	s.verification = services.NewEmailVerificationService(s.mockMailer, "")
+	s.uc = NewRequestReauthenticationUseCase(s.mockUserRepo, services.NewReauthenticationService(s.mockCodeRepo, s.verification))
+}
+
+func (s *RequestReauthenticationSuite) TestExecute_MailsCodeToCurrentAddress() {
+	var stored *entities.ReauthenticationCode
+	var sent services.MailMessage
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", ""), nil)
+	s.mockCodeRepo.EXPECT().Save(mock.Anything, mock.AnythingOfType("*entities.ReauthenticationCode")).
+		Run(func(_ context.Context, code *entities.ReauthenticationCode) { stored = code }).
+		Return(nil)
+	s.mockMailer.EXPECT().Send(mock.Anything, mock.AnythingOfType("services.MailMessage")).
+		Run(func(_ context.Context, message services.MailMessage) { sent = message }).
+		Return(nil)
+
+	err := s.uc.Execute(context.Background(), "user-uuid")
+
+	s.Require().NoError(err)
+	s.Equal("j@example.com", sent.To)
+	s.Equal("user-uuid", stored.UserId)
+	s.NotContains(sent.Body, stored.CodeHash)
+	s.False(stored.IsExpired())
+}
+
+func (s *RequestReauthenticationSuite) TestExecute_WhenUserHasPassword_ReturnsBadRequest() {
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+
+	err := s.uc.Execute(context.Background(), "user-uuid")
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "account has a password")
+}
@@ -0,0 +1,82 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/core/transactions"
+)
+
+type ScheduleAccountDeletionInput struct {
+	UserId string
+	// Password is required from users who have one.
+	Password   string
+	MapsAction entities.AccountDeletionMapsAction
+	// TransferToUsername names the user offered the maps when MapsAction is transfer.
+	TransferToUsername string
+}
+
+// ScheduleAccountDeletionUseCase schedules the erasure of the user's account after the grace period
+// and signs them out everywhere. Signing back in remains possible so the deletion can be cancelled.
+type ScheduleAccountDeletionUseCase struct {
+	userRepository            repositories.UserRepository
+	accountDeletionRepository repositories.AccountDeletionRepository
+	refreshTokenRepository    repositories.RefreshTokenRepository
+	txManager                 transactions.TransactionManager
+}
+
+func NewScheduleAccountDeletionUseCase(userRepository repositories.UserRepository, accountDeletionRepository repositories.AccountDeletionRepository, refreshTokenRepository repositories.RefreshTokenRepository, txManager transactions.TransactionManager) *ScheduleAccountDeletionUseCase {
+	return &ScheduleAccountDeletionUseCase{
+		userRepository:            userRepository,
+		accountDeletionRepository: accountDeletionRepository,
+		refreshTokenRepository:    refreshTokenRepository,
+		txManager:                 txManager,
+	}
+}
+
+func (uc *ScheduleAccountDeletionUseCase) Execute(ctx context.Context, input ScheduleAccountDeletionInput) (*entities.AccountDeletion, error) {
+	user, err := uc.userRepository.FindById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, coreerrors.NotFound("user not found")
+	}
+	if user.HasPassword() && user.ComparePassword(input.Password) != nil {
+		return nil, coreerrors.Unauthorized("password is incorrect")
+	}
+
+	var transferToUserId *string
+	switch input.MapsAction {
+	case entities.AccountDeletionMapsActionDelete:
+	case entities.AccountDeletionMapsActionTransfer:
+		recipient, err := uc.userRepository.FindByUsername(ctx, input.TransferToUsername)
+		if err != nil {
+			return nil, err
+		}
+		if recipient == nil || recipient.IsBanned() {
+			return nil, coreerrors.BadRequest("map recipient not found")
+		}
+		if recipient.ID == user.ID {
+			return nil, coreerrors.BadRequest("maps cannot be transferred to the account being deleted")
+		}
+		transferToUserId = &recipient.ID
+	default:
+		return nil, coreerrors.BadRequest("maps action must be delete or transfer")
+	}
+
+	deletion := entities.NewAccountDeletion(user.ID, input.MapsAction, transferToUserId, time.Now())
+	err = uc.txManager.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.accountDeletionRepository.Save(ctx, deletion); err != nil {
+			return err
+		}
+		return uc.refreshTokenRepository.ExpireAllByUserId(ctx, user.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	txmocks "github.com/mvcris/maya-guessr/backend/internal/core/transactions/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleAccountDeletionSuite struct {
+	suite.Suite
+	mockUserRepo     *repomocks.MockUserRepository
+	mockDeletionRepo *repomocks.MockAccountDeletionRepository
+	mockRefreshRepo  *repomocks.MockRefreshTokenRepository
+	txManager        *txmocks.MockTransactionManager
+	uc               *ScheduleAccountDeletionUseCase
+}
+
+func TestScheduleAccountDeletionSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleAccountDeletionSuite))
+}
+
+func (s *ScheduleAccountDeletionSuite) SetupTest() {
+	s.mockUserRepo = repomocks.NewMockUserRepository(s.T())
+	s.mockDeletionRepo = repomocks.NewMockAccountDeletionRepository(s.T())
+	s.mockRefreshRepo = repomocks.NewMockRefreshTokenRepository(s.T())
+	s.txManager = txmocks.NewMockTransactionManager(s.T())
+	s.uc = NewScheduleAccountDeletionUseCase(s.mockUserRepo, s.mockDeletionRepo, s.mockRefreshRepo, s.txManager)
+}
+
+func (s *ScheduleAccountDeletionSuite) newUser() *entities.User {
+	u := entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "secret123")
+	s.Require().NoError(u.EncryptPassword())
+	return u
+}
+
+func (s *ScheduleAccountDeletionSuite) TestExecute_WithTransfer_SchedulesAndRevokesSessions() {
+	passThroughTx(s.txManager)
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+	s.mockUserRepo.EXPECT().FindByUsername(mock.Anything, "jane").Return(entities.RestoreUser("jane-uuid", "Jane", "jane@example.com", "jane", "hash"), nil)
+	s.mockDeletionRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(d *entities.AccountDeletion) bool {
+		return d.UserId == "user-uuid" && d.MapsAction == entities.AccountDeletionMapsActionTransfer &&
+			d.TransferToUserId != nil && *d.TransferToUserId == "jane-uuid"
+	})).Return(nil)
+	s.mockRefreshRepo.EXPECT().ExpireAllByUserId(mock.Anything, "user-uuid").Return(nil)
+
+	deletion, err := s.uc.Execute(context.Background(), ScheduleAccountDeletionInput{
+		UserId:             "user-uuid",
+		Password:           "secret123",
+		MapsAction:         entities.AccountDeletionMapsActionTransfer,
+		TransferToUsername: "jane",
+	})
+
+	s.Require().NoError(err)
+	s.WithinDuration(time.Now().Add(entities.AccountDeletionGracePeriod), deletion.ScheduledFor, time.Minute)
+}
+
+func (s *ScheduleAccountDeletionSuite) TestExecute_WhenPasswordWrong_ReturnsUnauthorized() {
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+
+	_, err := s.uc.Execute(context.Background(), ScheduleAccountDeletionInput{
+		UserId:     "user-uuid",
+		Password:   "wrong",
+		MapsAction: entities.AccountDeletionMapsActionDelete,
+	})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "password is incorrect")
+}
+
+func (s *ScheduleAccountDeletionSuite) TestExecute_WhenTransferringToSelf_ReturnsBadRequest() {
+	user := s.newUser()
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(user, nil)
+	s.mockUserRepo.EXPECT().FindByUsername(mock.Anything, "john").Return(user, nil)
+
+	_, err := s.uc.Execute(context.Background(), ScheduleAccountDeletionInput{
+		UserId:             "user-uuid",
+		Password:           "secret123",
+		MapsAction:         entities.AccountDeletionMapsActionTransfer,
+		TransferToUsername: "john",
+	})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "cannot be transferred")
+}
+
+func (s *ScheduleAccountDeletionSuite) TestExecute_WhenMapsActionUnknown_ReturnsBadRequest() {
+	s.mockUserRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(s.newUser(), nil)
+
+	_, err := s.uc.Execute(context.Background(), ScheduleAccountDeletionInput{
+		UserId:     "user-uuid",
+		Password:   "secret123",
+		MapsAction: "keep",
+	})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "maps action")
+}
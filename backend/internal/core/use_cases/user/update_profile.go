@@ -0,0 +1,56 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+// UpdateProfileInput holds the fields to change. Nil fields are left untouched.
+type UpdateProfileInput struct {
+	UserId   string
+	Name     *string
+	Username *string
+}
+
+type UpdateProfileUseCase struct {
+	userRepository repositories.UserRepository
+}
+
+func NewUpdateProfileUseCase(userRepository repositories.UserRepository) *UpdateProfileUseCase {
+	return &UpdateProfileUseCase{userRepository: userRepository}
+}
+
+func (uc *UpdateProfileUseCase) Execute(ctx context.Context, input UpdateProfileInput) (*entities.User, error) {
+	user, err := uc.userRepository.FindById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, coreerrors.NotFound("user not found")
+	}
+
+	if input.Username != nil && *input.Username != user.Username {
+		if entities.IsReservedUsername(*input.Username) {
+			return nil, coreerrors.BadRequest("username is reserved")
+		}
+		existingUserByUsername, err := uc.userRepository.FindByUsername(ctx, *input.Username)
+		if err != nil {
+			return nil, err
+		}
+		if existingUserByUsername != nil {
+			return nil, coreerrors.Conflict("user with username already exists")
+		}
+		user.Username = *input.Username
+	}
+	if input.Name != nil {
+		user.Name = *input.Name
+	}
+
+	if err := uc.userRepository.Update(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,76 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	repomocks "github.com/mvcris/maya-guessr/backend/internal/core/repositories/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateProfileSuite struct {
+	suite.Suite
+}
+
+func TestUpdateProfileSuite(t *testing.T) {
+	suite.Run(t, new(UpdateProfileSuite))
+}
+
+func (s *UpdateProfileSuite) TestExecute_UpdatesNameAndUsername() {
+	mockRepo := repomocks.NewMockUserRepository(s.T())
+	uc := NewUpdateProfileUseCase(mockRepo)
+	name, username := "Johnny", "johnny"
+
+	mockRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	mockRepo.EXPECT().FindByUsername(mock.Anything, "johnny").Return(nil, nil)
+	mockRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(u *entities.User) bool {
+		return u.Name == "Johnny" && u.Username == "johnny"
+	})).Return(nil)
+
+	user, err := uc.Execute(context.Background(), UpdateProfileInput{UserId: "user-uuid", Name: &name, Username: &username})
+
+	s.Require().NoError(err)
+	s.Equal("johnny", user.Username)
+}
+
+func (s *UpdateProfileSuite) TestExecute_WhenUsernameUnchanged_SkipsUniquenessCheck() {
+	mockRepo := repomocks.NewMockUserRepository(s.T())
+	uc := NewUpdateProfileUseCase(mockRepo)
+	username := "john"
+
+	mockRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	mockRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+	_, err := uc.Execute(context.Background(), UpdateProfileInput{UserId: "user-uuid", Username: &username})
+
+	s.Require().NoError(err)
+}
+
+func (s *UpdateProfileSuite) TestExecute_WhenUsernameTaken_ReturnsConflict() {
+	mockRepo := repomocks.NewMockUserRepository(s.T())
+	uc := NewUpdateProfileUseCase(mockRepo)
+	username := "taken"
+
+	mockRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+	mockRepo.EXPECT().FindByUsername(mock.Anything, "taken").Return(entities.RestoreUser("other-uuid", "Other", "o@example.com", "taken", "hash"), nil)
+
+	_, err := uc.Execute(context.Background(), UpdateProfileInput{UserId: "user-uuid", Username: &username})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "user with username already exists")
+}
+
+func (s *UpdateProfileSuite) TestExecute_WhenUsernameReserved_ReturnsBadRequest() {
+	mockRepo := repomocks.NewMockUserRepository(s.T())
+	uc := NewUpdateProfileUseCase(mockRepo)
+	username := "deleted_0b8f5f3e8f2a"
+
+	mockRepo.EXPECT().FindById(mock.Anything, "user-uuid").Return(entities.RestoreUser("user-uuid", "John", "j@example.com", "john", "hash"), nil)
+
+	_, err := uc.Execute(context.Background(), UpdateProfileInput{UserId: "user-uuid", Username: &username})
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "username is reserved")
+}
@@ -0,0 +1,36 @@
+package gorm
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// AdvisoryLock keeps a background job to one instance of the API at a time, with a Postgres
+// session-level advisory lock.
+type AdvisoryLock struct {
+	db *gorm.DB
+}
+
+func NewAdvisoryLock(db *gorm.DB) *AdvisoryLock {
+	return &AdvisoryLock{db: db}
+}
+
+// RunExclusively runs fn unless another session holds the lock named name, in which case it
+// returns right away. The lock is held by a dedicated connection while fn runs, so Postgres also
+// releases it if the instance dies mid-run.
+func (l *AdvisoryLock) RunExclusively(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	return l.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		var acquired bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(hashtext(?))", name).Scan(&acquired).Error; err != nil {
+			return err
+		}
+		if !acquired {
+			return nil
+		}
+		// The connection goes back to the pool afterwards: unlock even if ctx was cancelled, or it
+		// would keep the lock.
+		defer conn.WithContext(context.WithoutCancel(ctx)).Exec("SELECT pg_advisory_unlock(hashtext(?))", name)
+		return fn(ctx)
+	})
+}
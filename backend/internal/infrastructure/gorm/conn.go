@@ -13,9 +13,15 @@ func NewConnection(dsn string) (*gorm.DB, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = db.AutoMigrate(&entities.User{}, &entities.RefreshToken{}, &entities.Map{}, &entities.Location{}, &entities.SinglePlayerGame{}, &entities.SinglePlayerRound{})
+	err = db.AutoMigrate(&entities.User{}, &entities.RefreshToken{}, &entities.Map{}, &entities.Location{}, &entities.SinglePlayerGame{}, &entities.SinglePlayerRound{}, &entities.UserIdentity{}, &entities.OidcAuthState{}, &entities.UserTwoFactor{}, &entities.TwoFactorBackupCode{}, &entities.RateLimitBucket{}, &entities.AccountLockout{}, &entities.PersonalAccessToken{}, &entities.EmailChangeRequest{}, &entities.AccountDeletion{}, &entities.UserStats{}, &entities.UserModeMapStats{}, &entities.UserCountryStats{}, &entities.UserDistanceBucket{}, &entities.LeaderboardEntry{}, &entities.UserAchievement{}, &entities.Friendship{}, &entities.UserBlock{}, &entities.Notification{}, &entities.MapCollaborator{}, &entities.MapRevision{}, &entities.MapRevisionChange{}, &entities.MapLike{}, &entities.MapRating{}, &entities.ReauthenticationCode{}, &entities.MapInvitation{}, &entities.JobState{})
 	if err != nil {
 		return nil, err
 	}
+	// idx_locations_sample was replaced by idx_locations_strata, which also covers the difficulty.
+	if db.Migrator().HasIndex(&entities.Location{}, "idx_locations_sample") {
+		if err := db.Migrator().DropIndex(&entities.Location{}, "idx_locations_sample"); err != nil {
+			return nil, err
+		}
+	}
 	return db, nil
 }
\ No newline at end of file
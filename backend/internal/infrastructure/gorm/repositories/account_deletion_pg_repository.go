@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AccountDeletionPgRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountDeletionPgRepository(db *gorm.DB) repositories.AccountDeletionRepository {
+	return &AccountDeletionPgRepository{db: db}
+}
+
+func (r *AccountDeletionPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *AccountDeletionPgRepository) Save(ctx context.Context, deletion *entities.AccountDeletion) error {
+	return r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"maps_action", "transfer_to_user_id", "scheduled_for", "updated_at"}),
+	}).Create(deletion).Error
+}
+
+func (r *AccountDeletionPgRepository) FindByUserId(ctx context.Context, userId string) (*entities.AccountDeletion, error) {
+	var deletion entities.AccountDeletion
+	if err := r.getDB(ctx).Where("user_id = ?", userId).First(&deletion).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &deletion, nil
+}
+
+func (r *AccountDeletionPgRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entities.AccountDeletion, error) {
+	var deletions []*entities.AccountDeletion
+	if err := r.getDB(ctx).Where("scheduled_for <= ?", now).Order("scheduled_for ASC").Limit(limit).Find(&deletions).Error; err != nil {
+		return nil, err
+	}
+	return deletions, nil
+}
+
+func (r *AccountDeletionPgRepository) Delete(ctx context.Context, deletion *entities.AccountDeletion) error {
+	return r.getDB(ctx).Delete(deletion).Error
+}
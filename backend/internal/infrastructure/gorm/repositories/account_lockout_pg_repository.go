@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AccountLockoutPgRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountLockoutPgRepository(db *gorm.DB) repositories.AccountLockoutRepository {
+	return &AccountLockoutPgRepository{db: db}
+}
+
+func (r *AccountLockoutPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *AccountLockoutPgRepository) FindByUserId(ctx context.Context, userId string) (*entities.AccountLockout, error) {
+	var lockout entities.AccountLockout
+	if err := r.getDB(ctx).Where("user_id = ?", userId).First(&lockout).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+func (r *AccountLockoutPgRepository) FindByUserIdWithLock(ctx context.Context, userId string) (*entities.AccountLockout, error) {
+	var lockout entities.AccountLockout
+	if err := r.getDB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userId).First(&lockout).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+// Save inserts the lockout or overwrites the existing row for the same user.
+func (r *AccountLockoutPgRepository) Save(ctx context.Context, lockout *entities.AccountLockout) error {
+	return r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"failed_attempts", "lockouts", "locked_until", "updated_at"}),
+	}).Create(lockout).Error
+}
+
+func (r *AccountLockoutPgRepository) DeleteByUserId(ctx context.Context, userId string) error {
+	return r.getDB(ctx).Where("user_id = ?", userId).Delete(&entities.AccountLockout{}).Error
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+type EmailChangeRequestPgRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailChangeRequestPgRepository(db *gorm.DB) repositories.EmailChangeRequestRepository {
+	return &EmailChangeRequestPgRepository{db: db}
+}
+
+func (r *EmailChangeRequestPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *EmailChangeRequestPgRepository) Create(ctx context.Context, request *entities.EmailChangeRequest) error {
+	return r.getDB(ctx).Create(request).Error
+}
+
+func (r *EmailChangeRequestPgRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.EmailChangeRequest, error) {
+	var request entities.EmailChangeRequest
+	if err := r.getDB(ctx).Where("token_hash = ?", tokenHash).First(&request).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *EmailChangeRequestPgRepository) Delete(ctx context.Context, request *entities.EmailChangeRequest) error {
+	return r.getDB(ctx).Delete(request).Error
+}
+
+func (r *EmailChangeRequestPgRepository) DeleteByUserId(ctx context.Context, userId string) error {
+	return r.getDB(ctx).Where("user_id = ?", userId).Delete(&entities.EmailChangeRequest{}).Error
+}
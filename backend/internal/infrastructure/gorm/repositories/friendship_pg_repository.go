@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+type FriendshipPgRepository struct {
+	db *gorm.DB
+}
+
+func NewFriendshipPgRepository(db *gorm.DB) repositories.FriendshipRepository {
+	return &FriendshipPgRepository{db: db}
+}
+
+func (r *FriendshipPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *FriendshipPgRepository) Create(ctx context.Context, friendship *entities.Friendship) error {
+	return r.getDB(ctx).Create(friendship).Error
+}
+
+func (r *FriendshipPgRepository) Update(ctx context.Context, friendship *entities.Friendship) error {
+	return r.getDB(ctx).Save(friendship).Error
+}
+
+func (r *FriendshipPgRepository) Delete(ctx context.Context, friendship *entities.Friendship) error {
+	return r.getDB(ctx).Where("user_a_id = ? AND user_b_id = ?", friendship.UserAId, friendship.UserBId).Delete(&entities.Friendship{}).Error
+}
+
+func (r *FriendshipPgRepository) FindByUsers(ctx context.Context, userId, otherUserId string) (*entities.Friendship, error) {
+	userAId, userBId := entities.FriendshipPair(userId, otherUserId)
+	var friendship entities.Friendship
+	if err := r.getDB(ctx).Where("user_a_id = ? AND user_b_id = ?", userAId, userBId).First(&friendship).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &friendship, nil
+}
+
+func (r *FriendshipPgRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.Friendship, error) {
+	var friendships []*entities.Friendship
+	if err := r.getDB(ctx).
+		Preload("UserA").
+		Preload("UserB").
+		Where("user_a_id = ? OR user_b_id = ?", userId, userId).
+		Order("created_at DESC").
+		Find(&friendships).Error; err != nil {
+		return nil, err
+	}
+	return friendships, nil
+}
+
+func (r *FriendshipPgRepository) FindFriendIds(ctx context.Context, userId string) ([]string, error) {
+	var ids []string
+	if err := r.getDB(ctx).Model(&entities.Friendship{}).
+		Select("CASE WHEN user_a_id = ? THEN user_b_id ELSE user_a_id END", userId).
+		Where("(user_a_id = ? OR user_b_id = ?) AND status = ?", userId, userId, entities.FriendshipStatusAccepted).
+		Scan(&ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *FriendshipPgRepository) CountFriends(ctx context.Context, userId string) (int64, error) {
+	var count int64
+	if err := r.getDB(ctx).Model(&entities.Friendship{}).
+		Where("(user_a_id = ? OR user_b_id = ?) AND status = ?", userId, userId, entities.FriendshipStatusAccepted).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JobStatePgRepository struct {
+	db *gorm.DB
+}
+
+func NewJobStatePgRepository(db *gorm.DB) repositories.JobStateRepository {
+	return &JobStatePgRepository{db: db}
+}
+
+func (r *JobStatePgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *JobStatePgRepository) Save(ctx context.Context, state *entities.JobState) error {
+	return r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"watermark", "updated_at"}),
+	}).Create(state).Error
+}
+
+func (r *JobStatePgRepository) FindByName(ctx context.Context, name string) (*entities.JobState, error) {
+	var state entities.JobState
+	if err := r.getDB(ctx).Where("name = ?", name).First(&state).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &state, nil
+}
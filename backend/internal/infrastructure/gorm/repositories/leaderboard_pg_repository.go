@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LeaderboardPgRepository struct {
+	db *gorm.DB
+}
+
+func NewLeaderboardPgRepository(db *gorm.DB) repositories.LeaderboardRepository {
+	return &LeaderboardPgRepository{db: db}
+}
+
+func (r *LeaderboardPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+// leaderboardScope scopes a query to the entries of one board; every query starts with the prefix of
+// idx_leaderboard_rank so it can walk the index in rank order.
+func leaderboardScope(key entities.LeaderboardKey) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(
+			"leaderboard_entries.map_id = ? AND leaderboard_entries.mode = ? AND leaderboard_entries.duration_bucket = ? AND leaderboard_entries.period = ? AND leaderboard_entries.period_start = ?",
+			key.MapId, key.Mode, key.DurationBucket, key.Period, key.PeriodStart,
+		)
+	}
+}
+
+func (r *LeaderboardPgRepository) SubmitBest(ctx context.Context, entries []*entities.LeaderboardEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return r.getDB(ctx).Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "map_id"}, {Name: "mode"}, {Name: "duration_bucket"}, {Name: "period"}, {Name: "period_start"}, {Name: "user_id"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"game_id", "score", "achieved_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			gorm.Expr("EXCLUDED.score > leaderboard_entries.score"),
+		}},
+	}).Create(entries).Error
+}
+
+func (r *LeaderboardPgRepository) FindTop(ctx context.Context, key entities.LeaderboardKey, offset, limit int) ([]*entities.LeaderboardEntry, error) {
+	var entries []*entities.LeaderboardEntry
+	if err := r.getDB(ctx).
+		Joins("User").
+		Scopes(leaderboardScope(key)).
+		Order("leaderboard_entries.score DESC, leaderboard_entries.achieved_at ASC, leaderboard_entries.user_id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *LeaderboardPgRepository) FindByKeyAndUserIds(ctx context.Context, key entities.LeaderboardKey, userIds []string) ([]*entities.LeaderboardEntry, error) {
+	var entries []*entities.LeaderboardEntry
+	if len(userIds) == 0 {
+		return entries, nil
+	}
+	if err := r.getDB(ctx).
+		Joins("User").
+		Scopes(leaderboardScope(key)).
+		Where("leaderboard_entries.user_id IN ?", userIds).
+		Order("leaderboard_entries.score DESC, leaderboard_entries.achieved_at ASC, leaderboard_entries.user_id ASC").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *LeaderboardPgRepository) CountByKey(ctx context.Context, key entities.LeaderboardKey) (int64, error) {
+	var count int64
+	if err := r.getDB(ctx).Model(&entities.LeaderboardEntry{}).Scopes(leaderboardScope(key)).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *LeaderboardPgRepository) FindByKeyAndUserId(ctx context.Context, key entities.LeaderboardKey, userId string) (*entities.LeaderboardEntry, error) {
+	var entry entities.LeaderboardEntry
+	if err := r.getDB(ctx).Scopes(leaderboardScope(key)).Where("leaderboard_entries.user_id = ?", userId).First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *LeaderboardPgRepository) CountAhead(ctx context.Context, entry *entities.LeaderboardEntry) (int64, error) {
+	var count int64
+	if err := r.getDB(ctx).Model(&entities.LeaderboardEntry{}).
+		Scopes(leaderboardScope(entry.Key())).
+		Where(
+			"leaderboard_entries.score > ? OR (leaderboard_entries.score = ? AND (leaderboard_entries.achieved_at, leaderboard_entries.user_id) < (?, ?))",
+			entry.Score, entry.Score, entry.AchievedAt, entry.UserId,
+		).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
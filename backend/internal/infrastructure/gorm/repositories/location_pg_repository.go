@@ -2,7 +2,11 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
@@ -28,6 +32,81 @@ func (r *LocationPgRepository) Create(ctx context.Context, l *entities.Location)
 	return r.getDB(ctx).Create(l).Error
 }
 
+func (r *LocationPgRepository) Update(ctx context.Context, l *entities.Location) error {
+	return r.getDB(ctx).Save(l).Error
+}
+
+func (r *LocationPgRepository) Delete(ctx context.Context, l *entities.Location) error {
+	return r.getDB(ctx).Delete(l).Error
+}
+
+func (r *LocationPgRepository) Restore(ctx context.Context, l *entities.Location) error {
+	return r.getDB(ctx).Unscoped().Model(l).Where("map_id = ?", l.MapId).Updates(map[string]interface{}{
+		"pano_id":      l.PanoId,
+		"latitude":     l.Latitude,
+		"longitude":    l.Longitude,
+		"heading":      l.Heading,
+		"pitch":        l.Pitch,
+		"country_code": l.CountryCode,
+		"provider":     l.Provider,
+		"capture_date": l.CaptureDate,
+		"tags":         l.Tags,
+		"note":         l.Note,
+		"deleted_at":   nil,
+	}).Error
+}
+
+func (r *LocationPgRepository) FindByIdAndMapId(ctx context.Context, id, mapId string) (*entities.Location, error) {
+	var location entities.Location
+	if err := r.getDB(ctx).Where("id = ? AND map_id = ?", id, mapId).First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &location, nil
+}
+
+func (r *LocationPgRepository) FindByMapId(ctx context.Context, mapId string) ([]*entities.Location, error) {
+	var locations []*entities.Location
+	if err := r.getDB(ctx).Where("map_id = ?", mapId).Order("created_at").Find(&locations).Error; err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+func (r *LocationPgRepository) CopyBatch(ctx context.Context, sourceMapId, targetMapId, afterId string, limit int) (int, string, error) {
+	if afterId == "" {
+		afterId = uuid.Nil.String()
+	}
+	var result struct {
+		Copied int
+		LastId *string
+	}
+	// The batch is copied in one statement so no location travels through the application.
+	err := r.getDB(ctx).Raw(`
+		WITH batch AS (
+			SELECT id, pano_id, latitude, longitude, heading, pitch, country_code, provider, capture_date, tags, note
+			FROM locations
+			WHERE map_id = ? AND deleted_at IS NULL AND id > ?
+			ORDER BY id
+			LIMIT ?
+		), copied AS (
+			INSERT INTO locations (pano_id, map_id, latitude, longitude, heading, pitch, country_code, provider, capture_date, tags, note, created_at, updated_at)
+			SELECT pano_id, ?, latitude, longitude, heading, pitch, country_code, provider, capture_date, tags, note, NOW(), NOW() FROM batch
+		)
+		SELECT (SELECT COUNT(*) FROM batch) AS copied, (SELECT id::text FROM batch ORDER BY id DESC LIMIT 1) AS last_id`,
+		sourceMapId, afterId, limit, targetMapId,
+	).Scan(&result).Error
+	if err != nil {
+		return 0, "", err
+	}
+	if result.LastId == nil {
+		return 0, afterId, nil
+	}
+	return result.Copied, *result.LastId, nil
+}
+
 func (r *LocationPgRepository) CountByMapId(ctx context.Context, mapId string) (int64, error) {
 	var count int64
 	if err := r.getDB(ctx).Model(&entities.Location{}).Where("map_id = ?", mapId).Count(&count).Error; err != nil {
@@ -36,10 +115,117 @@ func (r *LocationPgRepository) CountByMapId(ctx context.Context, mapId string) (
 	return count, nil
 }
 
-func (r *LocationPgRepository) FindRandomLocationByMapId(ctx context.Context, mapId string, quantity int) ([]*entities.Location, error) {
+// tagsCondition keeps the locations having every tag of the filter.
+func tagsCondition(filter repositories.LocationFilter) (string, []interface{}) {
+	if len(filter.Tags) == 0 {
+		return "TRUE", nil
+	}
+	return "string_to_array(tags, ' ') @> ARRAY[?]::text[]", []interface{}{filter.Tags}
+}
+
+func (r *LocationPgRepository) CountStrata(ctx context.Context, mapId string, filter repositories.LocationFilter) ([]repositories.LocationStratum, error) {
+	var strata []repositories.LocationStratum
+	tags, tagArgs := tagsCondition(filter)
+	if err := r.getDB(ctx).Model(&entities.Location{}).
+		Select("geo_cell, country_code, difficulty, COUNT(*) AS count").
+		Where("map_id = ?", mapId).
+		Where(tags, tagArgs...).
+		Group("geo_cell, country_code, difficulty").
+		Scan(&strata).Error; err != nil {
+		return nil, err
+	}
+	return strata, nil
+}
+
+func (r *LocationPgRepository) FindSample(ctx context.Context, mapId string, stratum repositories.LocationStratum, filter repositories.LocationFilter, from float64, limit int) ([]*entities.Location, error) {
 	var locations []*entities.Location
-	if err := r.getDB(ctx).Where("map_id = ?", mapId).Order("RANDOM()").Limit(quantity).Find(&locations).Error; err != nil {
+	tags, tagArgs := tagsCondition(filter)
+	half := func(keyCondition string) (string, []interface{}) {
+		query := `(SELECT * FROM locations
+			WHERE map_id = ? AND geo_cell = ? AND country_code = ? AND difficulty = ? AND deleted_at IS NULL AND ` + tags + ` AND ` + keyCondition + `
+			ORDER BY sample_key LIMIT ?)`
+		args := append([]interface{}{mapId, stratum.GeoCell, stratum.CountryCode, stratum.Difficulty}, tagArgs...)
+		return query, append(args, from, limit)
+	}
+	// Both halves are index range scans on idx_locations_strata, the tags being checked on the rows
+	// of the stratum; the second only runs when the first comes short.
+	upper, upperArgs := half("sample_key >= ?")
+	lower, lowerArgs := half("sample_key < ?")
+	args := append(append(upperArgs, lowerArgs...), limit)
+	err := r.getDB(ctx).Raw(upper+` UNION ALL `+lower+` LIMIT ?`, args...).Scan(&locations).Error
+	if err != nil {
 		return nil, err
 	}
 	return locations, nil
 }
+
+func (r *LocationPgRepository) FindPlayStats(ctx context.Context, since time.Time, afterId string, limit int) ([]entities.LocationPlayStats, string, error) {
+	if afterId == "" {
+		afterId = uuid.Nil.String()
+	}
+	var locationIds []string
+	err := r.getDB(ctx).Raw(`
+		SELECT DISTINCT location_id FROM single_player_rounds
+		WHERE round_status = ? AND deleted_at IS NULL AND ended_at >= ? AND location_id > ?
+		ORDER BY location_id
+		LIMIT ?`,
+		entities.SinglePlayerRoundStatusCompleted, since, afterId, limit,
+	).Scan(&locationIds).Error
+	if err != nil {
+		return nil, "", err
+	}
+	if len(locationIds) == 0 {
+		return nil, "", nil
+	}
+
+	var stats []entities.LocationPlayStats
+	// The rounds of the changed locations are summed in full, through the rounds' location_id index,
+	// so the job never has to keep partial sums.
+	err = r.getDB(ctx).Raw(`
+		SELECT location_id, COUNT(*) AS plays, SUM(score) AS score_sum, SUM(LN(1 + distance / 1000)) AS log_distance_sum
+		FROM single_player_rounds
+		WHERE round_status = ? AND deleted_at IS NULL AND location_id IN ?
+		GROUP BY location_id
+		ORDER BY location_id`,
+		entities.SinglePlayerRoundStatusCompleted, locationIds,
+	).Scan(&stats).Error
+	if err != nil {
+		return nil, "", err
+	}
+	if len(locationIds) < limit {
+		return stats, "", nil
+	}
+	return stats, locationIds[len(locationIds)-1], nil
+}
+
+func (r *LocationPgRepository) UpdateDifficulties(ctx context.Context, stats []entities.LocationPlayStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	values := make([]string, len(stats))
+	args := make([]any, 0, len(stats)*4)
+	for i, s := range stats {
+		values[i] = "(?::uuid, ?::bigint, ?::double precision, ?)"
+		args = append(args, s.LocationId, s.Plays, s.DifficultyScore(), s.Difficulty())
+	}
+	// updated_at is left alone: the locations did not change, only how they are played.
+	return r.getDB(ctx).Exec(`
+		UPDATE locations SET play_count = v.play_count, difficulty_score = v.difficulty_score, difficulty = v.difficulty
+		FROM (VALUES `+strings.Join(values, ", ")+`) AS v(id, play_count, difficulty_score, difficulty)
+		WHERE locations.id = v.id`,
+		args...,
+	).Error
+}
+
+func (r *LocationPgRepository) CountDifficulties(ctx context.Context, mapId string) ([]repositories.LocationDifficultyCount, error) {
+	var counts []repositories.LocationDifficultyCount
+	if err := r.getDB(ctx).Model(&entities.Location{}).
+		Select("difficulty, LEAST(FLOOR(difficulty_score * 10), 9)::integer AS bucket, COUNT(*) AS count").
+		Where("map_id = ?", mapId).
+		Group("difficulty, bucket").
+		Order("bucket, difficulty").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
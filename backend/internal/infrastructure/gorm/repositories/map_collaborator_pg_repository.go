@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MapCollaboratorPgRepository struct {
+	db *gorm.DB
+}
+
+func NewMapCollaboratorPgRepository(db *gorm.DB) repositories.MapCollaboratorRepository {
+	return &MapCollaboratorPgRepository{db: db}
+}
+
+func (r *MapCollaboratorPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *MapCollaboratorPgRepository) Save(ctx context.Context, collaborator *entities.MapCollaborator) error {
+	return r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "map_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+	}).Create(collaborator).Error
+}
+
+func (r *MapCollaboratorPgRepository) Delete(ctx context.Context, mapId, userId string) error {
+	return r.getDB(ctx).Where("map_id = ? AND user_id = ?", mapId, userId).Delete(&entities.MapCollaborator{}).Error
+}
+
+func (r *MapCollaboratorPgRepository) FindByMapIdAndUserId(ctx context.Context, mapId, userId string) (*entities.MapCollaborator, error) {
+	var collaborator entities.MapCollaborator
+	if err := r.getDB(ctx).Where("map_id = ? AND user_id = ?", mapId, userId).First(&collaborator).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &collaborator, nil
+}
+
+func (r *MapCollaboratorPgRepository) FindByMapId(ctx context.Context, mapId string) ([]*entities.MapCollaborator, error) {
+	var collaborators []*entities.MapCollaborator
+	if err := r.getDB(ctx).Joins("User").Where("map_collaborators.map_id = ?", mapId).
+		Order("map_collaborators.created_at").Find(&collaborators).Error; err != nil {
+		return nil, err
+	}
+	return collaborators, nil
+}
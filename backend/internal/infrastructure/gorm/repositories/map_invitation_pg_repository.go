@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MapInvitationPgRepository struct {
+	db *gorm.DB
+}
+
+func NewMapInvitationPgRepository(db *gorm.DB) repositories.MapInvitationRepository {
+	return &MapInvitationPgRepository{db: db}
+}
+
+func (r *MapInvitationPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *MapInvitationPgRepository) Save(ctx context.Context, invitation *entities.MapInvitation) error {
+	return r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "map_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "invited_by_id", "created_at"}),
+	}).Create(invitation).Error
+}
+
+func (r *MapInvitationPgRepository) Delete(ctx context.Context, mapId, userId string) error {
+	return r.getDB(ctx).Where("map_id = ? AND user_id = ?", mapId, userId).Delete(&entities.MapInvitation{}).Error
+}
+
+func (r *MapInvitationPgRepository) DeleteByMapId(ctx context.Context, mapId string) error {
+	return r.getDB(ctx).Where("map_id = ?", mapId).Delete(&entities.MapInvitation{}).Error
+}
+
+func (r *MapInvitationPgRepository) FindByMapIdAndUserId(ctx context.Context, mapId, userId string) (*entities.MapInvitation, error) {
+	var invitation entities.MapInvitation
+	if err := r.getDB(ctx).Where("map_id = ? AND user_id = ?", mapId, userId).First(&invitation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+func (r *MapInvitationPgRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.MapInvitation, error) {
+	var invitations []*entities.MapInvitation
+	if err := r.getDB(ctx).Joins("Map").Joins("InvitedBy").Where("map_invitations.user_id = ?", userId).
+		Order("map_invitations.created_at DESC").Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MapLikePgRepository struct {
+	db *gorm.DB
+}
+
+func NewMapLikePgRepository(db *gorm.DB) repositories.MapLikeRepository {
+	return &MapLikePgRepository{db: db}
+}
+
+func (r *MapLikePgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *MapLikePgRepository) Create(ctx context.Context, like *entities.MapLike) (bool, error) {
+	result := r.getDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(like)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *MapLikePgRepository) Delete(ctx context.Context, mapId, userId string) (bool, error) {
+	result := r.getDB(ctx).Where("map_id = ? AND user_id = ?", mapId, userId).Delete(&entities.MapLike{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *MapLikePgRepository) Exists(ctx context.Context, mapId, userId string) (bool, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&entities.MapLike{}).Where("map_id = ? AND user_id = ?", mapId, userId).Count(&count).Error
+	return count > 0, err
+}
@@ -3,11 +3,13 @@ package repositories
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MapPgRepository struct {
@@ -29,9 +31,39 @@ func (r *MapPgRepository) Create(ctx context.Context, m *entities.Map) error {
 	return r.getDB(ctx).Create(m).Error
 }
 
-func (r *MapPgRepository) FindByName(ctx context.Context, name string) (*entities.Map, error) {
+// mapSettingsColumns are the columns Update writes. Maps are read outside any lock, so writing
+// back the revision or the cached aggregates would undo concurrent edits, likes, ratings and plays.
+var mapSettingsColumns = []string{
+	"name", "description", "visibility", "published_at", "category", "tags", "boundary",
+	"outside_guesses", "allow_repeat_locations", "owner_id", "updated_at",
+}
+
+func (r *MapPgRepository) Update(ctx context.Context, m *entities.Map) error {
+	return r.getDB(ctx).Model(m).Select(mapSettingsColumns).Updates(m).Error
+}
+
+func (r *MapPgRepository) FindByOwnerIdAndName(ctx context.Context, ownerId, name string) (*entities.Map, error) {
+	var m entities.Map
+	if err := r.getDB(ctx).Where("owner_id = ? AND name = ?", ownerId, name).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *MapPgRepository) FindByOwnerId(ctx context.Context, ownerId string) ([]*entities.Map, error) {
+	var maps []*entities.Map
+	if err := r.getDB(ctx).Where("owner_id = ?", ownerId).Order("created_at").Find(&maps).Error; err != nil {
+		return nil, err
+	}
+	return maps, nil
+}
+
+func (r *MapPgRepository) FindById(ctx context.Context, id string) (*entities.Map, error) {
 	var m entities.Map
-	if err := r.getDB(ctx).Where("name = ?", name).First(&m).Error; err != nil {
+	if err := r.getDB(ctx).Where("id = ?", id).First(&m).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -39,3 +71,94 @@ func (r *MapPgRepository) FindByName(ctx context.Context, name string) (*entitie
 	}
 	return &m, nil
 }
+
+func (r *MapPgRepository) FindPublic(ctx context.Context, sort repositories.MapSort, offset, limit int) ([]*entities.Map, error) {
+	db := r.getDB(ctx).
+		Joins("Owner").
+		Where("maps.visibility = ?", entities.MapVisibilityPublic)
+	switch sort {
+	case repositories.MapSortTrending:
+		db = db.Order("maps.trending_score DESC")
+	case repositories.MapSortPopular:
+		db = db.Order("maps.like_count DESC")
+	}
+	var maps []*entities.Map
+	if err := db.
+		// Maps public since before publishing existed have no publication time.
+		Order("COALESCE(maps.published_at, maps.created_at) DESC").
+		Order("maps.id").
+		Offset(offset).
+		Limit(limit).
+		Find(&maps).Error; err != nil {
+		return nil, err
+	}
+	return maps, nil
+}
+
+func (r *MapPgRepository) CountPublic(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&entities.Map{}).Where("visibility = ?", entities.MapVisibilityPublic).Count(&count).Error
+	return count, err
+}
+
+func (r *MapPgRepository) CountForks(ctx context.Context, mapId string) (int64, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&entities.Map{}).Where("forked_from_id = ?", mapId).Count(&count).Error
+	return count, err
+}
+
+func (r *MapPgRepository) RecordActivity(ctx context.Context, mapId string, activity entities.MapActivity) error {
+	updates := map[string]interface{}{
+		"like_count":              gorm.Expr("like_count + ?", activity.Likes),
+		"play_count":              gorm.Expr("play_count + ?", activity.Plays),
+		"difficulty_rating_count": gorm.Expr("difficulty_rating_count + ?", activity.DifficultyRatings),
+		"difficulty_rating_sum":   gorm.Expr("difficulty_rating_sum + ?", activity.DifficultyRatingSum),
+	}
+	if activity.TrendingWeight > 0 {
+		// log(e^a + e^b), computed as entities.AddTrending does so it cannot overflow.
+		increment := entities.TrendingIncrement(activity.TrendingWeight, activity.At)
+		updates["trending_score"] = gorm.Expr(
+			"GREATEST(trending_score, ?) + LN(1 + EXP(LEAST(trending_score, ?) - GREATEST(trending_score, ?)))",
+			increment, increment, increment,
+		)
+	}
+	return r.getDB(ctx).Model(&entities.Map{}).Where("id = ?", mapId).UpdateColumns(updates).Error
+}
+
+func (r *MapPgRepository) IncrementRevision(ctx context.Context, mapId string) (int, error) {
+	m := entities.Map{ID: mapId}
+	result := r.getDB(ctx).Model(&m).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "revision"}}}).
+		UpdateColumn("revision", gorm.Expr("revision + 1"))
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return m.Revision, nil
+}
+
+func (r *MapPgRepository) Delete(ctx context.Context, m *entities.Map) error {
+	return r.getDB(ctx).Delete(m).Error
+}
+
+func (r *MapPgRepository) DeleteByOwnerId(ctx context.Context, ownerId string) error {
+	return r.getDB(ctx).Where("owner_id = ?", ownerId).Delete(&entities.Map{}).Error
+}
+
+func (r *MapPgRepository) FindRecentlyPublishedByOwnerIds(ctx context.Context, ownerIds []string, before time.Time, limit int) ([]*entities.Map, error) {
+	var maps []*entities.Map
+	if len(ownerIds) == 0 {
+		return maps, nil
+	}
+	if err := r.getDB(ctx).
+		Joins("Owner").
+		Where("maps.owner_id IN ? AND maps.visibility = ? AND maps.published_at < ?", ownerIds, entities.MapVisibilityPublic, before).
+		Order("maps.published_at DESC").
+		Limit(limit).
+		Find(&maps).Error; err != nil {
+		return nil, err
+	}
+	return maps, nil
+}
@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+// mapLocationCount counts the live locations of the map of the current row.
+const mapLocationCount = "(SELECT COUNT(*) FROM locations WHERE locations.map_id = maps.id AND locations.deleted_at IS NULL)"
+
+// MapPgSearch searches maps with Postgres full-text search over the generated maps.search_vector.
+type MapPgSearch struct {
+	db *gorm.DB
+}
+
+func NewMapPgSearch(db *gorm.DB) repositories.MapSearch {
+	return &MapPgSearch{db: db}
+}
+
+func (s *MapPgSearch) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return s.db.WithContext(ctx)
+}
+
+func (s *MapPgSearch) Search(ctx context.Context, query repositories.MapSearchQuery) ([]repositories.MapSearchHit, int64, error) {
+	var total int64
+	if err := s.filter(ctx, query).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []repositories.MapSearchHit{}, 0, nil
+	}
+
+	var rows []struct {
+		ID            string
+		LocationCount int64
+		Rank          float64
+	}
+	page := s.filter(ctx, query).Offset(query.Offset).Limit(query.Limit)
+	if query.Text != "" {
+		page = page.
+			Select("maps.id, "+mapLocationCount+" AS location_count, ts_rank(maps.search_vector, websearch_to_tsquery('simple', ?)) AS rank", query.Text).
+			Order("rank DESC")
+	} else {
+		page = page.Select("maps.id, " + mapLocationCount + " AS location_count, 0 AS rank")
+	}
+	if err := page.
+		Order("COALESCE(maps.published_at, maps.created_at) DESC").
+		Order("maps.id").
+		Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	var maps []*entities.Map
+	if err := s.getDB(ctx).Joins("Owner").Where("maps.id IN ?", ids).Find(&maps).Error; err != nil {
+		return nil, 0, err
+	}
+	byId := make(map[string]*entities.Map, len(maps))
+	for _, m := range maps {
+		byId[m.ID] = m
+	}
+	hits := make([]repositories.MapSearchHit, 0, len(rows))
+	for _, row := range rows {
+		if m, ok := byId[row.ID]; ok {
+			hits = append(hits, repositories.MapSearchHit{Map: m, LocationCount: row.LocationCount, Rank: row.Rank})
+		}
+	}
+	return hits, total, nil
+}
+
+func (s *MapPgSearch) filter(ctx context.Context, query repositories.MapSearchQuery) *gorm.DB {
+	db := s.getDB(ctx).Model(&entities.Map{}).Where("maps.visibility = ?", entities.MapVisibilityPublic)
+	if query.Text != "" {
+		db = db.Where("maps.search_vector @@ websearch_to_tsquery('simple', ?)", query.Text)
+	}
+	if query.Category != "" {
+		db = db.Where("maps.category = ?", query.Category)
+	}
+	for _, tag := range query.Tags {
+		db = db.Where("(' ' || maps.tags || ' ') LIKE ?", "% "+tag+" %")
+	}
+	if query.CountryCode != "" {
+		db = db.Where("EXISTS (SELECT 1 FROM locations WHERE locations.map_id = maps.id AND locations.deleted_at IS NULL AND locations.country_code = ?)", query.CountryCode)
+	}
+	if query.CreatorId != "" {
+		db = db.Where("maps.owner_id = ?", query.CreatorId)
+	}
+	if query.MinLocations > 0 {
+		db = db.Where(mapLocationCount+" >= ?", query.MinLocations)
+	}
+	if query.MaxLocations > 0 {
+		db = db.Where(mapLocationCount+" <= ?", query.MaxLocations)
+	}
+	return db
+}
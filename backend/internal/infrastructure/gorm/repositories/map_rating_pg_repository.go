@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MapRatingPgRepository struct {
+	db *gorm.DB
+}
+
+func NewMapRatingPgRepository(db *gorm.DB) repositories.MapRatingRepository {
+	return &MapRatingPgRepository{db: db}
+}
+
+func (r *MapRatingPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *MapRatingPgRepository) FindByMapIdAndUserIdForUpdate(ctx context.Context, mapId, userId string) (*entities.MapRating, error) {
+	var rating entities.MapRating
+	if err := r.getDB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("map_id = ? AND user_id = ?", mapId, userId).First(&rating).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rating, nil
+}
+
+func (r *MapRatingPgRepository) Save(ctx context.Context, rating *entities.MapRating) error {
+	return r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "map_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"difficulty", "updated_at"}),
+	}).Create(rating).Error
+}
+
+func (r *MapRatingPgRepository) Delete(ctx context.Context, rating *entities.MapRating) error {
+	return r.getDB(ctx).Where("map_id = ? AND user_id = ?", rating.MapId, rating.UserId).Delete(&entities.MapRating{}).Error
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+type MapRevisionPgRepository struct {
+	db *gorm.DB
+}
+
+func NewMapRevisionPgRepository(db *gorm.DB) repositories.MapRevisionRepository {
+	return &MapRevisionPgRepository{db: db}
+}
+
+func (r *MapRevisionPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *MapRevisionPgRepository) Create(ctx context.Context, revision *entities.MapRevision) error {
+	return r.getDB(ctx).Create(revision).Error
+}
+
+func (r *MapRevisionPgRepository) FindByMapId(ctx context.Context, mapId string, offset, limit int) ([]*entities.MapRevision, error) {
+	var revisions []*entities.MapRevision
+	if err := r.getDB(ctx).
+		Joins("Author").
+		Where("map_revisions.map_id = ?", mapId).
+		Order("map_revisions.number DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (r *MapRevisionPgRepository) CountByMapId(ctx context.Context, mapId string) (int64, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&entities.MapRevision{}).Where("map_id = ?", mapId).Count(&count).Error
+	return count, err
+}
+
+func (r *MapRevisionPgRepository) FindAfter(ctx context.Context, mapId string, number int) ([]*entities.MapRevision, error) {
+	var revisions []*entities.MapRevision
+	if err := r.getDB(ctx).
+		Preload("Changes").
+		Where("map_id = ? AND number > ?", mapId, number).
+		Order("number").
+		Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
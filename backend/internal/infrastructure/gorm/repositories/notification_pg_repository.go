@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+type NotificationPgRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPgRepository(db *gorm.DB) repositories.NotificationRepository {
+	return &NotificationPgRepository{db: db}
+}
+
+func (r *NotificationPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func inboxScope(userId string, unreadOnly bool) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("notifications.user_id = ?", userId)
+		if unreadOnly {
+			db = db.Where("notifications.read_at IS NULL")
+		}
+		return db
+	}
+}
+
+func (r *NotificationPgRepository) Create(ctx context.Context, notification *entities.Notification) error {
+	return r.getDB(ctx).Create(notification).Error
+}
+
+func (r *NotificationPgRepository) FindByUserId(ctx context.Context, userId string, unreadOnly bool, offset, limit int) ([]*entities.Notification, error) {
+	var notifications []*entities.Notification
+	err := r.getDB(ctx).Scopes(inboxScope(userId, unreadOnly)).Joins("Actor").
+		Order("notifications.created_at DESC").Order("notifications.id").
+		Offset(offset).Limit(limit).Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (r *NotificationPgRepository) CountByUserId(ctx context.Context, userId string, unreadOnly bool) (int64, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&entities.Notification{}).Scopes(inboxScope(userId, unreadOnly)).Count(&count).Error
+	return count, err
+}
+
+func (r *NotificationPgRepository) MarkRead(ctx context.Context, userId, id string, at time.Time) (bool, error) {
+	result := r.getDB(ctx).Model(&entities.Notification{}).
+		Where("id = ? AND user_id = ?", id, userId).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", at))
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *NotificationPgRepository) MarkAllRead(ctx context.Context, userId string, at time.Time) (int64, error) {
+	result := r.getDB(ctx).Model(&entities.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userId).
+		Update("read_at", at)
+	return result.RowsAffected, result.Error
+}
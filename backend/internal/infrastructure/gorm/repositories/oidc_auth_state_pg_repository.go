@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+type OidcAuthStatePgRepository struct {
+	db *gorm.DB
+}
+
+func NewOidcAuthStatePgRepository(db *gorm.DB) repositories.OidcAuthStateRepository {
+	return &OidcAuthStatePgRepository{db: db}
+}
+
+func (r *OidcAuthStatePgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *OidcAuthStatePgRepository) Create(ctx context.Context, state *entities.OidcAuthState) error {
+	return r.getDB(ctx).Create(state).Error
+}
+
+func (r *OidcAuthStatePgRepository) FindByState(ctx context.Context, state string) (*entities.OidcAuthState, error) {
+	var authState entities.OidcAuthState
+	if err := r.getDB(ctx).Where("state = ?", state).First(&authState).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &authState, nil
+}
+
+func (r *OidcAuthStatePgRepository) Delete(ctx context.Context, state *entities.OidcAuthState) error {
+	return r.getDB(ctx).Delete(state).Error
+}
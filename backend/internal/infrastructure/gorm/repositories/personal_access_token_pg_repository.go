@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+type PersonalAccessTokenPgRepository struct {
+	db *gorm.DB
+}
+
+func NewPersonalAccessTokenPgRepository(db *gorm.DB) repositories.PersonalAccessTokenRepository {
+	return &PersonalAccessTokenPgRepository{db: db}
+}
+
+func (r *PersonalAccessTokenPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *PersonalAccessTokenPgRepository) Create(ctx context.Context, token *entities.PersonalAccessToken) error {
+	return r.getDB(ctx).Create(token).Error
+}
+
+func (r *PersonalAccessTokenPgRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.PersonalAccessToken, error) {
+	var token entities.PersonalAccessToken
+	if err := r.getDB(ctx).Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *PersonalAccessTokenPgRepository) FindByIdAndUserId(ctx context.Context, id, userId string) (*entities.PersonalAccessToken, error) {
+	var token entities.PersonalAccessToken
+	if err := r.getDB(ctx).Where("id = ? AND user_id = ?", id, userId).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *PersonalAccessTokenPgRepository) FindByUserIdAndName(ctx context.Context, userId, name string) (*entities.PersonalAccessToken, error) {
+	var token entities.PersonalAccessToken
+	if err := r.getDB(ctx).Where("user_id = ? AND name = ?", userId, name).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *PersonalAccessTokenPgRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.PersonalAccessToken, error) {
+	var tokens []*entities.PersonalAccessToken
+	if err := r.getDB(ctx).Where("user_id = ?", userId).Order("created_at DESC").Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (r *PersonalAccessTokenPgRepository) CountByUserId(ctx context.Context, userId string) (int64, error) {
+	var count int64
+	if err := r.getDB(ctx).Model(&entities.PersonalAccessToken{}).Where("user_id = ?", userId).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// UpdateLastUsedAt only writes last_used_at so concurrent requests made with the same token never
+// overwrite each other's view of the rest of the row.
+func (r *PersonalAccessTokenPgRepository) UpdateLastUsedAt(ctx context.Context, token *entities.PersonalAccessToken) error {
+	return r.getDB(ctx).Model(token).UpdateColumn("last_used_at", token.LastUsedAt).Error
+}
+
+func (r *PersonalAccessTokenPgRepository) Delete(ctx context.Context, token *entities.PersonalAccessToken) error {
+	return r.getDB(ctx).Delete(token).Error
+}
@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+type PersonalDataPgRepository struct {
+	db *gorm.DB
+}
+
+func NewPersonalDataPgRepository(db *gorm.DB) repositories.PersonalDataRepository {
+	return &PersonalDataPgRepository{db: db}
+}
+
+func (r *PersonalDataPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *PersonalDataPgRepository) Export(ctx context.Context, userId string) (*repositories.PersonalDataExport, error) {
+	db := r.getDB(ctx)
+	export := &repositories.PersonalDataExport{User: &entities.User{}}
+	if err := db.Where("id = ?", userId).First(export.User).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := db.Where("owner_id = ?", userId).Order("created_at").Find(&export.Maps).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("map_id IN (?)", db.Model(&entities.Map{}).Select("id").Where("owner_id = ?", userId)).
+		Order("created_at").Find(&export.Locations).Error; err != nil {
+		return nil, err
+	}
+	// Rounds not started yet are left out: their locations would give away the rest of an ongoing game.
+	if err := db.Where("user_id = ?", userId).Order("created_at").
+		Preload("Rounds", "started_at IS NOT NULL").Find(&export.Games).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userId).Order("created_at").Find(&export.Sessions).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userId).Order("created_at").Find(&export.Identities).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userId).Order("created_at").Find(&export.PersonalAccessTokens).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userId).Order("unlocked_at").Find(&export.Achievements).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_a_id = ? OR user_b_id = ?", userId, userId).Order("created_at").Find(&export.Friendships).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("blocker_id = ?", userId).Order("created_at").Find(&export.Blocks).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userId).Order("created_at").Find(&export.Notifications).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userId).Order("created_at").Find(&export.Collaborations).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userId).Order("created_at").Find(&export.MapLikes).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userId).Order("created_at").Find(&export.MapRatings).Error; err != nil {
+		return nil, err
+	}
+	var twoFactorCount int64
+	if err := db.Model(&entities.UserTwoFactor{}).Where("user_id = ? AND confirmed_at IS NOT NULL", userId).Count(&twoFactorCount).Error; err != nil {
+		return nil, err
+	}
+	export.TwoFactorEnabled = twoFactorCount > 0
+	return export, nil
+}
+
+func (r *PersonalDataPgRepository) EraseCredentials(ctx context.Context, userId string) error {
+	db := r.getDB(ctx).Unscoped()
+	// The trending score keeps the erased likes, as it keeps likes taken back.
+	if err := db.Exec(`UPDATE maps SET like_count = like_count - 1
+		WHERE id IN (SELECT map_id FROM map_likes WHERE user_id = ?)`, userId).Error; err != nil {
+		return err
+	}
+	if err := db.Exec(`UPDATE maps SET difficulty_rating_count = difficulty_rating_count - 1,
+		difficulty_rating_sum = difficulty_rating_sum - map_ratings.difficulty
+		FROM map_ratings WHERE map_ratings.map_id = maps.id AND map_ratings.user_id = ?`, userId).Error; err != nil {
+		return err
+	}
+	for _, model := range []any{
+		&entities.RefreshToken{},
+		&entities.UserIdentity{},
+		&entities.OidcAuthState{},
+		&entities.UserTwoFactor{},
+		&entities.TwoFactorBackupCode{},
+		&entities.PersonalAccessToken{},
+		&entities.EmailChangeRequest{},
+		&entities.ReauthenticationCode{},
+		&entities.AccountLockout{},
+		&entities.Notification{},
+		&entities.MapCollaborator{},
+		&entities.MapInvitation{},
+		&entities.MapLike{},
+		&entities.MapRating{},
+	} {
+		if err := db.Where("user_id = ?", userId).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	if err := db.Where("user_a_id = ? OR user_b_id = ?", userId, userId).Delete(&entities.Friendship{}).Error; err != nil {
+		return err
+	}
+	return db.Where("blocker_id = ? OR blocked_id = ?", userId, userId).Delete(&entities.UserBlock{}).Error
+}
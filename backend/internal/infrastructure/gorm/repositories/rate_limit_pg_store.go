@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// rateLimitPruneInterval is how often each instance deletes the buckets that are full again.
+const rateLimitPruneInterval = time.Minute
+
+// RateLimitPgStore keeps token buckets in Postgres so limits are shared between API instances.
+type RateLimitPgStore struct {
+	db        *gorm.DB
+	mu        sync.Mutex
+	lastPrune time.Time
+}
+
+func NewRateLimitPgStore(db *gorm.DB) repositories.RateLimitStore {
+	return &RateLimitPgStore{db: db, lastPrune: time.Now()}
+}
+
+// Take runs in its own transaction, independent of any transaction in ctx, so a rolled back
+// request still spends its token.
+func (s *RateLimitPgStore) Take(ctx context.Context, key string, rule entities.RateLimitRule) (entities.RateLimitDecision, error) {
+	if err := s.prune(ctx); err != nil {
+		return entities.RateLimitDecision{}, err
+	}
+
+	var decision entities.RateLimitDecision
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(entities.NewRateLimitBucket(key, rule, now)).Error; err != nil {
+			return err
+		}
+		var bucket entities.RateLimitBucket
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key = ?", key).First(&bucket).Error; err != nil {
+			return err
+		}
+		decision = bucket.Take(rule, now)
+		return tx.Save(&bucket).Error
+	})
+	if err != nil {
+		return entities.RateLimitDecision{}, err
+	}
+	return decision, nil
+}
+
+// prune deletes the buckets that are full again, at most once per rateLimitPruneInterval, so one
+// row is not kept forever for every client that ever sent a request.
+func (s *RateLimitPgStore) prune(ctx context.Context) error {
+	s.mu.Lock()
+	now := time.Now()
+	if now.Sub(s.lastPrune) < rateLimitPruneInterval {
+		s.mu.Unlock()
+		return nil
+	}
+	s.lastPrune = now
+	s.mu.Unlock()
+
+	return s.db.WithContext(ctx).Where("full_at <= ?", now).Delete(&entities.RateLimitBucket{}).Error
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReauthenticationCodePgRepository struct {
+	db *gorm.DB
+}
+
+func NewReauthenticationCodePgRepository(db *gorm.DB) repositories.ReauthenticationCodeRepository {
+	return &ReauthenticationCodePgRepository{db: db}
+}
+
+func (r *ReauthenticationCodePgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReauthenticationCodePgRepository) Save(ctx context.Context, code *entities.ReauthenticationCode) error {
+	return r.getDB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"code_hash", "expires_at", "created_at"}),
+	}).Create(code).Error
+}
+
+func (r *ReauthenticationCodePgRepository) FindByUserId(ctx context.Context, userId string) (*entities.ReauthenticationCode, error) {
+	var code entities.ReauthenticationCode
+	if err := r.getDB(ctx).Where("user_id = ?", userId).First(&code).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &code, nil
+}
+
+func (r *ReauthenticationCodePgRepository) Delete(ctx context.Context, code *entities.ReauthenticationCode) error {
+	return r.getDB(ctx).Delete(code).Error
+}
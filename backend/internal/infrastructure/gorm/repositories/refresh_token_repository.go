@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
@@ -43,3 +44,10 @@ func (r *RefreshTokenPgRepository) FindById(ctx context.Context, id string) (*en
 func (r *RefreshTokenPgRepository) Update(ctx context.Context, refreshToken *entities.RefreshToken) error {
 	return r.getDB(ctx).Save(refreshToken).Error
 }
+
+func (r *RefreshTokenPgRepository) ExpireAllByUserId(ctx context.Context, userId string) error {
+	now := time.Now()
+	return r.getDB(ctx).Model(&entities.RefreshToken{}).
+		Where("user_id = ? AND expires_at > ?", userId, now).
+		Update("expires_at", now).Error
+}
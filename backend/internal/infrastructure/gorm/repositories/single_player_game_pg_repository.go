@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
@@ -54,4 +55,33 @@ func (r *SinglePlayerGamePgRepository) FindByIdAndUserIdWithLock(ctx context.Con
 		return nil, err
 	}
 	return &game, nil
-}
\ No newline at end of file
+}
+
+func (r *SinglePlayerGamePgRepository) FindByIdWithLock(ctx context.Context, id string) (*entities.SinglePlayerGame, error) {
+	var game entities.SinglePlayerGame
+	if err := r.getDB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&game).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &game, nil
+}
+
+func (r *SinglePlayerGamePgRepository) FindRecentCompletedByUserIds(ctx context.Context, userIds []string, before time.Time, limit int) ([]*entities.SinglePlayerGame, error) {
+	var games []*entities.SinglePlayerGame
+	if len(userIds) == 0 {
+		return games, nil
+	}
+	if err := r.getDB(ctx).
+		Joins("User").
+		Joins("Map").
+		Where("single_player_games.user_id IN ? AND single_player_games.status = ? AND single_player_games.ended_at < ?", userIds, entities.SinglePlayerGameStatusCompleted, before).
+		Where(`"Map".visibility <> ?`, entities.MapVisibilityPrivate).
+		Order("single_player_games.ended_at DESC").
+		Limit(limit).
+		Find(&games).Error; err != nil {
+		return nil, err
+	}
+	return games, nil
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
@@ -56,6 +57,35 @@ func (r *SinglePlayerRoundPgRepository) FindByGameIdAndRoundNumberWithLock(ctx c
 	return &round, nil
 }
 
+func (r *SinglePlayerRoundPgRepository) FindByGameId(ctx context.Context, gameId string) ([]*entities.SinglePlayerRound, error) {
+	var rounds []*entities.SinglePlayerRound
+	if err := r.getDB(ctx).
+		Joins("Location").
+		Where("single_player_rounds.game_id = ?", gameId).
+		Order("single_player_rounds.round_number").
+		Find(&rounds).Error; err != nil {
+		return nil, err
+	}
+	return rounds, nil
+}
+
+// FindSeenLocationIds walks idx_single_player_games_user_map for the games in the window and the
+// game_id index for their rounds. Rounds not started yet were never shown to the player.
+func (r *SinglePlayerRoundPgRepository) FindSeenLocationIds(ctx context.Context, userId, mapId string, since time.Time, limit int) ([]string, error) {
+	var locationIds []string
+	if err := r.getDB(ctx).Model(&entities.SinglePlayerRound{}).
+		Joins("JOIN single_player_games ON single_player_games.id = single_player_rounds.game_id AND single_player_games.deleted_at IS NULL").
+		Where("single_player_games.user_id = ? AND single_player_games.map_id = ? AND single_player_games.created_at >= ?", userId, mapId, since).
+		Where("single_player_rounds.started_at IS NOT NULL").
+		Group("single_player_rounds.location_id").
+		Order("MAX(single_player_rounds.started_at) DESC").
+		Limit(limit).
+		Pluck("single_player_rounds.location_id", &locationIds).Error; err != nil {
+		return nil, err
+	}
+	return locationIds, nil
+}
+
 func (r *SinglePlayerRoundPgRepository) Update(ctx context.Context, round *entities.SinglePlayerRound) error {
 	return r.getDB(ctx).Save(round).Error
 }
\ No newline at end of file
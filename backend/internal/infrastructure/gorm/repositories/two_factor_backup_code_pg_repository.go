@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TwoFactorBackupCodePgRepository struct {
+	db *gorm.DB
+}
+
+func NewTwoFactorBackupCodePgRepository(db *gorm.DB) repositories.TwoFactorBackupCodeRepository {
+	return &TwoFactorBackupCodePgRepository{db: db}
+}
+
+func (r *TwoFactorBackupCodePgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *TwoFactorBackupCodePgRepository) CreateMany(ctx context.Context, codes []*entities.TwoFactorBackupCode) error {
+	if len(codes) == 0 {
+		return nil
+	}
+	return r.getDB(ctx).Create(codes).Error
+}
+
+func (r *TwoFactorBackupCodePgRepository) FindUnusedByUserIdAndCodeHash(ctx context.Context, userId, codeHash string) (*entities.TwoFactorBackupCode, error) {
+	var code entities.TwoFactorBackupCode
+	if err := r.getDB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, codeHash).
+		First(&code).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &code, nil
+}
+
+func (r *TwoFactorBackupCodePgRepository) CountUnusedByUserId(ctx context.Context, userId string) (int64, error) {
+	var count int64
+	if err := r.getDB(ctx).Model(&entities.TwoFactorBackupCode{}).Where("user_id = ? AND used_at IS NULL", userId).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *TwoFactorBackupCodePgRepository) Update(ctx context.Context, code *entities.TwoFactorBackupCode) error {
+	return r.getDB(ctx).Save(code).Error
+}
+
+func (r *TwoFactorBackupCodePgRepository) DeleteByUserId(ctx context.Context, userId string) error {
+	return r.getDB(ctx).Where("user_id = ?", userId).Delete(&entities.TwoFactorBackupCode{}).Error
+}
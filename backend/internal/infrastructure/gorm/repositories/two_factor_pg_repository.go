@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TwoFactorPgRepository struct {
+	db *gorm.DB
+}
+
+func NewTwoFactorPgRepository(db *gorm.DB) repositories.TwoFactorRepository {
+	return &TwoFactorPgRepository{db: db}
+}
+
+func (r *TwoFactorPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *TwoFactorPgRepository) Create(ctx context.Context, twoFactor *entities.UserTwoFactor) error {
+	return r.getDB(ctx).Create(twoFactor).Error
+}
+
+func (r *TwoFactorPgRepository) Update(ctx context.Context, twoFactor *entities.UserTwoFactor) error {
+	return r.getDB(ctx).Save(twoFactor).Error
+}
+
+func (r *TwoFactorPgRepository) FindByUserId(ctx context.Context, userId string) (*entities.UserTwoFactor, error) {
+	var twoFactor entities.UserTwoFactor
+	if err := r.getDB(ctx).Where("user_id = ?", userId).First(&twoFactor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &twoFactor, nil
+}
+
+func (r *TwoFactorPgRepository) FindByUserIdWithLock(ctx context.Context, userId string) (*entities.UserTwoFactor, error) {
+	var twoFactor entities.UserTwoFactor
+	if err := r.getDB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userId).First(&twoFactor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &twoFactor, nil
+}
+
+func (r *TwoFactorPgRepository) Delete(ctx context.Context, twoFactor *entities.UserTwoFactor) error {
+	return r.getDB(ctx).Delete(twoFactor).Error
+}
@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserAchievementPgRepository struct {
+	db *gorm.DB
+}
+
+func NewUserAchievementPgRepository(db *gorm.DB) repositories.UserAchievementRepository {
+	return &UserAchievementPgRepository{db: db}
+}
+
+func (r *UserAchievementPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *UserAchievementPgRepository) Unlock(ctx context.Context, achievements []*entities.UserAchievement) error {
+	if len(achievements) == 0 {
+		return nil
+	}
+	return r.getDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(achievements).Error
+}
+
+func (r *UserAchievementPgRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.UserAchievement, error) {
+	var achievements []*entities.UserAchievement
+	if err := r.getDB(ctx).Where("user_id = ?", userId).Order("unlocked_at").Find(&achievements).Error; err != nil {
+		return nil, err
+	}
+	return achievements, nil
+}
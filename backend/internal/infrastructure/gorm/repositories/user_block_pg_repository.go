@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserBlockPgRepository struct {
+	db *gorm.DB
+}
+
+func NewUserBlockPgRepository(db *gorm.DB) repositories.UserBlockRepository {
+	return &UserBlockPgRepository{db: db}
+}
+
+func (r *UserBlockPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *UserBlockPgRepository) Create(ctx context.Context, block *entities.UserBlock) error {
+	return r.getDB(ctx).Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(block).Error
+}
+
+func (r *UserBlockPgRepository) Delete(ctx context.Context, blockerId, blockedId string) error {
+	return r.getDB(ctx).Where("blocker_id = ? AND blocked_id = ?", blockerId, blockedId).Delete(&entities.UserBlock{}).Error
+}
+
+func (r *UserBlockPgRepository) ExistsBetween(ctx context.Context, userId, otherUserId string) (bool, error) {
+	var count int64
+	if err := r.getDB(ctx).Model(&entities.UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userId, otherUserId, otherUserId, userId).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *UserBlockPgRepository) FindByBlockerId(ctx context.Context, blockerId string) ([]*entities.UserBlock, error) {
+	var blocks []*entities.UserBlock
+	if err := r.getDB(ctx).Preload("Blocked").Where("blocker_id = ?", blockerId).Order("created_at DESC").Find(&blocks).Error; err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
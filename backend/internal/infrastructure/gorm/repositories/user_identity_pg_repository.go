@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+)
+
+type UserIdentityPgRepository struct {
+	db *gorm.DB
+}
+
+func NewUserIdentityPgRepository(db *gorm.DB) repositories.UserIdentityRepository {
+	return &UserIdentityPgRepository{db: db}
+}
+
+func (r *UserIdentityPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+func (r *UserIdentityPgRepository) Create(ctx context.Context, identity *entities.UserIdentity) error {
+	return r.getDB(ctx).Create(identity).Error
+}
+
+func (r *UserIdentityPgRepository) FindByProviderAndSubject(ctx context.Context, provider, subject string) (*entities.UserIdentity, error) {
+	var identity entities.UserIdentity
+	if err := r.getDB(ctx).Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *UserIdentityPgRepository) FindByUserIdAndProvider(ctx context.Context, userId, provider string) (*entities.UserIdentity, error) {
+	var identity entities.UserIdentity
+	if err := r.getDB(ctx).Where("user_id = ? AND provider = ?", userId, provider).First(&identity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *UserIdentityPgRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.UserIdentity, error) {
+	var identities []*entities.UserIdentity
+	if err := r.getDB(ctx).Where("user_id = ?", userId).Order("created_at").Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+// Delete hard-deletes the identity so the provider subject can be linked again later.
+func (r *UserIdentityPgRepository) Delete(ctx context.Context, identity *entities.UserIdentity) error {
+	return r.getDB(ctx).Unscoped().Delete(identity).Error
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
@@ -29,6 +30,14 @@ func (r *UserPgRepository) Create(ctx context.Context, user *entities.User) erro
 	return r.getDB(ctx).Create(user).Error
 }
 
+func (r *UserPgRepository) Update(ctx context.Context, user *entities.User) error {
+	return r.getDB(ctx).Save(user).Error
+}
+
+func (r *UserPgRepository) Delete(ctx context.Context, user *entities.User) error {
+	return r.getDB(ctx).Delete(user).Error
+}
+
 func (r *UserPgRepository) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
 	var user entities.User
 	if err := r.getDB(ctx).Where("email = ?", email).First(&user).Error; err != nil {
@@ -61,3 +70,36 @@ func (r *UserPgRepository) FindById(ctx context.Context, id string) (*entities.U
 	}
 	return &user, nil
 }
+
+func (r *UserPgRepository) Search(ctx context.Context, filter repositories.UserSearchFilter) ([]*entities.User, int64, error) {
+	query := r.getDB(ctx).Model(&entities.User{})
+	if filter.Query != "" {
+		pattern := "%" + escapeLike(filter.Query) + "%"
+		query = query.Where("name ILIKE ? OR username ILIKE ? OR email ILIKE ?", pattern, pattern, pattern)
+	}
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	if filter.Banned != nil {
+		if *filter.Banned {
+			query = query.Where("banned_at IS NOT NULL")
+		} else {
+			query = query.Where("banned_at IS NULL")
+		}
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var users []*entities.User
+	if err := query.Order("created_at DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
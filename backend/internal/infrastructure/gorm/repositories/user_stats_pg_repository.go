@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserStatsPgRepository struct {
+	db *gorm.DB
+}
+
+func NewUserStatsPgRepository(db *gorm.DB) repositories.UserStatsRepository {
+	return &UserStatsPgRepository{db: db}
+}
+
+func (r *UserStatsPgRepository) getDB(ctx context.Context) *gorm.DB {
+	if tx, ok := localgorm.ExtractTx(ctx); ok {
+		return tx.WithContext(ctx)
+	}
+	return r.db.WithContext(ctx)
+}
+
+// incrementColumn returns the upsert assignment adding the inserted value of column to the stored one.
+func incrementColumn(table, column string) clause.Expr {
+	return gorm.Expr(table + "." + column + " + EXCLUDED." + column)
+}
+
+// Increment upserts every row of delta with ON CONFLICT ... DO UPDATE additions, so concurrent
+// games of the same user never lose an update.
+func (r *UserStatsPgRepository) Increment(ctx context.Context, delta *entities.UserStats) error {
+	db := r.getDB(ctx)
+
+	if err := db.Omit(clause.Associations).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"games_played":        incrementColumn("user_stats", "games_played"),
+			"rounds_played":       incrementColumn("user_stats", "rounds_played"),
+			"total_score":         incrementColumn("user_stats", "total_score"),
+			"perfect_rounds":      incrementColumn("user_stats", "perfect_rounds"),
+			"timed_rounds":        incrementColumn("user_stats", "timed_rounds"),
+			"total_guess_seconds": incrementColumn("user_stats", "total_guess_seconds"),
+			"best_game_id":        gorm.Expr("CASE WHEN EXCLUDED.best_game_score > user_stats.best_game_score THEN EXCLUDED.best_game_id ELSE user_stats.best_game_id END"),
+			"best_game_score":     gorm.Expr("GREATEST(user_stats.best_game_score, EXCLUDED.best_game_score)"),
+			"updated_at":          gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}).Create(delta).Error; err != nil {
+		return err
+	}
+
+	if len(delta.ModeMaps) > 0 {
+		if err := db.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "mode"}, {Name: "map_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"games_played": incrementColumn("user_mode_map_stats", "games_played"),
+				"total_score":  incrementColumn("user_mode_map_stats", "total_score"),
+				"best_score":   gorm.Expr("GREATEST(user_mode_map_stats.best_score, EXCLUDED.best_score)"),
+			}),
+		}).Create(delta.ModeMaps).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(delta.Countries) > 0 {
+		if err := db.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "country_code"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"rounds_played":  incrementColumn("user_country_stats", "rounds_played"),
+				"correct_rounds": incrementColumn("user_country_stats", "correct_rounds"),
+				"total_score":    incrementColumn("user_country_stats", "total_score"),
+				"total_distance": incrementColumn("user_country_stats", "total_distance"),
+			}),
+		}).Create(delta.Countries).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(delta.DistanceBuckets) > 0 {
+		if err := db.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "bucket"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"rounds": incrementColumn("user_distance_buckets", "rounds"),
+			}),
+		}).Create(delta.DistanceBuckets).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *UserStatsPgRepository) FindByUserId(ctx context.Context, userId string) (*entities.UserStats, error) {
+	var stats entities.UserStats
+	if err := r.getDB(ctx).
+		Preload("ModeMaps").
+		Preload("Countries").
+		Preload("DistanceBuckets").
+		Where("user_id = ?", userId).
+		First(&stats).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &stats, nil
+}
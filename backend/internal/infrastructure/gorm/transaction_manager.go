@@ -39,11 +39,15 @@ func (m *GormTransactionManager) RunInTransaction(ctx context.Context, fn func(c
 		}
 	}()
 
-	txCtx := injectTx(ctx, tx)
+	txCtx, hooks := transactions.WithCommitHooks(injectTx(ctx, tx))
 	if err := fn(txCtx); err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	return tx.Commit().Error
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+	hooks.Run()
+	return nil
 }
@@ -0,0 +1,67 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/smtp"
+	"os"
+	"strings"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+// NewMailerFromEnv returns an SMTP mailer configured by SMTP_HOST, SMTP_PORT, SMTP_USERNAME,
+// SMTP_PASSWORD and MAIL_FROM. Without SMTP_HOST emails are written to the log, which is enough
+// for local development.
+func NewMailerFromEnv() services.Mailer {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return NewLogMailer()
+	}
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		panic("MAIL_FROM must be set when SMTP_HOST is set")
+	}
+	var auth smtp.Auth
+	if username := os.Getenv("SMTP_USERNAME"); username != "" {
+		auth = smtp.PlainAuth("", username, os.Getenv("SMTP_PASSWORD"), host)
+	}
+	return NewSmtpMailer(host+":"+port, from, auth)
+}
+
+// LogMailer writes emails to the standard logger instead of delivering them.
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(_ context.Context, message services.MailMessage) error {
+	log.Printf("mail to %s: %s\n%s", message.To, message.Subject, message.Body)
+	return nil
+}
+
+// SmtpMailer delivers plain text emails through an SMTP relay.
+type SmtpMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSmtpMailer(addr, from string, auth smtp.Auth) *SmtpMailer {
+	return &SmtpMailer{addr: addr, from: from, auth: auth}
+}
+
+func (m *SmtpMailer) Send(_ context.Context, message services.MailMessage) error {
+	if strings.ContainsAny(message.To+message.Subject, "\r\n") {
+		return fmt.Errorf("mail headers must not contain line breaks")
+	}
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		m.from, message.To, message.Subject, message.Body)
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{message.To}, []byte(msg))
+}
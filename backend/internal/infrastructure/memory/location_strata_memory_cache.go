@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+const (
+	// locationStrataMaxAge matches how often locations are rated, which moves them between strata.
+	locationStrataMaxAge = 15 * time.Minute
+	// locationStrataCapacity bounds the number of map, revision and tags combinations kept.
+	locationStrataCapacity = 1024
+)
+
+type locationStrataEntry struct {
+	strata   []repositories.LocationStratum
+	storedAt time.Time
+}
+
+// LocationStrataMemoryCache keeps strata in process memory, each instance counting them once per
+// revision of a map and per locationStrataMaxAge.
+type LocationStrataMemoryCache struct {
+	mu      sync.Mutex
+	entries map[repositories.LocationStrataKey]locationStrataEntry
+	now     func() time.Time
+}
+
+func NewLocationStrataMemoryCache() repositories.LocationStrataCache {
+	return newLocationStrataMemoryCache(time.Now)
+}
+
+func newLocationStrataMemoryCache(now func() time.Time) *LocationStrataMemoryCache {
+	return &LocationStrataMemoryCache{
+		entries: make(map[repositories.LocationStrataKey]locationStrataEntry),
+		now:     now,
+	}
+}
+
+func (c *LocationStrataMemoryCache) Get(_ context.Context, key repositories.LocationStrataKey) ([]repositories.LocationStratum, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if c.now().Sub(entry.storedAt) >= locationStrataMaxAge {
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+	return entry.strata, true, nil
+}
+
+func (c *LocationStrataMemoryCache) Set(_ context.Context, key repositories.LocationStrataKey, strata []repositories.LocationStratum) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= locationStrataCapacity {
+		c.evict(now)
+	}
+	c.entries[key] = locationStrataEntry{strata: strata, storedAt: now}
+	return nil
+}
+
+// evict drops the expired entries, or the oldest one when none has expired.
+func (c *LocationStrataMemoryCache) evict(now time.Time) {
+	var oldest repositories.LocationStrataKey
+	var oldestAt time.Time
+	for key, entry := range c.entries {
+		if now.Sub(entry.storedAt) >= locationStrataMaxAge {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestAt.IsZero() || entry.storedAt.Before(oldestAt) {
+			oldest, oldestAt = key, entry.storedAt
+		}
+	}
+	if len(c.entries) >= locationStrataCapacity {
+		delete(c.entries, oldest)
+	}
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocationStrataMemoryCacheSuite struct {
+	suite.Suite
+	now   time.Time
+	cache *LocationStrataMemoryCache
+}
+
+func TestLocationStrataMemoryCacheSuite(t *testing.T) {
+	suite.Run(t, new(LocationStrataMemoryCacheSuite))
+}
+
+func (s *LocationStrataMemoryCacheSuite) SetupTest() {
+	s.now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.cache = newLocationStrataMemoryCache(func() time.Time { return s.now })
+}
+
+func (s *LocationStrataMemoryCacheSuite) TestGet_ReturnsTheStrataOfTheRevision() {
+	ctx := context.Background()
+	strata := []repositories.LocationStratum{{GeoCell: 1, CountryCode: "fr", Count: 3}}
+	s.Require().NoError(s.cache.Set(ctx, repositories.LocationStrataKey{MapId: "map", Revision: 2}, strata))
+
+	cached, ok, err := s.cache.Get(ctx, repositories.LocationStrataKey{MapId: "map", Revision: 2})
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal(strata, cached)
+
+	_, ok, err = s.cache.Get(ctx, repositories.LocationStrataKey{MapId: "map", Revision: 3})
+	s.Require().NoError(err)
+	s.False(ok)
+}
+
+func (s *LocationStrataMemoryCacheSuite) TestGet_WhenExpired_ReturnsNothing() {
+	ctx := context.Background()
+	key := repositories.LocationStrataKey{MapId: "map", Revision: 2}
+	s.Require().NoError(s.cache.Set(ctx, key, []repositories.LocationStratum{{GeoCell: 1}}))
+
+	s.now = s.now.Add(locationStrataMaxAge)
+	_, ok, err := s.cache.Get(ctx, key)
+
+	s.Require().NoError(err)
+	s.False(ok)
+}
+
+func (s *LocationStrataMemoryCacheSuite) TestSet_WhenFull_EvictsTheOldest() {
+	ctx := context.Background()
+	for i := 0; i < locationStrataCapacity; i++ {
+		s.Require().NoError(s.cache.Set(ctx, repositories.LocationStrataKey{MapId: fmt.Sprint(i)}, nil))
+		s.now = s.now.Add(time.Millisecond)
+	}
+
+	s.Require().NoError(s.cache.Set(ctx, repositories.LocationStrataKey{MapId: "new"}, nil))
+
+	s.Len(s.cache.entries, locationStrataCapacity)
+	_, ok, _ := s.cache.Get(ctx, repositories.LocationStrataKey{MapId: "0"})
+	s.False(ok)
+	_, ok, _ = s.cache.Get(ctx, repositories.LocationStrataKey{MapId: "new"})
+	s.True(ok)
+}
@@ -0,0 +1,173 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+// Weights given to matches in the name, tags and description, as Postgres ranks the A, B and C parts
+// of a search vector.
+const (
+	nameMatchWeight        = 1.0
+	tagMatchWeight         = 0.4
+	descriptionMatchWeight = 0.2
+)
+
+type mapSearchDocument struct {
+	m            *entities.Map
+	countryCodes map[string]bool
+	locations    int64
+}
+
+// MapMemorySearch searches maps kept in process memory with a simple word matching. It mirrors the
+// filters of the Postgres search and is meant for tests and local development.
+type MapMemorySearch struct {
+	mu        sync.RWMutex
+	documents map[string]mapSearchDocument
+}
+
+func NewMapMemorySearch() *MapMemorySearch {
+	return &MapMemorySearch{documents: make(map[string]mapSearchDocument)}
+}
+
+// Put makes m and its locations searchable, replacing what was stored for the map.
+func (s *MapMemorySearch) Put(m *entities.Map, locations []*entities.Location) {
+	doc := mapSearchDocument{m: m, countryCodes: make(map[string]bool), locations: int64(len(locations))}
+	for _, l := range locations {
+		if l.CountryCode != "" {
+			doc.countryCodes[l.CountryCode] = true
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.documents[m.ID] = doc
+}
+
+func (s *MapMemorySearch) Delete(mapId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.documents, mapId)
+}
+
+func (s *MapMemorySearch) Search(ctx context.Context, query repositories.MapSearchQuery) ([]repositories.MapSearchHit, int64, error) {
+	terms := searchTerms(query.Text)
+
+	s.mu.RLock()
+	hits := make([]repositories.MapSearchHit, 0)
+	for _, doc := range s.documents {
+		if !matchesFilters(doc, query) {
+			continue
+		}
+		rank, ok := rankDocument(doc.m, terms)
+		if !ok {
+			continue
+		}
+		hits = append(hits, repositories.MapSearchHit{Map: doc.m, LocationCount: doc.locations, Rank: rank})
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Rank != hits[j].Rank {
+			return hits[i].Rank > hits[j].Rank
+		}
+		ti, tj := publicationTime(hits[i].Map), publicationTime(hits[j].Map)
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return hits[i].Map.ID < hits[j].Map.ID
+	})
+
+	total := int64(len(hits))
+	start := min(query.Offset, len(hits))
+	end := len(hits)
+	if query.Limit > 0 {
+		end = min(start+query.Limit, len(hits))
+	}
+	return hits[start:end], total, nil
+}
+
+func matchesFilters(doc mapSearchDocument, query repositories.MapSearchQuery) bool {
+	m := doc.m
+	if m.Visibility != entities.MapVisibilityPublic {
+		return false
+	}
+	if query.Category != "" && m.Category != query.Category {
+		return false
+	}
+	for _, tag := range query.Tags {
+		if !m.Tags.Has(tag) {
+			return false
+		}
+	}
+	if query.CountryCode != "" && !doc.countryCodes[query.CountryCode] {
+		return false
+	}
+	if query.CreatorId != "" && m.OwnerId != query.CreatorId {
+		return false
+	}
+	if query.MinLocations > 0 && doc.locations < query.MinLocations {
+		return false
+	}
+	if query.MaxLocations > 0 && doc.locations > query.MaxLocations {
+		return false
+	}
+	return true
+}
+
+// rankDocument reports whether every term is in the map and how relevant the map is to them.
+func rankDocument(m *entities.Map, terms []string) (float64, bool) {
+	if len(terms) == 0 {
+		return 0, true
+	}
+	name := wordSet(m.Name)
+	tags := wordSet(strings.Join(m.Tags, " "))
+	description := wordSet(m.Description)
+	var rank float64
+	for _, term := range terms {
+		found := false
+		if name[term] {
+			rank += nameMatchWeight
+			found = true
+		}
+		if tags[term] {
+			rank += tagMatchWeight
+			found = true
+		}
+		if description[term] {
+			rank += descriptionMatchWeight
+			found = true
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return rank, true
+}
+
+func searchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func wordSet(text string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range searchTerms(text) {
+		words[word] = true
+	}
+	return words
+}
+
+func publicationTime(m *entities.Map) time.Time {
+	if m.PublishedAt != nil {
+		return *m.PublishedAt
+	}
+	return m.CreatedAt
+}
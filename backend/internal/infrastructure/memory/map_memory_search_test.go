@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+	"github.com/stretchr/testify/suite"
+)
+
+type MapMemorySearchSuite struct {
+	suite.Suite
+	search *MapMemorySearch
+}
+
+func TestMapMemorySearchSuite(t *testing.T) {
+	suite.Run(t, new(MapMemorySearchSuite))
+}
+
+func (s *MapMemorySearchSuite) SetupTest() {
+	s.search = NewMapMemorySearch()
+	for _, id := range []string{"map-a", "map-b", "map-c"} {
+		m := entities.NewMap("World "+id, "", "owner-uuid")
+		m.ID = id
+		m.Visibility = entities.MapVisibilityPublic
+		s.search.Put(m, nil)
+	}
+}
+
+func (s *MapMemorySearchSuite) TestSearch_PagesThroughMatches() {
+	hits, total, err := s.search.Search(context.Background(), repositories.MapSearchQuery{Text: "world", Offset: 2, Limit: 2})
+
+	s.Require().NoError(err)
+	s.Equal(int64(3), total)
+	s.Require().Len(hits, 1)
+	s.Equal("map-c", hits[0].Map.ID)
+}
+
+func (s *MapMemorySearchSuite) TestSearch_RequiresEveryTerm() {
+	hits, total, err := s.search.Search(context.Background(), repositories.MapSearchQuery{Text: "world map-b"})
+
+	s.Require().NoError(err)
+	s.Equal(int64(1), total)
+	s.Equal("map-b", hits[0].Map.ID)
+}
+
+func (s *MapMemorySearchSuite) TestDelete() {
+	s.search.Delete("map-a")
+
+	_, total, err := s.search.Search(context.Background(), repositories.MapSearchQuery{})
+
+	s.Require().NoError(err)
+	s.Equal(int64(2), total)
+}
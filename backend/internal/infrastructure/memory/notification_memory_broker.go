@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
+
+// notificationBufferSize is how many notifications a slow client may fall behind before new ones are
+// dropped from its stream. Dropped notifications are still in the inbox.
+const notificationBufferSize = 16
+
+// NotificationMemoryBroker fans notifications out to the clients connected to this instance. With
+// several instances a client only gets the notifications published by the one it is connected to.
+type NotificationMemoryBroker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *entities.Notification]struct{}
+}
+
+func NewNotificationMemoryBroker() services.NotificationBroker {
+	return newNotificationMemoryBroker()
+}
+
+func newNotificationMemoryBroker() *NotificationMemoryBroker {
+	return &NotificationMemoryBroker{subscribers: make(map[string]map[chan *entities.Notification]struct{})}
+}
+
+func (b *NotificationMemoryBroker) Publish(notification *entities.Notification) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers[notification.UserId] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
+
+func (b *NotificationMemoryBroker) Subscribe(userId string) (<-chan *entities.Notification, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan *entities.Notification, notificationBufferSize)
+	if b.subscribers[userId] == nil {
+		b.subscribers[userId] = make(map[chan *entities.Notification]struct{})
+	}
+	b.subscribers[userId][ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() { b.unsubscribe(userId, ch) })
+	}
+}
+
+func (b *NotificationMemoryBroker) unsubscribe(userId string, ch chan *entities.Notification) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribers[userId], ch)
+	if len(b.subscribers[userId]) == 0 {
+		delete(b.subscribers, userId)
+	}
+	close(ch)
+}
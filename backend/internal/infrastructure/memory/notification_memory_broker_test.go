@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationMemoryBrokerSuite struct {
+	suite.Suite
+	broker *NotificationMemoryBroker
+}
+
+func TestNotificationMemoryBrokerSuite(t *testing.T) {
+	suite.Run(t, new(NotificationMemoryBrokerSuite))
+}
+
+func (s *NotificationMemoryBrokerSuite) SetupTest() {
+	s.broker = newNotificationMemoryBroker()
+}
+
+func (s *NotificationMemoryBrokerSuite) TestPublish_ReachesEverySubscriberOfTheUser() {
+	first, cancelFirst := s.broker.Subscribe("bob-uuid")
+	defer cancelFirst()
+	second, cancelSecond := s.broker.Subscribe("bob-uuid")
+	defer cancelSecond()
+	other, cancelOther := s.broker.Subscribe("carol-uuid")
+	defer cancelOther()
+	notification := entities.NewNotification("bob-uuid", entities.NotificationTypeFriendRequest, "alice-uuid", "alice-uuid")
+
+	s.broker.Publish(notification)
+
+	s.Equal(notification, <-first)
+	s.Equal(notification, <-second)
+	s.Empty(other)
+}
+
+func (s *NotificationMemoryBrokerSuite) TestPublish_DropsWhenSubscriberIsFull() {
+	ch, cancel := s.broker.Subscribe("bob-uuid")
+	defer cancel()
+
+	for range notificationBufferSize + 1 {
+		s.broker.Publish(entities.NewNotification("bob-uuid", entities.NotificationTypeFriendRequest, "alice-uuid", "alice-uuid"))
+	}
+
+	s.Len(ch, notificationBufferSize)
+}
+
+func (s *NotificationMemoryBrokerSuite) TestCancel_ClosesChannelAndForgetsUser() {
+	ch, cancel := s.broker.Subscribe("bob-uuid")
+
+	cancel()
+	cancel()
+
+	_, open := <-ch
+	s.False(open)
+	s.Empty(s.broker.subscribers)
+	s.broker.Publish(entities.NewNotification("bob-uuid", entities.NotificationTypeFriendRequest, "alice-uuid", "alice-uuid"))
+}
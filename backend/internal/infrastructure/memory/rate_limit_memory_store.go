@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/repositories"
+)
+
+// rateLimitSweepInterval is how often full buckets are dropped so idle clients do not accumulate.
+const rateLimitSweepInterval = time.Minute
+
+type rateLimitEntry struct {
+	bucket *entities.RateLimitBucket
+	rule   entities.RateLimitRule
+}
+
+// RateLimitMemoryStore keeps token buckets in process memory. Limits are per instance, which makes
+// it suitable for single-instance deployments and tests.
+type RateLimitMemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]rateLimitEntry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewRateLimitMemoryStore() repositories.RateLimitStore {
+	return newRateLimitMemoryStore(time.Now)
+}
+
+func newRateLimitMemoryStore(now func() time.Time) *RateLimitMemoryStore {
+	return &RateLimitMemoryStore{
+		entries:   make(map[string]rateLimitEntry),
+		lastSweep: now(),
+		now:       now,
+	}
+}
+
+func (s *RateLimitMemoryStore) Take(_ context.Context, key string, rule entities.RateLimitRule) (entities.RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	entry, ok := s.entries[key]
+	if !ok {
+		entry = rateLimitEntry{bucket: entities.NewRateLimitBucket(key, rule, now)}
+	}
+	entry.rule = rule
+	s.entries[key] = entry
+	return entry.bucket.Take(rule, now), nil
+}
+
+func (s *RateLimitMemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for key, entry := range s.entries {
+		if entry.bucket.IsFull(entry.rule, now) {
+			delete(s.entries, key)
+		}
+	}
+	s.lastSweep = now
+}
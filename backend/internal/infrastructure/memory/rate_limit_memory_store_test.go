@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitMemoryStoreSuite struct {
+	suite.Suite
+	now   time.Time
+	store *RateLimitMemoryStore
+	rule  entities.RateLimitRule
+}
+
+func TestRateLimitMemoryStoreSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitMemoryStoreSuite))
+}
+
+func (s *RateLimitMemoryStoreSuite) SetupTest() {
+	s.now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.store = newRateLimitMemoryStore(func() time.Time { return s.now })
+	s.rule = entities.RateLimitRule{Capacity: 2, RefillInterval: time.Second}
+}
+
+func (s *RateLimitMemoryStoreSuite) TestTake_LimitsEachKeyIndependently() {
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		decision, err := s.store.Take(ctx, "a", s.rule)
+		s.Require().NoError(err)
+		s.True(decision.Allowed)
+	}
+	decision, err := s.store.Take(ctx, "a", s.rule)
+	s.Require().NoError(err)
+	s.False(decision.Allowed)
+	s.Equal(time.Second, decision.RetryAfter)
+
+	decision, err = s.store.Take(ctx, "b", s.rule)
+	s.Require().NoError(err)
+	s.True(decision.Allowed)
+}
+
+func (s *RateLimitMemoryStoreSuite) TestTake_SweepsFullBuckets() {
+	ctx := context.Background()
+	_, err := s.store.Take(ctx, "a", s.rule)
+	s.Require().NoError(err)
+	s.Len(s.store.entries, 1)
+
+	s.now = s.now.Add(rateLimitSweepInterval)
+	_, err = s.store.Take(ctx, "b", s.rule)
+	s.Require().NoError(err)
+
+	s.Len(s.store.entries, 1)
+	s.Contains(s.store.entries, "b")
+}
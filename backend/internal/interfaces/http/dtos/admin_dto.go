@@ -0,0 +1,46 @@
+package dtos
+
+import "time"
+
+type AdminListUsersRequest struct {
+	Query    string `form:"q"`
+	Role     string `form:"role"`
+	Banned   *bool  `form:"banned"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type AdminUserResponse struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Email     string     `json:"email"`
+	Username  string     `json:"username"`
+	Role      string     `json:"role"`
+	BannedAt  *time.Time `json:"banned_at"`
+	BanReason string     `json:"ban_reason,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type AdminListUsersResponse struct {
+	Users    []AdminUserResponse `json:"users"`
+	Total    int64               `json:"total"`
+	Page     int                 `json:"page"`
+	PageSize int                 `json:"page_size"`
+}
+
+type AdminBanUserRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type AdminSetUserRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+type AdminGameResponse struct {
+	ID      string     `json:"id"`
+	UserId  string     `json:"user_id"`
+	MapId   string     `json:"map_id"`
+	Status  string     `json:"status"`
+	Score   int        `json:"score"`
+	EndedAt *time.Time `json:"ended_at"`
+}
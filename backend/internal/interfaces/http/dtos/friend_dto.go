@@ -0,0 +1,19 @@
+package dtos
+
+import "time"
+
+type SendFriendRequestRequest struct {
+	Username string `json:"username" binding:"required"`
+}
+
+type FriendshipResponse struct {
+	UserId     string     `json:"user_id"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"created_at"`
+	AcceptedAt *time.Time `json:"accepted_at"`
+}
+
+type FriendsFeedRequest struct {
+	Before time.Time `form:"before" time_format:"2006-01-02T15:04:05.999999999Z07:00"`
+	Limit  int       `form:"limit" binding:"omitempty,min=1,max=50"`
+}
@@ -0,0 +1,11 @@
+package dtos
+
+// LeaderboardRequest selects a board from the query string. Omitting map_id selects the global board.
+type LeaderboardRequest struct {
+	MapId    string `form:"map_id" binding:"omitempty,uuid"`
+	Mode     string `form:"mode" binding:"required,oneof=move no_move nmpz"`
+	Duration string `form:"duration" binding:"required,oneof=blitz rapid standard"`
+	Period   string `form:"period" binding:"omitempty,oneof=all_time monthly weekly"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
@@ -1,35 +1,146 @@
 package dtos
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+)
 
 type CreateMapRequest struct {
-	Name        string            `json:"name" binding:"required"`
-	Description string            `json:"description" binding:"required"`
+	Name        string             `json:"name" binding:"required"`
+	Description string             `json:"description" binding:"required"`
+	Category    string             `json:"category"`
+	Tags        []string           `json:"tags" binding:"omitempty,max=10"`
 	Locations   []LocationInputDTO `json:"locations" binding:"required,dive"`
 }
 
 type LocationInputDTO struct {
-	PanoId    string  `json:"pano_id" binding:"required"`
-	Latitude  float64 `json:"latitude" binding:"required"`
-	Longitude float64 `json:"longitude" binding:"required"`
-	Heading   float64 `json:"heading"`
-	Pitch     float64 `json:"pitch"`
+	PanoId      string   `json:"pano_id" binding:"required"`
+	Latitude    float64  `json:"latitude" binding:"required"`
+	Longitude   float64  `json:"longitude" binding:"required"`
+	Heading     float64  `json:"heading"`
+	Pitch       float64  `json:"pitch"`
+	CountryCode string   `json:"country_code" binding:"omitempty,len=2,alpha"`
+	Provider    string   `json:"provider" binding:"omitempty,oneof=google mapillary custom"`
+	CaptureDate string   `json:"capture_date"`
+	Tags        []string `json:"tags" binding:"omitempty,max=10"`
+	Note        string   `json:"note" binding:"omitempty,max=500"`
 }
 
 type CreateMapResponse struct {
-	ID          string               `json:"id"`
-	Name        string               `json:"name"`
-	Description string               `json:"description"`
-	OwnerId     string               `json:"owner_id"`
-	Locations   []LocationOutputDTO  `json:"locations"`
-	CreatedAt   time.Time            `json:"created_at"`
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	OwnerId     string              `json:"owner_id"`
+	Visibility  string              `json:"visibility"`
+	Category    string              `json:"category"`
+	Tags        []string            `json:"tags"`
+	Locations   []LocationOutputDTO `json:"locations"`
+	// Warnings are the lint results of the locations saved with warnings.
+	Warnings  []services.LocationLintResult `json:"warnings"`
+	CreatedAt time.Time                     `json:"created_at"`
 }
 
 type LocationOutputDTO struct {
-	ID        string  `json:"id"`
-	PanoId    string  `json:"pano_id"`
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-	Heading   float64 `json:"heading"`
-	Pitch     float64 `json:"pitch"`
+	ID          string   `json:"id"`
+	PanoId      string   `json:"pano_id"`
+	Latitude    float64  `json:"latitude"`
+	Longitude   float64  `json:"longitude"`
+	Heading     float64  `json:"heading"`
+	Pitch       float64  `json:"pitch"`
+	CountryCode string   `json:"country_code,omitempty"`
+	Provider    string   `json:"provider"`
+	CaptureDate string   `json:"capture_date,omitempty"`
+	Tags        []string `json:"tags"`
+	Note        string   `json:"note,omitempty"`
+}
+
+type ChangeMapVisibilityRequest struct {
+	Visibility string `json:"visibility" binding:"required,oneof=private unlisted public"`
+}
+
+type ListMapsRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type ListPublicMapsRequest struct {
+	ListMapsRequest
+	Sort string `form:"sort" binding:"omitempty,oneof=recent trending popular"`
+}
+
+type RateMapRequest struct {
+	Difficulty int `json:"difficulty" binding:"required,min=1,max=5"`
+}
+
+// SetMapBoundaryRequest holds a GeoJSON Polygon or MultiPolygon, or a Feature holding one.
+type SetMapBoundaryRequest struct {
+	Boundary       json.RawMessage `json:"boundary" binding:"required"`
+	OutsideGuesses string          `json:"outside_guesses" binding:"omitempty,oneof=reject clamp"`
+}
+
+type UpdateMapRequest struct {
+	Name                 *string   `json:"name" binding:"omitempty,min=1"`
+	Description          *string   `json:"description"`
+	Category             *string   `json:"category"`
+	Tags                 *[]string `json:"tags" binding:"omitempty,max=10"`
+	AllowRepeatLocations *bool     `json:"allow_repeat_locations"`
+}
+
+type SearchMapsRequest struct {
+	Query        string   `form:"q"`
+	Category     string   `form:"category"`
+	Tags         []string `form:"tag" binding:"omitempty,max=10"`
+	CountryCode  string   `form:"country" binding:"omitempty,len=2,alpha"`
+	Creator      string   `form:"creator"`
+	MinLocations int64    `form:"min_locations" binding:"omitempty,min=0"`
+	MaxLocations int64    `form:"max_locations" binding:"omitempty,min=0"`
+	Page         int      `form:"page" binding:"omitempty,min=1"`
+	PageSize     int      `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type AddLocationsRequest struct {
+	Locations []LocationInputDTO `json:"locations" binding:"required,min=1,dive"`
+}
+
+type LintLocationsRequest struct {
+	// Locations is optional: without it the map's own locations are linted.
+	Locations             []LocationInputDTO `json:"locations" binding:"omitempty,max=50,dive"`
+	DuplicateRadiusMeters float64            `json:"duplicate_radius_meters" binding:"omitempty,gt=0,max=10000"`
+}
+
+type AddMapCollaboratorRequest struct {
+	Username string `json:"username" binding:"required"`
+	Role     string `json:"role" binding:"required,oneof=viewer editor"`
+}
+
+type TransferMapOwnershipRequest struct {
+	Username string `json:"username" binding:"required"`
+}
+
+type UpdateLocationRequest struct {
+	Latitude    *float64  `json:"latitude"`
+	Longitude   *float64  `json:"longitude"`
+	Heading     *float64  `json:"heading"`
+	Pitch       *float64  `json:"pitch"`
+	CountryCode *string   `json:"country_code" binding:"omitempty,len=2,alpha"`
+	CaptureDate *string   `json:"capture_date"`
+	Tags        *[]string `json:"tags" binding:"omitempty,max=10"`
+	Note        *string   `json:"note" binding:"omitempty,max=500"`
+}
+
+type DiffMapRevisionsRequest struct {
+	From int `form:"from" binding:"min=0"`
+	// To defaults to the latest revision.
+	To *int `form:"to" binding:"omitempty,min=0"`
+}
+
+type RevertMapRequest struct {
+	Revision *int `json:"revision" binding:"required,min=0"`
+}
+
+type ForkMapRequest struct {
+	Name        *string `json:"name" binding:"omitempty,min=1"`
+	Description *string `json:"description"`
 }
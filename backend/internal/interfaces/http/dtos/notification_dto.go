@@ -0,0 +1,7 @@
+package dtos
+
+type ListNotificationsRequest struct {
+	Unread   bool `form:"unread"`
+	Page     int  `form:"page" binding:"omitempty,min=1"`
+	PageSize int  `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
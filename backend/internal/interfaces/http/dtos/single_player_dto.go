@@ -4,12 +4,16 @@ import (
 	"time"
 
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
 )
 
 type CreateSinglePlayerGameRequest struct {
 	MapId                 string `json:"map_id" binding:"required,uuid"`
 	Mode                  string `json:"mode" binding:"required,oneof=move no_move nmpz"`
 	RoundSecondsDuration  int    `json:"round_seconds_duration" binding:"required,min=10,max=300"`
+	Difficulty            string `json:"difficulty" binding:"omitempty,oneof=easy medium hard mixed"`
+	// Tags restricts the rounds to the locations having every one of the tags.
+	Tags                  []string `json:"tags" binding:"omitempty,max=10"`
 }
 
 type CreateSinglePlayerGameResponse struct {
@@ -18,4 +22,28 @@ type CreateSinglePlayerGameResponse struct {
 	MapId     string                      `json:"map_id"`
 	Mode      entities.SinglePlayerGameMode `json:"mode"`
 	CreatedAt time.Time                   `json:"created_at"`
+	Round     SinglePlayerRoundResponse   `json:"round"`
+}
+
+// SinglePlayerRoundResponse is the same for every panorama provider, and never gives the location.
+type SinglePlayerRoundResponse struct {
+	ID          string                   `json:"id"`
+	RoundNumber int                      `json:"round_number"`
+	Panorama    services.PanoramaPayload `json:"panorama"`
+}
+
+type SinglePlayerGuessRequest struct {
+	// Pointers so that the equator and the prime meridian pass the required check.
+	Latitude  *float64 `json:"latitude" binding:"required,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required,min=-180,max=180"`
+}
+
+type SinglePlayerGuessResponse struct {
+	Score          int                        `json:"score"`
+	TotalScore     int                        `json:"total_score"`
+	GameEnded      bool                       `json:"game_ended"`
+	GuessLatitude  float64                    `json:"guess_latitude"`
+	GuessLongitude float64                    `json:"guess_longitude"`
+	LocationNote   string                     `json:"location_note,omitempty"`
+	NextRound      *SinglePlayerRoundResponse `json:"next_round,omitempty"`
 }
@@ -15,5 +15,39 @@ type CreateUserResponse struct {
 	Name string `json:"name"`
 	Email string `json:"email"`
 	Username string `json:"username"`
+	Role string `json:"role,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
-}
\ No newline at end of file
+}
+type UpdateProfileRequest struct {
+	Name     *string `json:"name" binding:"omitempty,min=1,max=100"`
+	Username *string `json:"username" binding:"omitempty,min=3,max=20"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	// ConfirmationCode replaces CurrentPassword for accounts without a password.
+	ConfirmationCode string `json:"confirmation_code"`
+	NewPassword      string `json:"new_password" binding:"required,min=6,max=20"`
+}
+
+type RequestEmailChangeRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password"`
+	// ConfirmationCode replaces Password for accounts without a password.
+	ConfirmationCode string `json:"confirmation_code"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type DeleteAccountRequest struct {
+	Password   string `json:"password"`
+	Maps       string `json:"maps" binding:"required,oneof=delete transfer"`
+	TransferTo string `json:"transfer_to" binding:"required_if=Maps transfer"`
+}
+
+type AccountDeletionResponse struct {
+	MapsAction   string    `json:"maps_action"`
+	ScheduledFor time.Time `json:"scheduled_for"`
+}
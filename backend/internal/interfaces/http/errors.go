@@ -2,7 +2,9 @@ package http
 
 import (
 	"log"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
@@ -11,10 +13,14 @@ import (
 // RespondError writes an appropriate HTTP response for err.
 // If err is a domain error (implements HTTP status), the corresponding status and message are returned.
 // Otherwise, the error is logged and a 500 response with a generic message is returned.
+// Errors carrying a retry hint also set the Retry-After header.
 func RespondError(c *gin.Context, err error) {
 	if err == nil {
 		return
 	}
+	if retryAfter, ok := coreerrors.RetryAfter(err); ok {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
 	if status, ok := coreerrors.Status(err); ok {
 		c.JSON(status, gin.H{"error": err.Error()})
 		return
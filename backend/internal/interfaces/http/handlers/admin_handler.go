@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/admin"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
+	"gorm.io/gorm"
+)
+
+type AdminHandler struct {
+	listUsersUseCase   *admin.ListUsersUseCase
+	banUserUseCase     *admin.BanUserUseCase
+	unbanUserUseCase   *admin.UnbanUserUseCase
+	setUserRoleUseCase *admin.SetUserRoleUseCase
+	deleteMapUseCase   *admin.DeleteMapUseCase
+	endGameUseCase     *admin.EndGameUseCase
+	accessTokens       *auth.AuthenticateAccessTokenUseCase
+	router             *gin.Engine
+	db                 *gorm.DB
+}
+
+func NewAdminHandler(db *gorm.DB, router *gin.Engine) *AdminHandler {
+	userRepository := repositories.NewUserPgRepository(db)
+	refreshTokenRepository := repositories.NewRefreshTokenPgRepository(db)
+	mapRepository := repositories.NewMapPgRepository(db)
+	gameRepository := repositories.NewSinglePlayerGamePgRepository(db)
+	txManager := localgorm.NewGormTransactionManager(db)
+	return &AdminHandler{
+		db:                 db,
+		router:             router,
+		listUsersUseCase:   admin.NewListUsersUseCase(userRepository),
+		banUserUseCase:     admin.NewBanUserUseCase(userRepository, refreshTokenRepository, txManager),
+		unbanUserUseCase:   admin.NewUnbanUserUseCase(userRepository),
+		setUserRoleUseCase: admin.NewSetUserRoleUseCase(userRepository),
+		deleteMapUseCase:   admin.NewDeleteMapUseCase(mapRepository, userRepository),
+		endGameUseCase:     admin.NewEndGameUseCase(gameRepository, userRepository, txManager),
+		accessTokens:       auth.NewAuthenticateAccessTokenUseCase(services.NewJwtService(), userRepository),
+	}
+}
+
+func toAdminUserResponse(u *entities.User) dtos.AdminUserResponse {
+	return dtos.AdminUserResponse{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Username:  u.Username,
+		Role:      string(u.Role),
+		BannedAt:  u.BannedAt,
+		BanReason: u.BanReason,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	actorID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.AdminListUsersRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.listUsersUseCase.Execute(c.Request.Context(), admin.ListUsersInput{
+		ActorId:  actorID,
+		Query:    input.Query,
+		Role:     input.Role,
+		Banned:   input.Banned,
+		Page:     input.Page,
+		PageSize: input.PageSize,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	users := make([]dtos.AdminUserResponse, len(output.Users))
+	for i, u := range output.Users {
+		users[i] = toAdminUserResponse(u)
+	}
+	c.JSON(http.StatusOK, dtos.AdminListUsersResponse{
+		Users:    users,
+		Total:    output.Total,
+		Page:     output.Page,
+		PageSize: output.PageSize,
+	})
+}
+
+func (h *AdminHandler) BanUser(c *gin.Context) {
+	actorID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.AdminBanUserRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	u, err := h.banUserUseCase.Execute(c.Request.Context(), admin.BanUserInput{
+		ActorId: actorID,
+		UserId:  c.Param("id"),
+		Reason:  input.Reason,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toAdminUserResponse(u))
+}
+
+func (h *AdminHandler) UnbanUser(c *gin.Context) {
+	actorID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	u, err := h.unbanUserUseCase.Execute(c.Request.Context(), admin.UnbanUserInput{
+		ActorId: actorID,
+		UserId:  c.Param("id"),
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toAdminUserResponse(u))
+}
+
+func (h *AdminHandler) SetUserRole(c *gin.Context) {
+	actorID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.AdminSetUserRoleRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	u, err := h.setUserRoleUseCase.Execute(c.Request.Context(), admin.SetUserRoleInput{
+		ActorId: actorID,
+		UserId:  c.Param("id"),
+		Role:    input.Role,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toAdminUserResponse(u))
+}
+
+func (h *AdminHandler) DeleteMap(c *gin.Context) {
+	actorID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.deleteMapUseCase.Execute(c.Request.Context(), admin.DeleteMapInput{ActorId: actorID, MapId: c.Param("id")}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AdminHandler) EndGame(c *gin.Context) {
+	actorID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	game, err := h.endGameUseCase.Execute(c.Request.Context(), admin.EndGameInput{ActorId: actorID, GameId: c.Param("id")})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dtos.AdminGameResponse{
+		ID:      game.ID,
+		UserId:  game.UserId,
+		MapId:   game.MapId,
+		Status:  string(game.Status),
+		Score:   game.Score,
+		EndedAt: game.EndedAt,
+	})
+}
+
+func (h *AdminHandler) SetupRoutes() {
+	adminGroup := h.router.Group("/admin", middleware.AuthMiddleware(h.accessTokens, nil))
+
+	staff := middleware.RequireRole(entities.UserRoleModerator)
+	adminGroup.GET("/users", staff, h.ListUsers)
+	adminGroup.POST("/users/:id/ban", staff, h.BanUser)
+	adminGroup.POST("/users/:id/unban", staff, h.UnbanUser)
+	adminGroup.PUT("/users/:id/role", middleware.RequireRole(entities.UserRoleAdmin), h.SetUserRole)
+	adminGroup.DELETE("/maps/:id", middleware.RequireRole(entities.UserRoleMapCurator, entities.UserRoleModerator), h.DeleteMap)
+	adminGroup.POST("/games/:id/end", staff, h.EndGame)
+}
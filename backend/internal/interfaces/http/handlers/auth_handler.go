@@ -8,6 +8,7 @@ import (
 	"github.com/mvcris/maya-guessr/backend/internal/core/services"
 	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
 	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/user"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
 	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
 	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
 	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
@@ -16,23 +17,36 @@ import (
 )
 
 type AuthHandler struct {
-	loginUseCase  *auth.LoginUseCase
-	getMeUseCase  *user.GetMeUseCase
-	jwtService    *services.JwtService
-	router        *gin.Engine
-	db            *gorm.DB
+	loginUseCase          *auth.LoginUseCase
+	getMeUseCase          *user.GetMeUseCase
+	oidcAuthorizeUseCase  *auth.OidcAuthorizeUseCase
+	oidcCallbackUseCase   *auth.OidcCallbackUseCase
+	listIdentitiesUseCase *auth.ListIdentitiesUseCase
+	unlinkIdentityUseCase *auth.UnlinkIdentityUseCase
+	accessTokens          *auth.AuthenticateAccessTokenUseCase
+	router                *gin.Engine
+	db                    *gorm.DB
 }
 
-func NewAuthHandler(db *gorm.DB, router *gin.Engine) *AuthHandler {
+func NewAuthHandler(db *gorm.DB, router *gin.Engine, oidcService *services.OidcService) *AuthHandler {
 	userRepository := repositories.NewUserPgRepository(db)
 	refreshTokenRepository := repositories.NewRefreshTokenPgRepository(db)
+	identityRepository := repositories.NewUserIdentityPgRepository(db)
+	authStateRepository := repositories.NewOidcAuthStatePgRepository(db)
+	twoFactorRepository := repositories.NewTwoFactorPgRepository(db)
+	accountLockoutRepository := repositories.NewAccountLockoutPgRepository(db)
+	txManager := localgorm.NewGormTransactionManager(db)
 	jwtService := services.NewJwtService()
 	return &AuthHandler{
-		db:            db,
-		router:        router,
-		loginUseCase:  auth.NewLoginUseCase(userRepository, refreshTokenRepository, jwtService),
-		getMeUseCase:  user.NewGetMeUseCase(userRepository),
-		jwtService:    jwtService,
+		db:                    db,
+		router:                router,
+		loginUseCase:          auth.NewLoginUseCase(userRepository, refreshTokenRepository, twoFactorRepository, accountLockoutRepository, txManager, jwtService),
+		getMeUseCase:          user.NewGetMeUseCase(userRepository),
+		oidcAuthorizeUseCase:  auth.NewOidcAuthorizeUseCase(identityRepository, authStateRepository, oidcService),
+		oidcCallbackUseCase:   auth.NewOidcCallbackUseCase(userRepository, identityRepository, authStateRepository, refreshTokenRepository, twoFactorRepository, txManager, oidcService, jwtService),
+		listIdentitiesUseCase: auth.NewListIdentitiesUseCase(identityRepository),
+		unlinkIdentityUseCase: auth.NewUnlinkIdentityUseCase(userRepository, identityRepository),
+		accessTokens:          auth.NewAuthenticateAccessTokenUseCase(jwtService, userRepository),
 	}
 }
 
@@ -52,6 +66,7 @@ func (h *AuthHandler) GetMe(c *gin.Context) {
 		Name:      u.Name,
 		Email:     u.Email,
 		Username:  u.Username,
+		Role:      string(u.Role),
 		CreatedAt: u.CreatedAt,
 	})
 }
@@ -73,8 +88,116 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	c.JSON(http.StatusOK, output)
 }
 
+func (h *AuthHandler) OidcAuthorize(c *gin.Context) {
+	output, err := h.oidcAuthorizeUseCase.Execute(c.Request.Context(), auth.OidcAuthorizeInput{
+		Provider: c.Param("provider"),
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dtos.OidcAuthorizeResponse{
+		AuthorizationUrl: output.AuthorizationUrl,
+		State:            output.State,
+	})
+}
+
+func (h *AuthHandler) OidcLink(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.oidcAuthorizeUseCase.Execute(c.Request.Context(), auth.OidcAuthorizeInput{
+		Provider: c.Param("provider"),
+		UserId:   userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dtos.OidcAuthorizeResponse{
+		AuthorizationUrl: output.AuthorizationUrl,
+		State:            output.State,
+	})
+}
+
+func (h *AuthHandler) OidcCallback(c *gin.Context) {
+	var input dtos.OidcCallbackRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	provider := c.Param("provider")
+	output, err := h.oidcCallbackUseCase.Execute(c.Request.Context(), auth.OidcCallbackInput{
+		Provider: provider,
+		Code:     input.Code,
+		State:    input.State,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	if output.Linked {
+		c.JSON(http.StatusOK, dtos.OidcLinkedResponse{Provider: provider, Linked: true})
+		return
+	}
+	c.JSON(http.StatusOK, dtos.LoginResponse{
+		AccessToken:       output.AccessToken,
+		RefreshToken:      output.RefreshToken,
+		TwoFactorRequired: output.TwoFactorRequired,
+		ChallengeToken:    output.ChallengeToken,
+	})
+}
+
+func (h *AuthHandler) ListIdentities(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.listIdentitiesUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	identities := make([]dtos.IdentityResponse, len(output))
+	for i, identity := range output {
+		identities[i] = dtos.IdentityResponse{
+			Provider:  identity.Provider,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		}
+	}
+	c.JSON(http.StatusOK, identities)
+}
+
+func (h *AuthHandler) UnlinkIdentity(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.unlinkIdentityUseCase.Execute(c.Request.Context(), auth.UnlinkIdentityInput{
+		UserId:   userID,
+		Provider: c.Param("provider"),
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
 func (h *AuthHandler) SetupRoutes() {
 	authGroup := h.router.Group("/auth")
 	authGroup.POST("/login", h.Login)
-	authGroup.GET("/me", middleware.AuthMiddleware(h.jwtService), h.GetMe)
-}
\ No newline at end of file
+	authGroup.GET("/me", middleware.AuthMiddleware(h.accessTokens, nil), h.GetMe)
+
+	oidcGroup := authGroup.Group("/oidc/:provider")
+	oidcGroup.GET("/authorize", h.OidcAuthorize)
+	oidcGroup.GET("/callback", h.OidcCallback)
+	oidcGroup.POST("/link", middleware.AuthMiddleware(h.accessTokens, nil), h.OidcLink)
+
+	authGroup.GET("/identities", middleware.AuthMiddleware(h.accessTokens, nil), h.ListIdentities)
+	authGroup.DELETE("/identities/:provider", middleware.AuthMiddleware(h.accessTokens, nil), h.UnlinkIdentity)
+}
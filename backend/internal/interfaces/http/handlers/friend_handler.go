@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/friend"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/notification"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
+	"gorm.io/gorm"
+)
+
+type FriendHandler struct {
+	sendFriendRequestUseCase    *friend.SendFriendRequestUseCase
+	acceptFriendRequestUseCase  *friend.AcceptFriendRequestUseCase
+	declineFriendRequestUseCase *friend.DeclineFriendRequestUseCase
+	removeFriendUseCase         *friend.RemoveFriendUseCase
+	listFriendsUseCase          *friend.ListFriendsUseCase
+	blockUserUseCase            *friend.BlockUserUseCase
+	unblockUserUseCase          *friend.UnblockUserUseCase
+	listBlockedUsersUseCase     *friend.ListBlockedUsersUseCase
+	getFriendsFeedUseCase       *friend.GetFriendsFeedUseCase
+	accessTokens                *auth.AuthenticateAccessTokenUseCase
+	router                      *gin.Engine
+}
+
+func NewFriendHandler(db *gorm.DB, router *gin.Engine, broker services.NotificationBroker) *FriendHandler {
+	userRepository := repositories.NewUserPgRepository(db)
+	friendshipRepository := repositories.NewFriendshipPgRepository(db)
+	userBlockRepository := repositories.NewUserBlockPgRepository(db)
+	singlePlayerGameRepository := repositories.NewSinglePlayerGamePgRepository(db)
+	mapRepository := repositories.NewMapPgRepository(db)
+	txManager := localgorm.NewGormTransactionManager(db)
+	notifier := notification.NewNotificationService(repositories.NewNotificationPgRepository(db), broker)
+	return &FriendHandler{
+		sendFriendRequestUseCase:    friend.NewSendFriendRequestUseCase(userRepository, friendshipRepository, userBlockRepository, txManager, notifier),
+		acceptFriendRequestUseCase:  friend.NewAcceptFriendRequestUseCase(friendshipRepository, txManager, notifier),
+		declineFriendRequestUseCase: friend.NewDeclineFriendRequestUseCase(friendshipRepository),
+		removeFriendUseCase:         friend.NewRemoveFriendUseCase(friendshipRepository),
+		listFriendsUseCase:          friend.NewListFriendsUseCase(friendshipRepository),
+		blockUserUseCase:            friend.NewBlockUserUseCase(userRepository, friendshipRepository, userBlockRepository, txManager),
+		unblockUserUseCase:          friend.NewUnblockUserUseCase(userBlockRepository),
+		listBlockedUsersUseCase:     friend.NewListBlockedUsersUseCase(userBlockRepository),
+		getFriendsFeedUseCase:       friend.NewGetFriendsFeedUseCase(friendshipRepository, singlePlayerGameRepository, mapRepository),
+		accessTokens:                auth.NewAuthenticateAccessTokenUseCase(services.NewJwtService(), userRepository),
+		router:                      router,
+	}
+}
+
+func toFriendshipResponse(userID string, f *entities.Friendship) dtos.FriendshipResponse {
+	return dtos.FriendshipResponse{
+		UserId:     f.OtherUserId(userID),
+		Status:     string(f.Status),
+		CreatedAt:  f.CreatedAt,
+		AcceptedAt: f.AcceptedAt,
+	}
+}
+
+func (h *FriendHandler) ListFriends(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.listFriendsUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *FriendHandler) SendRequest(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.SendFriendRequestRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	friendship, err := h.sendFriendRequestUseCase.Execute(c.Request.Context(), friend.SendFriendRequestInput{
+		UserId:   userID,
+		Username: input.Username,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, toFriendshipResponse(userID, friendship))
+}
+
+func (h *FriendHandler) AcceptRequest(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	friendship, err := h.acceptFriendRequestUseCase.Execute(c.Request.Context(), friend.FriendRequestInput{
+		UserId:      userID,
+		RequesterId: c.Param("id"),
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toFriendshipResponse(userID, friendship))
+}
+
+func (h *FriendHandler) DeclineRequest(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.declineFriendRequestUseCase.Execute(c.Request.Context(), friend.FriendRequestInput{
+		UserId:      userID,
+		RequesterId: c.Param("id"),
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *FriendHandler) RemoveFriend(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.removeFriendUseCase.Execute(c.Request.Context(), friend.RemoveFriendInput{
+		UserId:   userID,
+		FriendId: c.Param("id"),
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *FriendHandler) ListBlocks(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.listBlockedUsersUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *FriendHandler) Block(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.blockUserUseCase.Execute(c.Request.Context(), friend.BlockUserInput{
+		UserId:    userID,
+		BlockedId: c.Param("id"),
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *FriendHandler) Unblock(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.unblockUserUseCase.Execute(c.Request.Context(), friend.BlockUserInput{
+		UserId:    userID,
+		BlockedId: c.Param("id"),
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *FriendHandler) Feed(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.FriendsFeedRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.getFriendsFeedUseCase.Execute(c.Request.Context(), friend.GetFriendsFeedInput{
+		UserId: userID,
+		Before: input.Before,
+		Limit:  input.Limit,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *FriendHandler) SetupRoutes() {
+	friends := h.router.Group("/friends", middleware.AuthMiddleware(h.accessTokens, nil))
+	friends.GET("", h.ListFriends)
+	friends.GET("/feed", h.Feed)
+	friends.DELETE("/:id", h.RemoveFriend)
+
+	requests := friends.Group("/requests")
+	requests.POST("", h.SendRequest)
+	requests.POST("/:id/accept", h.AcceptRequest)
+	requests.DELETE("/:id", h.DeclineRequest)
+
+	blocks := friends.Group("/blocks")
+	blocks.GET("", h.ListBlocks)
+	blocks.PUT("/:id", h.Block)
+	blocks.DELETE("/:id", h.Unblock)
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/leaderboard"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
+	"gorm.io/gorm"
+)
+
+// leaderboardCacheControl lets clients and proxies reuse a page briefly; boards change on every
+// completed game, so anything longer would show stale ranks.
+const leaderboardCacheControl = "public, max-age=30"
+
+type LeaderboardHandler struct {
+	getLeaderboardUseCase *leaderboard.GetLeaderboardUseCase
+	getMyRankUseCase      *leaderboard.GetMyRankUseCase
+	getFriendsUseCase     *leaderboard.GetFriendsLeaderboardUseCase
+	personalAccessTokens  *auth.AuthenticatePersonalAccessTokenUseCase
+	accessTokens          *auth.AuthenticateAccessTokenUseCase
+	router                *gin.Engine
+}
+
+func NewLeaderboardHandler(db *gorm.DB, router *gin.Engine) *LeaderboardHandler {
+	leaderboardRepository := repositories.NewLeaderboardPgRepository(db)
+	friendshipRepository := repositories.NewFriendshipPgRepository(db)
+	userRepository := repositories.NewUserPgRepository(db)
+	personalAccessTokenRepository := repositories.NewPersonalAccessTokenPgRepository(db)
+	return &LeaderboardHandler{
+		getLeaderboardUseCase: leaderboard.NewGetLeaderboardUseCase(leaderboardRepository),
+		getMyRankUseCase:      leaderboard.NewGetMyRankUseCase(leaderboardRepository),
+		getFriendsUseCase:     leaderboard.NewGetFriendsLeaderboardUseCase(friendshipRepository, leaderboardRepository),
+		personalAccessTokens:  auth.NewAuthenticatePersonalAccessTokenUseCase(personalAccessTokenRepository, userRepository, services.NewPersonalAccessTokenService()),
+		accessTokens:          auth.NewAuthenticateAccessTokenUseCase(services.NewJwtService(), userRepository),
+		router:                router,
+	}
+}
+
+func toBoardInput(input dtos.LeaderboardRequest) leaderboard.BoardInput {
+	period := entities.LeaderboardPeriod(input.Period)
+	if period == "" {
+		period = entities.LeaderboardPeriodAllTime
+	}
+	return leaderboard.BoardInput{
+		MapId:          input.MapId,
+		Mode:           entities.SinglePlayerGameMode(input.Mode),
+		DurationBucket: entities.LeaderboardDurationBucket(input.Duration),
+		Period:         period,
+	}
+}
+
+func (h *LeaderboardHandler) GetLeaderboard(c *gin.Context) {
+	var input dtos.LeaderboardRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.getLeaderboardUseCase.Execute(c.Request.Context(), leaderboard.GetLeaderboardInput{
+		BoardInput: toBoardInput(input),
+		Page:       input.Page,
+		PageSize:   input.PageSize,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Header("Cache-Control", leaderboardCacheControl)
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *LeaderboardHandler) GetMyRank(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.LeaderboardRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.getMyRankUseCase.Execute(c.Request.Context(), leaderboard.GetMyRankInput{
+		BoardInput: toBoardInput(input),
+		UserId:     userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// GetFriendsLeaderboard ignores pagination: the whole friends view fits in one response.
+func (h *LeaderboardHandler) GetFriendsLeaderboard(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.LeaderboardRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.getFriendsUseCase.Execute(c.Request.Context(), leaderboard.GetFriendsLeaderboardInput{
+		BoardInput: toBoardInput(input),
+		UserId:     userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *LeaderboardHandler) SetupRoutes() {
+	leaderboards := h.router.Group("/leaderboards")
+	leaderboards.GET("", h.GetLeaderboard)
+	leaderboards.GET("/me", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeStatsRead), h.GetMyRank)
+	leaderboards.GET("/friends", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeStatsRead), h.GetFriendsLeaderboard)
+}
@@ -4,9 +4,13 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	corerepositories "github.com/mvcris/maya-guessr/backend/internal/core/repositories"
 	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
 	mapuc "github.com/mvcris/maya-guessr/backend/internal/core/use_cases/map"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/notification"
 	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
 	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
 	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
@@ -16,23 +20,109 @@ import (
 )
 
 type MapHandler struct {
-	createMapUseCase *mapuc.CreateMapUseCase
-	jwtService       *services.JwtService
-	router           *gin.Engine
+	createMapUseCase             *mapuc.CreateMapUseCase
+	getMapUseCase                *mapuc.GetMapUseCase
+	listPublicMapsUseCase        *mapuc.ListPublicMapsUseCase
+	changeMapVisibilityUseCase   *mapuc.ChangeMapVisibilityUseCase
+	updateMapUseCase             *mapuc.UpdateMapUseCase
+	addLocationsUseCase          *mapuc.AddLocationsUseCase
+	removeLocationUseCase        *mapuc.RemoveLocationUseCase
+	listMapCollaboratorsUseCase  *mapuc.ListMapCollaboratorsUseCase
+	addMapCollaboratorUseCase    *mapuc.AddMapCollaboratorUseCase
+	removeMapCollaboratorUseCase *mapuc.RemoveMapCollaboratorUseCase
+	transferMapOwnershipUseCase  *mapuc.TransferMapOwnershipUseCase
+	listMapInvitationsUseCase    *mapuc.ListMapInvitationsUseCase
+	acceptMapInvitationUseCase   *mapuc.AcceptMapInvitationUseCase
+	declineMapInvitationUseCase  *mapuc.DeclineMapInvitationUseCase
+	updateLocationUseCase        *mapuc.UpdateLocationUseCase
+	listMapRevisionsUseCase      *mapuc.ListMapRevisionsUseCase
+	diffMapRevisionsUseCase      *mapuc.DiffMapRevisionsUseCase
+	revertMapUseCase             *mapuc.RevertMapUseCase
+	forkMapUseCase               *mapuc.ForkMapUseCase
+	searchMapsUseCase            *mapuc.SearchMapsUseCase
+	lintLocationsUseCase         *mapuc.LintLocationsUseCase
+	likeMapUseCase               *mapuc.LikeMapUseCase
+	unlikeMapUseCase             *mapuc.UnlikeMapUseCase
+	rateMapUseCase               *mapuc.RateMapUseCase
+	removeMapRatingUseCase       *mapuc.RemoveMapRatingUseCase
+	getMapDifficultyUseCase      *mapuc.GetMapDifficultyUseCase
+	setMapBoundaryUseCase        *mapuc.SetMapBoundaryUseCase
+	removeMapBoundaryUseCase     *mapuc.RemoveMapBoundaryUseCase
+	getMapBoundsUseCase          *mapuc.GetMapBoundsUseCase
+	personalAccessTokens         *auth.AuthenticatePersonalAccessTokenUseCase
+	accessTokens                 *auth.AuthenticateAccessTokenUseCase
+	router                       *gin.Engine
 }
 
-func NewMapHandler(db *gorm.DB, router *gin.Engine) *MapHandler {
+func NewMapHandler(db *gorm.DB, router *gin.Engine, broker services.NotificationBroker) *MapHandler {
 	mapRepository := repositories.NewMapPgRepository(db)
 	locationRepository := repositories.NewLocationPgRepository(db)
+	userRepository := repositories.NewUserPgRepository(db)
+	collaboratorRepository := repositories.NewMapCollaboratorPgRepository(db)
+	invitationRepository := repositories.NewMapInvitationPgRepository(db)
+	revisionRepository := repositories.NewMapRevisionPgRepository(db)
+	likeRepository := repositories.NewMapLikePgRepository(db)
+	ratingRepository := repositories.NewMapRatingPgRepository(db)
+	mapAuthorization := services.NewMapAuthorizationService(collaboratorRepository)
+	locationLinter := services.NewLocationLinter(services.NewGeoService(), services.NewPanoramaRegistryFromEnv(), services.DefaultLocationLintConfig())
+	notifier := notification.NewNotificationService(repositories.NewNotificationPgRepository(db), broker)
 	txManager := localgorm.NewGormTransactionManager(db)
 	jwtService := services.NewJwtService()
 	return &MapHandler{
-		createMapUseCase: mapuc.NewCreateMapUseCase(mapRepository, locationRepository, txManager),
-		jwtService:       jwtService,
-		router:           router,
+		createMapUseCase:             mapuc.NewCreateMapUseCase(mapRepository, locationRepository, locationLinter, txManager),
+		getMapUseCase:                mapuc.NewGetMapUseCase(mapRepository, locationRepository, mapAuthorization),
+		listPublicMapsUseCase:        mapuc.NewListPublicMapsUseCase(mapRepository),
+		changeMapVisibilityUseCase:   mapuc.NewChangeMapVisibilityUseCase(mapRepository, locationRepository, mapAuthorization),
+		updateMapUseCase:             mapuc.NewUpdateMapUseCase(mapRepository, mapAuthorization),
+		addLocationsUseCase:          mapuc.NewAddLocationsUseCase(mapRepository, locationRepository, revisionRepository, mapAuthorization, locationLinter, txManager),
+		removeLocationUseCase:        mapuc.NewRemoveLocationUseCase(mapRepository, locationRepository, revisionRepository, mapAuthorization, txManager),
+		listMapCollaboratorsUseCase:  mapuc.NewListMapCollaboratorsUseCase(mapRepository, collaboratorRepository, mapAuthorization),
+		addMapCollaboratorUseCase:    mapuc.NewAddMapCollaboratorUseCase(mapRepository, userRepository, collaboratorRepository, invitationRepository, mapAuthorization, txManager, notifier),
+		removeMapCollaboratorUseCase: mapuc.NewRemoveMapCollaboratorUseCase(mapRepository, collaboratorRepository, invitationRepository, mapAuthorization),
+		transferMapOwnershipUseCase:  mapuc.NewTransferMapOwnershipUseCase(mapRepository, userRepository, invitationRepository, mapAuthorization, txManager, notifier),
+		listMapInvitationsUseCase:    mapuc.NewListMapInvitationsUseCase(invitationRepository),
+		acceptMapInvitationUseCase:   mapuc.NewAcceptMapInvitationUseCase(mapRepository, userRepository, collaboratorRepository, invitationRepository, txManager, notifier),
+		declineMapInvitationUseCase:  mapuc.NewDeclineMapInvitationUseCase(invitationRepository),
+		updateLocationUseCase:        mapuc.NewUpdateLocationUseCase(mapRepository, locationRepository, revisionRepository, mapAuthorization, txManager),
+		listMapRevisionsUseCase:      mapuc.NewListMapRevisionsUseCase(mapRepository, revisionRepository, mapAuthorization),
+		diffMapRevisionsUseCase:      mapuc.NewDiffMapRevisionsUseCase(mapRepository, locationRepository, revisionRepository, mapAuthorization),
+		revertMapUseCase:             mapuc.NewRevertMapUseCase(mapRepository, locationRepository, revisionRepository, mapAuthorization, txManager),
+		forkMapUseCase:               mapuc.NewForkMapUseCase(mapRepository, locationRepository, mapAuthorization, txManager),
+		searchMapsUseCase:            mapuc.NewSearchMapsUseCase(repositories.NewMapPgSearch(db), userRepository),
+		likeMapUseCase:               mapuc.NewLikeMapUseCase(mapRepository, likeRepository, mapAuthorization, txManager, notifier),
+		unlikeMapUseCase:             mapuc.NewUnlikeMapUseCase(mapRepository, likeRepository, txManager),
+		rateMapUseCase:               mapuc.NewRateMapUseCase(mapRepository, ratingRepository, mapAuthorization, txManager),
+		removeMapRatingUseCase:       mapuc.NewRemoveMapRatingUseCase(mapRepository, ratingRepository, txManager),
+		lintLocationsUseCase:         mapuc.NewLintLocationsUseCase(mapRepository, locationRepository, mapAuthorization, locationLinter),
+		getMapDifficultyUseCase:      mapuc.NewGetMapDifficultyUseCase(mapRepository, locationRepository, mapAuthorization),
+		setMapBoundaryUseCase:        mapuc.NewSetMapBoundaryUseCase(mapRepository, locationRepository, mapAuthorization),
+		removeMapBoundaryUseCase:     mapuc.NewRemoveMapBoundaryUseCase(mapRepository, mapAuthorization),
+		getMapBoundsUseCase:          mapuc.NewGetMapBoundsUseCase(mapRepository, locationRepository, mapAuthorization),
+		personalAccessTokens:         auth.NewAuthenticatePersonalAccessTokenUseCase(repositories.NewPersonalAccessTokenPgRepository(db), userRepository, services.NewPersonalAccessTokenService()),
+		accessTokens:                 auth.NewAuthenticateAccessTokenUseCase(jwtService, userRepository),
+		router:                       router,
 	}
 }
 
+func toLocationInputs(dtoLocations []dtos.LocationInputDTO) []mapuc.LocationInput {
+	locations := make([]mapuc.LocationInput, len(dtoLocations))
+	for i, loc := range dtoLocations {
+		locations[i] = mapuc.LocationInput{
+			PanoId:      loc.PanoId,
+			Latitude:    loc.Latitude,
+			Longitude:   loc.Longitude,
+			Heading:     loc.Heading,
+			Pitch:       loc.Pitch,
+			CountryCode: loc.CountryCode,
+			Provider:    loc.Provider,
+			CaptureDate: loc.CaptureDate,
+			Tags:        loc.Tags,
+			Note:        loc.Note,
+		}
+	}
+	return locations
+}
+
 func (h *MapHandler) CreateMap(c *gin.Context) {
 	userID, ok := middleware.GetAuthenticatedUserID(c)
 	if !ok {
@@ -46,22 +136,13 @@ func (h *MapHandler) CreateMap(c *gin.Context) {
 		return
 	}
 
-	locations := make([]mapuc.LocationInput, len(input.Locations))
-	for i, loc := range input.Locations {
-		locations[i] = mapuc.LocationInput{
-			PanoId:   loc.PanoId,
-			Latitude: loc.Latitude,
-			Longitude: loc.Longitude,
-			Heading:  loc.Heading,
-			Pitch:    loc.Pitch,
-		}
-	}
-
 	output, err := h.createMapUseCase.Execute(mapuc.CreateMapInput{
 		Name:        input.Name,
 		Description: input.Description,
 		OwnerId:     userID,
-		Locations:   locations,
+		Category:    input.Category,
+		Tags:        input.Tags,
+		Locations:   toLocationInputs(input.Locations),
 	})
 	if err != nil {
 		httppkg.RespondError(c, err)
@@ -71,12 +152,17 @@ func (h *MapHandler) CreateMap(c *gin.Context) {
 	locationDTOs := make([]dtos.LocationOutputDTO, len(output.Locations))
 	for i, loc := range output.Locations {
 		locationDTOs[i] = dtos.LocationOutputDTO{
-			ID:        loc.ID,
-			PanoId:    loc.PanoId,
-			Latitude:  loc.Latitude,
-			Longitude: loc.Longitude,
-			Heading:   loc.Heading,
-			Pitch:     loc.Pitch,
+			ID:          loc.ID,
+			PanoId:      loc.PanoId,
+			Latitude:    loc.Latitude,
+			Longitude:   loc.Longitude,
+			Heading:     loc.Heading,
+			Pitch:       loc.Pitch,
+			CountryCode: loc.CountryCode,
+			Provider:    string(loc.Provider),
+			CaptureDate: loc.CaptureDate,
+			Tags:        loc.Tags,
+			Note:        loc.Note,
 		}
 	}
 
@@ -85,11 +171,629 @@ func (h *MapHandler) CreateMap(c *gin.Context) {
 		Name:        output.Name,
 		Description: output.Description,
 		OwnerId:     output.OwnerId,
+		Visibility:  string(output.Visibility),
+		Category:    string(output.Category),
+		Tags:        output.Tags,
 		Locations:   locationDTOs,
+		Warnings:    output.Warnings,
 		CreatedAt:   output.CreatedAt,
 	})
 }
 
+func (h *MapHandler) ListPublicMaps(c *gin.Context) {
+	var input dtos.ListPublicMapsRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.listPublicMapsUseCase.Execute(c.Request.Context(), mapuc.ListPublicMapsInput{
+		Sort:     corerepositories.MapSort(input.Sort),
+		Page:     input.Page,
+		PageSize: input.PageSize,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) GetMap(c *gin.Context) {
+	userID, _ := middleware.GetAuthenticatedUserID(c)
+	output, err := h.getMapUseCase.Execute(c.Request.Context(), mapuc.GetMapInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) ChangeVisibility(c *gin.Context) {
+	var input dtos.ChangeMapVisibilityRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.changeVisibility(c, entities.MapVisibility(input.Visibility))
+}
+
+// Publish makes the map public once it has enough locations.
+func (h *MapHandler) Publish(c *gin.Context) {
+	h.changeVisibility(c, entities.MapVisibilityPublic)
+}
+
+func (h *MapHandler) changeVisibility(c *gin.Context, visibility entities.MapVisibility) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.changeMapVisibilityUseCase.Execute(c.Request.Context(), mapuc.ChangeMapVisibilityInput{
+		MapId:      c.Param("id"),
+		UserId:     userID,
+		Visibility: visibility,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) UpdateMap(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.UpdateMapRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.updateMapUseCase.Execute(c.Request.Context(), mapuc.UpdateMapInput{
+		MapId:                c.Param("id"),
+		UserId:               userID,
+		Name:                 input.Name,
+		Description:          input.Description,
+		Category:             input.Category,
+		Tags:                 input.Tags,
+		AllowRepeatLocations: input.AllowRepeatLocations,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) SearchMaps(c *gin.Context) {
+	var input dtos.SearchMapsRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.searchMapsUseCase.Execute(c.Request.Context(), mapuc.SearchMapsInput{
+		Query:        input.Query,
+		Category:     input.Category,
+		Tags:         input.Tags,
+		CountryCode:  input.CountryCode,
+		Creator:      input.Creator,
+		MinLocations: input.MinLocations,
+		MaxLocations: input.MaxLocations,
+		Page:         input.Page,
+		PageSize:     input.PageSize,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) ListCategories(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"categories": entities.MapCategories})
+}
+
+func (h *MapHandler) AddLocations(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.AddLocationsRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.addLocationsUseCase.Execute(c.Request.Context(), mapuc.AddLocationsInput{
+		MapId:     c.Param("id"),
+		UserId:    userID,
+		Locations: toLocationInputs(input.Locations),
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *MapHandler) LintLocations(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	// The body is optional: without it the map's own locations are linted.
+	var input dtos.LintLocationsRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	output, err := h.lintLocationsUseCase.Execute(c.Request.Context(), mapuc.LintLocationsInput{
+		MapId:                 c.Param("id"),
+		UserId:                userID,
+		Locations:             toLocationInputs(input.Locations),
+		DuplicateRadiusMeters: input.DuplicateRadiusMeters,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) RemoveLocation(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.removeLocationUseCase.Execute(c.Request.Context(), mapuc.RemoveLocationInput{
+		MapId:      c.Param("id"),
+		UserId:     userID,
+		LocationId: c.Param("location"),
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *MapHandler) UpdateLocation(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.UpdateLocationRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.updateLocationUseCase.Execute(c.Request.Context(), mapuc.UpdateLocationInput{
+		MapId:       c.Param("id"),
+		UserId:      userID,
+		LocationId:  c.Param("location"),
+		Latitude:    input.Latitude,
+		Longitude:   input.Longitude,
+		Heading:     input.Heading,
+		Pitch:       input.Pitch,
+		CountryCode: input.CountryCode,
+		CaptureDate: input.CaptureDate,
+		Tags:        input.Tags,
+		Note:        input.Note,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) ListRevisions(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.ListMapsRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.listMapRevisionsUseCase.Execute(c.Request.Context(), mapuc.ListMapRevisionsInput{
+		MapId:    c.Param("id"),
+		UserId:   userID,
+		Page:     input.Page,
+		PageSize: input.PageSize,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) DiffRevisions(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.DiffMapRevisionsRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.diffMapRevisionsUseCase.Execute(c.Request.Context(), mapuc.DiffMapRevisionsInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+		From:   input.From,
+		To:     input.To,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) Revert(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.RevertMapRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.revertMapUseCase.Execute(c.Request.Context(), mapuc.RevertMapInput{
+		MapId:    c.Param("id"),
+		UserId:   userID,
+		Revision: *input.Revision,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *MapHandler) Fork(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	// The body is optional: without it the fork takes the name and description of the source.
+	var input dtos.ForkMapRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	output, err := h.forkMapUseCase.Execute(c.Request.Context(), mapuc.ForkMapInput{
+		MapId:       c.Param("id"),
+		UserId:      userID,
+		Name:        input.Name,
+		Description: input.Description,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, output)
+}
+
+func (h *MapHandler) Like(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.likeMapUseCase.Execute(c.Request.Context(), mapuc.MapFeedbackInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) Unlike(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.unlikeMapUseCase.Execute(c.Request.Context(), mapuc.MapFeedbackInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *MapHandler) Rate(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.RateMapRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.rateMapUseCase.Execute(c.Request.Context(), mapuc.RateMapInput{
+		MapId:      c.Param("id"),
+		UserId:     userID,
+		Difficulty: input.Difficulty,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) RemoveRating(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.removeMapRatingUseCase.Execute(c.Request.Context(), mapuc.MapFeedbackInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *MapHandler) ListCollaborators(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.listMapCollaboratorsUseCase.Execute(c.Request.Context(), mapuc.ListMapCollaboratorsInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"collaborators": output})
+}
+
+func (h *MapHandler) AddCollaborator(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.AddMapCollaboratorRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.addMapCollaboratorUseCase.Execute(c.Request.Context(), mapuc.AddMapCollaboratorInput{
+		MapId:    c.Param("id"),
+		UserId:   userID,
+		Username: input.Username,
+		Role:     entities.MapRole(input.Role),
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) RemoveCollaborator(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.removeMapCollaboratorUseCase.Execute(c.Request.Context(), mapuc.RemoveMapCollaboratorInput{
+		MapId:          c.Param("id"),
+		UserId:         userID,
+		CollaboratorId: c.Param("user"),
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *MapHandler) TransferOwnership(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.TransferMapOwnershipRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.transferMapOwnershipUseCase.Execute(c.Request.Context(), mapuc.TransferMapOwnershipInput{
+		MapId:    c.Param("id"),
+		UserId:   userID,
+		Username: input.Username,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) ListInvitations(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.listMapInvitationsUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"invitations": output})
+}
+
+func (h *MapHandler) AcceptInvitation(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.acceptMapInvitationUseCase.Execute(c.Request.Context(), mapuc.MapInvitationInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) DeclineInvitation(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.declineMapInvitationUseCase.Execute(c.Request.Context(), mapuc.MapInvitationInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *MapHandler) GetDifficulty(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.getMapDifficultyUseCase.Execute(c.Request.Context(), mapuc.GetMapDifficultyInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) SetBoundary(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.SetMapBoundaryRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.setMapBoundaryUseCase.Execute(c.Request.Context(), mapuc.SetMapBoundaryInput{
+		MapId:          c.Param("id"),
+		UserId:         userID,
+		Boundary:       input.Boundary,
+		OutsideGuesses: input.OutsideGuesses,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *MapHandler) RemoveBoundary(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.removeMapBoundaryUseCase.Execute(c.Request.Context(), mapuc.RemoveMapBoundaryInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *MapHandler) GetBounds(c *gin.Context) {
+	userID, _ := middleware.GetAuthenticatedUserID(c)
+	output, err := h.getMapBoundsUseCase.Execute(c.Request.Context(), mapuc.GetMapInput{
+		MapId:  c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
 func (h *MapHandler) SetupRoutes() {
-	h.router.POST("/maps", middleware.AuthMiddleware(h.jwtService), h.CreateMap)
+	h.router.GET("/maps", h.ListPublicMaps)
+	h.router.POST("/maps", middleware.AuthMiddleware(h.accessTokens, nil), h.CreateMap)
+	h.router.GET("/maps/:id", middleware.OptionalAuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeMapsRead), h.GetMap)
+	h.router.PUT("/maps/:id/visibility", middleware.AuthMiddleware(h.accessTokens, nil), h.ChangeVisibility)
+	h.router.POST("/maps/:id/publish", middleware.AuthMiddleware(h.accessTokens, nil), h.Publish)
+	h.router.PATCH("/maps/:id", middleware.AuthMiddleware(h.accessTokens, nil), h.UpdateMap)
+	h.router.POST("/maps/:id/locations", middleware.AuthMiddleware(h.accessTokens, nil), h.AddLocations)
+	h.router.POST("/maps/:id/locations/lint", middleware.AuthMiddleware(h.accessTokens, nil), h.LintLocations)
+	h.router.PATCH("/maps/:id/locations/:location", middleware.AuthMiddleware(h.accessTokens, nil), h.UpdateLocation)
+	h.router.DELETE("/maps/:id/locations/:location", middleware.AuthMiddleware(h.accessTokens, nil), h.RemoveLocation)
+	h.router.GET("/maps/:id/revisions", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeMapsRead), h.ListRevisions)
+	h.router.GET("/maps/:id/revisions/diff", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeMapsRead), h.DiffRevisions)
+	h.router.POST("/maps/:id/revert", middleware.AuthMiddleware(h.accessTokens, nil), h.Revert)
+	h.router.GET("/maps/search", middleware.OptionalAuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeMapsRead), h.SearchMaps)
+	h.router.GET("/maps/categories", h.ListCategories)
+	h.router.POST("/maps/:id/fork", middleware.AuthMiddleware(h.accessTokens, nil), h.Fork)
+	h.router.PUT("/maps/:id/like", middleware.AuthMiddleware(h.accessTokens, nil), h.Like)
+	h.router.DELETE("/maps/:id/like", middleware.AuthMiddleware(h.accessTokens, nil), h.Unlike)
+	h.router.PUT("/maps/:id/rating", middleware.AuthMiddleware(h.accessTokens, nil), h.Rate)
+	h.router.DELETE("/maps/:id/rating", middleware.AuthMiddleware(h.accessTokens, nil), h.RemoveRating)
+	h.router.GET("/maps/:id/difficulty", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeMapsRead), h.GetDifficulty)
+	h.router.GET("/maps/:id/bounds", middleware.OptionalAuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeMapsRead), h.GetBounds)
+	h.router.PUT("/maps/:id/boundary", middleware.AuthMiddleware(h.accessTokens, nil), h.SetBoundary)
+	h.router.DELETE("/maps/:id/boundary", middleware.AuthMiddleware(h.accessTokens, nil), h.RemoveBoundary)
+	h.router.GET("/maps/:id/collaborators", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeMapsRead), h.ListCollaborators)
+	h.router.PUT("/maps/:id/collaborators", middleware.AuthMiddleware(h.accessTokens, nil), h.AddCollaborator)
+	h.router.DELETE("/maps/:id/collaborators/:user", middleware.AuthMiddleware(h.accessTokens, nil), h.RemoveCollaborator)
+	h.router.POST("/maps/:id/transfer", middleware.AuthMiddleware(h.accessTokens, nil), h.TransferOwnership)
+	h.router.GET("/maps/invitations", middleware.AuthMiddleware(h.accessTokens, nil), h.ListInvitations)
+	h.router.POST("/maps/invitations/:id/accept", middleware.AuthMiddleware(h.accessTokens, nil), h.AcceptInvitation)
+	h.router.DELETE("/maps/invitations/:id", middleware.AuthMiddleware(h.accessTokens, nil), h.DeclineInvitation)
 }
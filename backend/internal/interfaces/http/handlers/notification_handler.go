@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/notification"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
+	"gorm.io/gorm"
+)
+
+// notificationHeartbeatInterval keeps idle streams from being closed by proxies.
+const notificationHeartbeatInterval = 25 * time.Second
+
+type NotificationHandler struct {
+	listNotificationsUseCase        *notification.ListNotificationsUseCase
+	markNotificationReadUseCase     *notification.MarkNotificationReadUseCase
+	markAllNotificationsReadUseCase *notification.MarkAllNotificationsReadUseCase
+	broker                          services.NotificationBroker
+	accessTokens                    *auth.AuthenticateAccessTokenUseCase
+	router                          *gin.Engine
+}
+
+func NewNotificationHandler(db *gorm.DB, router *gin.Engine, broker services.NotificationBroker) *NotificationHandler {
+	notificationRepository := repositories.NewNotificationPgRepository(db)
+	return &NotificationHandler{
+		listNotificationsUseCase:        notification.NewListNotificationsUseCase(notificationRepository),
+		markNotificationReadUseCase:     notification.NewMarkNotificationReadUseCase(notificationRepository),
+		markAllNotificationsReadUseCase: notification.NewMarkAllNotificationsReadUseCase(notificationRepository),
+		broker:                          broker,
+		accessTokens:                    auth.NewAuthenticateAccessTokenUseCase(services.NewJwtService(), repositories.NewUserPgRepository(db)),
+		router:                          router,
+	}
+}
+
+func (h *NotificationHandler) List(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.ListNotificationsRequest
+	if err := c.ShouldBindQuery(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.listNotificationsUseCase.Execute(c.Request.Context(), notification.ListNotificationsInput{
+		UserId:     userID,
+		UnreadOnly: input.Unread,
+		Page:       input.Page,
+		PageSize:   input.PageSize,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.markNotificationReadUseCase.Execute(c.Request.Context(), notification.MarkNotificationReadInput{
+		UserId:         userID,
+		NotificationId: c.Param("id"),
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.markAllNotificationsReadUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// Stream pushes the user's new notifications as Server-Sent Events until the client disconnects.
+// Notifications created while the client was away are fetched from the inbox instead.
+func (h *NotificationHandler) Stream(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	notifications, cancel := h.broker.Subscribe(userID)
+	defer cancel()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(notificationHeartbeatInterval)
+	defer heartbeat.Stop()
+	c.Stream(func(io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case n, ok := <-notifications:
+			if !ok {
+				return false
+			}
+			c.SSEvent("notification", notification.ToNotificationOutput(n))
+			return true
+		case <-heartbeat.C:
+			c.SSEvent("ping", "")
+			return true
+		}
+	})
+}
+
+func (h *NotificationHandler) SetupRoutes() {
+	notifications := h.router.Group("/notifications", middleware.AuthMiddleware(h.accessTokens, nil))
+	notifications.GET("", h.List)
+	notifications.GET("/stream", h.Stream)
+	notifications.POST("/read", h.MarkAllRead)
+	notifications.POST("/:id/read", h.MarkRead)
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
+	"gorm.io/gorm"
+)
+
+type PersonalAccessTokenHandler struct {
+	createUseCase *auth.CreatePersonalAccessTokenUseCase
+	listUseCase   *auth.ListPersonalAccessTokensUseCase
+	revokeUseCase *auth.RevokePersonalAccessTokenUseCase
+	accessTokens  *auth.AuthenticateAccessTokenUseCase
+	router        *gin.Engine
+	db            *gorm.DB
+}
+
+func NewPersonalAccessTokenHandler(db *gorm.DB, router *gin.Engine) *PersonalAccessTokenHandler {
+	tokenRepository := repositories.NewPersonalAccessTokenPgRepository(db)
+	return &PersonalAccessTokenHandler{
+		db:            db,
+		router:        router,
+		createUseCase: auth.NewCreatePersonalAccessTokenUseCase(tokenRepository, services.NewPersonalAccessTokenService()),
+		listUseCase:   auth.NewListPersonalAccessTokensUseCase(tokenRepository),
+		revokeUseCase: auth.NewRevokePersonalAccessTokenUseCase(tokenRepository),
+		accessTokens:  auth.NewAuthenticateAccessTokenUseCase(services.NewJwtService(), repositories.NewUserPgRepository(db)),
+	}
+}
+
+func toPersonalAccessTokenResponse(output auth.PersonalAccessTokenOutput) dtos.PersonalAccessTokenResponse {
+	return dtos.PersonalAccessTokenResponse{
+		ID:          output.ID,
+		Name:        output.Name,
+		TokenPrefix: output.TokenPrefix,
+		Scopes:      output.Scopes,
+		ExpiresAt:   output.ExpiresAt,
+		LastUsedAt:  output.LastUsedAt,
+		CreatedAt:   output.CreatedAt,
+	}
+}
+
+func (h *PersonalAccessTokenHandler) Create(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.CreatePersonalAccessTokenRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.createUseCase.Execute(c.Request.Context(), auth.CreatePersonalAccessTokenInput{
+		UserId:    userID,
+		Name:      input.Name,
+		Scopes:    input.Scopes,
+		ExpiresIn: time.Duration(input.ExpiresInDays) * 24 * time.Hour,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dtos.CreatePersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: toPersonalAccessTokenResponse(output.PersonalAccessTokenOutput),
+		Token:                       output.Token,
+	})
+}
+
+func (h *PersonalAccessTokenHandler) List(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.listUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	response := make([]dtos.PersonalAccessTokenResponse, len(output))
+	for i, token := range output {
+		response[i] = toPersonalAccessTokenResponse(token)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PersonalAccessTokenHandler) Revoke(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	err := h.revokeUseCase.Execute(c.Request.Context(), auth.RevokePersonalAccessTokenInput{
+		UserId:  userID,
+		TokenId: c.Param("id"),
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *PersonalAccessTokenHandler) SetupRoutes() {
+	// Managing tokens requires a signed-in session; a personal access token cannot mint or revoke tokens.
+	tokensGroup := h.router.Group("/auth/tokens", middleware.AuthMiddleware(h.accessTokens, nil))
+	tokensGroup.GET("", h.List)
+	tokensGroup.POST("", h.Create)
+	tokensGroup.DELETE("/:id", h.Revoke)
+}
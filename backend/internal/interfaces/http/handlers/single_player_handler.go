@@ -5,11 +5,14 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	"github.com/mvcris/maya-guessr/backend/internal/core/events"
 	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
 	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
 	singleplayer "github.com/mvcris/maya-guessr/backend/internal/core/use_cases/single_player"
 	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
 	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/memory"
 	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
 	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
 	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
@@ -18,19 +21,34 @@ import (
 
 type SinglePlayerHandler struct {
 	createSinglePlayerGameUseCase *singleplayer.CreateSinglePlayerGameUseCase
-	jwtService                    *services.JwtService
+	guessUseCase                  *singleplayer.SinglePlayerGuessUseCase
+	getCurrentRoundUseCase        *singleplayer.GetCurrentRoundUseCase
+	personalAccessTokens          *auth.AuthenticatePersonalAccessTokenUseCase
+	accessTokens                  *auth.AuthenticateAccessTokenUseCase
 	router                        *gin.Engine
 }
 
-func NewSinglePlayerHandler(db *gorm.DB, router *gin.Engine) *SinglePlayerHandler {
+// NewSinglePlayerHandler publishes the round and game events of guesses to publisher.
+func NewSinglePlayerHandler(db *gorm.DB, router *gin.Engine, publisher events.Publisher) *SinglePlayerHandler {
 	singlePlayerGameRepository := repositories.NewSinglePlayerGamePgRepository(db)
 	singlePlayerRoundRepository := repositories.NewSinglePlayerRoundPgRepository(db)
-	locationRepository := repositories.NewLocationPgRepository(db)
+	locationSampler := services.NewSpatialLocationSampler(repositories.NewLocationPgRepository(db), memory.NewLocationStrataMemoryCache(), services.NewGeoService(), services.DefaultLocationSamplerConfig())
+	userRepository := repositories.NewUserPgRepository(db)
+	personalAccessTokenRepository := repositories.NewPersonalAccessTokenPgRepository(db)
 	txManager := localgorm.NewGormTransactionManager(db)
 	jwtService := services.NewJwtService()
+	mapRepository := repositories.NewMapPgRepository(db)
+	panoramas := services.NewPanoramaRegistryFromEnv()
+	seenLocations, err := singleplayer.SeenLocationsConfigFromEnv()
+	if err != nil {
+		panic(err)
+	}
 	return &SinglePlayerHandler{
-		createSinglePlayerGameUseCase: singleplayer.NewCreateSinglePlayerGameUseCase(singlePlayerGameRepository, singlePlayerRoundRepository, locationRepository, txManager),
-		jwtService:                    jwtService,
+		createSinglePlayerGameUseCase: singleplayer.NewCreateSinglePlayerGameUseCase(singlePlayerGameRepository, singlePlayerRoundRepository, locationSampler, mapRepository, services.NewMapAuthorizationService(repositories.NewMapCollaboratorPgRepository(db)), panoramas, txManager, seenLocations),
+		guessUseCase:                  singleplayer.NewSinglePlayerGuessUseCase(singlePlayerGameRepository, singlePlayerRoundRepository, repositories.NewUserStatsPgRepository(db), repositories.NewLeaderboardPgRepository(db), mapRepository, panoramas, txManager, services.NewGeoService(), publisher),
+		getCurrentRoundUseCase:        singleplayer.NewGetCurrentRoundUseCase(singlePlayerGameRepository, singlePlayerRoundRepository, panoramas, txManager),
+		personalAccessTokens:          auth.NewAuthenticatePersonalAccessTokenUseCase(personalAccessTokenRepository, userRepository, services.NewPersonalAccessTokenService()),
+		accessTokens:                  auth.NewAuthenticateAccessTokenUseCase(jwtService, userRepository),
 		router:                        router,
 	}
 }
@@ -53,6 +71,8 @@ func (h *SinglePlayerHandler) CreateGame(c *gin.Context) {
 		MapId:                 input.MapId,
 		Mode:                  entities.SinglePlayerGameMode(input.Mode),
 		RoundSecondsDuration:  input.RoundSecondsDuration,
+		Difficulty:            input.Difficulty,
+		Tags:                  input.Tags,
 	})
 	if err != nil {
 		httppkg.RespondError(c, err)
@@ -65,9 +85,79 @@ func (h *SinglePlayerHandler) CreateGame(c *gin.Context) {
 		MapId:     output.MapId,
 		Mode:      output.Mode,
 		CreatedAt: output.CreatedAt,
+		Round:     toSinglePlayerRoundResponse(output.Round),
+	})
+}
+
+func (h *SinglePlayerHandler) Guess(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+
+	var input dtos.SinglePlayerGuessRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	output, err := h.guessUseCase.Execute(c.Request.Context(), singleplayer.SinglePlayerGuessInput{
+		GameId:         c.Param("id"),
+		RoundId:        c.Param("roundId"),
+		UserId:         userID,
+		GuessLatitude:  *input.Latitude,
+		GuessLongitude: *input.Longitude,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+
+	response := dtos.SinglePlayerGuessResponse{
+		Score:          output.Score,
+		TotalScore:     output.TotalScore,
+		GameEnded:      output.GameEnded,
+		GuessLatitude:  output.GuessLatitude,
+		GuessLongitude: output.GuessLongitude,
+		LocationNote:   output.LocationNote,
+	}
+	if output.NextRound != nil {
+		nextRound := toSinglePlayerRoundResponse(*output.NextRound)
+		response.NextRound = &nextRound
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *SinglePlayerHandler) GetCurrentRound(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+
+	output, err := h.getCurrentRoundUseCase.Execute(c.Request.Context(), singleplayer.GetCurrentRoundInput{
+		GameId: c.Param("id"),
+		UserId: userID,
 	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toSinglePlayerRoundResponse(output))
+}
+
+func toSinglePlayerRoundResponse(round singleplayer.SinglePlayerRoundOutput) dtos.SinglePlayerRoundResponse {
+	return dtos.SinglePlayerRoundResponse{
+		ID:          round.ID,
+		RoundNumber: round.RoundNumber,
+		Panorama:    round.Panorama,
+	}
 }
 
 func (h *SinglePlayerHandler) SetupRoutes() {
-	h.router.POST("/single-player/games", middleware.AuthMiddleware(h.jwtService), h.CreateGame)
+	h.router.POST("/single-player/games", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeGamesPlay), h.CreateGame)
+	h.router.GET("/single-player/games/:id/rounds/current", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeGamesPlay), h.GetCurrentRound)
+	h.router.POST("/single-player/games/:id/rounds/:roundId/guess", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeGamesPlay), h.Guess)
 }
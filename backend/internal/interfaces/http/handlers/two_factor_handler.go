@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
+	"gorm.io/gorm"
+)
+
+type TwoFactorHandler struct {
+	getStatusUseCase             *auth.GetTwoFactorStatusUseCase
+	enrollUseCase                *auth.EnrollTwoFactorUseCase
+	confirmUseCase               *auth.ConfirmTwoFactorUseCase
+	disableUseCase               *auth.DisableTwoFactorUseCase
+	regenerateBackupCodesUseCase *auth.RegenerateBackupCodesUseCase
+	verifyUseCase                *auth.VerifyTwoFactorUseCase
+	accessTokens                 *auth.AuthenticateAccessTokenUseCase
+	router                       *gin.Engine
+	db                           *gorm.DB
+}
+
+func NewTwoFactorHandler(db *gorm.DB, router *gin.Engine) *TwoFactorHandler {
+	userRepository := repositories.NewUserPgRepository(db)
+	refreshTokenRepository := repositories.NewRefreshTokenPgRepository(db)
+	twoFactorRepository := repositories.NewTwoFactorPgRepository(db)
+	backupCodeRepository := repositories.NewTwoFactorBackupCodePgRepository(db)
+	txManager := localgorm.NewGormTransactionManager(db)
+	jwtService := services.NewJwtService()
+	totpService := services.NewTotpService("Maya Guessr")
+	return &TwoFactorHandler{
+		db:                           db,
+		router:                       router,
+		getStatusUseCase:             auth.NewGetTwoFactorStatusUseCase(twoFactorRepository, backupCodeRepository),
+		enrollUseCase:                auth.NewEnrollTwoFactorUseCase(userRepository, twoFactorRepository, totpService),
+		confirmUseCase:               auth.NewConfirmTwoFactorUseCase(twoFactorRepository, backupCodeRepository, txManager, totpService),
+		disableUseCase:               auth.NewDisableTwoFactorUseCase(twoFactorRepository, backupCodeRepository, txManager, totpService),
+		regenerateBackupCodesUseCase: auth.NewRegenerateBackupCodesUseCase(twoFactorRepository, backupCodeRepository, txManager, totpService),
+		verifyUseCase:                auth.NewVerifyTwoFactorUseCase(userRepository, twoFactorRepository, backupCodeRepository, refreshTokenRepository, txManager, totpService, jwtService),
+		accessTokens:                 auth.NewAuthenticateAccessTokenUseCase(jwtService, userRepository),
+	}
+}
+
+func (h *TwoFactorHandler) GetStatus(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.getStatusUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dtos.TwoFactorStatusResponse{
+		Enabled:              output.Enabled,
+		BackupCodesRemaining: output.BackupCodesRemaining,
+	})
+}
+
+func (h *TwoFactorHandler) Enroll(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.enrollUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dtos.TwoFactorEnrollResponse{
+		Secret:     output.Secret,
+		OtpauthUri: output.OtpauthUri,
+	})
+}
+
+func (h *TwoFactorHandler) Confirm(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.confirmUseCase.Execute(c.Request.Context(), auth.ConfirmTwoFactorInput{
+		UserId: userID,
+		Code:   input.Code,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dtos.TwoFactorBackupCodesResponse{BackupCodes: output.BackupCodes})
+}
+
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := h.disableUseCase.Execute(c.Request.Context(), auth.DisableTwoFactorInput{
+		UserId: userID,
+		Code:   input.Code,
+	}); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TwoFactorHandler) RegenerateBackupCodes(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.regenerateBackupCodesUseCase.Execute(c.Request.Context(), auth.RegenerateBackupCodesInput{
+		UserId: userID,
+		Code:   input.Code,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dtos.TwoFactorBackupCodesResponse{BackupCodes: output.BackupCodes})
+}
+
+func (h *TwoFactorHandler) Verify(c *gin.Context) {
+	var input dtos.TwoFactorVerifyRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	output, err := h.verifyUseCase.Execute(c.Request.Context(), auth.VerifyTwoFactorInput{
+		ChallengeToken: input.ChallengeToken,
+		Code:           input.Code,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dtos.LoginResponse{
+		AccessToken:  output.AccessToken,
+		RefreshToken: output.RefreshToken,
+	})
+}
+
+func (h *TwoFactorHandler) SetupRoutes() {
+	twoFactorGroup := h.router.Group("/auth/2fa")
+	twoFactorGroup.POST("/verify", h.Verify)
+
+	authenticated := twoFactorGroup.Group("", middleware.AuthMiddleware(h.accessTokens, nil))
+	authenticated.GET("", h.GetStatus)
+	authenticated.POST("/enroll", h.Enroll)
+	authenticated.POST("/confirm", h.Confirm)
+	authenticated.POST("/disable", h.Disable)
+	authenticated.POST("/backup-codes", h.RegenerateBackupCodes)
+}
@@ -4,22 +4,81 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
+	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
+	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/achievement"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
 	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/user"
+	localgorm "github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm"
 	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/gorm/repositories"
+	"github.com/mvcris/maya-guessr/backend/internal/infrastructure/mail"
 	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
 	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/dtos"
+	"github.com/mvcris/maya-guessr/backend/internal/interfaces/http/middleware"
 	"gorm.io/gorm"
 )
 
 type UserHandler struct {
 	db *gorm.DB
 	router *gin.Engine
+	accessTokens *auth.AuthenticateAccessTokenUseCase
+	personalAccessTokens *auth.AuthenticatePersonalAccessTokenUseCase
 	createUserUseCase *user.CreateUserUseCase
+	updateProfileUseCase *user.UpdateProfileUseCase
+	changePasswordUseCase *user.ChangePasswordUseCase
+	requestEmailChangeUseCase *user.RequestEmailChangeUseCase
+	requestReauthenticationUseCase *user.RequestReauthenticationUseCase
+	confirmEmailChangeUseCase *user.ConfirmEmailChangeUseCase
+	getProfileUseCase *user.GetProfileUseCase
+	getUserStatsUseCase *user.GetUserStatsUseCase
+	listUserAchievementsUseCase *achievement.ListUserAchievementsUseCase
+	exportPersonalDataUseCase *user.ExportPersonalDataUseCase
+	scheduleAccountDeletionUseCase *user.ScheduleAccountDeletionUseCase
+	cancelAccountDeletionUseCase *user.CancelAccountDeletionUseCase
 }
 
 func NewUserHandler(db *gorm.DB, router *gin.Engine) *UserHandler {
 	userPgRepository := repositories.NewUserPgRepository(db)
-	return &UserHandler{ db: db, router: router, createUserUseCase: user.NewCreateUserUseCase(userPgRepository)}
+	refreshTokenRepository := repositories.NewRefreshTokenPgRepository(db)
+	emailChangeRequestRepository := repositories.NewEmailChangeRequestPgRepository(db)
+	userStatsRepository := repositories.NewUserStatsPgRepository(db)
+	userAchievementRepository := repositories.NewUserAchievementPgRepository(db)
+	personalAccessTokenRepository := repositories.NewPersonalAccessTokenPgRepository(db)
+	accountDeletionRepository := repositories.NewAccountDeletionPgRepository(db)
+	personalDataRepository := repositories.NewPersonalDataPgRepository(db)
+	txManager := localgorm.NewGormTransactionManager(db)
+	emailVerificationService := services.NewEmailVerificationServiceFromEnv(mail.NewMailerFromEnv())
+	reauthenticationService := services.NewReauthenticationService(repositories.NewReauthenticationCodePgRepository(db), emailVerificationService)
+	return &UserHandler{
+		db: db,
+		router: router,
+		accessTokens: auth.NewAuthenticateAccessTokenUseCase(services.NewJwtService(), userPgRepository),
+		personalAccessTokens: auth.NewAuthenticatePersonalAccessTokenUseCase(personalAccessTokenRepository, userPgRepository, services.NewPersonalAccessTokenService()),
+		createUserUseCase: user.NewCreateUserUseCase(userPgRepository),
+		updateProfileUseCase: user.NewUpdateProfileUseCase(userPgRepository),
+		changePasswordUseCase: user.NewChangePasswordUseCase(userPgRepository, refreshTokenRepository, reauthenticationService, txManager),
+		requestEmailChangeUseCase: user.NewRequestEmailChangeUseCase(userPgRepository, emailChangeRequestRepository, txManager, emailVerificationService, reauthenticationService),
+		requestReauthenticationUseCase: user.NewRequestReauthenticationUseCase(userPgRepository, reauthenticationService),
+		confirmEmailChangeUseCase: user.NewConfirmEmailChangeUseCase(userPgRepository, emailChangeRequestRepository, txManager, emailVerificationService),
+		getProfileUseCase: user.NewGetProfileUseCase(userPgRepository, userStatsRepository),
+		getUserStatsUseCase: user.NewGetUserStatsUseCase(userPgRepository, userStatsRepository),
+		listUserAchievementsUseCase: achievement.NewListUserAchievementsUseCase(userPgRepository, userAchievementRepository),
+		exportPersonalDataUseCase: user.NewExportPersonalDataUseCase(personalDataRepository, accountDeletionRepository),
+		scheduleAccountDeletionUseCase: user.NewScheduleAccountDeletionUseCase(userPgRepository, accountDeletionRepository, refreshTokenRepository, txManager),
+		cancelAccountDeletionUseCase: user.NewCancelAccountDeletionUseCase(accountDeletionRepository),
+	}
+}
+
+func toUserResponse(u *entities.User) dtos.CreateUserResponse {
+	return dtos.CreateUserResponse{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Username:  u.Username,
+		Role:      string(u.Role),
+		CreatedAt: u.CreatedAt,
+	}
 }
 
 func (h *UserHandler) CreateUser(c *gin.Context) {
@@ -43,6 +102,202 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 	c.JSON(http.StatusOK, output)
 }
 
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	u, err := h.updateProfileUseCase.Execute(c.Request.Context(), user.UpdateProfileInput{
+		UserId:   userID,
+		Name:     input.Name,
+		Username: input.Username,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toUserResponse(u))
+}
+
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	err := h.changePasswordUseCase.Execute(c.Request.Context(), user.ChangePasswordInput{
+		UserId:           userID,
+		CurrentPassword:  input.CurrentPassword,
+		ConfirmationCode: input.ConfirmationCode,
+		NewPassword:      input.NewPassword,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) RequestEmailChange(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.RequestEmailChangeRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	err := h.requestEmailChangeUseCase.Execute(c.Request.Context(), user.RequestEmailChangeInput{
+		UserId:           userID,
+		NewEmail:         input.Email,
+		Password:         input.Password,
+		ConfirmationCode: input.ConfirmationCode,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (h *UserHandler) RequestReauthentication(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.requestReauthenticationUseCase.Execute(c.Request.Context(), userID); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
+
+func (h *UserHandler) ConfirmEmailChange(c *gin.Context) {
+	var input dtos.ConfirmEmailChangeRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	u, err := h.confirmEmailChangeUseCase.Execute(c.Request.Context(), input.Token)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toUserResponse(u))
+}
+
+func (h *UserHandler) GetProfile(c *gin.Context) {
+	output, err := h.getProfileUseCase.Execute(c.Request.Context(), c.Param("user"))
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *UserHandler) GetStats(c *gin.Context) {
+	output, err := h.getUserStatsUseCase.Execute(c.Request.Context(), c.Param("user"))
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *UserHandler) ListAchievements(c *gin.Context) {
+	output, err := h.listUserAchievementsUseCase.Execute(c.Request.Context(), c.Param("user"))
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+func (h *UserHandler) ExportPersonalData(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	output, err := h.exportPersonalDataUseCase.Execute(c.Request.Context(), userID)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="maya-guessr-data.json"`)
+	c.IndentedJSON(http.StatusOK, output)
+}
+
+func (h *UserHandler) DeleteMe(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	var input dtos.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	deletion, err := h.scheduleAccountDeletionUseCase.Execute(c.Request.Context(), user.ScheduleAccountDeletionInput{
+		UserId:             userID,
+		Password:           input.Password,
+		MapsAction:         entities.AccountDeletionMapsAction(input.Maps),
+		TransferToUsername: input.TransferTo,
+	})
+	if err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, dtos.AccountDeletionResponse{
+		MapsAction:   string(deletion.MapsAction),
+		ScheduledFor: deletion.ScheduledFor,
+	})
+}
+
+func (h *UserHandler) CancelDeletion(c *gin.Context) {
+	userID, ok := middleware.GetAuthenticatedUserID(c)
+	if !ok {
+		httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+		return
+	}
+	if err := h.cancelAccountDeletionUseCase.Execute(c.Request.Context(), userID); err != nil {
+		httppkg.RespondError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
 func (h *UserHandler) SetupRoutes() {
 	h.router.POST("/users", h.CreateUser)
-}
\ No newline at end of file
+	// The emailed token proves ownership of the new address, so confirming needs no session.
+	h.router.POST("/users/email/confirm", h.ConfirmEmailChange)
+	// Gin needs sibling wildcards to share a name: :user is the username for the profile and the
+	// user id for the stats and achievements.
+	h.router.GET("/users/:user", h.GetProfile)
+	h.router.GET("/users/:user/stats", middleware.AuthMiddleware(h.accessTokens, h.personalAccessTokens, entities.TokenScopeStatsRead), h.GetStats)
+	h.router.GET("/users/:user/achievements", h.ListAchievements)
+
+	me := h.router.Group("/users/me", middleware.AuthMiddleware(h.accessTokens, nil))
+	me.PATCH("", h.UpdateMe)
+	me.PUT("/password", h.ChangePassword)
+	me.POST("/email", h.RequestEmailChange)
+	me.POST("/reauthentication", h.RequestReauthentication)
+	me.GET("/export", h.ExportPersonalData)
+	me.DELETE("", h.DeleteMe)
+	me.DELETE("/deletion", h.CancelDeletion)
+}
@@ -4,8 +4,9 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mvcris/maya-guessr/backend/internal/core/entities"
 	coreerrors "github.com/mvcris/maya-guessr/backend/internal/core/errors"
-	"github.com/mvcris/maya-guessr/backend/internal/core/services"
+	"github.com/mvcris/maya-guessr/backend/internal/core/use_cases/auth"
 	httppkg "github.com/mvcris/maya-guessr/backend/internal/interfaces/http"
 )
 
@@ -16,6 +17,9 @@ const UserIDKey contextKey = "user_id"
 // UserIDContextKey is the string key used for storing user_id in Gin context.
 const UserIDContextKey = "user_id"
 
+// UserRoleContextKey is the string key used for storing the user's role in Gin context.
+const UserRoleContextKey = "user_role"
+
 // GetAuthenticatedUserID returns the authenticated user's ID from the request context, set by AuthMiddleware.
 // Returns (userID, true) if present, ("", false) otherwise.
 func GetAuthenticatedUserID(c *gin.Context) (string, bool) {
@@ -30,31 +34,109 @@ func GetAuthenticatedUserID(c *gin.Context) (string, bool) {
 	return userID, true
 }
 
-func AuthMiddleware(jwtService *services.JwtService) gin.HandlerFunc {
+// bearerToken returns the token of a "Bearer" Authorization header.
+func bearerToken(c *gin.Context) (string, bool) {
+	parts := strings.SplitN(c.GetHeader("Authorization"), " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return "", false
+	}
+	return strings.TrimSpace(parts[1]), true
+}
+
+// GetAuthenticatedUserRole returns the authenticated user's role from the request context, set by AuthMiddleware.
+// Returns (role, true) if present, ("", false) otherwise.
+func GetAuthenticatedUserRole(c *gin.Context) (entities.UserRole, bool) {
+	roleVal, ok := c.Get(UserRoleContextKey)
+	if !ok {
+		return "", false
+	}
+	role, ok := roleVal.(entities.UserRole)
+	return role, ok
+}
+
+// AuthMiddleware authenticates requests carrying an access token or, on routes declaring scopes,
+// a personal access token granted all of them. Routes without scopes, or built with a nil
+// personalAccessTokens, reject personal access tokens so they can never reach account management.
+// Either way the user is checked against the database, so banned users are turned away at once.
+func AuthMiddleware(accessTokens *auth.AuthenticateAccessTokenUseCase, personalAccessTokens *auth.AuthenticatePersonalAccessTokenUseCase, scopes ...entities.TokenScope) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
+		tokenString, ok := bearerToken(c)
+		if !ok {
 			httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
 			c.Abort()
 			return
 		}
 
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-			httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
-			c.Abort()
+		if personalAccessTokens != nil && personalAccessTokens.IsPersonalAccessToken(tokenString) {
+			authenticatePersonalAccessToken(c, personalAccessTokens, tokenString, scopes)
 			return
 		}
 
-		tokenString := strings.TrimSpace(parts[1])
-		claims, err := jwtService.ValidateAccessToken(tokenString)
+		output, err := accessTokens.Execute(c.Request.Context(), tokenString)
 		if err != nil {
-			httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+			httppkg.RespondError(c, err)
 			c.Abort()
 			return
 		}
 
-		c.Set(UserIDContextKey, claims.UserId)
+		c.Set(UserIDContextKey, output.UserId)
+		c.Set(UserRoleContextKey, output.Role)
+		c.Next()
+	}
+}
+
+// OptionalAuthMiddleware authenticates requests carrying a token like AuthMiddleware and lets
+// anonymous requests through, for routes whose response depends on who is asking.
+func OptionalAuthMiddleware(accessTokens *auth.AuthenticateAccessTokenUseCase, personalAccessTokens *auth.AuthenticatePersonalAccessTokenUseCase, scopes ...entities.TokenScope) gin.HandlerFunc {
+	authenticate := AuthMiddleware(accessTokens, personalAccessTokens, scopes...)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		authenticate(c)
+	}
+}
+
+func authenticatePersonalAccessToken(c *gin.Context, personalAccessTokens *auth.AuthenticatePersonalAccessTokenUseCase, tokenString string, scopes []entities.TokenScope) {
+	if len(scopes) == 0 {
+		httppkg.RespondError(c, coreerrors.Forbidden("personal access tokens cannot be used for this route"))
+		c.Abort()
+		return
+	}
+	output, err := personalAccessTokens.Execute(c.Request.Context(), tokenString)
+	if err != nil {
+		httppkg.RespondError(c, err)
+		c.Abort()
+		return
+	}
+	if !output.Token.HasScopes(scopes...) {
+		httppkg.RespondError(c, coreerrors.Forbidden("token is missing a required scope"))
+		c.Abort()
+		return
+	}
+
+	c.Set(UserIDContextKey, output.UserId)
+	c.Set(UserRoleContextKey, output.Role)
+	c.Next()
+}
+
+// RequireRole only lets through users having one of roles; admins are always let through.
+// It must run after AuthMiddleware, which reads the role from the database. The admin use cases
+// still check the actor again inside their own transaction.
+func RequireRole(roles ...entities.UserRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, ok := GetAuthenticatedUserRole(c)
+		if !ok {
+			httppkg.RespondError(c, coreerrors.Unauthorized("invalid or missing token"))
+			c.Abort()
+			return
+		}
+		if !role.Satisfies(roles...) {
+			httppkg.RespondError(c, coreerrors.Forbidden("insufficient permissions"))
+			c.Abort()
+			return
+		}
 		c.Next()
 	}
 }